@@ -0,0 +1,132 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+)
+
+const subscriberBufferSize = 64
+
+type subscriber struct {
+	estateID uuid.UUID
+	ch       chan domain.Event
+}
+
+// broker is an in-process event broker, it keeps the latest events in a bounded history
+// so reconnecting subscribers can resume from their last received event id
+type broker struct {
+	mu          sync.Mutex
+	history     []domain.Event
+	historySize int
+	subscribers map[*subscriber]struct{}
+	closed      bool
+}
+
+func NewBroker(historySize int) *broker {
+	return &broker{
+		historySize: historySize,
+		subscribers: map[*subscriber]struct{}{},
+	}
+}
+
+// Publish stores event into history and fan out to subscribers of the same estate.
+// Slow subscribers whose buffer is full are dropped, they are expected to reconnect using Last-Event-ID
+func (b *broker) Publish(ctx context.Context, event domain.Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.history = append(b.history, event)
+	if len(b.history) > b.historySize {
+		b.history = b.history[len(b.history)-b.historySize:]
+	}
+
+	for sub := range b.subscribers {
+		if sub.estateID != event.EstateID {
+			continue
+		}
+		select {
+		case sub.ch <- event:
+		default:
+			b.unsubscribe(sub)
+		}
+	}
+}
+
+// Subscribe returns channel of estate events, replaying events after lastEventID when it is still in history.
+// The channel is closed when ctx is done or the subscriber is too slow
+func (b *broker) Subscribe(ctx context.Context, estateID uuid.UUID, lastEventID string) (<-chan domain.Event, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil, domain.ErrorEventStreamUnavailable
+	}
+
+	replay := b.replayAfter(estateID, lastEventID)
+	sub := &subscriber{
+		estateID: estateID,
+		ch:       make(chan domain.Event, len(replay)+subscriberBufferSize),
+	}
+	for _, event := range replay {
+		sub.ch <- event
+	}
+	b.subscribers[sub] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.unsubscribe(sub)
+	}()
+
+	return sub.ch, nil
+}
+
+func (b *broker) replayAfter(estateID uuid.UUID, lastEventID string) []domain.Event {
+	if lastEventID == "" {
+		return nil
+	}
+
+	start := -1
+	for i, event := range b.history {
+		if event.ID.String() == lastEventID {
+			start = i + 1
+			break
+		}
+	}
+	if start < 0 {
+		return nil
+	}
+
+	var events []domain.Event
+	for _, event := range b.history[start:] {
+		if event.EstateID == estateID {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// Close end every subscription and reject new ones, so streams do not hold the server open while it shuts down.
+// Clients reconnect with their last event id
+func (b *broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for sub := range b.subscribers {
+		b.unsubscribe(sub)
+	}
+}
+
+// unsubscribe must be called while holding the lock
+func (b *broker) unsubscribe(sub *subscriber) {
+	if _, ok := b.subscribers[sub]; !ok {
+		return
+	}
+	delete(b.subscribers, sub)
+	close(sub.ch)
+}
@@ -0,0 +1,105 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBroker_PublishSubscribe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := NewBroker(10)
+	estateID := uuid.New()
+
+	ch, err := b.Subscribe(ctx, estateID, "")
+	assert.NoError(t, err)
+
+	other := domain.NewEvent(domain.EventEstateCreated, uuid.New(), uuid.New(), domain.EstateEventData{})
+	event := domain.NewEvent(domain.EventTreePlanted, uuid.New(), estateID, domain.TreeEventData{Height: 10})
+	b.Publish(ctx, other)
+	b.Publish(ctx, event)
+
+	got := <-ch
+	assert.Equal(t, event, got)
+	assert.Len(t, ch, 0)
+}
+
+func TestBroker_SubscribeResume(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := NewBroker(2)
+	estateID := uuid.New()
+
+	first := domain.NewEvent(domain.EventEstateCreated, uuid.New(), estateID, domain.EstateEventData{})
+	second := domain.NewEvent(domain.EventTreePlanted, uuid.New(), estateID, domain.TreeEventData{})
+	third := domain.NewEvent(domain.EventTreePlanted, uuid.New(), estateID, domain.TreeEventData{})
+	b.Publish(ctx, first)
+	b.Publish(ctx, second)
+	b.Publish(ctx, third)
+
+	tests := []struct {
+		name        string
+		lastEventID string
+		expected    []domain.Event
+	}{
+		{
+			name:        "Resume from event in history",
+			lastEventID: second.ID.String(),
+			expected:    []domain.Event{third},
+		},
+		{
+			name:        "Event evicted from history",
+			lastEventID: first.ID.String(),
+			expected:    nil,
+		},
+		{
+			name:        "No last event id",
+			lastEventID: "",
+			expected:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ch, err := b.Subscribe(ctx, estateID, tt.lastEventID)
+			assert.NoError(t, err)
+
+			var got []domain.Event
+			for len(ch) > 0 {
+				got = append(got, <-ch)
+			}
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestBroker_SubscribeClosedOnContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	b := NewBroker(10)
+	ch, err := b.Subscribe(ctx, uuid.New(), "")
+	assert.NoError(t, err)
+
+	cancel()
+	_, ok := <-ch
+	assert.False(t, ok)
+}
+
+func TestBroker_Close(t *testing.T) {
+	b := NewBroker(10)
+	ch, err := b.Subscribe(context.Background(), uuid.New(), "")
+	assert.NoError(t, err)
+
+	b.Close()
+	_, ok := <-ch
+	assert.False(t, ok)
+
+	_, err = b.Subscribe(context.Background(), uuid.New(), "")
+	assert.Equal(t, domain.ErrorEventStreamUnavailable, err)
+}
@@ -0,0 +1,7522 @@
+// Package api provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version (devel) DO NOT EDIT.
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	ApiKeyAuthScopes = "ApiKeyAuth.Scopes"
+)
+
+// Defines values for ApiKeyRole.
+const (
+	Admin   ApiKeyRole = "admin"
+	Planter ApiKeyRole = "planter"
+	Viewer  ApiKeyRole = "viewer"
+)
+
+// Defines values for DroneStatus.
+const (
+	Available   DroneStatus = "available"
+	Maintenance DroneStatus = "maintenance"
+	Retired     DroneStatus = "retired"
+)
+
+// Defines values for EstateEventType.
+const (
+	EstateEventTypeDronePlanDistanceChanged EstateEventType = "drone_plan.distance_changed"
+	EstateEventTypeEstateCreated            EstateEventType = "estate.created"
+	EstateEventTypeEstateDeleted            EstateEventType = "estate.deleted"
+	EstateEventTypeEstateResized            EstateEventType = "estate.resized"
+	EstateEventTypeTreePlanted              EstateEventType = "tree.planted"
+	EstateEventTypeTreeRemoved              EstateEventType = "tree.removed"
+	EstateEventTypeTreeUpdated              EstateEventType = "tree.updated"
+)
+
+// Defines values for MissionStatus.
+const (
+	Aborted    MissionStatus = "aborted"
+	Completed  MissionStatus = "completed"
+	InProgress MissionStatus = "in_progress"
+	Planned    MissionStatus = "planned"
+)
+
+// Defines values for WebhookEventType.
+const (
+	WebhookEventTypeEstateCreated WebhookEventType = "estate.created"
+	WebhookEventTypeEstateDeleted WebhookEventType = "estate.deleted"
+	WebhookEventTypeEstateResized WebhookEventType = "estate.resized"
+	WebhookEventTypeTreePlanted   WebhookEventType = "tree.planted"
+	WebhookEventTypeTreeRemoved   WebhookEventType = "tree.removed"
+	WebhookEventTypeTreeUpdated   WebhookEventType = "tree.updated"
+)
+
+// Defines values for GetEstateIdDronePlanProfileParamsFormat.
+const (
+	GetEstateIdDronePlanProfileParamsFormatJson GetEstateIdDronePlanProfileParamsFormat = "json"
+	GetEstateIdDronePlanProfileParamsFormatSvg  GetEstateIdDronePlanProfileParamsFormat = "svg"
+)
+
+// Defines values for GetEstateIdDronePlanRenderParamsFormat.
+const (
+	GetEstateIdDronePlanRenderParamsFormatPng GetEstateIdDronePlanRenderParamsFormat = "png"
+	GetEstateIdDronePlanRenderParamsFormatSvg GetEstateIdDronePlanRenderParamsFormat = "svg"
+)
+
+// Defines values for GetEstateIdExportParamsFormat.
+const (
+	Json   GetEstateIdExportParamsFormat = "json"
+	Ndjson GetEstateIdExportParamsFormat = "ndjson"
+)
+
+// AltitudeProfileResponse defines model for AltitudeProfileResponse.
+type AltitudeProfileResponse struct {
+	HorizontalDistance int     `json:"horizontal_distance"`
+	HorizontalShare    float32 `json:"horizontal_share"`
+
+	// LargestClimbs Largest climbs first, takeoff excluded
+	LargestClimbs    []Climb        `json:"largest_climbs"`
+	Points           []ProfilePoint `json:"points"`
+	VerticalDistance int            `json:"vertical_distance"`
+
+	// VerticalShare Part of the total distance flown climbing or descending, between 0 and 1
+	VerticalShare float32 `json:"vertical_share"`
+}
+
+// ApiKey defines model for ApiKey.
+type ApiKey struct {
+	CreatedAt time.Time           `json:"created_at"`
+	EstateId  *openapi_types.UUID `json:"estate_id,omitempty"`
+	Id        openapi_types.UUID  `json:"id"`
+	Name      string              `json:"name"`
+	Prefix    string              `json:"prefix"`
+	RevokedAt *time.Time          `json:"revoked_at,omitempty"`
+	Role      ApiKeyRole          `json:"role"`
+}
+
+// ApiKeyRole defines model for ApiKeyRole.
+type ApiKeyRole string
+
+// ChargingPad defines model for ChargingPad.
+type ChargingPad struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+// ChargingPads defines model for ChargingPads.
+type ChargingPads struct {
+	Pads []ChargingPad `json:"pads"`
+}
+
+// ClearanceDeviation defines model for ClearanceDeviation.
+type ClearanceDeviation struct {
+	// Altitude Lowest altitude flown over the tree
+	Altitude float64 `json:"altitude"`
+
+	// Clearance Altitude above the tree, negative when the drone flew lower than its top
+	Clearance       float64 `json:"clearance"`
+	PlannedAltitude int     `json:"planned_altitude"`
+	TreeHeight      int     `json:"tree_height"`
+	X               int     `json:"x"`
+	Y               int     `json:"y"`
+}
+
+// Climb defines model for Climb.
+type Climb struct {
+	// Altitude Altitude reached over the plot
+	Altitude int `json:"altitude"`
+	Distance int `json:"distance"`
+
+	// Height Altitude gained
+	Height int `json:"height"`
+	X      int `json:"x"`
+	Y      int `json:"y"`
+}
+
+// CreateApiKeyRequest defines model for CreateApiKeyRequest.
+type CreateApiKeyRequest struct {
+	// EstateId Scope the key to this estate, all estates when omitted
+	EstateId *openapi_types.UUID `json:"estate_id,omitempty"`
+	Name     string              `json:"name"`
+	Role     ApiKeyRole          `json:"role"`
+}
+
+// CreateApiKeyResponse defines model for CreateApiKeyResponse.
+type CreateApiKeyResponse struct {
+	CreatedAt time.Time           `json:"created_at"`
+	EstateId  *openapi_types.UUID `json:"estate_id,omitempty"`
+	Id        openapi_types.UUID  `json:"id"`
+
+	// Key Raw API key, send it as X-API-Key header
+	Key       string     `json:"key"`
+	Name      string     `json:"name"`
+	Prefix    string     `json:"prefix"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	Role      ApiKeyRole `json:"role"`
+}
+
+// CreateDroneRequest defines model for CreateDroneRequest.
+type CreateDroneRequest struct {
+	// ClimbSpeed Vertical speed in meters per second
+	ClimbSpeed float64 `json:"climb_speed"`
+
+	// CruiseSpeed Horizontal speed in meters per second
+	CruiseSpeed float64 `json:"cruise_speed"`
+	MaxAltitude int     `json:"max_altitude"`
+
+	// MaxRange Meters flown on one battery, landing included
+	MaxRange int    `json:"max_range"`
+	Model    string `json:"model"`
+	Name     string `json:"name"`
+}
+
+// CreateEstateRequest defines model for CreateEstateRequest.
+type CreateEstateRequest struct {
+	Length int `json:"length"`
+	Width  int `json:"width"`
+}
+
+// CreateEstateResponse defines model for CreateEstateResponse.
+type CreateEstateResponse struct {
+	Id *openapi_types.UUID `json:"id,omitempty"`
+}
+
+// CreateMissionRequest With drone_id the mission is planned within the range of that drone, max_distance can not be given too
+type CreateMissionRequest struct {
+	Drone       *string             `json:"drone,omitempty"`
+	DroneId     *openapi_types.UUID `json:"drone_id,omitempty"`
+	MaxDistance *int                `json:"max_distance,omitempty"`
+	Pilot       *string             `json:"pilot,omitempty"`
+}
+
+// CreateTreeRequest defines model for CreateTreeRequest.
+type CreateTreeRequest struct {
+	Height int `json:"height"`
+	X      int `json:"x"`
+	Y      int `json:"y"`
+}
+
+// CreateTreeResponse defines model for CreateTreeResponse.
+type CreateTreeResponse struct {
+	Id *openapi_types.UUID `json:"id,omitempty"`
+}
+
+// CreateWebhookRequest defines model for CreateWebhookRequest.
+type CreateWebhookRequest struct {
+	// EstateId Only deliver events of this estate, every estate when omitted
+	EstateId *openapi_types.UUID `json:"estate_id,omitempty"`
+
+	// EventTypes Only deliver these event types, every event type when empty
+	EventTypes *[]WebhookEventType `json:"event_types,omitempty"`
+
+	// Secret Signing secret, generated when omitted
+	Secret *string `json:"secret,omitempty"`
+
+	// Url Absolute http or https url, loopback, private and link-local hosts are rejected
+	Url string `json:"url"`
+}
+
+// CreateWebhookResponse defines model for CreateWebhookResponse.
+type CreateWebhookResponse struct {
+	CreatedAt  time.Time           `json:"created_at"`
+	EstateId   *openapi_types.UUID `json:"estate_id,omitempty"`
+	EventTypes []WebhookEventType  `json:"event_types"`
+	Id         openapi_types.UUID  `json:"id"`
+	Secret     string              `json:"secret"`
+	Url        string              `json:"url"`
+}
+
+// Drone defines model for Drone.
+type Drone struct {
+	ClimbSpeed  float64            `json:"climb_speed"`
+	CreatedAt   time.Time          `json:"created_at"`
+	CruiseSpeed float64            `json:"cruise_speed"`
+	Id          openapi_types.UUID `json:"id"`
+	MaxAltitude int                `json:"max_altitude"`
+	MaxRange    int                `json:"max_range"`
+	Model       string             `json:"model"`
+	Name        string             `json:"name"`
+	Status      DroneStatus        `json:"status"`
+	UpdatedAt   time.Time          `json:"updated_at"`
+}
+
+// DroneStatus defines model for DroneStatus.
+type DroneStatus string
+
+// Estate defines model for Estate.
+type Estate struct {
+	// Draft Set on sandboxes cloned from another estate
+	Draft  bool               `json:"draft"`
+	Id     openapi_types.UUID `json:"id"`
+	Length int                `json:"length"`
+
+	// SourceEstateId Estate the sandbox was cloned from, unset once that estate is deleted
+	SourceEstateId *openapi_types.UUID `json:"source_estate_id,omitempty"`
+	Width          int                 `json:"width"`
+}
+
+// EstateEvent defines model for EstateEvent.
+type EstateEvent struct {
+	Data       *map[string]interface{} `json:"data,omitempty"`
+	EstateId   openapi_types.UUID      `json:"estate_id"`
+	Id         openapi_types.UUID      `json:"id"`
+	OccurredAt time.Time               `json:"occurred_at"`
+	Type       EstateEventType         `json:"type"`
+}
+
+// EstateEventType defines model for EstateEvent.Type.
+type EstateEventType string
+
+// EstatePlan defines model for EstatePlan.
+type EstatePlan struct {
+	Count    int `json:"count"`
+	Distance int `json:"distance"`
+	Max      int `json:"max"`
+	Median   int `json:"median"`
+	Min      int `json:"min"`
+}
+
+// EstateSnapshot defines model for EstateSnapshot.
+type EstateSnapshot struct {
+	DroneRoutes  *[]SnapshotDroneRoute  `json:"drone_routes,omitempty"`
+	Estate       SnapshotEstate         `json:"estate"`
+	ExportedAt   *time.Time             `json:"exported_at,omitempty"`
+	Measurements *[]SnapshotMeasurement `json:"measurements,omitempty"`
+	Trees        []SnapshotTree         `json:"trees"`
+	Version      int                    `json:"version"`
+}
+
+// GetEstateDronePlanResponse defines model for GetEstateDronePlanResponse.
+type GetEstateDronePlanResponse struct {
+	Distance *int `json:"distance,omitempty"`
+
+	// FlightTime Seconds the drone flies until rest, landing included, only given with drone_id
+	FlightTime *int `json:"flight_time,omitempty"`
+	Rest       *struct {
+		X *int `json:"x,omitempty"`
+		Y *int `json:"y,omitempty"`
+	} `json:"rest,omitempty"`
+}
+
+// GetEstateTreeStatsResponse defines model for GetEstateTreeStatsResponse.
+type GetEstateTreeStatsResponse struct {
+	Count  *int `json:"count,omitempty"`
+	Max    *int `json:"max,omitempty"`
+	Median *int `json:"median,omitempty"`
+	Min    *int `json:"min,omitempty"`
+}
+
+// ListApiKeysResponse defines model for ListApiKeysResponse.
+type ListApiKeysResponse struct {
+	ApiKeys []ApiKey `json:"api_keys"`
+}
+
+// ListDronesResponse defines model for ListDronesResponse.
+type ListDronesResponse struct {
+	Drones []Drone `json:"drones"`
+}
+
+// ListEstatesResponse defines model for ListEstatesResponse.
+type ListEstatesResponse struct {
+	Estates []Estate `json:"estates"`
+}
+
+// ListMissionsResponse defines model for ListMissionsResponse.
+type ListMissionsResponse struct {
+	Missions []Mission `json:"missions"`
+}
+
+// ListTreesResponse defines model for ListTreesResponse.
+type ListTreesResponse struct {
+	Trees []Tree `json:"trees"`
+}
+
+// ListWebhookDeadLettersResponse defines model for ListWebhookDeadLettersResponse.
+type ListWebhookDeadLettersResponse struct {
+	DeadLetters []WebhookDeadLetter `json:"dead_letters"`
+}
+
+// ListWebhooksResponse defines model for ListWebhooksResponse.
+type ListWebhooksResponse struct {
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+// Mission defines model for Mission.
+type Mission struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Distance Planned flight distance, landing included
+	Distance int    `json:"distance"`
+	Drone    string `json:"drone"`
+
+	// DroneId Drone of the fleet the mission is planned for
+	DroneId *openapi_types.UUID `json:"drone_id,omitempty"`
+
+	// DroneProfile Specification of the fleet drone when the mission was planned, later changes to the drone are not reflected
+	DroneProfile *MissionDroneProfile `json:"drone_profile,omitempty"`
+	EstateId     openapi_types.UUID   `json:"estate_id"`
+	FinishedAt   *time.Time           `json:"finished_at,omitempty"`
+	Id           openapi_types.UUID   `json:"id"`
+	MaxDistance  *int                 `json:"max_distance,omitempty"`
+	Pilot        string               `json:"pilot"`
+	StartedAt    *time.Time           `json:"started_at,omitempty"`
+	Status       MissionStatus        `json:"status"`
+
+	// Waypoints Waypoints in flight order, left out of mission lists
+	Waypoints *[]MissionWaypoint `json:"waypoints,omitempty"`
+}
+
+// MissionDroneProfile Specification of the fleet drone when the mission was planned, later changes to the drone are not reflected
+type MissionDroneProfile struct {
+	ClimbSpeed  float64 `json:"climb_speed"`
+	CruiseSpeed float64 `json:"cruise_speed"`
+	MaxAltitude int     `json:"max_altitude"`
+	MaxRange    int     `json:"max_range"`
+}
+
+// MissionStatus defines model for MissionStatus.
+type MissionStatus string
+
+// MissionWaypoint defines model for MissionWaypoint.
+type MissionWaypoint struct {
+	Altitude int `json:"altitude"`
+
+	// Distance Distance flown from takeoff until reaching the waypoint
+	Distance int `json:"distance"`
+	Sequence int `json:"sequence"`
+	X        int `json:"x"`
+	Y        int `json:"y"`
+}
+
+// Problem RFC 7807 problem details, clients should branch on code rather than title or detail
+type Problem struct {
+	// Code Stable machine readable error code
+	Code   string  `json:"code"`
+	Detail *string `json:"detail,omitempty"`
+
+	// Errors Field level details of validation failures
+	Errors *[]ProblemFieldError `json:"errors,omitempty"`
+
+	// RequestId Id of the request, also returned as X-Request-Id header
+	RequestId *string `json:"request_id,omitempty"`
+	Status    int     `json:"status"`
+	Title     string  `json:"title"`
+	Type      string  `json:"type"`
+}
+
+// ProblemFieldError defines model for ProblemFieldError.
+type ProblemFieldError struct {
+	// Field Path of the invalid field in the request body, or the name of the invalid parameter
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ProfilePoint defines model for ProfilePoint.
+type ProfilePoint struct {
+	Altitude int `json:"altitude"`
+
+	// Distance Horizontal distance flown since takeoff
+	Distance int `json:"distance"`
+	X        int `json:"x"`
+	Y        int `json:"y"`
+}
+
+// RecordTelemetryRequest defines model for RecordTelemetryRequest.
+type RecordTelemetryRequest struct {
+	Samples []TelemetrySample `json:"samples"`
+}
+
+// ResizeEstateRequest defines model for ResizeEstateRequest.
+type ResizeEstateRequest struct {
+	Length int `json:"length"`
+	Width  int `json:"width"`
+}
+
+// SandboxDiffResponse defines model for SandboxDiffResponse.
+type SandboxDiffResponse struct {
+	Changes        TreeChanges        `json:"changes"`
+	Sandbox        EstatePlan         `json:"sandbox"`
+	Source         EstatePlan         `json:"source"`
+	SourceEstateId openapi_types.UUID `json:"source_estate_id"`
+}
+
+// SnapshotDroneRoute defines model for SnapshotDroneRoute.
+type SnapshotDroneRoute struct {
+	Altitude int `json:"altitude"`
+	Route    int `json:"route"`
+	X        int `json:"x"`
+	Y        int `json:"y"`
+}
+
+// SnapshotEstate defines model for SnapshotEstate.
+type SnapshotEstate struct {
+	Id      openapi_types.UUID `json:"id"`
+	Length  int                `json:"length"`
+	Version int                `json:"version"`
+	Width   int                `json:"width"`
+}
+
+// SnapshotMeasurement defines model for SnapshotMeasurement.
+type SnapshotMeasurement struct {
+	Height     int                `json:"height"`
+	MeasuredAt time.Time          `json:"measured_at"`
+	TreeId     openapi_types.UUID `json:"tree_id"`
+}
+
+// SnapshotTree defines model for SnapshotTree.
+type SnapshotTree struct {
+	Height  int                `json:"height"`
+	Id      openapi_types.UUID `json:"id"`
+	Version int                `json:"version"`
+	X       int                `json:"x"`
+	Y       int                `json:"y"`
+}
+
+// Sortie defines model for Sortie.
+type Sortie struct {
+	// Distance Distance flown from takeoff to landing, ferry legs included
+	Distance int `json:"distance"`
+
+	// FerryDistance Distance flown from the pad to the first waypoint and from the last waypoint to the landing pad
+	FerryDistance int `json:"ferry_distance"`
+
+	// FlightTime Seconds flown from takeoff to landing
+	FlightTime int         `json:"flight_time"`
+	LandingPad ChargingPad `json:"landing_pad"`
+	Pad        ChargingPad `json:"pad"`
+	Sequence   int         `json:"sequence"`
+
+	// Waypoints Plots covered in flight order, sequence is their place in the whole drone route
+	Waypoints []MissionWaypoint `json:"waypoints"`
+}
+
+// SortiePlan defines model for SortiePlan.
+type SortiePlan struct {
+	BatterySwaps int `json:"battery_swaps"`
+
+	// Distance Distance flown by every sortie, ferry legs included
+	Distance      int `json:"distance"`
+	FerryDistance int `json:"ferry_distance"`
+
+	// FlightTime Seconds flown by every sortie, battery swaps excluded
+	FlightTime int      `json:"flight_time"`
+	Sorties    []Sortie `json:"sorties"`
+}
+
+// TelemetryPlot defines model for TelemetryPlot.
+type TelemetryPlot struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+// TelemetryReport defines model for TelemetryReport.
+type TelemetryReport struct {
+	// ActualDistance Distance of the flown plots and their highest altitudes, measured like the planned one
+	ActualDistance int `json:"actual_distance"`
+
+	// Coverage Part of the planned plots visited, between 0 and 1
+	Coverage       float64 `json:"coverage"`
+	DeviationCount int     `json:"deviation_count"`
+
+	// Deviations Tree plots flown over further than 1 meter from the planned altitude, lowest clearance first, at most 100
+	Deviations []ClearanceDeviation `json:"deviations"`
+
+	// MissedPlots Planned plots without samples in flight order, at most 100
+	MissedPlots []TelemetryPlot `json:"missed_plots"`
+
+	// OutsideSamples Samples outside of the estate, they are not mapped to a plot
+	OutsideSamples  int `json:"outside_samples"`
+	PlannedDistance int `json:"planned_distance"`
+	PlannedPlots    int `json:"planned_plots"`
+	Samples         int `json:"samples"`
+
+	// VisitedPlots Planned plots with at least one sample over them
+	VisitedPlots int `json:"visited_plots"`
+}
+
+// TelemetrySample defines model for TelemetrySample.
+type TelemetrySample struct {
+	Altitude   float64   `json:"altitude"`
+	RecordedAt time.Time `json:"recorded_at"`
+
+	// X Meters from the center of plot (1, 1) along the estate length
+	X float64 `json:"x"`
+
+	// Y Meters from the center of plot (1, 1) along the estate width
+	Y float64 `json:"y"`
+}
+
+// Tree defines model for Tree.
+type Tree struct {
+	Height int                `json:"height"`
+	Id     openapi_types.UUID `json:"id"`
+	X      int                `json:"x"`
+	Y      int                `json:"y"`
+}
+
+// TreeChanges defines model for TreeChanges.
+type TreeChanges struct {
+	Planted []Tree `json:"planted"`
+	Removed []Tree `json:"removed"`
+
+	// Updated Trees of the source with their height in the sandbox
+	Updated []Tree `json:"updated"`
+}
+
+// UpdateDroneRequest defines model for UpdateDroneRequest.
+type UpdateDroneRequest struct {
+	ClimbSpeed  *float64     `json:"climb_speed,omitempty"`
+	CruiseSpeed *float64     `json:"cruise_speed,omitempty"`
+	MaxAltitude *int         `json:"max_altitude,omitempty"`
+	MaxRange    *int         `json:"max_range,omitempty"`
+	Model       *string      `json:"model,omitempty"`
+	Name        *string      `json:"name,omitempty"`
+	Status      *DroneStatus `json:"status,omitempty"`
+}
+
+// UpdateMissionRequest defines model for UpdateMissionRequest.
+type UpdateMissionRequest struct {
+	Drone  *string        `json:"drone,omitempty"`
+	Pilot  *string        `json:"pilot,omitempty"`
+	Status *MissionStatus `json:"status,omitempty"`
+}
+
+// UpdateTreeRequest defines model for UpdateTreeRequest.
+type UpdateTreeRequest struct {
+	Height int `json:"height"`
+}
+
+// Webhook defines model for Webhook.
+type Webhook struct {
+	CreatedAt  time.Time           `json:"created_at"`
+	EstateId   *openapi_types.UUID `json:"estate_id,omitempty"`
+	EventTypes []WebhookEventType  `json:"event_types"`
+	Id         openapi_types.UUID  `json:"id"`
+	Url        string              `json:"url"`
+}
+
+// WebhookDeadLetter defines model for WebhookDeadLetter.
+type WebhookDeadLetter struct {
+	Attempts  int                `json:"attempts"`
+	Event     EstateEvent        `json:"event"`
+	Id        openapi_types.UUID `json:"id"`
+	LastError *string            `json:"last_error,omitempty"`
+	WebhookId openapi_types.UUID `json:"webhook_id"`
+}
+
+// WebhookEventType defines model for WebhookEventType.
+type WebhookEventType string
+
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey = string
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// Forbidden RFC 7807 problem details, clients should branch on code rather than title or detail
+type Forbidden = Problem
+
+// IdempotencyInProgress RFC 7807 problem details, clients should branch on code rather than title or detail
+type IdempotencyInProgress = Problem
+
+// IdempotencyKeyReused RFC 7807 problem details, clients should branch on code rather than title or detail
+type IdempotencyKeyReused = Problem
+
+// PreconditionFailed RFC 7807 problem details, clients should branch on code rather than title or detail
+type PreconditionFailed = Problem
+
+// PreconditionRequired RFC 7807 problem details, clients should branch on code rather than title or detail
+type PreconditionRequired = Problem
+
+// Unauthorized RFC 7807 problem details, clients should branch on code rather than title or detail
+type Unauthorized = Problem
+
+// GetDronesParams defines parameters for GetDrones.
+type GetDronesParams struct {
+	Status *DroneStatus `form:"status,omitempty" json:"status,omitempty"`
+	Limit  *int         `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int         `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetEstateParams defines parameters for GetEstate.
+type GetEstateParams struct {
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// PostEstateParams defines parameters for PostEstate.
+type PostEstateParams struct {
+	// IdempotencyKey Unique key chosen by the client to safely retry the request. A retry with the same key, query and body gets the original response with the Idempotent-Replayed header, keys are kept for 24 hours.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// PostEstateImportParams defines parameters for PostEstateImport.
+type PostEstateImportParams struct {
+	KeepIds *bool `form:"keep_ids,omitempty" json:"keep_ids,omitempty"`
+
+	// IdempotencyKey Unique key chosen by the client to safely retry the request. A retry with the same key, query and body gets the original response with the Idempotent-Replayed header, keys are kept for 24 hours.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// PatchEstateIdParams defines parameters for PatchEstateId.
+type PatchEstateIdParams struct {
+	// IfMatch ETag of the resource from the last read. The update is rejected with 412 when the resource was changed since then, and with 428 when the header is missing.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostEstateIdCloneParams defines parameters for PostEstateIdClone.
+type PostEstateIdCloneParams struct {
+	// IdempotencyKey Unique key chosen by the client to safely retry the request. A retry with the same key, query and body gets the original response with the Idempotent-Replayed header, keys are kept for 24 hours.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetEstateIdDronePlanParams defines parameters for GetEstateIdDronePlan.
+type GetEstateIdDronePlanParams struct {
+	MaxDistance *int `form:"max-distance,omitempty" json:"max-distance,omitempty"`
+
+	// DroneId Plan for a drone of the fleet, max-distance then comes from its range and can not be given
+	DroneId *openapi_types.UUID `form:"drone_id,omitempty" json:"drone_id,omitempty"`
+}
+
+// GetEstateIdDronePlanProfileParams defines parameters for GetEstateIdDronePlanProfile.
+type GetEstateIdDronePlanProfileParams struct {
+	Format *GetEstateIdDronePlanProfileParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetEstateIdDronePlanProfileParamsFormat defines parameters for GetEstateIdDronePlanProfile.
+type GetEstateIdDronePlanProfileParamsFormat string
+
+// GetEstateIdDronePlanRenderParams defines parameters for GetEstateIdDronePlanRender.
+type GetEstateIdDronePlanRenderParams struct {
+	Format      *GetEstateIdDronePlanRenderParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+	MaxDistance *int                                    `form:"max-distance,omitempty" json:"max-distance,omitempty"`
+
+	// DroneId Plan for a drone of the fleet, max-distance then comes from its range and can not be given
+	DroneId *openapi_types.UUID `form:"drone_id,omitempty" json:"drone_id,omitempty"`
+}
+
+// GetEstateIdDronePlanRenderParamsFormat defines parameters for GetEstateIdDronePlanRender.
+type GetEstateIdDronePlanRenderParamsFormat string
+
+// GetEstateIdDronePlanSortiesParams defines parameters for GetEstateIdDronePlanSorties.
+type GetEstateIdDronePlanSortiesParams struct {
+	DroneId openapi_types.UUID `form:"drone_id" json:"drone_id"`
+}
+
+// GetEstateIdEventsParams defines parameters for GetEstateIdEvents.
+type GetEstateIdEventsParams struct {
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// GetEstateIdExportParams defines parameters for GetEstateIdExport.
+type GetEstateIdExportParams struct {
+	Format *GetEstateIdExportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetEstateIdExportParamsFormat defines parameters for GetEstateIdExport.
+type GetEstateIdExportParamsFormat string
+
+// GetEstateIdMissionParams defines parameters for GetEstateIdMission.
+type GetEstateIdMissionParams struct {
+	Status *MissionStatus `form:"status,omitempty" json:"status,omitempty"`
+	Limit  *int           `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int           `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// PostEstateIdMissionParams defines parameters for PostEstateIdMission.
+type PostEstateIdMissionParams struct {
+	// IdempotencyKey Unique key chosen by the client to safely retry the request. A retry with the same key, query and body gets the original response with the Idempotent-Replayed header, keys are kept for 24 hours.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// PostEstateIdTreeParams defines parameters for PostEstateIdTree.
+type PostEstateIdTreeParams struct {
+	// IdempotencyKey Unique key chosen by the client to safely retry the request. A retry with the same key, query and body gets the original response with the Idempotent-Replayed header, keys are kept for 24 hours.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// PatchEstateIdTreeTreeIdParams defines parameters for PatchEstateIdTreeTreeId.
+type PatchEstateIdTreeTreeIdParams struct {
+	// IfMatch ETag of the resource from the last read. The update is rejected with 412 when the resource was changed since then, and with 428 when the header is missing.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostApiKeysJSONRequestBody defines body for PostApiKeys for application/json ContentType.
+type PostApiKeysJSONRequestBody = CreateApiKeyRequest
+
+// PostDronesJSONRequestBody defines body for PostDrones for application/json ContentType.
+type PostDronesJSONRequestBody = CreateDroneRequest
+
+// PatchDronesDroneIdJSONRequestBody defines body for PatchDronesDroneId for application/json ContentType.
+type PatchDronesDroneIdJSONRequestBody = UpdateDroneRequest
+
+// PostEstateJSONRequestBody defines body for PostEstate for application/json ContentType.
+type PostEstateJSONRequestBody = CreateEstateRequest
+
+// PostEstateImportJSONRequestBody defines body for PostEstateImport for application/json ContentType.
+type PostEstateImportJSONRequestBody = EstateSnapshot
+
+// PatchEstateIdJSONRequestBody defines body for PatchEstateId for application/json ContentType.
+type PatchEstateIdJSONRequestBody = ResizeEstateRequest
+
+// PutEstateIdChargingPadsJSONRequestBody defines body for PutEstateIdChargingPads for application/json ContentType.
+type PutEstateIdChargingPadsJSONRequestBody = ChargingPads
+
+// PostEstateIdMissionJSONRequestBody defines body for PostEstateIdMission for application/json ContentType.
+type PostEstateIdMissionJSONRequestBody = CreateMissionRequest
+
+// PatchEstateIdMissionMissionIdJSONRequestBody defines body for PatchEstateIdMissionMissionId for application/json ContentType.
+type PatchEstateIdMissionMissionIdJSONRequestBody = UpdateMissionRequest
+
+// PutEstateIdMissionMissionIdTelemetryJSONRequestBody defines body for PutEstateIdMissionMissionIdTelemetry for application/json ContentType.
+type PutEstateIdMissionMissionIdTelemetryJSONRequestBody = RecordTelemetryRequest
+
+// PostEstateIdTreeJSONRequestBody defines body for PostEstateIdTree for application/json ContentType.
+type PostEstateIdTreeJSONRequestBody = CreateTreeRequest
+
+// PatchEstateIdTreeTreeIdJSONRequestBody defines body for PatchEstateIdTreeTreeId for application/json ContentType.
+type PatchEstateIdTreeTreeIdJSONRequestBody = UpdateTreeRequest
+
+// PostWebhooksJSONRequestBody defines body for PostWebhooks for application/json ContentType.
+type PostWebhooksJSONRequestBody = CreateWebhookRequest
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// GetApiKeys request
+	GetApiKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiKeysWithBody request with any body
+	PostApiKeysWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiKeys(ctx context.Context, body PostApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiKeysId request
+	DeleteApiKeysId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDrones request
+	GetDrones(ctx context.Context, params *GetDronesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostDronesWithBody request with any body
+	PostDronesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostDrones(ctx context.Context, body PostDronesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDronesDroneId request
+	GetDronesDroneId(ctx context.Context, droneId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchDronesDroneIdWithBody request with any body
+	PatchDronesDroneIdWithBody(ctx context.Context, droneId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchDronesDroneId(ctx context.Context, droneId openapi_types.UUID, body PatchDronesDroneIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEstate request
+	GetEstate(ctx context.Context, params *GetEstateParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostEstateWithBody request with any body
+	PostEstateWithBody(ctx context.Context, params *PostEstateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostEstate(ctx context.Context, params *PostEstateParams, body PostEstateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostEstateImportWithBody request with any body
+	PostEstateImportWithBody(ctx context.Context, params *PostEstateImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostEstateImport(ctx context.Context, params *PostEstateImportParams, body PostEstateImportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteEstateId request
+	DeleteEstateId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEstateId request
+	GetEstateId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchEstateIdWithBody request with any body
+	PatchEstateIdWithBody(ctx context.Context, id openapi_types.UUID, params *PatchEstateIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchEstateId(ctx context.Context, id openapi_types.UUID, params *PatchEstateIdParams, body PatchEstateIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEstateIdChargingPads request
+	GetEstateIdChargingPads(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutEstateIdChargingPadsWithBody request with any body
+	PutEstateIdChargingPadsWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutEstateIdChargingPads(ctx context.Context, id openapi_types.UUID, body PutEstateIdChargingPadsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostEstateIdClone request
+	PostEstateIdClone(ctx context.Context, id openapi_types.UUID, params *PostEstateIdCloneParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEstateIdDiff request
+	GetEstateIdDiff(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEstateIdDronePlan request
+	GetEstateIdDronePlan(ctx context.Context, id openapi_types.UUID, params *GetEstateIdDronePlanParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEstateIdDronePlanProfile request
+	GetEstateIdDronePlanProfile(ctx context.Context, id openapi_types.UUID, params *GetEstateIdDronePlanProfileParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEstateIdDronePlanRender request
+	GetEstateIdDronePlanRender(ctx context.Context, id openapi_types.UUID, params *GetEstateIdDronePlanRenderParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEstateIdDronePlanSorties request
+	GetEstateIdDronePlanSorties(ctx context.Context, id openapi_types.UUID, params *GetEstateIdDronePlanSortiesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEstateIdEvents request
+	GetEstateIdEvents(ctx context.Context, id openapi_types.UUID, params *GetEstateIdEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEstateIdExport request
+	GetEstateIdExport(ctx context.Context, id openapi_types.UUID, params *GetEstateIdExportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEstateIdMission request
+	GetEstateIdMission(ctx context.Context, id openapi_types.UUID, params *GetEstateIdMissionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostEstateIdMissionWithBody request with any body
+	PostEstateIdMissionWithBody(ctx context.Context, id openapi_types.UUID, params *PostEstateIdMissionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostEstateIdMission(ctx context.Context, id openapi_types.UUID, params *PostEstateIdMissionParams, body PostEstateIdMissionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEstateIdMissionMissionId request
+	GetEstateIdMissionMissionId(ctx context.Context, id openapi_types.UUID, missionId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchEstateIdMissionMissionIdWithBody request with any body
+	PatchEstateIdMissionMissionIdWithBody(ctx context.Context, id openapi_types.UUID, missionId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchEstateIdMissionMissionId(ctx context.Context, id openapi_types.UUID, missionId openapi_types.UUID, body PatchEstateIdMissionMissionIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEstateIdMissionMissionIdTelemetry request
+	GetEstateIdMissionMissionIdTelemetry(ctx context.Context, id openapi_types.UUID, missionId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutEstateIdMissionMissionIdTelemetryWithBody request with any body
+	PutEstateIdMissionMissionIdTelemetryWithBody(ctx context.Context, id openapi_types.UUID, missionId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutEstateIdMissionMissionIdTelemetry(ctx context.Context, id openapi_types.UUID, missionId openapi_types.UUID, body PutEstateIdMissionMissionIdTelemetryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostEstateIdPromote request
+	PostEstateIdPromote(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEstateIdStats request
+	GetEstateIdStats(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEstateIdTree request
+	GetEstateIdTree(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostEstateIdTreeWithBody request with any body
+	PostEstateIdTreeWithBody(ctx context.Context, id openapi_types.UUID, params *PostEstateIdTreeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostEstateIdTree(ctx context.Context, id openapi_types.UUID, params *PostEstateIdTreeParams, body PostEstateIdTreeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteEstateIdTreeTreeId request
+	DeleteEstateIdTreeTreeId(ctx context.Context, id openapi_types.UUID, treeId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEstateIdTreeTreeId request
+	GetEstateIdTreeTreeId(ctx context.Context, id openapi_types.UUID, treeId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchEstateIdTreeTreeIdWithBody request with any body
+	PatchEstateIdTreeTreeIdWithBody(ctx context.Context, id openapi_types.UUID, treeId openapi_types.UUID, params *PatchEstateIdTreeTreeIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchEstateIdTreeTreeId(ctx context.Context, id openapi_types.UUID, treeId openapi_types.UUID, params *PatchEstateIdTreeTreeIdParams, body PatchEstateIdTreeTreeIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhooks request
+	GetWebhooks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWebhooksWithBody request with any body
+	PostWebhooksWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostWebhooks(ctx context.Context, body PostWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhooksDeadLetters request
+	GetWebhooksDeadLetters(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWebhooksDeadLettersIdRetry request
+	PostWebhooksDeadLettersIdRetry(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteWebhooksId request
+	DeleteWebhooksId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetApiKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiKeysRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiKeysWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiKeysRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiKeys(ctx context.Context, body PostApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiKeysRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiKeysId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiKeysIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDrones(ctx context.Context, params *GetDronesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDronesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostDronesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostDronesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostDrones(ctx context.Context, body PostDronesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostDronesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDronesDroneId(ctx context.Context, droneId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDronesDroneIdRequest(c.Server, droneId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchDronesDroneIdWithBody(ctx context.Context, droneId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchDronesDroneIdRequestWithBody(c.Server, droneId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchDronesDroneId(ctx context.Context, droneId openapi_types.UUID, body PatchDronesDroneIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchDronesDroneIdRequest(c.Server, droneId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEstate(ctx context.Context, params *GetEstateParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEstateRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostEstateWithBody(ctx context.Context, params *PostEstateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostEstateRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostEstate(ctx context.Context, params *PostEstateParams, body PostEstateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostEstateRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostEstateImportWithBody(ctx context.Context, params *PostEstateImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostEstateImportRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostEstateImport(ctx context.Context, params *PostEstateImportParams, body PostEstateImportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostEstateImportRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteEstateId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteEstateIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEstateId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEstateIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchEstateIdWithBody(ctx context.Context, id openapi_types.UUID, params *PatchEstateIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchEstateIdRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchEstateId(ctx context.Context, id openapi_types.UUID, params *PatchEstateIdParams, body PatchEstateIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchEstateIdRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEstateIdChargingPads(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEstateIdChargingPadsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutEstateIdChargingPadsWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutEstateIdChargingPadsRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutEstateIdChargingPads(ctx context.Context, id openapi_types.UUID, body PutEstateIdChargingPadsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutEstateIdChargingPadsRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostEstateIdClone(ctx context.Context, id openapi_types.UUID, params *PostEstateIdCloneParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostEstateIdCloneRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEstateIdDiff(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEstateIdDiffRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEstateIdDronePlan(ctx context.Context, id openapi_types.UUID, params *GetEstateIdDronePlanParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEstateIdDronePlanRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEstateIdDronePlanProfile(ctx context.Context, id openapi_types.UUID, params *GetEstateIdDronePlanProfileParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEstateIdDronePlanProfileRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEstateIdDronePlanRender(ctx context.Context, id openapi_types.UUID, params *GetEstateIdDronePlanRenderParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEstateIdDronePlanRenderRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEstateIdDronePlanSorties(ctx context.Context, id openapi_types.UUID, params *GetEstateIdDronePlanSortiesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEstateIdDronePlanSortiesRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEstateIdEvents(ctx context.Context, id openapi_types.UUID, params *GetEstateIdEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEstateIdEventsRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEstateIdExport(ctx context.Context, id openapi_types.UUID, params *GetEstateIdExportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEstateIdExportRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEstateIdMission(ctx context.Context, id openapi_types.UUID, params *GetEstateIdMissionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEstateIdMissionRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostEstateIdMissionWithBody(ctx context.Context, id openapi_types.UUID, params *PostEstateIdMissionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostEstateIdMissionRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostEstateIdMission(ctx context.Context, id openapi_types.UUID, params *PostEstateIdMissionParams, body PostEstateIdMissionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostEstateIdMissionRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEstateIdMissionMissionId(ctx context.Context, id openapi_types.UUID, missionId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEstateIdMissionMissionIdRequest(c.Server, id, missionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchEstateIdMissionMissionIdWithBody(ctx context.Context, id openapi_types.UUID, missionId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchEstateIdMissionMissionIdRequestWithBody(c.Server, id, missionId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchEstateIdMissionMissionId(ctx context.Context, id openapi_types.UUID, missionId openapi_types.UUID, body PatchEstateIdMissionMissionIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchEstateIdMissionMissionIdRequest(c.Server, id, missionId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEstateIdMissionMissionIdTelemetry(ctx context.Context, id openapi_types.UUID, missionId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEstateIdMissionMissionIdTelemetryRequest(c.Server, id, missionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutEstateIdMissionMissionIdTelemetryWithBody(ctx context.Context, id openapi_types.UUID, missionId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutEstateIdMissionMissionIdTelemetryRequestWithBody(c.Server, id, missionId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutEstateIdMissionMissionIdTelemetry(ctx context.Context, id openapi_types.UUID, missionId openapi_types.UUID, body PutEstateIdMissionMissionIdTelemetryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutEstateIdMissionMissionIdTelemetryRequest(c.Server, id, missionId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostEstateIdPromote(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostEstateIdPromoteRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEstateIdStats(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEstateIdStatsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEstateIdTree(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEstateIdTreeRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostEstateIdTreeWithBody(ctx context.Context, id openapi_types.UUID, params *PostEstateIdTreeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostEstateIdTreeRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostEstateIdTree(ctx context.Context, id openapi_types.UUID, params *PostEstateIdTreeParams, body PostEstateIdTreeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostEstateIdTreeRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteEstateIdTreeTreeId(ctx context.Context, id openapi_types.UUID, treeId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteEstateIdTreeTreeIdRequest(c.Server, id, treeId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEstateIdTreeTreeId(ctx context.Context, id openapi_types.UUID, treeId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEstateIdTreeTreeIdRequest(c.Server, id, treeId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchEstateIdTreeTreeIdWithBody(ctx context.Context, id openapi_types.UUID, treeId openapi_types.UUID, params *PatchEstateIdTreeTreeIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchEstateIdTreeTreeIdRequestWithBody(c.Server, id, treeId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchEstateIdTreeTreeId(ctx context.Context, id openapi_types.UUID, treeId openapi_types.UUID, params *PatchEstateIdTreeTreeIdParams, body PatchEstateIdTreeTreeIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchEstateIdTreeTreeIdRequest(c.Server, id, treeId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWebhooks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhooksRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostWebhooksWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostWebhooks(ctx context.Context, body PostWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWebhooksDeadLetters(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhooksDeadLettersRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostWebhooksDeadLettersIdRetry(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksDeadLettersIdRetryRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteWebhooksId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWebhooksIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetApiKeysRequest generates requests for GetApiKeys
+func NewGetApiKeysRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api-keys")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiKeysRequest calls the generic PostApiKeys builder with application/json body
+func NewPostApiKeysRequest(server string, body PostApiKeysJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiKeysRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiKeysRequestWithBody generates requests for PostApiKeys with any type of body
+func NewPostApiKeysRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api-keys")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiKeysIdRequest generates requests for DeleteApiKeysId
+func NewDeleteApiKeysIdRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api-keys/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDronesRequest generates requests for GetDrones
+func NewGetDronesRequest(server string, params *GetDronesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/drones")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostDronesRequest calls the generic PostDrones builder with application/json body
+func NewPostDronesRequest(server string, body PostDronesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostDronesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostDronesRequestWithBody generates requests for PostDrones with any type of body
+func NewPostDronesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/drones")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetDronesDroneIdRequest generates requests for GetDronesDroneId
+func NewGetDronesDroneIdRequest(server string, droneId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "drone_id", runtime.ParamLocationPath, droneId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/drones/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchDronesDroneIdRequest calls the generic PatchDronesDroneId builder with application/json body
+func NewPatchDronesDroneIdRequest(server string, droneId openapi_types.UUID, body PatchDronesDroneIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchDronesDroneIdRequestWithBody(server, droneId, "application/json", bodyReader)
+}
+
+// NewPatchDronesDroneIdRequestWithBody generates requests for PatchDronesDroneId with any type of body
+func NewPatchDronesDroneIdRequestWithBody(server string, droneId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "drone_id", runtime.ParamLocationPath, droneId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/drones/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetEstateRequest generates requests for GetEstate
+func NewGetEstateRequest(server string, params *GetEstateParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/estate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostEstateRequest calls the generic PostEstate builder with application/json body
+func NewPostEstateRequest(server string, params *PostEstateParams, body PostEstateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostEstateRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostEstateRequestWithBody generates requests for PostEstate with any type of body
+func NewPostEstateRequestWithBody(server string, params *PostEstateParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/estate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostEstateImportRequest calls the generic PostEstateImport builder with application/json body
+func NewPostEstateImportRequest(server string, params *PostEstateImportParams, body PostEstateImportJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostEstateImportRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostEstateImportRequestWithBody generates requests for PostEstateImport with any type of body
+func NewPostEstateImportRequestWithBody(server string, params *PostEstateImportParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/estate/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.KeepIds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keep_ids", runtime.ParamLocationQuery, *params.KeepIds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteEstateIdRequest generates requests for DeleteEstateId
+func NewDeleteEstateIdRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/estate/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetEstateIdRequest generates requests for GetEstateId
+func NewGetEstateIdRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/estate/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchEstateIdRequest calls the generic PatchEstateId builder with application/json body
+func NewPatchEstateIdRequest(server string, id openapi_types.UUID, params *PatchEstateIdParams, body PatchEstateIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchEstateIdRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPatchEstateIdRequestWithBody generates requests for PatchEstateId with any type of body
+func NewPatchEstateIdRequestWithBody(server string, id openapi_types.UUID, params *PatchEstateIdParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/estate/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetEstateIdChargingPadsRequest generates requests for GetEstateIdChargingPads
+func NewGetEstateIdChargingPadsRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/estate/%s/charging-pads", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutEstateIdChargingPadsRequest calls the generic PutEstateIdChargingPads builder with application/json body
+func NewPutEstateIdChargingPadsRequest(server string, id openapi_types.UUID, body PutEstateIdChargingPadsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutEstateIdChargingPadsRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPutEstateIdChargingPadsRequestWithBody generates requests for PutEstateIdChargingPads with any type of body
+func NewPutEstateIdChargingPadsRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/estate/%s/charging-pads", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostEstateIdCloneRequest generates requests for PostEstateIdClone
+func NewPostEstateIdCloneRequest(server string, id openapi_types.UUID, params *PostEstateIdCloneParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/estate/%s/clone", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetEstateIdDiffRequest generates requests for GetEstateIdDiff
+func NewGetEstateIdDiffRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/estate/%s/diff", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetEstateIdDronePlanRequest generates requests for GetEstateIdDronePlan
+func NewGetEstateIdDronePlanRequest(server string, id openapi_types.UUID, params *GetEstateIdDronePlanParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/estate/%s/drone-plan", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.MaxDistance != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max-distance", runtime.ParamLocationQuery, *params.MaxDistance); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DroneId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "drone_id", runtime.ParamLocationQuery, *params.DroneId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetEstateIdDronePlanProfileRequest generates requests for GetEstateIdDronePlanProfile
+func NewGetEstateIdDronePlanProfileRequest(server string, id openapi_types.UUID, params *GetEstateIdDronePlanProfileParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/estate/%s/drone-plan/profile", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetEstateIdDronePlanRenderRequest generates requests for GetEstateIdDronePlanRender
+func NewGetEstateIdDronePlanRenderRequest(server string, id openapi_types.UUID, params *GetEstateIdDronePlanRenderParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/estate/%s/drone-plan/render", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxDistance != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max-distance", runtime.ParamLocationQuery, *params.MaxDistance); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DroneId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "drone_id", runtime.ParamLocationQuery, *params.DroneId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetEstateIdDronePlanSortiesRequest generates requests for GetEstateIdDronePlanSorties
+func NewGetEstateIdDronePlanSortiesRequest(server string, id openapi_types.UUID, params *GetEstateIdDronePlanSortiesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/estate/%s/drone-plan/sorties", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "drone_id", runtime.ParamLocationQuery, params.DroneId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetEstateIdEventsRequest generates requests for GetEstateIdEvents
+func NewGetEstateIdEventsRequest(server string, id openapi_types.UUID, params *GetEstateIdEventsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/estate/%s/events", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.LastEventID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, *params.LastEventID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Last-Event-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetEstateIdExportRequest generates requests for GetEstateIdExport
+func NewGetEstateIdExportRequest(server string, id openapi_types.UUID, params *GetEstateIdExportParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/estate/%s/export", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetEstateIdMissionRequest generates requests for GetEstateIdMission
+func NewGetEstateIdMissionRequest(server string, id openapi_types.UUID, params *GetEstateIdMissionParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/estate/%s/mission", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostEstateIdMissionRequest calls the generic PostEstateIdMission builder with application/json body
+func NewPostEstateIdMissionRequest(server string, id openapi_types.UUID, params *PostEstateIdMissionParams, body PostEstateIdMissionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostEstateIdMissionRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPostEstateIdMissionRequestWithBody generates requests for PostEstateIdMission with any type of body
+func NewPostEstateIdMissionRequestWithBody(server string, id openapi_types.UUID, params *PostEstateIdMissionParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/estate/%s/mission", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetEstateIdMissionMissionIdRequest generates requests for GetEstateIdMissionMissionId
+func NewGetEstateIdMissionMissionIdRequest(server string, id openapi_types.UUID, missionId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "mission_id", runtime.ParamLocationPath, missionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/estate/%s/mission/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchEstateIdMissionMissionIdRequest calls the generic PatchEstateIdMissionMissionId builder with application/json body
+func NewPatchEstateIdMissionMissionIdRequest(server string, id openapi_types.UUID, missionId openapi_types.UUID, body PatchEstateIdMissionMissionIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchEstateIdMissionMissionIdRequestWithBody(server, id, missionId, "application/json", bodyReader)
+}
+
+// NewPatchEstateIdMissionMissionIdRequestWithBody generates requests for PatchEstateIdMissionMissionId with any type of body
+func NewPatchEstateIdMissionMissionIdRequestWithBody(server string, id openapi_types.UUID, missionId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "mission_id", runtime.ParamLocationPath, missionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/estate/%s/mission/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetEstateIdMissionMissionIdTelemetryRequest generates requests for GetEstateIdMissionMissionIdTelemetry
+func NewGetEstateIdMissionMissionIdTelemetryRequest(server string, id openapi_types.UUID, missionId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "mission_id", runtime.ParamLocationPath, missionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/estate/%s/mission/%s/telemetry", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutEstateIdMissionMissionIdTelemetryRequest calls the generic PutEstateIdMissionMissionIdTelemetry builder with application/json body
+func NewPutEstateIdMissionMissionIdTelemetryRequest(server string, id openapi_types.UUID, missionId openapi_types.UUID, body PutEstateIdMissionMissionIdTelemetryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutEstateIdMissionMissionIdTelemetryRequestWithBody(server, id, missionId, "application/json", bodyReader)
+}
+
+// NewPutEstateIdMissionMissionIdTelemetryRequestWithBody generates requests for PutEstateIdMissionMissionIdTelemetry with any type of body
+func NewPutEstateIdMissionMissionIdTelemetryRequestWithBody(server string, id openapi_types.UUID, missionId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "mission_id", runtime.ParamLocationPath, missionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/estate/%s/mission/%s/telemetry", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostEstateIdPromoteRequest generates requests for PostEstateIdPromote
+func NewPostEstateIdPromoteRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/estate/%s/promote", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetEstateIdStatsRequest generates requests for GetEstateIdStats
+func NewGetEstateIdStatsRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/estate/%s/stats", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetEstateIdTreeRequest generates requests for GetEstateIdTree
+func NewGetEstateIdTreeRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/estate/%s/tree", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostEstateIdTreeRequest calls the generic PostEstateIdTree builder with application/json body
+func NewPostEstateIdTreeRequest(server string, id openapi_types.UUID, params *PostEstateIdTreeParams, body PostEstateIdTreeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostEstateIdTreeRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPostEstateIdTreeRequestWithBody generates requests for PostEstateIdTree with any type of body
+func NewPostEstateIdTreeRequestWithBody(server string, id openapi_types.UUID, params *PostEstateIdTreeParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/estate/%s/tree", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteEstateIdTreeTreeIdRequest generates requests for DeleteEstateIdTreeTreeId
+func NewDeleteEstateIdTreeTreeIdRequest(server string, id openapi_types.UUID, treeId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tree_id", runtime.ParamLocationPath, treeId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/estate/%s/tree/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetEstateIdTreeTreeIdRequest generates requests for GetEstateIdTreeTreeId
+func NewGetEstateIdTreeTreeIdRequest(server string, id openapi_types.UUID, treeId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tree_id", runtime.ParamLocationPath, treeId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/estate/%s/tree/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchEstateIdTreeTreeIdRequest calls the generic PatchEstateIdTreeTreeId builder with application/json body
+func NewPatchEstateIdTreeTreeIdRequest(server string, id openapi_types.UUID, treeId openapi_types.UUID, params *PatchEstateIdTreeTreeIdParams, body PatchEstateIdTreeTreeIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchEstateIdTreeTreeIdRequestWithBody(server, id, treeId, params, "application/json", bodyReader)
+}
+
+// NewPatchEstateIdTreeTreeIdRequestWithBody generates requests for PatchEstateIdTreeTreeId with any type of body
+func NewPatchEstateIdTreeTreeIdRequestWithBody(server string, id openapi_types.UUID, treeId openapi_types.UUID, params *PatchEstateIdTreeTreeIdParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tree_id", runtime.ParamLocationPath, treeId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/estate/%s/tree/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetWebhooksRequest generates requests for GetWebhooks
+func NewGetWebhooksRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostWebhooksRequest calls the generic PostWebhooks builder with application/json body
+func NewPostWebhooksRequest(server string, body PostWebhooksJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostWebhooksRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostWebhooksRequestWithBody generates requests for PostWebhooks with any type of body
+func NewPostWebhooksRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetWebhooksDeadLettersRequest generates requests for GetWebhooksDeadLetters
+func NewGetWebhooksDeadLettersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/dead-letters")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostWebhooksDeadLettersIdRetryRequest generates requests for PostWebhooksDeadLettersIdRetry
+func NewPostWebhooksDeadLettersIdRetryRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/dead-letters/%s/retry", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteWebhooksIdRequest generates requests for DeleteWebhooksId
+func NewDeleteWebhooksIdRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetApiKeysWithResponse request
+	GetApiKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiKeysResponse, error)
+
+	// PostApiKeysWithBodyWithResponse request with any body
+	PostApiKeysWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiKeysResponse, error)
+
+	PostApiKeysWithResponse(ctx context.Context, body PostApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiKeysResponse, error)
+
+	// DeleteApiKeysIdWithResponse request
+	DeleteApiKeysIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiKeysIdResponse, error)
+
+	// GetDronesWithResponse request
+	GetDronesWithResponse(ctx context.Context, params *GetDronesParams, reqEditors ...RequestEditorFn) (*GetDronesResponse, error)
+
+	// PostDronesWithBodyWithResponse request with any body
+	PostDronesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDronesResponse, error)
+
+	PostDronesWithResponse(ctx context.Context, body PostDronesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDronesResponse, error)
+
+	// GetDronesDroneIdWithResponse request
+	GetDronesDroneIdWithResponse(ctx context.Context, droneId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetDronesDroneIdResponse, error)
+
+	// PatchDronesDroneIdWithBodyWithResponse request with any body
+	PatchDronesDroneIdWithBodyWithResponse(ctx context.Context, droneId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchDronesDroneIdResponse, error)
+
+	PatchDronesDroneIdWithResponse(ctx context.Context, droneId openapi_types.UUID, body PatchDronesDroneIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchDronesDroneIdResponse, error)
+
+	// GetEstateWithResponse request
+	GetEstateWithResponse(ctx context.Context, params *GetEstateParams, reqEditors ...RequestEditorFn) (*GetEstateResponse, error)
+
+	// PostEstateWithBodyWithResponse request with any body
+	PostEstateWithBodyWithResponse(ctx context.Context, params *PostEstateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEstateResponse, error)
+
+	PostEstateWithResponse(ctx context.Context, params *PostEstateParams, body PostEstateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEstateResponse, error)
+
+	// PostEstateImportWithBodyWithResponse request with any body
+	PostEstateImportWithBodyWithResponse(ctx context.Context, params *PostEstateImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEstateImportResponse, error)
+
+	PostEstateImportWithResponse(ctx context.Context, params *PostEstateImportParams, body PostEstateImportJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEstateImportResponse, error)
+
+	// DeleteEstateIdWithResponse request
+	DeleteEstateIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteEstateIdResponse, error)
+
+	// GetEstateIdWithResponse request
+	GetEstateIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetEstateIdResponse, error)
+
+	// PatchEstateIdWithBodyWithResponse request with any body
+	PatchEstateIdWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, params *PatchEstateIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchEstateIdResponse, error)
+
+	PatchEstateIdWithResponse(ctx context.Context, id openapi_types.UUID, params *PatchEstateIdParams, body PatchEstateIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchEstateIdResponse, error)
+
+	// GetEstateIdChargingPadsWithResponse request
+	GetEstateIdChargingPadsWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetEstateIdChargingPadsResponse, error)
+
+	// PutEstateIdChargingPadsWithBodyWithResponse request with any body
+	PutEstateIdChargingPadsWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutEstateIdChargingPadsResponse, error)
+
+	PutEstateIdChargingPadsWithResponse(ctx context.Context, id openapi_types.UUID, body PutEstateIdChargingPadsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutEstateIdChargingPadsResponse, error)
+
+	// PostEstateIdCloneWithResponse request
+	PostEstateIdCloneWithResponse(ctx context.Context, id openapi_types.UUID, params *PostEstateIdCloneParams, reqEditors ...RequestEditorFn) (*PostEstateIdCloneResponse, error)
+
+	// GetEstateIdDiffWithResponse request
+	GetEstateIdDiffWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetEstateIdDiffResponse, error)
+
+	// GetEstateIdDronePlanWithResponse request
+	GetEstateIdDronePlanWithResponse(ctx context.Context, id openapi_types.UUID, params *GetEstateIdDronePlanParams, reqEditors ...RequestEditorFn) (*GetEstateIdDronePlanResponse, error)
+
+	// GetEstateIdDronePlanProfileWithResponse request
+	GetEstateIdDronePlanProfileWithResponse(ctx context.Context, id openapi_types.UUID, params *GetEstateIdDronePlanProfileParams, reqEditors ...RequestEditorFn) (*GetEstateIdDronePlanProfileResponse, error)
+
+	// GetEstateIdDronePlanRenderWithResponse request
+	GetEstateIdDronePlanRenderWithResponse(ctx context.Context, id openapi_types.UUID, params *GetEstateIdDronePlanRenderParams, reqEditors ...RequestEditorFn) (*GetEstateIdDronePlanRenderResponse, error)
+
+	// GetEstateIdDronePlanSortiesWithResponse request
+	GetEstateIdDronePlanSortiesWithResponse(ctx context.Context, id openapi_types.UUID, params *GetEstateIdDronePlanSortiesParams, reqEditors ...RequestEditorFn) (*GetEstateIdDronePlanSortiesResponse, error)
+
+	// GetEstateIdEventsWithResponse request
+	GetEstateIdEventsWithResponse(ctx context.Context, id openapi_types.UUID, params *GetEstateIdEventsParams, reqEditors ...RequestEditorFn) (*GetEstateIdEventsResponse, error)
+
+	// GetEstateIdExportWithResponse request
+	GetEstateIdExportWithResponse(ctx context.Context, id openapi_types.UUID, params *GetEstateIdExportParams, reqEditors ...RequestEditorFn) (*GetEstateIdExportResponse, error)
+
+	// GetEstateIdMissionWithResponse request
+	GetEstateIdMissionWithResponse(ctx context.Context, id openapi_types.UUID, params *GetEstateIdMissionParams, reqEditors ...RequestEditorFn) (*GetEstateIdMissionResponse, error)
+
+	// PostEstateIdMissionWithBodyWithResponse request with any body
+	PostEstateIdMissionWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, params *PostEstateIdMissionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEstateIdMissionResponse, error)
+
+	PostEstateIdMissionWithResponse(ctx context.Context, id openapi_types.UUID, params *PostEstateIdMissionParams, body PostEstateIdMissionJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEstateIdMissionResponse, error)
+
+	// GetEstateIdMissionMissionIdWithResponse request
+	GetEstateIdMissionMissionIdWithResponse(ctx context.Context, id openapi_types.UUID, missionId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetEstateIdMissionMissionIdResponse, error)
+
+	// PatchEstateIdMissionMissionIdWithBodyWithResponse request with any body
+	PatchEstateIdMissionMissionIdWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, missionId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchEstateIdMissionMissionIdResponse, error)
+
+	PatchEstateIdMissionMissionIdWithResponse(ctx context.Context, id openapi_types.UUID, missionId openapi_types.UUID, body PatchEstateIdMissionMissionIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchEstateIdMissionMissionIdResponse, error)
+
+	// GetEstateIdMissionMissionIdTelemetryWithResponse request
+	GetEstateIdMissionMissionIdTelemetryWithResponse(ctx context.Context, id openapi_types.UUID, missionId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetEstateIdMissionMissionIdTelemetryResponse, error)
+
+	// PutEstateIdMissionMissionIdTelemetryWithBodyWithResponse request with any body
+	PutEstateIdMissionMissionIdTelemetryWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, missionId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutEstateIdMissionMissionIdTelemetryResponse, error)
+
+	PutEstateIdMissionMissionIdTelemetryWithResponse(ctx context.Context, id openapi_types.UUID, missionId openapi_types.UUID, body PutEstateIdMissionMissionIdTelemetryJSONRequestBody, reqEditors ...RequestEditorFn) (*PutEstateIdMissionMissionIdTelemetryResponse, error)
+
+	// PostEstateIdPromoteWithResponse request
+	PostEstateIdPromoteWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostEstateIdPromoteResponse, error)
+
+	// GetEstateIdStatsWithResponse request
+	GetEstateIdStatsWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetEstateIdStatsResponse, error)
+
+	// GetEstateIdTreeWithResponse request
+	GetEstateIdTreeWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetEstateIdTreeResponse, error)
+
+	// PostEstateIdTreeWithBodyWithResponse request with any body
+	PostEstateIdTreeWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, params *PostEstateIdTreeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEstateIdTreeResponse, error)
+
+	PostEstateIdTreeWithResponse(ctx context.Context, id openapi_types.UUID, params *PostEstateIdTreeParams, body PostEstateIdTreeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEstateIdTreeResponse, error)
+
+	// DeleteEstateIdTreeTreeIdWithResponse request
+	DeleteEstateIdTreeTreeIdWithResponse(ctx context.Context, id openapi_types.UUID, treeId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteEstateIdTreeTreeIdResponse, error)
+
+	// GetEstateIdTreeTreeIdWithResponse request
+	GetEstateIdTreeTreeIdWithResponse(ctx context.Context, id openapi_types.UUID, treeId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetEstateIdTreeTreeIdResponse, error)
+
+	// PatchEstateIdTreeTreeIdWithBodyWithResponse request with any body
+	PatchEstateIdTreeTreeIdWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, treeId openapi_types.UUID, params *PatchEstateIdTreeTreeIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchEstateIdTreeTreeIdResponse, error)
+
+	PatchEstateIdTreeTreeIdWithResponse(ctx context.Context, id openapi_types.UUID, treeId openapi_types.UUID, params *PatchEstateIdTreeTreeIdParams, body PatchEstateIdTreeTreeIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchEstateIdTreeTreeIdResponse, error)
+
+	// GetWebhooksWithResponse request
+	GetWebhooksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWebhooksResponse, error)
+
+	// PostWebhooksWithBodyWithResponse request with any body
+	PostWebhooksWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWebhooksResponse, error)
+
+	PostWebhooksWithResponse(ctx context.Context, body PostWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWebhooksResponse, error)
+
+	// GetWebhooksDeadLettersWithResponse request
+	GetWebhooksDeadLettersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWebhooksDeadLettersResponse, error)
+
+	// PostWebhooksDeadLettersIdRetryWithResponse request
+	PostWebhooksDeadLettersIdRetryWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostWebhooksDeadLettersIdRetryResponse, error)
+
+	// DeleteWebhooksIdWithResponse request
+	DeleteWebhooksIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteWebhooksIdResponse, error)
+}
+
+type GetApiKeysResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ListApiKeysResponse
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiKeysResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *CreateApiKeyResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiKeysIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiKeysIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiKeysIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDronesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ListDronesResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDronesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDronesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostDronesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *Drone
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r PostDronesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostDronesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDronesDroneIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Drone
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDronesDroneIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDronesDroneIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchDronesDroneIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Drone
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchDronesDroneIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchDronesDroneIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEstateResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ListEstatesResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEstateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEstateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostEstateResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *CreateEstateResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON409 *IdempotencyInProgress
+	ApplicationproblemJSON422 *IdempotencyKeyReused
+}
+
+// Status returns HTTPResponse.Status
+func (r PostEstateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostEstateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostEstateImportResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *Estate
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON413 *Problem
+	ApplicationproblemJSON422 *IdempotencyKeyReused
+}
+
+// Status returns HTTPResponse.Status
+func (r PostEstateImportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostEstateImportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteEstateIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteEstateIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteEstateIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEstateIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Estate
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEstateIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEstateIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchEstateIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Estate
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON412 *PreconditionFailed
+	ApplicationproblemJSON428 *PreconditionRequired
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchEstateIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchEstateIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEstateIdChargingPadsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ChargingPads
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEstateIdChargingPadsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEstateIdChargingPadsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutEstateIdChargingPadsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ChargingPads
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PutEstateIdChargingPadsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutEstateIdChargingPadsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostEstateIdCloneResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *Estate
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *IdempotencyInProgress
+	ApplicationproblemJSON422 *IdempotencyKeyReused
+}
+
+// Status returns HTTPResponse.Status
+func (r PostEstateIdCloneResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostEstateIdCloneResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEstateIdDiffResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *SandboxDiffResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEstateIdDiffResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEstateIdDiffResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEstateIdDronePlanResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *GetEstateDronePlanResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON422 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEstateIdDronePlanResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEstateIdDronePlanResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEstateIdDronePlanProfileResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AltitudeProfileResponse
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEstateIdDronePlanProfileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEstateIdDronePlanProfileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEstateIdDronePlanRenderResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON422 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEstateIdDronePlanRenderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEstateIdDronePlanRenderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEstateIdDronePlanSortiesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *SortiePlan
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON422 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEstateIdDronePlanSortiesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEstateIdDronePlanSortiesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEstateIdEventsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEstateIdEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEstateIdEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEstateIdExportResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *EstateSnapshot
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEstateIdExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEstateIdExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEstateIdMissionResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ListMissionsResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEstateIdMissionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEstateIdMissionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostEstateIdMissionResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *Mission
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *IdempotencyInProgress
+	ApplicationproblemJSON422 *IdempotencyKeyReused
+}
+
+// Status returns HTTPResponse.Status
+func (r PostEstateIdMissionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostEstateIdMissionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEstateIdMissionMissionIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Mission
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEstateIdMissionMissionIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEstateIdMissionMissionIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchEstateIdMissionMissionIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Mission
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON422 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchEstateIdMissionMissionIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchEstateIdMissionMissionIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEstateIdMissionMissionIdTelemetryResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TelemetryReport
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEstateIdMissionMissionIdTelemetryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEstateIdMissionMissionIdTelemetryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutEstateIdMissionMissionIdTelemetryResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TelemetryReport
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PutEstateIdMissionMissionIdTelemetryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutEstateIdMissionMissionIdTelemetryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostEstateIdPromoteResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Estate
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostEstateIdPromoteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostEstateIdPromoteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEstateIdStatsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *GetEstateTreeStatsResponse
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEstateIdStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEstateIdStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEstateIdTreeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ListTreesResponse
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEstateIdTreeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEstateIdTreeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostEstateIdTreeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *CreateTreeResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *IdempotencyInProgress
+	ApplicationproblemJSON422 *IdempotencyKeyReused
+}
+
+// Status returns HTTPResponse.Status
+func (r PostEstateIdTreeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostEstateIdTreeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteEstateIdTreeTreeIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteEstateIdTreeTreeIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteEstateIdTreeTreeIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEstateIdTreeTreeIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Tree
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEstateIdTreeTreeIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEstateIdTreeTreeIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchEstateIdTreeTreeIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Tree
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON412 *PreconditionFailed
+	ApplicationproblemJSON428 *PreconditionRequired
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchEstateIdTreeTreeIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchEstateIdTreeTreeIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWebhooksResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ListWebhooksResponse
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebhooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWebhooksResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *CreateWebhookResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWebhooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWebhooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWebhooksDeadLettersResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ListWebhookDeadLettersResponse
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebhooksDeadLettersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhooksDeadLettersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWebhooksDeadLettersIdRetryResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWebhooksDeadLettersIdRetryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWebhooksDeadLettersIdRetryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteWebhooksIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteWebhooksIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteWebhooksIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetApiKeysWithResponse request returning *GetApiKeysResponse
+func (c *ClientWithResponses) GetApiKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiKeysResponse, error) {
+	rsp, err := c.GetApiKeys(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiKeysResponse(rsp)
+}
+
+// PostApiKeysWithBodyWithResponse request with arbitrary body returning *PostApiKeysResponse
+func (c *ClientWithResponses) PostApiKeysWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiKeysResponse, error) {
+	rsp, err := c.PostApiKeysWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiKeysResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiKeysWithResponse(ctx context.Context, body PostApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiKeysResponse, error) {
+	rsp, err := c.PostApiKeys(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiKeysResponse(rsp)
+}
+
+// DeleteApiKeysIdWithResponse request returning *DeleteApiKeysIdResponse
+func (c *ClientWithResponses) DeleteApiKeysIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiKeysIdResponse, error) {
+	rsp, err := c.DeleteApiKeysId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiKeysIdResponse(rsp)
+}
+
+// GetDronesWithResponse request returning *GetDronesResponse
+func (c *ClientWithResponses) GetDronesWithResponse(ctx context.Context, params *GetDronesParams, reqEditors ...RequestEditorFn) (*GetDronesResponse, error) {
+	rsp, err := c.GetDrones(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDronesResponse(rsp)
+}
+
+// PostDronesWithBodyWithResponse request with arbitrary body returning *PostDronesResponse
+func (c *ClientWithResponses) PostDronesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDronesResponse, error) {
+	rsp, err := c.PostDronesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostDronesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostDronesWithResponse(ctx context.Context, body PostDronesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDronesResponse, error) {
+	rsp, err := c.PostDrones(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostDronesResponse(rsp)
+}
+
+// GetDronesDroneIdWithResponse request returning *GetDronesDroneIdResponse
+func (c *ClientWithResponses) GetDronesDroneIdWithResponse(ctx context.Context, droneId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetDronesDroneIdResponse, error) {
+	rsp, err := c.GetDronesDroneId(ctx, droneId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDronesDroneIdResponse(rsp)
+}
+
+// PatchDronesDroneIdWithBodyWithResponse request with arbitrary body returning *PatchDronesDroneIdResponse
+func (c *ClientWithResponses) PatchDronesDroneIdWithBodyWithResponse(ctx context.Context, droneId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchDronesDroneIdResponse, error) {
+	rsp, err := c.PatchDronesDroneIdWithBody(ctx, droneId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchDronesDroneIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchDronesDroneIdWithResponse(ctx context.Context, droneId openapi_types.UUID, body PatchDronesDroneIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchDronesDroneIdResponse, error) {
+	rsp, err := c.PatchDronesDroneId(ctx, droneId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchDronesDroneIdResponse(rsp)
+}
+
+// GetEstateWithResponse request returning *GetEstateResponse
+func (c *ClientWithResponses) GetEstateWithResponse(ctx context.Context, params *GetEstateParams, reqEditors ...RequestEditorFn) (*GetEstateResponse, error) {
+	rsp, err := c.GetEstate(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEstateResponse(rsp)
+}
+
+// PostEstateWithBodyWithResponse request with arbitrary body returning *PostEstateResponse
+func (c *ClientWithResponses) PostEstateWithBodyWithResponse(ctx context.Context, params *PostEstateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEstateResponse, error) {
+	rsp, err := c.PostEstateWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostEstateResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostEstateWithResponse(ctx context.Context, params *PostEstateParams, body PostEstateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEstateResponse, error) {
+	rsp, err := c.PostEstate(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostEstateResponse(rsp)
+}
+
+// PostEstateImportWithBodyWithResponse request with arbitrary body returning *PostEstateImportResponse
+func (c *ClientWithResponses) PostEstateImportWithBodyWithResponse(ctx context.Context, params *PostEstateImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEstateImportResponse, error) {
+	rsp, err := c.PostEstateImportWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostEstateImportResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostEstateImportWithResponse(ctx context.Context, params *PostEstateImportParams, body PostEstateImportJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEstateImportResponse, error) {
+	rsp, err := c.PostEstateImport(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostEstateImportResponse(rsp)
+}
+
+// DeleteEstateIdWithResponse request returning *DeleteEstateIdResponse
+func (c *ClientWithResponses) DeleteEstateIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteEstateIdResponse, error) {
+	rsp, err := c.DeleteEstateId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteEstateIdResponse(rsp)
+}
+
+// GetEstateIdWithResponse request returning *GetEstateIdResponse
+func (c *ClientWithResponses) GetEstateIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetEstateIdResponse, error) {
+	rsp, err := c.GetEstateId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEstateIdResponse(rsp)
+}
+
+// PatchEstateIdWithBodyWithResponse request with arbitrary body returning *PatchEstateIdResponse
+func (c *ClientWithResponses) PatchEstateIdWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, params *PatchEstateIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchEstateIdResponse, error) {
+	rsp, err := c.PatchEstateIdWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchEstateIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchEstateIdWithResponse(ctx context.Context, id openapi_types.UUID, params *PatchEstateIdParams, body PatchEstateIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchEstateIdResponse, error) {
+	rsp, err := c.PatchEstateId(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchEstateIdResponse(rsp)
+}
+
+// GetEstateIdChargingPadsWithResponse request returning *GetEstateIdChargingPadsResponse
+func (c *ClientWithResponses) GetEstateIdChargingPadsWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetEstateIdChargingPadsResponse, error) {
+	rsp, err := c.GetEstateIdChargingPads(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEstateIdChargingPadsResponse(rsp)
+}
+
+// PutEstateIdChargingPadsWithBodyWithResponse request with arbitrary body returning *PutEstateIdChargingPadsResponse
+func (c *ClientWithResponses) PutEstateIdChargingPadsWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutEstateIdChargingPadsResponse, error) {
+	rsp, err := c.PutEstateIdChargingPadsWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutEstateIdChargingPadsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutEstateIdChargingPadsWithResponse(ctx context.Context, id openapi_types.UUID, body PutEstateIdChargingPadsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutEstateIdChargingPadsResponse, error) {
+	rsp, err := c.PutEstateIdChargingPads(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutEstateIdChargingPadsResponse(rsp)
+}
+
+// PostEstateIdCloneWithResponse request returning *PostEstateIdCloneResponse
+func (c *ClientWithResponses) PostEstateIdCloneWithResponse(ctx context.Context, id openapi_types.UUID, params *PostEstateIdCloneParams, reqEditors ...RequestEditorFn) (*PostEstateIdCloneResponse, error) {
+	rsp, err := c.PostEstateIdClone(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostEstateIdCloneResponse(rsp)
+}
+
+// GetEstateIdDiffWithResponse request returning *GetEstateIdDiffResponse
+func (c *ClientWithResponses) GetEstateIdDiffWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetEstateIdDiffResponse, error) {
+	rsp, err := c.GetEstateIdDiff(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEstateIdDiffResponse(rsp)
+}
+
+// GetEstateIdDronePlanWithResponse request returning *GetEstateIdDronePlanResponse
+func (c *ClientWithResponses) GetEstateIdDronePlanWithResponse(ctx context.Context, id openapi_types.UUID, params *GetEstateIdDronePlanParams, reqEditors ...RequestEditorFn) (*GetEstateIdDronePlanResponse, error) {
+	rsp, err := c.GetEstateIdDronePlan(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEstateIdDronePlanResponse(rsp)
+}
+
+// GetEstateIdDronePlanProfileWithResponse request returning *GetEstateIdDronePlanProfileResponse
+func (c *ClientWithResponses) GetEstateIdDronePlanProfileWithResponse(ctx context.Context, id openapi_types.UUID, params *GetEstateIdDronePlanProfileParams, reqEditors ...RequestEditorFn) (*GetEstateIdDronePlanProfileResponse, error) {
+	rsp, err := c.GetEstateIdDronePlanProfile(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEstateIdDronePlanProfileResponse(rsp)
+}
+
+// GetEstateIdDronePlanRenderWithResponse request returning *GetEstateIdDronePlanRenderResponse
+func (c *ClientWithResponses) GetEstateIdDronePlanRenderWithResponse(ctx context.Context, id openapi_types.UUID, params *GetEstateIdDronePlanRenderParams, reqEditors ...RequestEditorFn) (*GetEstateIdDronePlanRenderResponse, error) {
+	rsp, err := c.GetEstateIdDronePlanRender(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEstateIdDronePlanRenderResponse(rsp)
+}
+
+// GetEstateIdDronePlanSortiesWithResponse request returning *GetEstateIdDronePlanSortiesResponse
+func (c *ClientWithResponses) GetEstateIdDronePlanSortiesWithResponse(ctx context.Context, id openapi_types.UUID, params *GetEstateIdDronePlanSortiesParams, reqEditors ...RequestEditorFn) (*GetEstateIdDronePlanSortiesResponse, error) {
+	rsp, err := c.GetEstateIdDronePlanSorties(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEstateIdDronePlanSortiesResponse(rsp)
+}
+
+// GetEstateIdEventsWithResponse request returning *GetEstateIdEventsResponse
+func (c *ClientWithResponses) GetEstateIdEventsWithResponse(ctx context.Context, id openapi_types.UUID, params *GetEstateIdEventsParams, reqEditors ...RequestEditorFn) (*GetEstateIdEventsResponse, error) {
+	rsp, err := c.GetEstateIdEvents(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEstateIdEventsResponse(rsp)
+}
+
+// GetEstateIdExportWithResponse request returning *GetEstateIdExportResponse
+func (c *ClientWithResponses) GetEstateIdExportWithResponse(ctx context.Context, id openapi_types.UUID, params *GetEstateIdExportParams, reqEditors ...RequestEditorFn) (*GetEstateIdExportResponse, error) {
+	rsp, err := c.GetEstateIdExport(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEstateIdExportResponse(rsp)
+}
+
+// GetEstateIdMissionWithResponse request returning *GetEstateIdMissionResponse
+func (c *ClientWithResponses) GetEstateIdMissionWithResponse(ctx context.Context, id openapi_types.UUID, params *GetEstateIdMissionParams, reqEditors ...RequestEditorFn) (*GetEstateIdMissionResponse, error) {
+	rsp, err := c.GetEstateIdMission(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEstateIdMissionResponse(rsp)
+}
+
+// PostEstateIdMissionWithBodyWithResponse request with arbitrary body returning *PostEstateIdMissionResponse
+func (c *ClientWithResponses) PostEstateIdMissionWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, params *PostEstateIdMissionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEstateIdMissionResponse, error) {
+	rsp, err := c.PostEstateIdMissionWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostEstateIdMissionResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostEstateIdMissionWithResponse(ctx context.Context, id openapi_types.UUID, params *PostEstateIdMissionParams, body PostEstateIdMissionJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEstateIdMissionResponse, error) {
+	rsp, err := c.PostEstateIdMission(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostEstateIdMissionResponse(rsp)
+}
+
+// GetEstateIdMissionMissionIdWithResponse request returning *GetEstateIdMissionMissionIdResponse
+func (c *ClientWithResponses) GetEstateIdMissionMissionIdWithResponse(ctx context.Context, id openapi_types.UUID, missionId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetEstateIdMissionMissionIdResponse, error) {
+	rsp, err := c.GetEstateIdMissionMissionId(ctx, id, missionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEstateIdMissionMissionIdResponse(rsp)
+}
+
+// PatchEstateIdMissionMissionIdWithBodyWithResponse request with arbitrary body returning *PatchEstateIdMissionMissionIdResponse
+func (c *ClientWithResponses) PatchEstateIdMissionMissionIdWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, missionId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchEstateIdMissionMissionIdResponse, error) {
+	rsp, err := c.PatchEstateIdMissionMissionIdWithBody(ctx, id, missionId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchEstateIdMissionMissionIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchEstateIdMissionMissionIdWithResponse(ctx context.Context, id openapi_types.UUID, missionId openapi_types.UUID, body PatchEstateIdMissionMissionIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchEstateIdMissionMissionIdResponse, error) {
+	rsp, err := c.PatchEstateIdMissionMissionId(ctx, id, missionId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchEstateIdMissionMissionIdResponse(rsp)
+}
+
+// GetEstateIdMissionMissionIdTelemetryWithResponse request returning *GetEstateIdMissionMissionIdTelemetryResponse
+func (c *ClientWithResponses) GetEstateIdMissionMissionIdTelemetryWithResponse(ctx context.Context, id openapi_types.UUID, missionId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetEstateIdMissionMissionIdTelemetryResponse, error) {
+	rsp, err := c.GetEstateIdMissionMissionIdTelemetry(ctx, id, missionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEstateIdMissionMissionIdTelemetryResponse(rsp)
+}
+
+// PutEstateIdMissionMissionIdTelemetryWithBodyWithResponse request with arbitrary body returning *PutEstateIdMissionMissionIdTelemetryResponse
+func (c *ClientWithResponses) PutEstateIdMissionMissionIdTelemetryWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, missionId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutEstateIdMissionMissionIdTelemetryResponse, error) {
+	rsp, err := c.PutEstateIdMissionMissionIdTelemetryWithBody(ctx, id, missionId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutEstateIdMissionMissionIdTelemetryResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutEstateIdMissionMissionIdTelemetryWithResponse(ctx context.Context, id openapi_types.UUID, missionId openapi_types.UUID, body PutEstateIdMissionMissionIdTelemetryJSONRequestBody, reqEditors ...RequestEditorFn) (*PutEstateIdMissionMissionIdTelemetryResponse, error) {
+	rsp, err := c.PutEstateIdMissionMissionIdTelemetry(ctx, id, missionId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutEstateIdMissionMissionIdTelemetryResponse(rsp)
+}
+
+// PostEstateIdPromoteWithResponse request returning *PostEstateIdPromoteResponse
+func (c *ClientWithResponses) PostEstateIdPromoteWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostEstateIdPromoteResponse, error) {
+	rsp, err := c.PostEstateIdPromote(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostEstateIdPromoteResponse(rsp)
+}
+
+// GetEstateIdStatsWithResponse request returning *GetEstateIdStatsResponse
+func (c *ClientWithResponses) GetEstateIdStatsWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetEstateIdStatsResponse, error) {
+	rsp, err := c.GetEstateIdStats(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEstateIdStatsResponse(rsp)
+}
+
+// GetEstateIdTreeWithResponse request returning *GetEstateIdTreeResponse
+func (c *ClientWithResponses) GetEstateIdTreeWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetEstateIdTreeResponse, error) {
+	rsp, err := c.GetEstateIdTree(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEstateIdTreeResponse(rsp)
+}
+
+// PostEstateIdTreeWithBodyWithResponse request with arbitrary body returning *PostEstateIdTreeResponse
+func (c *ClientWithResponses) PostEstateIdTreeWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, params *PostEstateIdTreeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEstateIdTreeResponse, error) {
+	rsp, err := c.PostEstateIdTreeWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostEstateIdTreeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostEstateIdTreeWithResponse(ctx context.Context, id openapi_types.UUID, params *PostEstateIdTreeParams, body PostEstateIdTreeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEstateIdTreeResponse, error) {
+	rsp, err := c.PostEstateIdTree(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostEstateIdTreeResponse(rsp)
+}
+
+// DeleteEstateIdTreeTreeIdWithResponse request returning *DeleteEstateIdTreeTreeIdResponse
+func (c *ClientWithResponses) DeleteEstateIdTreeTreeIdWithResponse(ctx context.Context, id openapi_types.UUID, treeId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteEstateIdTreeTreeIdResponse, error) {
+	rsp, err := c.DeleteEstateIdTreeTreeId(ctx, id, treeId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteEstateIdTreeTreeIdResponse(rsp)
+}
+
+// GetEstateIdTreeTreeIdWithResponse request returning *GetEstateIdTreeTreeIdResponse
+func (c *ClientWithResponses) GetEstateIdTreeTreeIdWithResponse(ctx context.Context, id openapi_types.UUID, treeId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetEstateIdTreeTreeIdResponse, error) {
+	rsp, err := c.GetEstateIdTreeTreeId(ctx, id, treeId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEstateIdTreeTreeIdResponse(rsp)
+}
+
+// PatchEstateIdTreeTreeIdWithBodyWithResponse request with arbitrary body returning *PatchEstateIdTreeTreeIdResponse
+func (c *ClientWithResponses) PatchEstateIdTreeTreeIdWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, treeId openapi_types.UUID, params *PatchEstateIdTreeTreeIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchEstateIdTreeTreeIdResponse, error) {
+	rsp, err := c.PatchEstateIdTreeTreeIdWithBody(ctx, id, treeId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchEstateIdTreeTreeIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchEstateIdTreeTreeIdWithResponse(ctx context.Context, id openapi_types.UUID, treeId openapi_types.UUID, params *PatchEstateIdTreeTreeIdParams, body PatchEstateIdTreeTreeIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchEstateIdTreeTreeIdResponse, error) {
+	rsp, err := c.PatchEstateIdTreeTreeId(ctx, id, treeId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchEstateIdTreeTreeIdResponse(rsp)
+}
+
+// GetWebhooksWithResponse request returning *GetWebhooksResponse
+func (c *ClientWithResponses) GetWebhooksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWebhooksResponse, error) {
+	rsp, err := c.GetWebhooks(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWebhooksResponse(rsp)
+}
+
+// PostWebhooksWithBodyWithResponse request with arbitrary body returning *PostWebhooksResponse
+func (c *ClientWithResponses) PostWebhooksWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWebhooksResponse, error) {
+	rsp, err := c.PostWebhooksWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostWebhooksWithResponse(ctx context.Context, body PostWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWebhooksResponse, error) {
+	rsp, err := c.PostWebhooks(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksResponse(rsp)
+}
+
+// GetWebhooksDeadLettersWithResponse request returning *GetWebhooksDeadLettersResponse
+func (c *ClientWithResponses) GetWebhooksDeadLettersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWebhooksDeadLettersResponse, error) {
+	rsp, err := c.GetWebhooksDeadLetters(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWebhooksDeadLettersResponse(rsp)
+}
+
+// PostWebhooksDeadLettersIdRetryWithResponse request returning *PostWebhooksDeadLettersIdRetryResponse
+func (c *ClientWithResponses) PostWebhooksDeadLettersIdRetryWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostWebhooksDeadLettersIdRetryResponse, error) {
+	rsp, err := c.PostWebhooksDeadLettersIdRetry(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksDeadLettersIdRetryResponse(rsp)
+}
+
+// DeleteWebhooksIdWithResponse request returning *DeleteWebhooksIdResponse
+func (c *ClientWithResponses) DeleteWebhooksIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteWebhooksIdResponse, error) {
+	rsp, err := c.DeleteWebhooksId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteWebhooksIdResponse(rsp)
+}
+
+// ParseGetApiKeysResponse parses an HTTP response from a GetApiKeysWithResponse call
+func ParseGetApiKeysResponse(rsp *http.Response) (*GetApiKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListApiKeysResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiKeysResponse parses an HTTP response from a PostApiKeysWithResponse call
+func ParsePostApiKeysResponse(rsp *http.Response) (*PostApiKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreateApiKeyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiKeysIdResponse parses an HTTP response from a DeleteApiKeysIdWithResponse call
+func ParseDeleteApiKeysIdResponse(rsp *http.Response) (*DeleteApiKeysIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiKeysIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDronesResponse parses an HTTP response from a GetDronesWithResponse call
+func ParseGetDronesResponse(rsp *http.Response) (*GetDronesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDronesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListDronesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostDronesResponse parses an HTTP response from a PostDronesWithResponse call
+func ParsePostDronesResponse(rsp *http.Response) (*PostDronesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostDronesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Drone
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDronesDroneIdResponse parses an HTTP response from a GetDronesDroneIdWithResponse call
+func ParseGetDronesDroneIdResponse(rsp *http.Response) (*GetDronesDroneIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDronesDroneIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Drone
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchDronesDroneIdResponse parses an HTTP response from a PatchDronesDroneIdWithResponse call
+func ParsePatchDronesDroneIdResponse(rsp *http.Response) (*PatchDronesDroneIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchDronesDroneIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Drone
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEstateResponse parses an HTTP response from a GetEstateWithResponse call
+func ParseGetEstateResponse(rsp *http.Response) (*GetEstateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEstateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListEstatesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostEstateResponse parses an HTTP response from a PostEstateWithResponse call
+func ParsePostEstateResponse(rsp *http.Response) (*PostEstateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostEstateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreateEstateResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest IdempotencyInProgress
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest IdempotencyKeyReused
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostEstateImportResponse parses an HTTP response from a PostEstateImportWithResponse call
+func ParsePostEstateImportResponse(rsp *http.Response) (*PostEstateImportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostEstateImportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Estate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest IdempotencyKeyReused
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteEstateIdResponse parses an HTTP response from a DeleteEstateIdWithResponse call
+func ParseDeleteEstateIdResponse(rsp *http.Response) (*DeleteEstateIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteEstateIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEstateIdResponse parses an HTTP response from a GetEstateIdWithResponse call
+func ParseGetEstateIdResponse(rsp *http.Response) (*GetEstateIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEstateIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Estate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchEstateIdResponse parses an HTTP response from a PatchEstateIdWithResponse call
+func ParsePatchEstateIdResponse(rsp *http.Response) (*PatchEstateIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchEstateIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Estate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 428:
+		var dest PreconditionRequired
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON428 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEstateIdChargingPadsResponse parses an HTTP response from a GetEstateIdChargingPadsWithResponse call
+func ParseGetEstateIdChargingPadsResponse(rsp *http.Response) (*GetEstateIdChargingPadsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEstateIdChargingPadsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ChargingPads
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutEstateIdChargingPadsResponse parses an HTTP response from a PutEstateIdChargingPadsWithResponse call
+func ParsePutEstateIdChargingPadsResponse(rsp *http.Response) (*PutEstateIdChargingPadsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutEstateIdChargingPadsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ChargingPads
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostEstateIdCloneResponse parses an HTTP response from a PostEstateIdCloneWithResponse call
+func ParsePostEstateIdCloneResponse(rsp *http.Response) (*PostEstateIdCloneResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostEstateIdCloneResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Estate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest IdempotencyInProgress
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest IdempotencyKeyReused
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEstateIdDiffResponse parses an HTTP response from a GetEstateIdDiffWithResponse call
+func ParseGetEstateIdDiffResponse(rsp *http.Response) (*GetEstateIdDiffResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEstateIdDiffResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SandboxDiffResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEstateIdDronePlanResponse parses an HTTP response from a GetEstateIdDronePlanWithResponse call
+func ParseGetEstateIdDronePlanResponse(rsp *http.Response) (*GetEstateIdDronePlanResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEstateIdDronePlanResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetEstateDronePlanResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEstateIdDronePlanProfileResponse parses an HTTP response from a GetEstateIdDronePlanProfileWithResponse call
+func ParseGetEstateIdDronePlanProfileResponse(rsp *http.Response) (*GetEstateIdDronePlanProfileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEstateIdDronePlanProfileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AltitudeProfileResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (image/svg+xml) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEstateIdDronePlanRenderResponse parses an HTTP response from a GetEstateIdDronePlanRenderWithResponse call
+func ParseGetEstateIdDronePlanRenderResponse(rsp *http.Response) (*GetEstateIdDronePlanRenderResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEstateIdDronePlanRenderResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEstateIdDronePlanSortiesResponse parses an HTTP response from a GetEstateIdDronePlanSortiesWithResponse call
+func ParseGetEstateIdDronePlanSortiesResponse(rsp *http.Response) (*GetEstateIdDronePlanSortiesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEstateIdDronePlanSortiesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SortiePlan
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEstateIdEventsResponse parses an HTTP response from a GetEstateIdEventsWithResponse call
+func ParseGetEstateIdEventsResponse(rsp *http.Response) (*GetEstateIdEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEstateIdEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEstateIdExportResponse parses an HTTP response from a GetEstateIdExportWithResponse call
+func ParseGetEstateIdExportResponse(rsp *http.Response) (*GetEstateIdExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEstateIdExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EstateSnapshot
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (application/gzip) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEstateIdMissionResponse parses an HTTP response from a GetEstateIdMissionWithResponse call
+func ParseGetEstateIdMissionResponse(rsp *http.Response) (*GetEstateIdMissionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEstateIdMissionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListMissionsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostEstateIdMissionResponse parses an HTTP response from a PostEstateIdMissionWithResponse call
+func ParsePostEstateIdMissionResponse(rsp *http.Response) (*PostEstateIdMissionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostEstateIdMissionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Mission
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest IdempotencyInProgress
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest IdempotencyKeyReused
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEstateIdMissionMissionIdResponse parses an HTTP response from a GetEstateIdMissionMissionIdWithResponse call
+func ParseGetEstateIdMissionMissionIdResponse(rsp *http.Response) (*GetEstateIdMissionMissionIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEstateIdMissionMissionIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Mission
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchEstateIdMissionMissionIdResponse parses an HTTP response from a PatchEstateIdMissionMissionIdWithResponse call
+func ParsePatchEstateIdMissionMissionIdResponse(rsp *http.Response) (*PatchEstateIdMissionMissionIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchEstateIdMissionMissionIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Mission
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEstateIdMissionMissionIdTelemetryResponse parses an HTTP response from a GetEstateIdMissionMissionIdTelemetryWithResponse call
+func ParseGetEstateIdMissionMissionIdTelemetryResponse(rsp *http.Response) (*GetEstateIdMissionMissionIdTelemetryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEstateIdMissionMissionIdTelemetryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TelemetryReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutEstateIdMissionMissionIdTelemetryResponse parses an HTTP response from a PutEstateIdMissionMissionIdTelemetryWithResponse call
+func ParsePutEstateIdMissionMissionIdTelemetryResponse(rsp *http.Response) (*PutEstateIdMissionMissionIdTelemetryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutEstateIdMissionMissionIdTelemetryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TelemetryReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostEstateIdPromoteResponse parses an HTTP response from a PostEstateIdPromoteWithResponse call
+func ParsePostEstateIdPromoteResponse(rsp *http.Response) (*PostEstateIdPromoteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostEstateIdPromoteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Estate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEstateIdStatsResponse parses an HTTP response from a GetEstateIdStatsWithResponse call
+func ParseGetEstateIdStatsResponse(rsp *http.Response) (*GetEstateIdStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEstateIdStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetEstateTreeStatsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEstateIdTreeResponse parses an HTTP response from a GetEstateIdTreeWithResponse call
+func ParseGetEstateIdTreeResponse(rsp *http.Response) (*GetEstateIdTreeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEstateIdTreeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListTreesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostEstateIdTreeResponse parses an HTTP response from a PostEstateIdTreeWithResponse call
+func ParsePostEstateIdTreeResponse(rsp *http.Response) (*PostEstateIdTreeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostEstateIdTreeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreateTreeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest IdempotencyInProgress
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest IdempotencyKeyReused
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteEstateIdTreeTreeIdResponse parses an HTTP response from a DeleteEstateIdTreeTreeIdWithResponse call
+func ParseDeleteEstateIdTreeTreeIdResponse(rsp *http.Response) (*DeleteEstateIdTreeTreeIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteEstateIdTreeTreeIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEstateIdTreeTreeIdResponse parses an HTTP response from a GetEstateIdTreeTreeIdWithResponse call
+func ParseGetEstateIdTreeTreeIdResponse(rsp *http.Response) (*GetEstateIdTreeTreeIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEstateIdTreeTreeIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Tree
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchEstateIdTreeTreeIdResponse parses an HTTP response from a PatchEstateIdTreeTreeIdWithResponse call
+func ParsePatchEstateIdTreeTreeIdResponse(rsp *http.Response) (*PatchEstateIdTreeTreeIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchEstateIdTreeTreeIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Tree
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 428:
+		var dest PreconditionRequired
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON428 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWebhooksResponse parses an HTTP response from a GetWebhooksWithResponse call
+func ParseGetWebhooksResponse(rsp *http.Response) (*GetWebhooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWebhooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListWebhooksResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostWebhooksResponse parses an HTTP response from a PostWebhooksWithResponse call
+func ParsePostWebhooksResponse(rsp *http.Response) (*PostWebhooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostWebhooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreateWebhookResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWebhooksDeadLettersResponse parses an HTTP response from a GetWebhooksDeadLettersWithResponse call
+func ParseGetWebhooksDeadLettersResponse(rsp *http.Response) (*GetWebhooksDeadLettersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWebhooksDeadLettersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListWebhookDeadLettersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostWebhooksDeadLettersIdRetryResponse parses an HTTP response from a PostWebhooksDeadLettersIdRetryWithResponse call
+func ParsePostWebhooksDeadLettersIdRetryResponse(rsp *http.Response) (*PostWebhooksDeadLettersIdRetryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostWebhooksDeadLettersIdRetryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteWebhooksIdResponse parses an HTTP response from a DeleteWebhooksIdWithResponse call
+func ParseDeleteWebhooksIdResponse(rsp *http.Response) (*DeleteWebhooksIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteWebhooksIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
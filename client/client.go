@@ -0,0 +1,415 @@
+// Package client is the Go client of the Estate API.
+//
+// It wraps the client generated from api.yml in package api with typed results, retries of
+// requests that are safe to send again, idempotency keys on creations, a default deadline per call,
+// and errors matching the problem codes of the API:
+//
+//	c, err := client.New("http://localhost:1323", client.WithAPIKey(key))
+//	estate, err := c.CreateEstate(ctx, 10, 20)
+//	_, err = c.CreateTree(ctx, estate.ID, 1, 1, 5)
+//	if errors.Is(err, client.ErrTreeAlreadyExists) {
+//		...
+//	}
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/client/api"
+	"github.com/google/uuid"
+)
+
+const defaultTimeout = 30 * time.Second
+
+// Client call the Estate API, it is safe for concurrent use
+type Client struct {
+	api        *api.ClientWithResponses
+	httpClient api.HttpRequestDoer
+	apiKey     string
+	userAgent  string
+	timeout    time.Duration
+	retry      retryPolicy
+}
+
+type ClientOptions func(*Client)
+
+// WithAPIKey send key as X-API-Key with every request
+func WithAPIKey(key string) ClientOptions {
+	return func(c *Client) {
+		c.apiKey = key
+	}
+}
+
+// WithHTTPClient send requests with doer instead of http.DefaultClient
+func WithHTTPClient(doer api.HttpRequestDoer) ClientOptions {
+	return func(c *Client) {
+		c.httpClient = doer
+	}
+}
+
+// WithUserAgent set the User-Agent header of every request
+func WithUserAgent(userAgent string) ClientOptions {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithTimeout bound calls whose context has no deadline, retries included. 0 disables it
+func WithTimeout(timeout time.Duration) ClientOptions {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+// WithRetry send a failed request up to maxAttempts times, waiting a random backoff
+// starting from initialBackoff and doubled per attempt up to maxBackoff. 1 disables retries
+func WithRetry(maxAttempts int, initialBackoff time.Duration, maxBackoff time.Duration) ClientOptions {
+	return func(c *Client) {
+		c.retry = retryPolicy{maxAttempts: maxAttempts, initialBackoff: initialBackoff, maxBackoff: maxBackoff}
+	}
+}
+
+// New create a client of the Estate API served at server
+func New(server string, opts ...ClientOptions) (*Client, error) {
+	c := &Client{
+		httpClient: http.DefaultClient,
+		userAgent:  "estate-service-go-client",
+		timeout:    defaultTimeout,
+		retry:      defaultRetry,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	var err error
+	c.api, err = api.NewClientWithResponses(server,
+		api.WithHTTPClient(&retryDoer{next: c.httpClient, policy: c.retry}),
+		api.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+			if c.apiKey != "" {
+				req.Header.Set("X-API-Key", c.apiKey)
+			}
+			req.Header.Set("User-Agent", c.userAgent)
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create estate api client: %w", err)
+	}
+	return c, nil
+}
+
+// API return the generated client, for endpoints without a typed method
+func (c *Client) API() *api.ClientWithResponses {
+	return c.api
+}
+
+// requestConfig holds the options of one call
+type requestConfig struct {
+	idempotencyKey string
+}
+
+type RequestOptions func(*requestConfig)
+
+// WithIdempotencyKey send key as Idempotency-Key instead of a random one, so the same creation
+// can be sent again later, e.g. after a crash, without creating a duplicate
+func WithIdempotencyKey(key string) RequestOptions {
+	return func(r *requestConfig) {
+		r.idempotencyKey = key
+	}
+}
+
+// idempotencyKey return the key of a creation, a random one makes its retries safe
+func idempotencyKey(opts []RequestOptions) *api.IdempotencyKey {
+	config := requestConfig{idempotencyKey: uuid.NewString()}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	return &config.idempotencyKey
+}
+
+// withTimeout apply the default timeout unless ctx already has a deadline
+func (c *Client) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok || c.timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, c.timeout)
+}
+
+// Estate is a rectangular plot of Width by Length squares, Version is the one to send
+// with ResizeEstate and is 0 when the response did not carry it
+type Estate struct {
+	ID      uuid.UUID `json:"id"`
+	Width   int       `json:"width"`
+	Length  int       `json:"length"`
+	Version int       `json:"version,omitempty"`
+	// Draft is set on sandboxes cloned from the estate SourceEstateID
+	Draft          bool       `json:"draft,omitempty"`
+	SourceEstateID *uuid.UUID `json:"source_estate_id,omitempty"`
+}
+
+// Tree planted at column X and row Y of its estate
+type Tree struct {
+	ID      uuid.UUID `json:"id"`
+	X       int       `json:"x"`
+	Y       int       `json:"y"`
+	Height  int       `json:"height"`
+	Version int       `json:"version,omitempty"`
+}
+
+// EstateStats of the tree heights of an estate, all zero when it has no tree
+type EstateStats struct {
+	Count  int `json:"count"`
+	Max    int `json:"max"`
+	Min    int `json:"min"`
+	Median int `json:"median"`
+}
+
+// DronePlan is the distance flown over an estate, Rest is where the drone lands when it ran out of distance
+type DronePlan struct {
+	Distance int   `json:"distance"`
+	Rest     *Plot `json:"rest,omitempty"`
+}
+
+type Plot struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+// versionOf parse the strong ETag of a response
+func versionOf(res *http.Response) int {
+	etag := res.Header.Get("ETag")
+	if !strings.HasPrefix(etag, `"`) || !strings.HasSuffix(etag, `"`) || len(etag) < 2 {
+		return 0
+	}
+	version, err := strconv.Atoi(etag[1 : len(etag)-1])
+	if err != nil {
+		return 0
+	}
+	return version
+}
+
+func ifMatch(version int) *api.IfMatch {
+	etag := `"` + strconv.Itoa(version) + `"`
+	return &etag
+}
+
+func toEstate(estate api.Estate, version int) Estate {
+	return Estate{
+		ID:             estate.Id,
+		Width:          estate.Width,
+		Length:         estate.Length,
+		Version:        version,
+		Draft:          estate.Draft,
+		SourceEstateID: estate.SourceEstateId,
+	}
+}
+
+func toTree(tree api.Tree, version int) Tree {
+	return Tree{ID: tree.Id, X: tree.X, Y: tree.Y, Height: tree.Height, Version: version}
+}
+
+func value(v *int) int {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+// CreateEstate create an estate of width by length squares
+func (c *Client) CreateEstate(ctx context.Context, width int, length int, opts ...RequestOptions) (Estate, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	params := &api.PostEstateParams{IdempotencyKey: idempotencyKey(opts)}
+	res, err := c.api.PostEstateWithResponse(ctx, params, api.CreateEstateRequest{Width: width, Length: length})
+	if err != nil {
+		return Estate{}, err
+	}
+	err = checkStatus(res.HTTPResponse, res.Body, http.StatusCreated)
+	if err != nil {
+		return Estate{}, err
+	}
+	return Estate{ID: *res.JSON201.Id, Width: width, Length: length}, nil
+}
+
+// ListEstates return at most limit estates, oldest first, skipping the first offset ones
+func (c *Client) ListEstates(ctx context.Context, limit int, offset int) ([]Estate, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	res, err := c.api.GetEstateWithResponse(ctx, &api.GetEstateParams{Limit: &limit, Offset: &offset})
+	if err != nil {
+		return nil, err
+	}
+	err = checkStatus(res.HTTPResponse, res.Body, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	estates := make([]Estate, 0, len(res.JSON200.Estates))
+	for _, estate := range res.JSON200.Estates {
+		estates = append(estates, toEstate(estate, 0))
+	}
+	return estates, nil
+}
+
+func (c *Client) GetEstate(ctx context.Context, estateID uuid.UUID) (Estate, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	res, err := c.api.GetEstateIdWithResponse(ctx, estateID)
+	if err != nil {
+		return Estate{}, err
+	}
+	err = checkStatus(res.HTTPResponse, res.Body, http.StatusOK)
+	if err != nil {
+		return Estate{}, err
+	}
+	return toEstate(*res.JSON200, versionOf(res.HTTPResponse)), nil
+}
+
+// ResizeEstate resize the estate if it is still at version, ErrVersionMismatch otherwise
+func (c *Client) ResizeEstate(ctx context.Context, estateID uuid.UUID, width int, length int, version int) (Estate, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	params := &api.PatchEstateIdParams{IfMatch: ifMatch(version)}
+	res, err := c.api.PatchEstateIdWithResponse(ctx, estateID, params, api.ResizeEstateRequest{Width: width, Length: length})
+	if err != nil {
+		return Estate{}, err
+	}
+	err = checkStatus(res.HTTPResponse, res.Body, http.StatusOK)
+	if err != nil {
+		return Estate{}, err
+	}
+	return toEstate(*res.JSON200, versionOf(res.HTTPResponse)), nil
+}
+
+// DeleteEstate delete the estate with its trees
+func (c *Client) DeleteEstate(ctx context.Context, estateID uuid.UUID) error {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	res, err := c.api.DeleteEstateIdWithResponse(ctx, estateID)
+	if err != nil {
+		return err
+	}
+	return checkStatus(res.HTTPResponse, res.Body, http.StatusNoContent)
+}
+
+// CreateTree plant a tree at column x and row y of the estate
+func (c *Client) CreateTree(ctx context.Context, estateID uuid.UUID, x int, y int, height int, opts ...RequestOptions) (Tree, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	params := &api.PostEstateIdTreeParams{IdempotencyKey: idempotencyKey(opts)}
+	res, err := c.api.PostEstateIdTreeWithResponse(ctx, estateID, params, api.CreateTreeRequest{X: x, Y: y, Height: height})
+	if err != nil {
+		return Tree{}, err
+	}
+	err = checkStatus(res.HTTPResponse, res.Body, http.StatusCreated)
+	if err != nil {
+		return Tree{}, err
+	}
+	return Tree{ID: *res.JSON201.Id, X: x, Y: y, Height: height}, nil
+}
+
+// ListTrees return every tree of the estate by row then column
+func (c *Client) ListTrees(ctx context.Context, estateID uuid.UUID) ([]Tree, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	res, err := c.api.GetEstateIdTreeWithResponse(ctx, estateID)
+	if err != nil {
+		return nil, err
+	}
+	err = checkStatus(res.HTTPResponse, res.Body, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	trees := make([]Tree, 0, len(res.JSON200.Trees))
+	for _, tree := range res.JSON200.Trees {
+		trees = append(trees, toTree(tree, 0))
+	}
+	return trees, nil
+}
+
+func (c *Client) GetTree(ctx context.Context, estateID uuid.UUID, treeID uuid.UUID) (Tree, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	res, err := c.api.GetEstateIdTreeTreeIdWithResponse(ctx, estateID, treeID)
+	if err != nil {
+		return Tree{}, err
+	}
+	err = checkStatus(res.HTTPResponse, res.Body, http.StatusOK)
+	if err != nil {
+		return Tree{}, err
+	}
+	return toTree(*res.JSON200, versionOf(res.HTTPResponse)), nil
+}
+
+// UpdateTreeHeight change the height of the tree if it is still at version, ErrVersionMismatch otherwise
+func (c *Client) UpdateTreeHeight(ctx context.Context, estateID uuid.UUID, treeID uuid.UUID, height int, version int) (Tree, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	params := &api.PatchEstateIdTreeTreeIdParams{IfMatch: ifMatch(version)}
+	res, err := c.api.PatchEstateIdTreeTreeIdWithResponse(ctx, estateID, treeID, params, api.UpdateTreeRequest{Height: height})
+	if err != nil {
+		return Tree{}, err
+	}
+	err = checkStatus(res.HTTPResponse, res.Body, http.StatusOK)
+	if err != nil {
+		return Tree{}, err
+	}
+	return toTree(*res.JSON200, versionOf(res.HTTPResponse)), nil
+}
+
+func (c *Client) GetEstateStats(ctx context.Context, estateID uuid.UUID) (EstateStats, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	res, err := c.api.GetEstateIdStatsWithResponse(ctx, estateID)
+	if err != nil {
+		return EstateStats{}, err
+	}
+	err = checkStatus(res.HTTPResponse, res.Body, http.StatusOK)
+	if err != nil {
+		return EstateStats{}, err
+	}
+
+	stats := res.JSON200
+	return EstateStats{Count: value(stats.Count), Max: value(stats.Max), Min: value(stats.Min), Median: value(stats.Median)}, nil
+}
+
+// GetDronePlan return the distance the drone flies over the estate, maxDistance 0 is unlimited
+func (c *Client) GetDronePlan(ctx context.Context, estateID uuid.UUID, maxDistance int) (DronePlan, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	params := &api.GetEstateIdDronePlanParams{}
+	if maxDistance > 0 {
+		params.MaxDistance = &maxDistance
+	}
+	res, err := c.api.GetEstateIdDronePlanWithResponse(ctx, estateID, params)
+	if err != nil {
+		return DronePlan{}, err
+	}
+	err = checkStatus(res.HTTPResponse, res.Body, http.StatusOK)
+	if err != nil {
+		return DronePlan{}, err
+	}
+
+	plan := DronePlan{Distance: value(res.JSON200.Distance)}
+	if rest := res.JSON200.Rest; rest != nil {
+		plan.Rest = &Plot{X: value(rest.X), Y: value(rest.Y)}
+	}
+	return plan, nil
+}
@@ -0,0 +1,224 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	estateID = uuid.MustParse("7a8c9a3e-2f4b-4d6a-9b1e-3c5d7e9f1a2b")
+	treeID   = uuid.MustParse("1b2c3d4e-5f6a-4b7c-8d9e-0f1a2b3c4d5e")
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc, opts ...ClientOptions) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	opts = append([]ClientOptions{WithAPIKey("secret"), WithRetry(3, time.Millisecond, time.Millisecond)}, opts...)
+	c, err := New(server.URL, opts...)
+	require.NoError(t, err)
+	return c
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+func writeProblem(w http.ResponseWriter, status int, code string, title string) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]any{
+		"type": "about:blank", "status": status, "code": code, "title": title, "request_id": "req-1",
+	})
+}
+
+func TestClient_CreateEstate(t *testing.T) {
+	var keys []string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/estate", r.URL.Path)
+		assert.Equal(t, "secret", r.Header.Get("X-API-Key"))
+		assert.Equal(t, "estate-service-go-client", r.Header.Get("User-Agent"))
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		if len(keys) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		writeJSON(w, http.StatusCreated, map[string]any{"id": estateID})
+	})
+
+	estate, err := c.CreateEstate(context.Background(), 10, 20)
+	require.NoError(t, err)
+	assert.Equal(t, Estate{ID: estateID, Width: 10, Length: 20}, estate)
+
+	// the generated key is kept across retries, so the API replays the first creation
+	require.Len(t, keys, 2)
+	assert.NotEmpty(t, keys[0])
+	assert.Equal(t, keys[0], keys[1])
+}
+
+func TestClient_CreateTree_IdempotencyKey(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/estate/"+estateID.String()+"/tree", r.URL.Path)
+		assert.Equal(t, "import-1", r.Header.Get("Idempotency-Key"))
+		writeJSON(w, http.StatusCreated, map[string]any{"id": treeID})
+	})
+
+	tree, err := c.CreateTree(context.Background(), estateID, 1, 2, 5, WithIdempotencyKey("import-1"))
+	require.NoError(t, err)
+	assert.Equal(t, Tree{ID: treeID, X: 1, Y: 2, Height: 5}, tree)
+}
+
+func TestClient_GetEstate_Version(t *testing.T) {
+	tests := []struct {
+		name        string
+		etag        string
+		wantVersion int
+	}{
+		{name: "Strong ETag", etag: `"3"`, wantVersion: 3},
+		{name: "Missing ETag", etag: "", wantVersion: 0},
+		{name: "Weak ETag", etag: `W/"3"`, wantVersion: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if tt.etag != "" {
+					w.Header().Set("ETag", tt.etag)
+				}
+				writeJSON(w, http.StatusOK, map[string]any{"id": estateID, "width": 10, "length": 20})
+			})
+
+			estate, err := c.GetEstate(context.Background(), estateID)
+			require.NoError(t, err)
+			assert.Equal(t, Estate{ID: estateID, Width: 10, Length: 20, Version: tt.wantVersion}, estate)
+		})
+	}
+}
+
+func TestClient_UpdateTreeHeight(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		if r.Header.Get("If-Match") != `"2"` {
+			writeProblem(w, http.StatusPreconditionFailed, "version_mismatch", "Version mismatch")
+			return
+		}
+		w.Header().Set("ETag", `"3"`)
+		writeJSON(w, http.StatusOK, map[string]any{"id": treeID, "x": 1, "y": 2, "height": 7})
+	})
+
+	tree, err := c.UpdateTreeHeight(context.Background(), estateID, treeID, 7, 2)
+	require.NoError(t, err)
+	assert.Equal(t, Tree{ID: treeID, X: 1, Y: 2, Height: 7, Version: 3}, tree)
+
+	_, err = c.UpdateTreeHeight(context.Background(), estateID, treeID, 7, 1)
+	assert.ErrorIs(t, err, ErrVersionMismatch)
+}
+
+func TestClient_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		handler    http.HandlerFunc
+		wantErr    error
+		wantStatus int
+		wantMsg    string
+	}{
+		{
+			name: "Estate not found",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				writeProblem(w, http.StatusNotFound, "estate_not_found", "Estate not found")
+			},
+			wantErr:    ErrEstateNotFound,
+			wantStatus: http.StatusNotFound,
+			wantMsg:    "estate api: Estate not found (estate_not_found, request id req-1)",
+		},
+		{
+			name: "Unauthenticated",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				writeProblem(w, http.StatusUnauthorized, "unauthenticated", "Unauthenticated")
+			},
+			wantErr:    ErrUnauthenticated,
+			wantStatus: http.StatusUnauthorized,
+			wantMsg:    "estate api: Unauthenticated (unauthenticated, request id req-1)",
+		},
+		{
+			name: "Not a problem",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadGateway)
+			},
+			wantStatus: http.StatusBadGateway,
+			wantMsg:    "estate api: unexpected response status 502",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, tt.handler)
+
+			_, err := c.GetEstateStats(context.Background(), estateID)
+			var apiErr *Error
+			require.True(t, errors.As(err, &apiErr))
+			assert.Equal(t, tt.wantStatus, apiErr.StatusCode)
+			assert.Equal(t, tt.wantMsg, err.Error())
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestClient_ValidationError(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"type":"about:blank","status":400,"code":"validation_failed","title":"Validation failed",` +
+			`"errors":[{"field":"width","message":"must be at least 1"}]}`))
+	})
+
+	_, err := c.CreateEstate(context.Background(), 0, 1)
+	assert.ErrorIs(t, err, ErrValidation)
+	var apiErr *Error
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, []FieldError{{Field: "width", Message: "must be at least 1"}}, apiErr.FieldErrors)
+}
+
+func TestClient_Timeout(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}, WithTimeout(10*time.Millisecond))
+
+	_, err := c.GetEstate(context.Background(), estateID)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestClient_ListTrees(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]any{"trees": []map[string]any{{"id": treeID, "x": 1, "y": 2, "height": 5}}})
+	})
+
+	trees, err := c.ListTrees(context.Background(), estateID)
+	require.NoError(t, err)
+	assert.Equal(t, []Tree{{ID: treeID, X: 1, Y: 2, Height: 5}}, trees)
+}
+
+func TestClient_GetDronePlan(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "15", r.URL.Query().Get("max-distance"))
+		writeJSON(w, http.StatusOK, map[string]any{"distance": 15, "rest": map[string]any{"x": 2, "y": 1}})
+	})
+
+	plan, err := c.GetDronePlan(context.Background(), estateID, 15)
+	require.NoError(t, err)
+	assert.Equal(t, DronePlan{Distance: 15, Rest: &Plot{X: 2, Y: 1}}, plan)
+}
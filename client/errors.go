@@ -0,0 +1,132 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/SawitProRecruitment/EstateService/client/api"
+)
+
+// Errors of the API, an *Error matches the one of its problem code with errors.Is
+var (
+	ErrUnauthenticated         = errors.New("missing or invalid api key")
+	ErrForbidden               = errors.New("api key is not allowed to perform this action")
+	ErrValidation              = errors.New("request validation failed")
+	ErrEstateNotFound          = errors.New("estates not found")
+	ErrTreeNotFound            = errors.New("tree not found")
+	ErrTreeAlreadyExists       = errors.New("tree already exists")
+	ErrTreePlotOutOfBound      = errors.New("tree plot out of bound")
+	ErrVersionMismatch         = errors.New("resource was modified by another request")
+	ErrPreconditionRequired    = errors.New("if-match header is required")
+	ErrIdempotencyKeyInvalid   = errors.New("invalid idempotency key")
+	ErrIdempotencyInProgress   = errors.New("request with the same idempotency key is still in progress")
+	ErrIdempotencyKeyReused    = errors.New("idempotency key was already used with a different request")
+	ErrFeatureDisabled         = errors.New("feature is disabled")
+	ErrEventStreamUnavailable  = errors.New("event stream unavailable")
+	ErrWebhookNotFound         = errors.New("webhook not found")
+	ErrWebhookInvalidURL       = errors.New("invalid webhook url")
+	ErrWebhookInvalidEventType = errors.New("invalid webhook event type")
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+	ErrAPIKeyNotFound          = errors.New("api key not found")
+	ErrInvalidRole             = errors.New("role must be one of viewer, planter or admin")
+	ErrInternal                = errors.New("internal server error")
+)
+
+// codeErrors map the stable problem codes of the API to their errors
+var codeErrors = map[string]error{
+	"unauthenticated":                 ErrUnauthenticated,
+	"forbidden":                       ErrForbidden,
+	"validation_failed":               ErrValidation,
+	"invalid_request":                 ErrValidation,
+	"estate_not_found":                ErrEstateNotFound,
+	"tree_not_found":                  ErrTreeNotFound,
+	"tree_already_exists":             ErrTreeAlreadyExists,
+	"tree_plot_out_of_bound":          ErrTreePlotOutOfBound,
+	"version_mismatch":                ErrVersionMismatch,
+	"precondition_required":           ErrPreconditionRequired,
+	"idempotency_key_invalid":         ErrIdempotencyKeyInvalid,
+	"idempotency_request_in_progress": ErrIdempotencyInProgress,
+	"idempotency_key_reused":          ErrIdempotencyKeyReused,
+	"feature_disabled":                ErrFeatureDisabled,
+	"event_stream_unavailable":        ErrEventStreamUnavailable,
+	"webhook_not_found":               ErrWebhookNotFound,
+	"webhook_invalid_url":             ErrWebhookInvalidURL,
+	"webhook_invalid_event_type":      ErrWebhookInvalidEventType,
+	"webhook_delivery_not_found":      ErrWebhookDeliveryNotFound,
+	"api_key_not_found":               ErrAPIKeyNotFound,
+	"invalid_role":                    ErrInvalidRole,
+	"internal_error":                  ErrInternal,
+}
+
+// Error is an unexpected response of the API, described by its RFC 7807 problem when the body is one
+type Error struct {
+	StatusCode  int
+	Code        string
+	Title       string
+	Detail      string
+	RequestID   string
+	FieldErrors []FieldError
+}
+
+// FieldError is one invalid field of a request that failed validation
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e *Error) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("estate api: unexpected response status %d", e.StatusCode)
+	}
+
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "estate api: %s", e.Title)
+	if e.Detail != "" {
+		msg.WriteString(": " + e.Detail)
+	}
+	fmt.Fprintf(&msg, " (%s", e.Code)
+	if e.RequestID != "" {
+		fmt.Fprintf(&msg, ", request id %s", e.RequestID)
+	}
+	msg.WriteString(")")
+	for _, fieldErr := range e.FieldErrors {
+		fmt.Fprintf(&msg, "; %s: %s", fieldErr.Field, fieldErr.Message)
+	}
+	return msg.String()
+}
+
+// Unwrap return the error of the problem code, so errors.Is(err, ErrEstateNotFound) works
+func (e *Error) Unwrap() error {
+	return codeErrors[e.Code]
+}
+
+// checkStatus return an *Error unless res has the expected status
+func checkStatus(res *http.Response, body []byte, want int) error {
+	if res.StatusCode == want {
+		return nil
+	}
+
+	apiErr := &Error{StatusCode: res.StatusCode}
+	var problem api.Problem
+	if json.Unmarshal(body, &problem) != nil {
+		return apiErr
+	}
+
+	apiErr.Code = problem.Code
+	apiErr.Title = problem.Title
+	if problem.Detail != nil {
+		apiErr.Detail = *problem.Detail
+	}
+	if problem.RequestId != nil {
+		apiErr.RequestID = *problem.RequestId
+	}
+	if problem.Errors != nil {
+		for _, fieldErr := range *problem.Errors {
+			apiErr.FieldErrors = append(apiErr.FieldErrors, FieldError{Field: fieldErr.Field, Message: fieldErr.Message})
+		}
+	}
+	return apiErr
+}
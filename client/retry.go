@@ -0,0 +1,143 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/client/api"
+)
+
+// retryPolicy retry a request with exponential backoff and full jitter, maxAttempts below 2 disables retries
+type retryPolicy struct {
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+}
+
+var defaultRetry = retryPolicy{maxAttempts: 3, initialBackoff: 100 * time.Millisecond, maxBackoff: 2 * time.Second}
+
+// backoff return a random delay before the attempt following attempt, up to initialBackoff doubled per attempt
+func (r retryPolicy) backoff(attempt int) time.Duration {
+	backoff := r.initialBackoff
+	for i := 1; i < attempt && backoff < r.maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > r.maxBackoff {
+		backoff = r.maxBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(backoff) + 1))
+}
+
+// retryDoer send a request again when it failed with a transient error and sending it twice is safe:
+// GET requests, and POST requests carrying an Idempotency-Key since the API replays their first response
+type retryDoer struct {
+	next   api.HttpRequestDoer
+	policy retryPolicy
+}
+
+func (d *retryDoer) Do(req *http.Request) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		res, err := d.next.Do(req)
+		if attempt >= d.policy.maxAttempts || !retryable(req, res, err) {
+			return res, err
+		}
+
+		delay := d.policy.backoff(attempt)
+		if res != nil {
+			if after, ok := retryAfter(res); ok {
+				delay = min(after, d.policy.maxBackoff)
+			}
+			io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		req, err = rewind(req)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// retryable report transient failures of requests that are safe to send again,
+// a request cancelled by its context is never retried
+func retryable(req *http.Request, res *http.Response, err error) bool {
+	keyed := req.Method == http.MethodPost && req.Header.Get("Idempotency-Key") != ""
+	if req.Method != http.MethodGet && !keyed {
+		return false
+	}
+
+	if err != nil {
+		return req.Context().Err() == nil
+	}
+
+	switch res.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	case http.StatusConflict:
+		// the first request with the same key is still running, its response is replayed once it is done,
+		// any other conflict such as a reused key or a version mismatch fails again the same way
+		return keyed && problemCode(res) == "idempotency_request_in_progress"
+	default:
+		return false
+	}
+}
+
+// problemCode return the code of the problem in the body of res, the body is restored so the caller can still read it
+func problemCode(res *http.Response) string {
+	if res.Body == nil {
+		return ""
+	}
+
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	res.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return ""
+	}
+
+	var problem api.Problem
+	if json.Unmarshal(body, &problem) != nil {
+		return ""
+	}
+	return problem.Code
+}
+
+// retryAfter return the delay of a Retry-After header given in seconds
+func retryAfter(res *http.Response) (time.Duration, bool) {
+	seconds, err := strconv.Atoi(res.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
+
+// rewind return a copy of req with a fresh body, the previous one was consumed by the failed attempt
+func rewind(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.GetBody == nil {
+		return req, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	req.Body = body
+	return req, nil
+}
@@ -0,0 +1,171 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_retryPolicy_backoff(t *testing.T) {
+	policy := retryPolicy{maxAttempts: 5, initialBackoff: 10 * time.Millisecond, maxBackoff: 50 * time.Millisecond}
+
+	for attempt, limit := range map[int]time.Duration{1: 10 * time.Millisecond, 2: 20 * time.Millisecond, 3: 40 * time.Millisecond, 10: 50 * time.Millisecond} {
+		for i := 0; i < 100; i++ {
+			delay := policy.backoff(attempt)
+			assert.GreaterOrEqual(t, delay, time.Duration(0))
+			assert.LessOrEqual(t, delay, limit)
+		}
+	}
+	assert.Equal(t, time.Duration(0), retryPolicy{}.backoff(1))
+}
+
+// doerFunc adapt a function to api.HttpRequestDoer
+type doerFunc func(*http.Request) (*http.Response, error)
+
+func (f doerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func response(status int) *http.Response {
+	return &http.Response{StatusCode: status, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(""))}
+}
+
+func problemResponse(status int, code string) *http.Response {
+	res := response(status)
+	res.Header.Set("Content-Type", "application/problem+json")
+	res.Body = io.NopCloser(strings.NewReader(`{"type":"about:blank","title":"Conflict","status":409,"code":"` + code + `"}`))
+	return res
+}
+
+func Test_retryDoer_Do(t *testing.T) {
+	errNetwork := errors.New("connection reset")
+	policy := retryPolicy{maxAttempts: 3, initialBackoff: time.Millisecond, maxBackoff: time.Millisecond}
+
+	tests := []struct {
+		name       string
+		method     string
+		key        string
+		responses  []*http.Response
+		errs       []error
+		wantStatus int
+		wantErr    error
+		wantCalls  int
+	}{
+		{name: "GET succeeds first", method: http.MethodGet, responses: []*http.Response{response(200)}, wantStatus: 200, wantCalls: 1},
+		{name: "GET retried on 503", method: http.MethodGet, responses: []*http.Response{response(503), response(200)}, wantStatus: 200, wantCalls: 2},
+		{name: "GET retried on network error", method: http.MethodGet, responses: []*http.Response{nil, response(200)}, errs: []error{errNetwork, nil}, wantStatus: 200, wantCalls: 2},
+		{name: "GET not retried on 404", method: http.MethodGet, responses: []*http.Response{response(404)}, wantStatus: 404, wantCalls: 1},
+		{name: "Attempts exhausted", method: http.MethodGet, responses: []*http.Response{response(502), response(502), response(502)}, wantStatus: 502, wantCalls: 3},
+		{name: "POST without key not retried", method: http.MethodPost, responses: []*http.Response{response(503)}, wantStatus: 503, wantCalls: 1},
+		{name: "POST with key retried", method: http.MethodPost, key: "key", responses: []*http.Response{nil, response(201)}, errs: []error{errNetwork, nil}, wantStatus: 201, wantCalls: 2},
+		{name: "POST with key retried while in progress", method: http.MethodPost, key: "key", responses: []*http.Response{problemResponse(409, "idempotency_request_in_progress"), response(201)}, wantStatus: 201, wantCalls: 2},
+		{name: "POST with reused key not retried", method: http.MethodPost, key: "key", responses: []*http.Response{problemResponse(409, "idempotency_key_reused")}, wantStatus: 409, wantCalls: 1},
+		{name: "POST with key not retried on other conflict", method: http.MethodPost, key: "key", responses: []*http.Response{problemResponse(409, "tree_already_exists")}, wantStatus: 409, wantCalls: 1},
+		{name: "POST with key not retried on 409 without problem", method: http.MethodPost, key: "key", responses: []*http.Response{response(409)}, wantStatus: 409, wantCalls: 1},
+		{name: "GET not retried on 409", method: http.MethodGet, responses: []*http.Response{response(409)}, wantStatus: 409, wantCalls: 1},
+		{name: "PATCH not retried", method: http.MethodPatch, responses: []*http.Response{response(503)}, wantStatus: 503, wantCalls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var bodies []string
+			doer := &retryDoer{policy: policy, next: doerFunc(func(req *http.Request) (*http.Response, error) {
+				body, _ := io.ReadAll(req.Body)
+				bodies = append(bodies, string(body))
+				calls := len(bodies) - 1
+				var err error
+				if tt.errs != nil {
+					err = tt.errs[calls]
+				}
+				return tt.responses[calls], err
+			})}
+
+			req, err := http.NewRequest(tt.method, "http://estate.test/estate", strings.NewReader(`{"width":1}`))
+			require.NoError(t, err)
+			if tt.key != "" {
+				req.Header.Set("Idempotency-Key", tt.key)
+			}
+
+			res, err := doer.Do(req)
+			assert.Equal(t, tt.wantErr, err)
+			require.NotNil(t, res)
+			assert.Equal(t, tt.wantStatus, res.StatusCode)
+			assert.Len(t, bodies, tt.wantCalls)
+			for _, body := range bodies {
+				assert.Equal(t, `{"width":1}`, body)
+			}
+		})
+	}
+}
+
+func Test_retryDoer_Do_ConflictBodyRestored(t *testing.T) {
+	doer := &retryDoer{
+		policy: retryPolicy{maxAttempts: 3, initialBackoff: time.Millisecond, maxBackoff: time.Millisecond},
+		next: doerFunc(func(req *http.Request) (*http.Response, error) {
+			return problemResponse(409, "idempotency_key_reused"), nil
+		}),
+	}
+
+	req, err := http.NewRequest(http.MethodPost, "http://estate.test/estate", strings.NewReader(`{"width":1}`))
+	require.NoError(t, err)
+	req.Header.Set("Idempotency-Key", "key")
+
+	res, err := doer.Do(req)
+	require.NoError(t, err)
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	assert.ErrorIs(t, checkStatus(res, body, http.StatusCreated), ErrIdempotencyKeyReused)
+}
+
+func Test_retryDoer_Do_ContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	doer := &retryDoer{
+		policy: retryPolicy{maxAttempts: 3, initialBackoff: time.Hour, maxBackoff: time.Hour},
+		next: doerFunc(func(req *http.Request) (*http.Response, error) {
+			calls++
+			cancel()
+			return response(503), nil
+		}),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://estate.test/estate", nil)
+	require.NoError(t, err)
+
+	res, err := doer.Do(req)
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, calls)
+}
+
+func Test_retryDoer_Do_RetryAfter(t *testing.T) {
+	calls := 0
+	doer := &retryDoer{
+		policy: retryPolicy{maxAttempts: 2, initialBackoff: time.Hour, maxBackoff: time.Millisecond},
+		next: doerFunc(func(req *http.Request) (*http.Response, error) {
+			calls++
+			if calls == 1 {
+				res := response(429)
+				res.Header.Set("Retry-After", "60")
+				return res, nil
+			}
+			return response(200), nil
+		}),
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "http://estate.test/estate", nil)
+	require.NoError(t, err)
+
+	// Retry-After is capped by maxBackoff, the test would time out otherwise
+	res, err := doer.Do(req)
+	require.NoError(t, err)
+	assert.Equal(t, 200, res.StatusCode)
+	assert.Equal(t, 2, calls)
+}
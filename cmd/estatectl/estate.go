@@ -0,0 +1,130 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/SawitProRecruitment/EstateService/client"
+	"github.com/google/uuid"
+)
+
+// estateIDArg parse the single ESTATE_ID argument of a command
+func estateIDArg(cmd *command) (uuid.UUID, error) {
+	if len(cmd.args) != 1 {
+		return uuid.Nil, errors.New("expected one ESTATE_ID argument")
+	}
+	id, err := uuid.Parse(cmd.args[0])
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid estate id %q", cmd.args[0])
+	}
+	return id, nil
+}
+
+func estateCreate(cmd *command, flags *commandFlags) error {
+	estate, err := cmd.client.CreateEstate(cmd.ctx, flags.width, flags.length, idempotencyKey(flags.idempotencyKey)...)
+	if err != nil {
+		return err
+	}
+	return cmd.out.print(estate, estateHeader, [][]string{estateRow(estate)})
+}
+
+var estateHeader = []string{"ID", "WIDTH", "LENGTH"}
+
+func estateRow(estate client.Estate) []string {
+	return []string{estate.ID.String(), strconv.Itoa(estate.Width), strconv.Itoa(estate.Length)}
+}
+
+// estateList list one page of estates, or every page with -all
+func estateList(cmd *command, flags *commandFlags) error {
+	estates := []client.Estate{}
+	offset := flags.offset
+	for {
+		page, err := cmd.client.ListEstates(cmd.ctx, flags.limit, offset)
+		if err != nil {
+			return err
+		}
+		estates = append(estates, page...)
+
+		if !flags.all || len(page) < flags.limit {
+			break
+		}
+		offset += len(page)
+	}
+
+	rows := make([][]string, 0, len(estates))
+	for _, estate := range estates {
+		rows = append(rows, estateRow(estate))
+	}
+	return cmd.out.print(estates, estateHeader, rows)
+}
+
+func estateGet(cmd *command, _ *commandFlags) error {
+	id, err := estateIDArg(cmd)
+	if err != nil {
+		return err
+	}
+
+	estate, err := cmd.client.GetEstate(cmd.ctx, id)
+	if err != nil {
+		return err
+	}
+	return cmd.out.print(estate, estateHeader, [][]string{estateRow(estate)})
+}
+
+// estateDelete delete the estate, confirmation is written to stderr so stdout stays empty for scripts
+func estateDelete(cmd *command, _ *commandFlags) error {
+	id, err := estateIDArg(cmd)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.client.DeleteEstate(cmd.ctx, id)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.stderr, "Estate %s deleted\n", id)
+	return nil
+}
+
+func stats(cmd *command, _ *commandFlags) error {
+	id, err := estateIDArg(cmd)
+	if err != nil {
+		return err
+	}
+
+	s, err := cmd.client.GetEstateStats(cmd.ctx, id)
+	if err != nil {
+		return err
+	}
+	return cmd.out.print(s, []string{"COUNT", "MAX", "MIN", "MEDIAN"}, [][]string{{
+		strconv.Itoa(s.Count), strconv.Itoa(s.Max), strconv.Itoa(s.Min), strconv.Itoa(s.Median),
+	}})
+}
+
+func dronePlan(cmd *command, flags *commandFlags) error {
+	id, err := estateIDArg(cmd)
+	if err != nil {
+		return err
+	}
+
+	plan, err := cmd.client.GetDronePlan(cmd.ctx, id, flags.maxDistance)
+	if err != nil {
+		return err
+	}
+
+	row := []string{strconv.Itoa(plan.Distance), "", ""}
+	if plan.Rest != nil {
+		row[1], row[2] = strconv.Itoa(plan.Rest.X), strconv.Itoa(plan.Rest.Y)
+	}
+	return cmd.out.print(plan, []string{"DISTANCE", "REST_X", "REST_Y"}, [][]string{row})
+}
+
+// idempotencyKey return the request option of the -idempotency-key flag, the client generates a key without it
+func idempotencyKey(key string) []client.RequestOptions {
+	if key == "" {
+		return nil
+	}
+	return []client.RequestOptions{client.WithIdempotencyKey(key)}
+}
@@ -0,0 +1,239 @@
+// Command estatectl manages estates and trees through the Estate API.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/client"
+)
+
+const usage = `Usage: estatectl <command> [flags] [args]
+
+Commands:
+  estate create -width W -length L      create an estate
+  estate list [-limit N] [-offset N]    list estates
+  estate get ESTATE_ID                  show an estate
+  estate delete ESTATE_ID               delete an estate with its trees
+  tree add ESTATE_ID -x X -y Y -height H
+                                        plant a tree
+  tree import ESTATE_ID -file FILE      plant every tree of a CSV file with x,y,height columns
+  tree list ESTATE_ID                   list trees of an estate
+  stats ESTATE_ID                       show tree stats of an estate
+  drone-plan ESTATE_ID [-max-distance D]
+                                        show the drone travel distance of an estate
+  profile set NAME -server URL -api-key KEY
+                                        save server and api key as a profile
+  profile use NAME                      make a profile the default one
+  profile list                          list saved profiles
+
+Estate, tree, stats and drone-plan commands accept -profile, -server, -api-key and -o (table, json or csv).
+Server and api key are taken from flags, then ESTATECTL_SERVER and ESTATECTL_API_KEY,
+then the profile given by -profile or ESTATECTL_PROFILE, then the current profile.
+Profiles are stored in ESTATECTL_CONFIG, by default estatectl/config.yml in the user config directory.
+`
+
+// requestTimeout bound every api call, retries included, imports apply it per tree
+const requestTimeout = 30 * time.Second
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	os.Exit(run(ctx, os.Args[1:], os.Stdin, os.Stdout, os.Stderr, os.Getenv))
+}
+
+// command is a resolved invocation, its api client is created from the selected profile
+type command struct {
+	ctx    context.Context
+	client *client.Client
+	out    *printer
+	stdin  io.Reader
+	stderr io.Writer
+	args   []string
+}
+
+// run execute the command of args and return the exit code, 2 for usage errors
+func run(ctx context.Context, args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer, getenv func(string) string) int {
+	if len(args) == 0 || args[0] == "-h" || args[0] == "-help" || args[0] == "help" {
+		fmt.Fprint(stderr, usage)
+		if len(args) == 0 {
+			return 2
+		}
+		return 0
+	}
+
+	name := args[0]
+	args = args[1:]
+	if name == "estate" || name == "tree" || name == "profile" {
+		if len(args) == 0 {
+			fmt.Fprintf(stderr, "estatectl %s: missing subcommand\n\n%s", name, usage)
+			return 2
+		}
+		name += " " + args[0]
+		args = args[1:]
+	}
+
+	handler, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(stderr, "estatectl: unknown command %q\n\n%s", name, usage)
+		return 2
+	}
+
+	err := handler(ctx, name, args, stdin, stdout, stderr, getenv)
+	switch {
+	case err == nil:
+		return 0
+	case errors.Is(err, flag.ErrHelp):
+		return 0
+	case errors.Is(err, errUsage):
+		return 2
+	default:
+		fmt.Fprintf(stderr, "estatectl %s: %s\n", name, err)
+		return 1
+	}
+}
+
+// errUsage is returned once the usage error was already reported by the flag set
+var errUsage = errors.New("usage error")
+
+type commandFunc func(ctx context.Context, name string, args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer, getenv func(string) string) error
+
+var commands = map[string]commandFunc{
+	"estate create": apiCommand(estateCreate, "width", "length", "idempotency-key"),
+	"estate list":   apiCommand(estateList, "limit", "offset", "all"),
+	"estate get":    apiCommand(estateGet),
+	"estate delete": apiCommand(estateDelete),
+	"tree add":      apiCommand(treeAdd, "x", "y", "height", "idempotency-key"),
+	"tree import":   apiCommand(treeImport, "file"),
+	"tree list":     apiCommand(treeList),
+	"stats":         apiCommand(stats),
+	"drone-plan":    apiCommand(dronePlan, "max-distance"),
+	"profile set":   profileSet,
+	"profile use":   profileUse,
+	"profile list":  profileList,
+}
+
+// apiFunc run one api command, flags holds the values of the command flags named by apiCommand
+type apiFunc func(cmd *command, flags *commandFlags) error
+
+// commandFlags are the flags specific to api commands, each command registers only the ones it reads
+type commandFlags struct {
+	width, length, x, y, height int
+	limit, offset, maxDistance  int
+	all                         bool
+	idempotencyKey, file        string
+}
+
+// apiCommand parse the global flags and the given command flags, then run fn with a client of the selected profile
+func apiCommand(fn apiFunc, flagNames ...string) commandFunc {
+	return func(ctx context.Context, name string, args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer, getenv func(string) string) error {
+		fs := flag.NewFlagSet("estatectl "+name, flag.ContinueOnError)
+		fs.SetOutput(stderr)
+
+		var global globalFlags
+		global.register(fs)
+
+		var flags commandFlags
+		register := map[string]func(){
+			"width":  func() { fs.IntVar(&flags.width, "width", 0, "estate width") },
+			"length": func() { fs.IntVar(&flags.length, "length", 0, "estate length") },
+			"x":      func() { fs.IntVar(&flags.x, "x", 0, "tree column, from 1 to the estate length") },
+			"y":      func() { fs.IntVar(&flags.y, "y", 0, "tree row, from 1 to the estate width") },
+			"height": func() { fs.IntVar(&flags.height, "height", 0, "tree height") },
+			"limit":  func() { fs.IntVar(&flags.limit, "limit", 100, "maximum number of estates") },
+			"offset": func() { fs.IntVar(&flags.offset, "offset", 0, "number of estates to skip") },
+			"all":    func() { fs.BoolVar(&flags.all, "all", false, "list every estate, page by page") },
+			"max-distance": func() {
+				fs.IntVar(&flags.maxDistance, "max-distance", 0, "maximum distance the drone can travel, 0 is unlimited")
+			},
+			"idempotency-key": func() { fs.StringVar(&flags.idempotencyKey, "idempotency-key", "", "key to safely retry the request") },
+			"file":            func() { fs.StringVar(&flags.file, "file", "-", "CSV file of trees, - reads standard input") },
+		}
+		for _, flagName := range flagNames {
+			register[flagName]()
+		}
+
+		err := parseInterspersed(fs, args)
+		if err != nil {
+			return err
+		}
+
+		out, err := newPrinter(stdout, global.output)
+		if err != nil {
+			return err
+		}
+
+		apiClient, err := global.newClient(getenv)
+		if err != nil {
+			return err
+		}
+
+		return fn(&command{
+			ctx:    ctx,
+			client: apiClient,
+			out:    out,
+			stdin:  stdin,
+			stderr: stderr,
+			args:   fs.Args(),
+		}, &flags)
+	}
+}
+
+// parseInterspersed parse flags placed before and after positional arguments,
+// so `estate get ID -o json` works like `estate get -o json ID`
+func parseInterspersed(fs *flag.FlagSet, args []string) error {
+	var positional []string
+	for {
+		err := fs.Parse(args)
+		if err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return err
+			}
+			return errUsage
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			break
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+	return fs.Parse(append([]string{"--"}, positional...))
+}
+
+// globalFlags select the server, credentials and output format of every api command
+type globalFlags struct {
+	profile string
+	server  string
+	apiKey  string
+	output  string
+}
+
+func (g *globalFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&g.profile, "profile", "", "profile to use instead of the current one")
+	fs.StringVar(&g.server, "server", "", "api server url")
+	fs.StringVar(&g.apiKey, "api-key", "", "api key sent as X-API-Key")
+	fs.StringVar(&g.output, "o", formatTable, "output format: table, json or csv")
+}
+
+// newClient create an api client for the server and api key resolved from flags, environment and profiles
+func (g *globalFlags) newClient(getenv func(string) string) (*client.Client, error) {
+	target, err := resolveTarget(g, getenv)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.New(target.Server,
+		client.WithAPIKey(target.APIKey),
+		client.WithUserAgent("estatectl"),
+		client.WithTimeout(requestTimeout),
+	)
+}
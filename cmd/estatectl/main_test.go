@@ -0,0 +1,312 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testEstateID = "3f0c4c2e-2b6b-4f3e-9d7a-0b8f7a1c2d3e"
+	testTreeID   = "9a1b2c3d-4e5f-4a6b-8c7d-1e2f3a4b5c6d"
+)
+
+// fakeAPI answer like the estate api, requests are recorded to check what was sent
+type fakeAPI struct {
+	requests []*http.Request
+	bodies   []string
+}
+
+func (f *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	f.requests = append(f.requests, r)
+	f.bodies = append(f.bodies, string(body))
+
+	if r.Header.Get("X-API-Key") != "test-key" {
+		problem(w, http.StatusUnauthorized, "unauthenticated", "Missing or invalid API key")
+		return
+	}
+
+	switch r.Method + " " + r.URL.Path {
+	case "POST /estate":
+		respond(w, http.StatusCreated, `{"id":"`+testEstateID+`"}`)
+	case "GET /estate":
+		if r.URL.Query().Get("offset") == "0" {
+			respond(w, http.StatusOK, `{"estates":[{"id":"`+testEstateID+`","width":10,"length":20}]}`)
+			return
+		}
+		respond(w, http.StatusOK, `{"estates":[]}`)
+	case "GET /estate/" + testEstateID:
+		respond(w, http.StatusOK, `{"id":"`+testEstateID+`","width":10,"length":20}`)
+	case "DELETE /estate/" + testEstateID:
+		w.WriteHeader(http.StatusNoContent)
+	case "POST /estate/" + testEstateID + "/tree":
+		if strings.Contains(string(body), `"x":99`) {
+			problem(w, http.StatusBadRequest, "tree_plot_out_of_bound", "Tree plot is out of the estate")
+			return
+		}
+		respond(w, http.StatusCreated, `{"id":"`+testTreeID+`"}`)
+	case "GET /estate/" + testEstateID + "/tree":
+		respond(w, http.StatusOK, `{"trees":[{"id":"`+testTreeID+`","x":1,"y":2,"height":5}]}`)
+	case "GET /estate/" + testEstateID + "/stats":
+		respond(w, http.StatusOK, `{"count":1,"max":5,"min":5,"median":5}`)
+	case "GET /estate/" + testEstateID + "/drone-plan":
+		respond(w, http.StatusOK, `{"distance":42}`)
+	default:
+		problem(w, http.StatusNotFound, "estate_not_found", "Estate not found")
+	}
+}
+
+func respond(w http.ResponseWriter, status int, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	io.WriteString(w, body)
+}
+
+func problem(w http.ResponseWriter, status int, code string, title string) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]any{"type": "urn:estate-service:problem:" + code, "title": title, "status": status, "code": code, "request_id": "req-1"})
+}
+
+// runCLI run estatectl against api with a fresh profile file and return exit code, stdout and stderr
+func runCLI(t *testing.T, api *fakeAPI, env map[string]string, stdin string, args ...string) (int, string, string) {
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	vars := map[string]string{
+		"ESTATECTL_CONFIG":  filepath.Join(t.TempDir(), "config.yml"),
+		"ESTATECTL_SERVER":  server.URL,
+		"ESTATECTL_API_KEY": "test-key",
+	}
+	for k, v := range env {
+		vars[k] = v
+	}
+
+	var stdout, stderr bytes.Buffer
+	code := run(context.Background(), args, strings.NewReader(stdin), &stdout, &stderr, func(key string) string { return vars[key] })
+	return code, stdout.String(), stderr.String()
+}
+
+func TestRun_Commands(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		wantCode   int
+		wantStdout string
+		wantStderr string
+		wantPath   string
+	}{
+		{
+			name:       "Estate create",
+			args:       []string{"estate", "create", "-width", "10", "-length", "20", "-idempotency-key", "k1"},
+			wantStdout: "ID                                    WIDTH  LENGTH\n" + testEstateID + "  10     20\n",
+			wantPath:   "/estate",
+		},
+		{
+			name:       "Estate list as csv",
+			args:       []string{"estate", "list", "-o", "csv"},
+			wantStdout: "id,width,length\n" + testEstateID + ",10,20\n",
+			wantPath:   "/estate?limit=100&offset=0",
+		},
+		{
+			name:       "Estate get with flags after the id",
+			args:       []string{"estate", "get", testEstateID, "-o", "json"},
+			wantStdout: "{\n  \"id\": \"" + testEstateID + "\",\n  \"width\": 10,\n  \"length\": 20\n}\n",
+			wantPath:   "/estate/" + testEstateID,
+		},
+		{
+			name:       "Estate delete",
+			args:       []string{"estate", "delete", testEstateID},
+			wantStderr: "Estate " + testEstateID + " deleted\n",
+			wantPath:   "/estate/" + testEstateID,
+		},
+		{
+			name:       "Tree add",
+			args:       []string{"tree", "add", testEstateID, "-x", "1", "-y", "2", "-height", "5", "-o", "csv"},
+			wantStdout: "id,x,y,height\n" + testTreeID + ",1,2,5\n",
+			wantPath:   "/estate/" + testEstateID + "/tree",
+		},
+		{
+			name:       "Tree list",
+			args:       []string{"tree", "list", testEstateID, "-o", "csv"},
+			wantStdout: "id,x,y,height\n" + testTreeID + ",1,2,5\n",
+			wantPath:   "/estate/" + testEstateID + "/tree",
+		},
+		{
+			name:       "Stats",
+			args:       []string{"stats", testEstateID, "-o", "csv"},
+			wantStdout: "count,max,min,median\n1,5,5,5\n",
+			wantPath:   "/estate/" + testEstateID + "/stats",
+		},
+		{
+			name:       "Drone plan",
+			args:       []string{"drone-plan", testEstateID, "-max-distance", "30", "-o", "csv"},
+			wantStdout: "distance,rest_x,rest_y\n42,,\n",
+			wantPath:   "/estate/" + testEstateID + "/drone-plan?max-distance=30",
+		},
+		{
+			name:       "Problem is reported",
+			args:       []string{"tree", "add", testEstateID, "-x", "99", "-y", "1", "-height", "5"},
+			wantCode:   1,
+			wantStderr: "estatectl tree add: estate api: Tree plot is out of the estate (tree_plot_out_of_bound, request id req-1)\n",
+			wantPath:   "/estate/" + testEstateID + "/tree",
+		},
+		{
+			name:       "Invalid estate id",
+			args:       []string{"stats", "not-an-id"},
+			wantCode:   1,
+			wantStderr: "estatectl stats: invalid estate id \"not-an-id\"\n",
+		},
+		{
+			name:       "Unknown output format",
+			args:       []string{"estate", "list", "-o", "yaml"},
+			wantCode:   1,
+			wantStderr: "estatectl estate list: unknown output format \"yaml\", use table, json or csv\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := &fakeAPI{}
+			code, stdout, stderr := runCLI(t, api, nil, "", tt.args...)
+			assert.Equal(t, tt.wantCode, code)
+			assert.Equal(t, tt.wantStdout, stdout)
+			assert.Equal(t, tt.wantStderr, stderr)
+			if tt.wantPath != "" && assert.Len(t, api.requests, 1) {
+				assert.Equal(t, tt.wantPath, api.requests[0].URL.RequestURI())
+			}
+		})
+	}
+}
+
+func TestRun_EstateCreateSendsIdempotencyKey(t *testing.T) {
+	api := &fakeAPI{}
+	code, _, _ := runCLI(t, api, nil, "", "estate", "create", "-width", "10", "-length", "20", "-idempotency-key", "k1")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "k1", api.requests[0].Header.Get("Idempotency-Key"))
+	assert.JSONEq(t, `{"width":10,"length":20}`, api.bodies[0])
+}
+
+func TestRun_EstateListAll(t *testing.T) {
+	api := &fakeAPI{}
+	code, stdout, _ := runCLI(t, api, nil, "", "estate", "list", "-all", "-limit", "1", "-o", "csv")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "id,width,length\n"+testEstateID+",10,20\n", stdout)
+	if assert.Len(t, api.requests, 2) {
+		assert.Equal(t, "/estate?limit=1&offset=1", api.requests[1].URL.RequestURI())
+	}
+}
+
+func TestRun_TreeImport(t *testing.T) {
+	api := &fakeAPI{}
+	input := "height,x,y\n5,1,2\n5,99,1\nfive,1,3\n"
+
+	code, stdout, stderr := runCLI(t, api, nil, input, "tree", "import", testEstateID, "-o", "csv")
+	assert.Equal(t, 1, code)
+	assert.Equal(t, "estatectl tree import: 2 of 3 trees failed\n", stderr)
+	assert.Equal(t, "line,x,y,height,id,error\n"+
+		"2,1,2,5,"+testTreeID+",\n"+
+		"3,99,1,5,,\"estate api: Tree plot is out of the estate (tree_plot_out_of_bound, request id req-1)\"\n"+
+		"4,0,0,0,,\"invalid height \"\"five\"\"\"\n", stdout)
+
+	if assert.Len(t, api.requests, 2) {
+		assert.Equal(t, "estatectl-import-"+testEstateID+"-1-2-5", api.requests[0].Header.Get("Idempotency-Key"))
+	}
+}
+
+func TestRun_TreeImportMissingColumn(t *testing.T) {
+	code, _, stderr := runCLI(t, &fakeAPI{}, nil, "x,y\n1,2\n", "tree", "import", testEstateID)
+	assert.Equal(t, 1, code)
+	assert.Equal(t, "estatectl tree import: missing \"height\" column, the header must name x, y and height\n", stderr)
+}
+
+func TestRun_Profiles(t *testing.T) {
+	api := &fakeAPI{}
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	configPath := filepath.Join(t.TempDir(), "estatectl", "config.yml")
+	env := map[string]string{"ESTATECTL_CONFIG": configPath}
+	getenv := func(key string) string { return env[key] }
+	cli := func(args ...string) (int, string, string) {
+		var stdout, stderr bytes.Buffer
+		code := run(context.Background(), args, strings.NewReader(""), &stdout, &stderr, getenv)
+		return code, stdout.String(), stderr.String()
+	}
+
+	code, _, stderr := cli("stats", testEstateID)
+	assert.Equal(t, 1, code)
+	assert.Equal(t, "estatectl stats: no api key, set -api-key, ESTATECTL_API_KEY or a profile\n", stderr)
+
+	code, stdout, _ := cli("profile", "set", "staging", "-server", server.URL, "-api-key", "test-key")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "Profile \"staging\" saved\n", stdout)
+
+	info, err := os.Stat(configPath)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	code, _, _ = cli("profile", "set", "prod", "-server", "http://prod.invalid", "-api-key", "prod-key")
+	assert.Equal(t, 0, code)
+
+	// the first profile stays current
+	code, stdout, _ = cli("stats", testEstateID, "-o", "csv")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "count,max,min,median\n1,5,5,5\n", stdout)
+
+	code, stdout, _ = cli("profile", "use", "prod")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "Using profile \"prod\"\n", stdout)
+
+	code, stdout, _ = cli("profile", "list", "-o", "csv")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "name,server,current\nprod,http://prod.invalid,true\nstaging,"+server.URL+",false\n", stdout)
+
+	// -profile overrides the current profile, the api key flag overrides the profile
+	code, _, _ = cli("stats", testEstateID, "-profile", "staging")
+	assert.Equal(t, 0, code)
+
+	code, _, stderr = cli("stats", testEstateID, "-profile", "staging", "-api-key", "wrong")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "unauthenticated")
+
+	code, _, stderr = cli("stats", testEstateID, "-profile", "missing")
+	assert.Equal(t, 1, code)
+	assert.Equal(t, "estatectl stats: unknown profile \"missing\"\n", stderr)
+
+	code, _, _ = cli("profile", "use", "missing")
+	assert.Equal(t, 1, code)
+}
+
+func TestRun_Usage(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		wantCode int
+	}{
+		{name: "No command", wantCode: 2},
+		{name: "Help", args: []string{"help"}, wantCode: 0},
+		{name: "Unknown command", args: []string{"orchard"}, wantCode: 2},
+		{name: "Missing subcommand", args: []string{"estate"}, wantCode: 2},
+		{name: "Unknown flag", args: []string{"estate", "list", "-color"}, wantCode: 2},
+		{name: "Command help", args: []string{"tree", "add", "-h"}, wantCode: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, _, stderr := runCLI(t, &fakeAPI{}, nil, "", tt.args...)
+			assert.Equal(t, tt.wantCode, code)
+			assert.NotEmpty(t, stderr)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatCSV   = "csv"
+)
+
+// printer write command results as an aligned table, indented json or csv with a header line
+type printer struct {
+	w      io.Writer
+	format string
+}
+
+func newPrinter(w io.Writer, format string) (*printer, error) {
+	switch format {
+	case formatTable, formatJSON, formatCSV:
+		return &printer{w: w, format: format}, nil
+	default:
+		return nil, fmt.Errorf("unknown output format %q, use table, json or csv", format)
+	}
+}
+
+// print write value as json, or header and rows as table or csv
+func (p *printer) print(value any, header []string, rows [][]string) error {
+	switch p.format {
+	case formatJSON:
+		encoder := json.NewEncoder(p.w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(value)
+	case formatCSV:
+		w := csv.NewWriter(p.w)
+		err := w.Write(lower(header))
+		if err != nil {
+			return err
+		}
+		err = w.WriteAll(rows)
+		if err != nil {
+			return err
+		}
+		return w.Error()
+	default:
+		w := tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, strings.Join(header, "\t"))
+		for _, row := range rows {
+			fmt.Fprintln(w, strings.Join(row, "\t"))
+		}
+		return w.Flush()
+	}
+}
+
+// lower turn table headers into csv column names
+func lower(header []string) []string {
+	columns := make([]string, len(header))
+	for i, name := range header {
+		columns[i] = strings.ToLower(name)
+	}
+	return columns
+}
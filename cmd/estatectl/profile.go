@@ -0,0 +1,235 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// defaultServer is the address of a service started locally with default settings
+const defaultServer = "http://localhost:1323"
+
+// profile is a saved server and its api key
+type profile struct {
+	Server string `yaml:"server"`
+	APIKey string `yaml:"api_key"`
+}
+
+// profileFile is the file holding profiles, Current is used when no profile is selected
+type profileFile struct {
+	Current  string             `yaml:"current,omitempty"`
+	Profiles map[string]profile `yaml:"profiles,omitempty"`
+}
+
+// profilePath return ESTATECTL_CONFIG, or estatectl/config.yml in the user config directory
+func profilePath(getenv func(string) string) (string, error) {
+	if path := getenv("ESTATECTL_CONFIG"); path != "" {
+		return path, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "estatectl", "config.yml"), nil
+}
+
+// loadProfiles read the profile file, a missing file has no profile
+func loadProfiles(path string) (*profileFile, error) {
+	profiles := &profileFile{Profiles: map[string]profile{}}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return profiles, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = yaml.Unmarshal(data, profiles)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+	if profiles.Profiles == nil {
+		profiles.Profiles = map[string]profile{}
+	}
+	return profiles, nil
+}
+
+// save write the profile file readable only by its owner, since it holds api keys
+func (p *profileFile) save(path string) error {
+	data, err := yaml.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0o700)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+// resolveTarget pick server and api key from flags, then environment, then the selected profile
+func resolveTarget(g *globalFlags, getenv func(string) string) (profile, error) {
+	path, err := profilePath(getenv)
+	if err != nil {
+		return profile{}, err
+	}
+	profiles, err := loadProfiles(path)
+	if err != nil {
+		return profile{}, err
+	}
+
+	name := firstNonEmpty(g.profile, getenv("ESTATECTL_PROFILE"))
+	selected, ok := profiles.Profiles[name]
+	if name != "" && !ok {
+		return profile{}, fmt.Errorf("unknown profile %q", name)
+	}
+	if name == "" {
+		selected = profiles.Profiles[profiles.Current]
+	}
+
+	target := profile{
+		Server: firstNonEmpty(g.server, getenv("ESTATECTL_SERVER"), selected.Server, defaultServer),
+		APIKey: firstNonEmpty(g.apiKey, getenv("ESTATECTL_API_KEY"), selected.APIKey),
+	}
+	if target.APIKey == "" {
+		return profile{}, errors.New("no api key, set -api-key, ESTATECTL_API_KEY or a profile")
+	}
+	return target, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// profileSet save a profile, the first saved profile becomes the current one
+func profileSet(_ context.Context, name string, args []string, _ io.Reader, stdout io.Writer, stderr io.Writer, getenv func(string) string) error {
+	fs := flag.NewFlagSet("estatectl "+name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	var saved profile
+	fs.StringVar(&saved.Server, "server", defaultServer, "api server url")
+	fs.StringVar(&saved.APIKey, "api-key", "", "api key sent as X-API-Key")
+
+	err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if fs.NArg() != 1 || saved.APIKey == "" {
+		fmt.Fprintf(stderr, "usage: estatectl %s NAME -server URL -api-key KEY\n", name)
+		return errUsage
+	}
+
+	err = updateProfiles(getenv, func(profiles *profileFile) error {
+		profiles.Profiles[fs.Arg(0)] = saved
+		if profiles.Current == "" {
+			profiles.Current = fs.Arg(0)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "Profile %q saved\n", fs.Arg(0))
+	return nil
+}
+
+// profileUse make a saved profile the current one
+func profileUse(_ context.Context, name string, args []string, _ io.Reader, stdout io.Writer, stderr io.Writer, getenv func(string) string) error {
+	if len(args) != 1 {
+		fmt.Fprintf(stderr, "usage: estatectl %s NAME\n", name)
+		return errUsage
+	}
+
+	err := updateProfiles(getenv, func(profiles *profileFile) error {
+		if _, ok := profiles.Profiles[args[0]]; !ok {
+			return fmt.Errorf("unknown profile %q", args[0])
+		}
+		profiles.Current = args[0]
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "Using profile %q\n", args[0])
+	return nil
+}
+
+// profileList print saved profiles without their api keys
+func profileList(_ context.Context, name string, args []string, _ io.Reader, stdout io.Writer, stderr io.Writer, getenv func(string) string) error {
+	fs := flag.NewFlagSet("estatectl "+name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	output := fs.String("o", formatTable, "output format: table, json or csv")
+	err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+
+	out, err := newPrinter(stdout, *output)
+	if err != nil {
+		return err
+	}
+
+	path, err := profilePath(getenv)
+	if err != nil {
+		return err
+	}
+	profiles, err := loadProfiles(path)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(profiles.Profiles))
+	for profileName := range profiles.Profiles {
+		names = append(names, profileName)
+	}
+	sort.Strings(names)
+
+	type listedProfile struct {
+		Name    string `json:"name"`
+		Server  string `json:"server"`
+		Current bool   `json:"current"`
+	}
+	listed := make([]listedProfile, 0, len(names))
+	rows := make([][]string, 0, len(names))
+	for _, profileName := range names {
+		current := profileName == profiles.Current
+		listed = append(listed, listedProfile{Name: profileName, Server: profiles.Profiles[profileName].Server, Current: current})
+		rows = append(rows, []string{profileName, profiles.Profiles[profileName].Server, fmt.Sprint(current)})
+	}
+
+	return out.print(listed, []string{"NAME", "SERVER", "CURRENT"}, rows)
+}
+
+// updateProfiles load the profile file, apply update and save it back
+func updateProfiles(getenv func(string) string, update func(profiles *profileFile) error) error {
+	path, err := profilePath(getenv)
+	if err != nil {
+		return err
+	}
+	profiles, err := loadProfiles(path)
+	if err != nil {
+		return err
+	}
+
+	err = update(profiles)
+	if err != nil {
+		return err
+	}
+	return profiles.save(path)
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/SawitProRecruitment/EstateService/client"
+	"github.com/google/uuid"
+)
+
+var treeHeader = []string{"ID", "X", "Y", "HEIGHT"}
+
+func treeRow(tree client.Tree) []string {
+	return []string{tree.ID.String(), strconv.Itoa(tree.X), strconv.Itoa(tree.Y), strconv.Itoa(tree.Height)}
+}
+
+func treeAdd(cmd *command, flags *commandFlags) error {
+	estateID, err := estateIDArg(cmd)
+	if err != nil {
+		return err
+	}
+
+	tree, err := cmd.client.CreateTree(cmd.ctx, estateID, flags.x, flags.y, flags.height, idempotencyKey(flags.idempotencyKey)...)
+	if err != nil {
+		return err
+	}
+	return cmd.out.print(tree, treeHeader, [][]string{treeRow(tree)})
+}
+
+// importedTree is the outcome of one line of an import file
+type importedTree struct {
+	Line   int        `json:"line"`
+	X      int        `json:"x"`
+	Y      int        `json:"y"`
+	Height int        `json:"height"`
+	ID     *uuid.UUID `json:"id,omitempty"`
+	Error  string     `json:"error,omitempty"`
+}
+
+// treeImport plant every tree of a CSV file with x, y and height columns in any order.
+// Each tree is sent with an idempotency key derived from its line, so an interrupted import can be run again
+// and failed lines do not stop the others
+func treeImport(cmd *command, flags *commandFlags) error {
+	estateID, err := estateIDArg(cmd)
+	if err != nil {
+		return err
+	}
+
+	input := cmd.stdin
+	if flags.file != "-" {
+		file, err := os.Open(flags.file)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		input = file
+	}
+
+	reader := csv.NewReader(input)
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("read header: %w", err)
+	}
+	columns, err := importColumns(header)
+	if err != nil {
+		return err
+	}
+
+	results := []importedTree{}
+	failed := 0
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		line, _ := reader.FieldPos(0)
+
+		result := importedTree{Line: line}
+		result.X, result.Y, result.Height, err = parseTreeRecord(record, columns)
+		if err == nil {
+			key := fmt.Sprintf("estatectl-import-%s-%d-%d-%d", estateID, result.X, result.Y, result.Height)
+			var tree client.Tree
+			tree, err = cmd.client.CreateTree(cmd.ctx, estateID, result.X, result.Y, result.Height, client.WithIdempotencyKey(key))
+			if err == nil {
+				result.ID = &tree.ID
+			}
+		}
+		if err != nil {
+			if cmd.ctx.Err() != nil {
+				return cmd.ctx.Err()
+			}
+			result.Error = err.Error()
+			failed++
+		}
+		results = append(results, result)
+	}
+
+	rows := make([][]string, 0, len(results))
+	for _, result := range results {
+		id := ""
+		if result.ID != nil {
+			id = result.ID.String()
+		}
+		rows = append(rows, []string{strconv.Itoa(result.Line), strconv.Itoa(result.X), strconv.Itoa(result.Y), strconv.Itoa(result.Height), id, result.Error})
+	}
+	err = cmd.out.print(results, []string{"LINE", "X", "Y", "HEIGHT", "ID", "ERROR"}, rows)
+	if err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d trees failed", failed, len(results))
+	}
+	return nil
+}
+
+// importColumns return the index of the x, y and height columns of header
+func importColumns(header []string) (map[string]int, error) {
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"x", "y", "height"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("missing %q column, the header must name x, y and height", name)
+		}
+	}
+	return columns, nil
+}
+
+func parseTreeRecord(record []string, columns map[string]int) (x int, y int, height int, err error) {
+	for _, field := range []struct {
+		name  string
+		value *int
+	}{{"x", &x}, {"y", &y}, {"height", &height}} {
+		*field.value, err = strconv.Atoi(strings.TrimSpace(record[columns[field.name]]))
+		if err != nil {
+			return 0, 0, 0, fmt.Errorf("invalid %s %q", field.name, record[columns[field.name]])
+		}
+	}
+	return x, y, height, nil
+}
+
+func treeList(cmd *command, _ *commandFlags) error {
+	estateID, err := estateIDArg(cmd)
+	if err != nil {
+		return err
+	}
+
+	trees, err := cmd.client.ListTrees(cmd.ctx, estateID)
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0, len(trees))
+	for _, tree := range trees {
+		rows = append(rows, treeRow(tree))
+	}
+	return cmd.out.print(trees, treeHeader, rows)
+}
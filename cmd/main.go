@@ -1,32 +1,355 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
 	"log"
+	"log/slog"
+	"net"
+	"net/http"
 	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 
+	"github.com/SawitProRecruitment/EstateService/broker/memory"
+	"github.com/SawitProRecruitment/EstateService/config"
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
 	"github.com/SawitProRecruitment/EstateService/core/usecase"
 	"github.com/SawitProRecruitment/EstateService/generated"
+	"github.com/SawitProRecruitment/EstateService/graphqlhandler"
+	"github.com/SawitProRecruitment/EstateService/grpchandler"
 	"github.com/SawitProRecruitment/EstateService/handler"
+	"github.com/SawitProRecruitment/EstateService/metrics"
+	estatev1 "github.com/SawitProRecruitment/EstateService/proto/estate/v1"
 	"github.com/SawitProRecruitment/EstateService/storage/postgres"
+	"github.com/SawitProRecruitment/EstateService/tracing"
+	"github.com/SawitProRecruitment/EstateService/webhook"
 
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	echoMiddleware "github.com/oapi-codegen/echo-middleware"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 )
 
+// outboxPurgeInterval is how often the webhook dispatcher deletes outbox events past webhooks.outbox_retention
+const outboxPurgeInterval = time.Hour
+
 func main() {
+	if len(os.Args) > 2 && os.Args[1] == "config" && os.Args[2] == "print" {
+		os.Exit(printConfig(os.Args[3:]))
+	}
+
+	cfg, err := config.Load(os.Args[0], os.Args[1:], os.Getenv, os.Stderr)
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		log.Fatalf("Error loading config: %s", err)
+	}
+	slog.SetDefault(newLogger(cfg.Log))
+
+	// ctx is done on SIGTERM, background loops stop with it and the server starts draining
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	e := echo.New()
 	swagger, err := generated.GetSwagger()
 	if err != nil {
 		log.Fatalf("Error loading swagger spec: %s", err)
 	}
 
-	repo := postgres.NewRepository(os.Getenv("DATABASE_URL"))
-	usecase := usecase.NewEstateUsecase(repo)
+	tracerProvider, err := tracing.NewTracerProvider(context.Background(), cfg.Tracing.Exporter)
+	if err != nil {
+		log.Fatalf("Error creating tracer provider: %s", err)
+	}
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(tracing.Propagator)
+
+	driverName, err := tracing.RegisterSQLDriver(tracerProvider)
+	if err != nil {
+		log.Fatalf("Error registering traced sql driver: %s", err)
+	}
+
+	pg := cfg.Storage.Postgres
+	repo, err := postgres.NewRepositoryWithDriver(ctx, driverName, pg.URL,
+		postgres.WithPostgresPool(pg.MaxOpenConns, pg.MaxIdleConns, int(pg.ConnMaxLifetime.Seconds())),
+		postgres.WithConnectRetry(pg.ConnectAttempts, 100*time.Millisecond, 5*time.Second),
+	)
+	if err != nil {
+		log.Fatalf("Error connecting to database: %s", err)
+	}
+	appMetrics := metrics.New(metrics.WithDBStats(repo.DB, "estates"))
+
+	droneRepo := tracing.NewDroneRepository(metrics.NewDroneRepository(repo, appMetrics), tracerProvider)
+	droneUsecase := tracing.NewDroneUsecase(usecase.NewDroneUsecase(droneRepo), tracerProvider)
+
+	estateUsecaseOpts := []usecase.EstateUsecaseOptions{usecase.WithDroneRepository(droneRepo)}
+	if cfg.EventStream.Enabled {
+		broker := memory.NewBroker(cfg.EventStream.BufferSize)
+		// open event streams would otherwise hold the server until the shutdown deadline
+		e.Server.RegisterOnShutdown(broker.Close)
+		estateUsecaseOpts = append(estateUsecaseOpts, usecase.WithEventBroker(broker))
+	}
+	estateRepo := tracing.NewEstateRepository(metrics.NewEstateRepository(repo, appMetrics), tracerProvider)
+	estateUsecase := tracing.NewEstateUsecase(usecase.NewEstateUsecase(estateRepo, estateUsecaseOpts...), tracerProvider)
+	authUsecase := usecase.NewAuthUsecase(repo)
+
+	if cfg.Bootstrap.APIKey != "" {
+		err = authUsecase.EnsureAPIKey(context.Background(), uuid.MustParse(cfg.Bootstrap.TenantID), "bootstrap", cfg.Bootstrap.APIKey, domain.RoleAdmin)
+		if err != nil {
+			log.Fatalf("Error creating bootstrap api key: %s", err)
+		}
+	}
+
+	// background loops are waited for before the database is closed
+	var background sync.WaitGroup
+
+	serverOpts := []handler.ServerOptions{handler.WithAuthUsecase(authUsecase), handler.WithDroneUsecase(droneUsecase)}
+	if cfg.Webhooks.Enabled {
+		webhookUsecase := usecase.NewWebhookUsecase(repo, webhook.NewSender(webhook.NewClient(cfg.Webhooks.DeliveryTimeout)), cfg.Webhooks.OutboxRetention)
+		serverOpts = append(serverOpts, handler.WithWebhookUsecase(webhookUsecase))
+		background.Add(1)
+		go func() {
+			defer background.Done()
+			dispatchWebhooks(ctx, webhookUsecase, cfg.Webhooks.DispatchInterval)
+		}()
+	}
+	if cfg.Metrics.Addr != "" {
+		go serveMetrics(cfg.Metrics.Addr, appMetrics.Handler())
+	}
+
+	generated.RegisterHandlers(e, handler.NewServer(estateUsecase, serverOpts...))
+	if cfg.GraphQL.Enabled {
+		graphqlHandler, err := graphqlhandler.NewHandler(estateUsecase, graphqlhandler.WithMaxDepth(cfg.GraphQL.MaxDepth))
+		if err != nil {
+			log.Fatalf("Error creating graphql handler: %s", err)
+		}
+		e.POST(graphqlhandler.Path, echo.WrapHandler(graphqlHandler))
+	}
 
-	generated.RegisterHandlers(e, handler.NewServer(usecase))
+	// every error is written as problem+json carrying the request id,
+	// the metrics middleware is the outermost so it observes the rendered status of every request,
+	// the tracing one comes next so authentication and validation are part of the request span
+	e.HTTPErrorHandler = handler.ProblemErrorHandler
+	e.Pre(handler.Health(repo, cfg.Server.ReadinessTimeout))
+	e.Use(appMetrics.HTTPMiddleware())
+	e.Use(tracing.HTTPMiddleware(tracerProvider))
+	e.Use(middleware.RequestID())
 
-	e.Use(echoMiddleware.OapiRequestValidator(swagger))
+	// api key is checked by APIKeyAuth, the validator only validates request shape
+	// and collect every invalid field instead of stopping at the first one.
+	// graphql queries are not part of the openapi spec, the graphql schema validates them.
+	// gzipped snapshots are only checked to be present, the import decodes them.
+	// snapshot bodies are bounded before the validator reads them
+	e.Use(handler.APIKeyAuth(authUsecase))
+	e.Use(handler.BodyLimit())
+	openapi3filter.RegisterBodyDecoder(handler.MIMEApplicationGzip, openapi3filter.FileBodyDecoder)
+	e.Use(echoMiddleware.OapiRequestValidatorWithOptions(swagger, &echoMiddleware.Options{
+		Skipper: func(c echo.Context) bool {
+			return c.Request().URL.Path == graphqlhandler.Path
+		},
+		Options: openapi3filter.Options{
+			AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+			MultiError:         true,
+		},
+	}))
+	// idempotency runs after validation so a rejected request does not reserve the key
+	if cfg.Idempotency.Enabled {
+		idempotencyUsecase := usecase.NewIdempotencyUsecase(repo, cfg.Idempotency.TTL)
+		e.Use(handler.Idempotency(idempotencyUsecase))
+		background.Add(1)
+		go func() {
+			defer background.Done()
+			purgeIdempotencyKeys(ctx, idempotencyUsecase, cfg.Idempotency.PurgeInterval)
+		}()
+	}
 	e.Use(middleware.Logger())
-	e.Logger.Fatal(e.Start(":1323"))
+
+	e.Server.ReadHeaderTimeout = cfg.Server.ReadHeaderTimeout
+	e.Server.ReadTimeout = cfg.Server.ReadTimeout
+	e.Server.WriteTimeout = cfg.Server.WriteTimeout
+	e.Server.IdleTimeout = cfg.Server.IdleTimeout
+
+	serveErr := make(chan error, 2)
+	go func() {
+		if cfg.Server.TLS.Enabled() {
+			serveErr <- e.StartTLS(cfg.Server.Addr, cfg.Server.TLS.CertFile, cfg.Server.TLS.KeyFile)
+			return
+		}
+		serveErr <- e.Start(cfg.Server.Addr)
+	}()
+
+	// the gRPC api shares the estate usecase of the REST one, only the inbound adapter differs
+	var grpcServer *grpc.Server
+	if cfg.GRPC.Addr != "" {
+		grpcServer, err = newGRPCServer(cfg, estateUsecase, authUsecase, repo, tracerProvider)
+		if err != nil {
+			log.Fatalf("Error creating grpc server: %s", err)
+		}
+		listener, err := net.Listen("tcp", cfg.GRPC.Addr)
+		if err != nil {
+			log.Fatalf("Error listening for grpc: %s", err)
+		}
+		slog.Info("grpc server started", "addr", listener.Addr().String())
+		go func() {
+			serveErr <- grpcServer.Serve(listener)
+		}()
+	}
+
+	select {
+	case err := <-serveErr:
+		log.Fatalf("Error starting server: %s", err)
+	case <-ctx.Done():
+	}
+
+	slog.Info("shutting down", "timeout", cfg.Server.ShutdownTimeout.String())
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+	defer cancel()
+
+	// stop accepting connections and wait for in-flight requests until the deadline
+	err = e.Shutdown(shutdownCtx)
+	if err != nil {
+		slog.Error("failed to drain connections", "message", err.Error())
+	}
+	if grpcServer != nil {
+		stopGRPCServer(shutdownCtx, grpcServer)
+	}
+
+	background.Wait()
+	err = repo.DB.Close()
+	if err != nil {
+		slog.Error("failed to close database", "message", err.Error())
+	}
+	err = tracerProvider.Shutdown(shutdownCtx)
+	if err != nil {
+		slog.Error("failed to flush traces", "message", err.Error())
+	}
+}
+
+// newGRPCServer create the gRPC api with api key authentication, tracing, health and reflection.
+// Health and reflection are served without api key, like the REST probes
+func newGRPCServer(cfg config.Config, estateUsecase interfaces.EstateUsecase, authUsecase interfaces.AuthUsecase, readinessChecker interfaces.ReadinessChecker, tp *sdktrace.TracerProvider) (*grpc.Server, error) {
+	opts := []grpc.ServerOption{
+		grpc.StatsHandler(tracing.GRPCServerHandler(tp)),
+		grpc.ChainUnaryInterceptor(grpchandler.UnaryAPIKeyAuth(authUsecase)),
+		grpc.ChainStreamInterceptor(grpchandler.StreamAPIKeyAuth(authUsecase)),
+	}
+	if cfg.Server.TLS.Enabled() {
+		creds, err := credentials.NewServerTLSFromFile(cfg.Server.TLS.CertFile, cfg.Server.TLS.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+
+	server := grpc.NewServer(opts...)
+	estatev1.RegisterEstateServiceServer(server, grpchandler.NewServer(estateUsecase))
+	healthpb.RegisterHealthServer(server, grpchandler.NewHealthServer(readinessChecker, cfg.Server.ReadinessTimeout))
+	reflection.Register(server)
+	return server, nil
+}
+
+// stopGRPCServer wait for in-flight rpcs until ctx is done, then close the remaining ones
+func stopGRPCServer(ctx context.Context, server *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		slog.Error("failed to drain grpc calls", "message", ctx.Err().Error())
+		server.Stop()
+	}
+}
+
+// printConfig write the resolved config with secrets redacted, it exits non zero when the config is invalid
+func printConfig(args []string) int {
+	cfg, err := config.Load("config print", args, os.Getenv, os.Stderr)
+	if errors.Is(err, flag.ErrHelp) {
+		return 0
+	}
+	if printErr := config.Print(os.Stdout, cfg); printErr != nil {
+		fmt.Fprintln(os.Stderr, printErr)
+		return 1
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid config: %s\n", err)
+		return 1
+	}
+	return 0
+}
+
+// newLogger create the default logger of the service
+func newLogger(cfg config.LogConfig) *slog.Logger {
+	opts := &slog.HandlerOptions{Level: cfg.SlogLevel()}
+	if cfg.Format == config.LogFormatJSON {
+		return slog.New(slog.NewJSONHandler(os.Stderr, opts))
+	}
+	return slog.New(slog.NewTextHandler(os.Stderr, opts))
+}
+
+// dispatchWebhooks periodically deliver committed outbox events to webhook subscribers,
+// and purge the outbox of events past their retention every outboxPurgeInterval
+func dispatchWebhooks(ctx context.Context, webhookUsecase interfaces.WebhookUsecase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	purgeTicker := time.NewTicker(outboxPurgeInterval)
+	defer purgeTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := webhookUsecase.DispatchWebhooks(ctx)
+			if err != nil {
+				slog.Error("failed to dispatch webhooks", "message", err.Error())
+			}
+		case <-purgeTicker.C:
+			err := webhookUsecase.PurgeOutboxEvents(ctx)
+			if err != nil {
+				slog.Error("failed to purge outbox events", "message", err.Error())
+			}
+		}
+	}
+}
+
+// purgeIdempotencyKeys periodically delete expired idempotency keys
+func purgeIdempotencyKeys(ctx context.Context, idempotencyUsecase interfaces.IdempotencyUsecase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := idempotencyUsecase.PurgeExpired(ctx)
+			if err != nil {
+				slog.Error("failed to purge idempotency keys", "message", err.Error())
+			}
+		}
+	}
+}
+
+// serveMetrics expose prometheus metrics on their own address, outside of api key authentication
+func serveMetrics(addr string, metricsHandler http.Handler) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metricsHandler)
+	log.Fatal(http.ListenAndServe(addr, mux))
 }
@@ -0,0 +1,278 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	StorageBackendPostgres = "postgres"
+
+	LogFormatText = "text"
+	LogFormatJSON = "json"
+)
+
+// redacted replace secrets when the configuration is printed
+const redacted = "REDACTED"
+
+// Config is the whole configuration of the service, loaded by Load from defaults, a yaml file, env and flags
+type Config struct {
+	Server      ServerConfig      `yaml:"server"`
+	GRPC        GRPCConfig        `yaml:"grpc"`
+	Storage     StorageConfig     `yaml:"storage"`
+	Log         LogConfig         `yaml:"log"`
+	Metrics     MetricsConfig     `yaml:"metrics"`
+	Tracing     TracingConfig     `yaml:"tracing"`
+	Webhooks    WebhooksConfig    `yaml:"webhooks"`
+	Idempotency IdempotencyConfig `yaml:"idempotency"`
+	EventStream EventStreamConfig `yaml:"event_stream"`
+	GraphQL     GraphQLConfig     `yaml:"graphql"`
+	Bootstrap   BootstrapConfig   `yaml:"bootstrap"`
+}
+
+type ServerConfig struct {
+	Addr              string        `yaml:"addr"`
+	TLS               TLSConfig     `yaml:"tls"`
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout"`
+	ReadTimeout       time.Duration `yaml:"read_timeout"`
+	// WriteTimeout is disabled by default since it would cut estate event streams
+	WriteTimeout time.Duration `yaml:"write_timeout"`
+	IdleTimeout  time.Duration `yaml:"idle_timeout"`
+	// ShutdownTimeout bound how long in-flight requests are drained on SIGTERM
+	ShutdownTimeout  time.Duration `yaml:"shutdown_timeout"`
+	ReadinessTimeout time.Duration `yaml:"readiness_timeout"`
+}
+
+// TLSConfig serve https when both files are set
+type TLSConfig struct {
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+}
+
+func (t TLSConfig) Enabled() bool {
+	return t.CertFile != "" || t.KeyFile != ""
+}
+
+// GRPCConfig serve the gRPC api on Addr, an empty Addr disables the gRPC server.
+// It shares the TLS files and shutdown timeout of the REST server
+type GRPCConfig struct {
+	Addr string `yaml:"addr"`
+}
+
+type StorageConfig struct {
+	Backend  string         `yaml:"backend"`
+	Postgres PostgresConfig `yaml:"postgres"`
+}
+
+type PostgresConfig struct {
+	URL             string        `yaml:"url"`
+	MaxOpenConns    int           `yaml:"max_open_conns"`
+	MaxIdleConns    int           `yaml:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
+	// ConnectAttempts is the number of pings at startup before giving up on the database
+	ConnectAttempts int `yaml:"connect_attempts"`
+}
+
+type LogConfig struct {
+	Level  string `yaml:"level"`
+	Format string `yaml:"format"`
+}
+
+// MetricsConfig serve prometheus metrics on Addr, an empty Addr disables the metrics server
+type MetricsConfig struct {
+	Addr string `yaml:"addr"`
+}
+
+type TracingConfig struct {
+	Exporter string `yaml:"exporter"`
+}
+
+type WebhooksConfig struct {
+	Enabled          bool          `yaml:"enabled"`
+	DispatchInterval time.Duration `yaml:"dispatch_interval"`
+	DeliveryTimeout  time.Duration `yaml:"delivery_timeout"`
+	OutboxRetention  time.Duration `yaml:"outbox_retention"`
+}
+
+type IdempotencyConfig struct {
+	Enabled       bool          `yaml:"enabled"`
+	TTL           time.Duration `yaml:"ttl"`
+	PurgeInterval time.Duration `yaml:"purge_interval"`
+}
+
+type EventStreamConfig struct {
+	Enabled    bool `yaml:"enabled"`
+	BufferSize int  `yaml:"buffer_size"`
+}
+
+// GraphQLConfig serve the /graphql endpoint, MaxDepth bound how deep a query may nest
+type GraphQLConfig struct {
+	Enabled  bool `yaml:"enabled"`
+	MaxDepth int  `yaml:"max_depth"`
+}
+
+// BootstrapConfig create an admin api key for TenantID at startup when APIKey is set
+type BootstrapConfig struct {
+	APIKey   string `yaml:"api_key"`
+	TenantID string `yaml:"tenant_id"`
+}
+
+// Default return the configuration used when nothing is set
+func Default() Config {
+	return Config{
+		Server: ServerConfig{
+			Addr:              ":1323",
+			ReadHeaderTimeout: 5 * time.Second,
+			ReadTimeout:       30 * time.Second,
+			IdleTimeout:       2 * time.Minute,
+			ShutdownTimeout:   30 * time.Second,
+			ReadinessTimeout:  2 * time.Second,
+		},
+		GRPC: GRPCConfig{
+			Addr: ":50051",
+		},
+		Storage: StorageConfig{
+			Backend: StorageBackendPostgres,
+			Postgres: PostgresConfig{
+				MaxOpenConns:    25,
+				MaxIdleConns:    25,
+				ConnMaxLifetime: 5 * time.Minute,
+				ConnectAttempts: 10,
+			},
+		},
+		Log: LogConfig{
+			Level:  "info",
+			Format: LogFormatText,
+		},
+		Metrics: MetricsConfig{
+			Addr: ":9090",
+		},
+		Tracing: TracingConfig{
+			Exporter: "none",
+		},
+		Webhooks: WebhooksConfig{
+			Enabled:          true,
+			DispatchInterval: 5 * time.Second,
+			DeliveryTimeout:  10 * time.Second,
+			OutboxRetention:  7 * 24 * time.Hour,
+		},
+		Idempotency: IdempotencyConfig{
+			Enabled:       true,
+			TTL:           24 * time.Hour,
+			PurgeInterval: time.Hour,
+		},
+		EventStream: EventStreamConfig{
+			Enabled:    true,
+			BufferSize: 1000,
+		},
+		GraphQL: GraphQLConfig{
+			Enabled:  true,
+			MaxDepth: 10,
+		},
+	}
+}
+
+// Validate return every invalid setting joined into one error
+func (c Config) Validate() error {
+	var errs []error
+	invalid := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	if c.Server.Addr == "" {
+		invalid("server.addr is required")
+	}
+	if c.Server.TLS.Enabled() && (c.Server.TLS.CertFile == "" || c.Server.TLS.KeyFile == "") {
+		invalid("server.tls.cert_file and server.tls.key_file must be set together")
+	}
+	if c.Server.ReadHeaderTimeout < 0 || c.Server.ReadTimeout < 0 || c.Server.WriteTimeout < 0 || c.Server.IdleTimeout < 0 {
+		invalid("server timeouts must not be negative")
+	}
+	if c.Server.ShutdownTimeout <= 0 || c.Server.ReadinessTimeout <= 0 {
+		invalid("server.shutdown_timeout and server.readiness_timeout must be positive")
+	}
+
+	if c.GRPC.Addr != "" && c.GRPC.Addr == c.Server.Addr {
+		invalid("grpc.addr must differ from server.addr")
+	}
+
+	switch c.Storage.Backend {
+	case StorageBackendPostgres:
+		pg := c.Storage.Postgres
+		if pg.URL == "" {
+			invalid("storage.postgres.url is required")
+		}
+		if pg.MaxOpenConns < 0 || pg.MaxIdleConns < 0 {
+			invalid("storage.postgres connection limits must not be negative")
+		}
+		if pg.ConnMaxLifetime < 0 {
+			invalid("storage.postgres.conn_max_lifetime must not be negative")
+		}
+		if pg.ConnectAttempts < 1 {
+			invalid("storage.postgres.connect_attempts must be at least 1")
+		}
+	default:
+		invalid("unknown storage.backend %q", c.Storage.Backend)
+	}
+
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(c.Log.Level)); err != nil {
+		invalid("unknown log.level %q", c.Log.Level)
+	}
+	if c.Log.Format != LogFormatText && c.Log.Format != LogFormatJSON {
+		invalid("unknown log.format %q", c.Log.Format)
+	}
+
+	switch c.Tracing.Exporter {
+	case "none", "stdout", "otlp":
+	default:
+		invalid("unknown tracing.exporter %q", c.Tracing.Exporter)
+	}
+
+	if c.Webhooks.Enabled && (c.Webhooks.DispatchInterval <= 0 || c.Webhooks.DeliveryTimeout <= 0 || c.Webhooks.OutboxRetention <= 0) {
+		invalid("webhooks.dispatch_interval, webhooks.delivery_timeout and webhooks.outbox_retention must be positive")
+	}
+	if c.Idempotency.Enabled && (c.Idempotency.TTL <= 0 || c.Idempotency.PurgeInterval <= 0) {
+		invalid("idempotency.ttl and idempotency.purge_interval must be positive")
+	}
+	if c.EventStream.Enabled && c.EventStream.BufferSize <= 0 {
+		invalid("event_stream.buffer_size must be positive")
+	}
+	if c.GraphQL.Enabled && c.GraphQL.MaxDepth <= 0 {
+		invalid("graphql.max_depth must be positive")
+	}
+
+	if c.Bootstrap.APIKey != "" {
+		if _, err := uuid.Parse(c.Bootstrap.TenantID); err != nil {
+			invalid("bootstrap.tenant_id must be a uuid when bootstrap.api_key is set")
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// SlogLevel return the parsed level, info when the level is invalid
+func (l LogConfig) SlogLevel() slog.Level {
+	var level slog.Level
+	_ = level.UnmarshalText([]byte(l.Level))
+	return level
+}
+
+// Redacted return a copy safe to print, with the database password and bootstrap api key replaced
+func (c Config) Redacted() Config {
+	if u, err := url.Parse(c.Storage.Postgres.URL); err == nil && u.User != nil {
+		if _, ok := u.User.Password(); ok {
+			u.User = url.UserPassword(u.User.Username(), redacted)
+			c.Storage.Postgres.URL = u.String()
+		}
+	}
+	if c.Bootstrap.APIKey != "" {
+		c.Bootstrap.APIKey = redacted
+	}
+	return c
+}
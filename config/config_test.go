@@ -0,0 +1,207 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func env(values map[string]string) func(string) string {
+	return func(key string) string {
+		return values[key]
+	}
+}
+
+func writeFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "config.yml")
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	file := writeFile(t, `
+server:
+  addr: ":8080"
+  read_timeout: 10s
+storage:
+  postgres:
+    url: postgres://file
+    max_open_conns: 50
+log:
+  level: debug
+webhooks:
+  enabled: false
+`)
+
+	tests := []struct {
+		name    string
+		args    []string
+		env     map[string]string
+		want    func(c *Config)
+		wantErr string
+	}{
+		{
+			name: "defaults and env",
+			env:  map[string]string{"DATABASE_URL": "postgres://env", "METRICS_ADDR": ":9191", "GRPC_ADDR": ":50052", "IDEMPOTENCY_ENABLED": "false"},
+			want: func(c *Config) {
+				c.Storage.Postgres.URL = "postgres://env"
+				c.Metrics.Addr = ":9191"
+				c.GRPC.Addr = ":50052"
+				c.Idempotency.Enabled = false
+			},
+		},
+		{
+			name: "file",
+			args: []string{"-config", file},
+			want: func(c *Config) {
+				c.Server.Addr = ":8080"
+				c.Server.ReadTimeout = 10 * time.Second
+				c.Storage.Postgres.URL = "postgres://file"
+				c.Storage.Postgres.MaxOpenConns = 50
+				c.Log.Level = "debug"
+				c.Webhooks.Enabled = false
+			},
+		},
+		{
+			name: "env overrides file and flags override env",
+			args: []string{"-addr", ":7070", "-log-format", "json", "-shutdown-timeout", "1m"},
+			env:  map[string]string{"CONFIG_FILE": file, "LISTEN_ADDR": ":6060", "DB_MAX_OPEN_CONNS": "5", "WEBHOOKS_ENABLED": "true"},
+			want: func(c *Config) {
+				c.Server.Addr = ":7070"
+				c.Server.ShutdownTimeout = time.Minute
+				c.Server.ReadTimeout = 10 * time.Second
+				c.Storage.Postgres.URL = "postgres://file"
+				c.Storage.Postgres.MaxOpenConns = 5
+				c.Log.Level = "debug"
+				c.Log.Format = LogFormatJSON
+			},
+		},
+		{
+			name:    "invalid env value",
+			env:     map[string]string{"DATABASE_URL": "postgres://env", "DB_CONN_MAX_LIFETIME": "forever"},
+			wantErr: "invalid DB_CONN_MAX_LIFETIME",
+		},
+		{
+			name:    "invalid flag value",
+			args:    []string{"-db-max-idle-conns", "many"},
+			wantErr: "invalid value",
+		},
+		{
+			name:    "missing file",
+			args:    []string{"-config", "missing.yml"},
+			wantErr: "reading config file",
+		},
+		{
+			name:    "unknown file key",
+			args:    []string{"-config", writeFile(t, "server:\n  port: 1323\n")},
+			wantErr: "field port not found",
+		},
+		{
+			name:    "invalid config",
+			wantErr: "storage.postgres.url is required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Load("test", tt.args, env(tt.env), io.Discard)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+
+			want := Default()
+			tt.want(&want)
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func TestLoad_Help(t *testing.T) {
+	var output bytes.Buffer
+	_, err := Load("test", []string{"-h"}, env(nil), &output)
+	assert.True(t, errors.Is(err, flag.ErrHelp))
+	assert.Contains(t, output.String(), "-database-url")
+}
+
+func TestConfig_Validate(t *testing.T) {
+	valid := Default()
+	valid.Storage.Postgres.URL = "postgres://db"
+	assert.NoError(t, valid.Validate())
+
+	tests := []struct {
+		name    string
+		change  func(c *Config)
+		wantErr string
+	}{
+		{"empty addr", func(c *Config) { c.Server.Addr = "" }, "server.addr is required"},
+		{"tls without key", func(c *Config) { c.Server.TLS.CertFile = "cert.pem" }, "must be set together"},
+		{"negative timeout", func(c *Config) { c.Server.WriteTimeout = -time.Second }, "must not be negative"},
+		{"shutdown timeout", func(c *Config) { c.Server.ShutdownTimeout = 0 }, "server.shutdown_timeout"},
+		{"grpc on the api addr", func(c *Config) { c.GRPC.Addr = c.Server.Addr }, "grpc.addr must differ"},
+		{"unknown backend", func(c *Config) { c.Storage.Backend = "mysql" }, `unknown storage.backend "mysql"`},
+		{"negative pool", func(c *Config) { c.Storage.Postgres.MaxIdleConns = -1 }, "connection limits"},
+		{"no connect attempt", func(c *Config) { c.Storage.Postgres.ConnectAttempts = 0 }, "connect_attempts"},
+		{"unknown log level", func(c *Config) { c.Log.Level = "verbose" }, `unknown log.level "verbose"`},
+		{"unknown log format", func(c *Config) { c.Log.Format = "xml" }, `unknown log.format "xml"`},
+		{"unknown exporter", func(c *Config) { c.Tracing.Exporter = "jaeger" }, `unknown tracing.exporter "jaeger"`},
+		{"webhook interval", func(c *Config) { c.Webhooks.DispatchInterval = 0 }, "webhooks.dispatch_interval"},
+		{"outbox retention", func(c *Config) { c.Webhooks.OutboxRetention = 0 }, "webhooks.outbox_retention"},
+		{"idempotency ttl", func(c *Config) { c.Idempotency.TTL = 0 }, "idempotency.ttl"},
+		{"event buffer", func(c *Config) { c.EventStream.BufferSize = 0 }, "event_stream.buffer_size"},
+		{"graphql depth", func(c *Config) { c.GraphQL.MaxDepth = 0 }, "graphql.max_depth"},
+		{"bootstrap tenant", func(c *Config) { c.Bootstrap.APIKey = "key" }, "bootstrap.tenant_id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := valid
+			tt.change(&c)
+			assert.ErrorContains(t, c.Validate(), tt.wantErr)
+		})
+	}
+
+	// disabled features are not validated
+	disabled := valid
+	disabled.Webhooks = WebhooksConfig{}
+	disabled.Idempotency = IdempotencyConfig{}
+	disabled.EventStream = EventStreamConfig{}
+	disabled.GraphQL = GraphQLConfig{}
+	assert.NoError(t, disabled.Validate())
+
+	// every error is reported at once
+	invalid := valid
+	invalid.Server.Addr = ""
+	invalid.Log.Format = "xml"
+	err := invalid.Validate()
+	assert.ErrorContains(t, err, "server.addr")
+	assert.ErrorContains(t, err, "log.format")
+}
+
+func TestPrint(t *testing.T) {
+	c := Default()
+	c.Storage.Postgres.URL = "postgres://postgres:secret@db:5432/database?sslmode=disable"
+	c.Bootstrap.APIKey = "local-admin-key"
+	c.Bootstrap.TenantID = "00000000-0000-0000-0000-000000000001"
+
+	var output bytes.Buffer
+	assert.NoError(t, Print(&output, c))
+	assert.Contains(t, output.String(), "url: postgres://postgres:REDACTED@db:5432/database?sslmode=disable")
+	assert.Contains(t, output.String(), "api_key: REDACTED")
+	assert.Contains(t, output.String(), "read_timeout: 30s")
+	assert.NotContains(t, output.String(), "secret")
+	assert.NotContains(t, output.String(), "local-admin-key")
+
+	// printed config can be loaded back
+	path := filepath.Join(t.TempDir(), "printed.yml")
+	assert.NoError(t, os.WriteFile(path, output.Bytes(), 0o600))
+	got, err := Load("test", []string{"-config", path}, env(nil), io.Discard)
+	assert.NoError(t, err)
+	assert.Equal(t, c.Redacted(), got)
+}
@@ -0,0 +1,170 @@
+package config
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// setting bind one config field to its env variable and flag, an empty name means the setting has none
+type setting struct {
+	env   string
+	flag  string
+	usage string
+	set   func(c *Config, value string) error
+}
+
+// settings is every setting overridable from env or flags, env names predating the config file are kept
+var settings = []setting{
+	{"LISTEN_ADDR", "addr", "address the api listens on", stringSetting(func(c *Config) *string { return &c.Server.Addr })},
+	{"TLS_CERT_FILE", "tls-cert-file", "tls certificate file", stringSetting(func(c *Config) *string { return &c.Server.TLS.CertFile })},
+	{"TLS_KEY_FILE", "tls-key-file", "tls private key file", stringSetting(func(c *Config) *string { return &c.Server.TLS.KeyFile })},
+	{"SERVER_READ_TIMEOUT", "read-timeout", "maximum duration for reading a request", durationSetting(func(c *Config) *time.Duration { return &c.Server.ReadTimeout })},
+	{"SERVER_WRITE_TIMEOUT", "write-timeout", "maximum duration for writing a response, 0 disables it", durationSetting(func(c *Config) *time.Duration { return &c.Server.WriteTimeout })},
+	{"SERVER_IDLE_TIMEOUT", "idle-timeout", "maximum duration of idle keep-alive connections", durationSetting(func(c *Config) *time.Duration { return &c.Server.IdleTimeout })},
+	{"SERVER_SHUTDOWN_TIMEOUT", "shutdown-timeout", "maximum duration to drain in-flight requests on shutdown", durationSetting(func(c *Config) *time.Duration { return &c.Server.ShutdownTimeout })},
+	{"SERVER_READINESS_TIMEOUT", "readiness-timeout", "maximum duration of the readiness database check", durationSetting(func(c *Config) *time.Duration { return &c.Server.ReadinessTimeout })},
+	{"GRPC_ADDR", "grpc-addr", "address the gRPC api listens on, empty disables it", stringSetting(func(c *Config) *string { return &c.GRPC.Addr })},
+	{"STORAGE_BACKEND", "storage-backend", "storage backend, only postgres is supported", stringSetting(func(c *Config) *string { return &c.Storage.Backend })},
+	{"DATABASE_URL", "database-url", "postgres connection url", stringSetting(func(c *Config) *string { return &c.Storage.Postgres.URL })},
+	{"DB_MAX_OPEN_CONNS", "db-max-open-conns", "maximum open database connections, 0 is unlimited", intSetting(func(c *Config) *int { return &c.Storage.Postgres.MaxOpenConns })},
+	{"DB_MAX_IDLE_CONNS", "db-max-idle-conns", "maximum idle database connections", intSetting(func(c *Config) *int { return &c.Storage.Postgres.MaxIdleConns })},
+	{"DB_CONN_MAX_LIFETIME", "db-conn-max-lifetime", "maximum lifetime of a database connection", durationSetting(func(c *Config) *time.Duration { return &c.Storage.Postgres.ConnMaxLifetime })},
+	{"DB_CONNECT_ATTEMPTS", "db-connect-attempts", "database pings at startup before giving up", intSetting(func(c *Config) *int { return &c.Storage.Postgres.ConnectAttempts })},
+	{"LOG_LEVEL", "log-level", "log level: debug, info, warn or error", stringSetting(func(c *Config) *string { return &c.Log.Level })},
+	{"LOG_FORMAT", "log-format", "log format: text or json", stringSetting(func(c *Config) *string { return &c.Log.Format })},
+	{"METRICS_ADDR", "metrics-addr", "address of the prometheus metrics server, empty disables it", stringSetting(func(c *Config) *string { return &c.Metrics.Addr })},
+	{"OTEL_TRACES_EXPORTER", "traces-exporter", "trace exporter: none, stdout or otlp", stringSetting(func(c *Config) *string { return &c.Tracing.Exporter })},
+	{"WEBHOOKS_ENABLED", "webhooks", "enable webhook endpoints and delivery", boolSetting(func(c *Config) *bool { return &c.Webhooks.Enabled })},
+	{"IDEMPOTENCY_ENABLED", "idempotency", "enable Idempotency-Key handling", boolSetting(func(c *Config) *bool { return &c.Idempotency.Enabled })},
+	{"EVENT_STREAM_ENABLED", "event-stream", "enable estate event streams", boolSetting(func(c *Config) *bool { return &c.EventStream.Enabled })},
+	{"GRAPHQL_ENABLED", "graphql", "enable the /graphql endpoint", boolSetting(func(c *Config) *bool { return &c.GraphQL.Enabled })},
+	{"GRAPHQL_MAX_DEPTH", "graphql-max-depth", "maximum nesting depth of graphql queries", intSetting(func(c *Config) *int { return &c.GraphQL.MaxDepth })},
+	{"BOOTSTRAP_ADMIN_API_KEY", "", "", stringSetting(func(c *Config) *string { return &c.Bootstrap.APIKey })},
+	{"BOOTSTRAP_TENANT_ID", "", "", stringSetting(func(c *Config) *string { return &c.Bootstrap.TenantID })},
+}
+
+func stringSetting(field func(c *Config) *string) func(c *Config, value string) error {
+	return func(c *Config, value string) error {
+		*field(c) = value
+		return nil
+	}
+}
+
+func intSetting(field func(c *Config) *int) func(c *Config, value string) error {
+	return func(c *Config, value string) error {
+		v, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		*field(c) = v
+		return nil
+	}
+}
+
+func boolSetting(field func(c *Config) *bool) func(c *Config, value string) error {
+	return func(c *Config, value string) error {
+		v, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		*field(c) = v
+		return nil
+	}
+}
+
+func durationSetting(field func(c *Config) *time.Duration) func(c *Config, value string) error {
+	return func(c *Config, value string) error {
+		v, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		*field(c) = v
+		return nil
+	}
+}
+
+// Load build the config from defaults, overridden in order by the yaml file given by -config or CONFIG_FILE,
+// env variables and flags, then validate it. flag.ErrHelp is returned when -h is given
+func Load(name string, args []string, getenv func(string) string, output io.Writer) (Config, error) {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(output)
+	configFile := fs.String("config", getenv("CONFIG_FILE"), "yaml config file")
+
+	// flags are applied after the file and env, so they are only recorded while parsing
+	var flagValues []func(c *Config) error
+	for _, s := range settings {
+		if s.flag == "" {
+			continue
+		}
+		s := s
+		fs.Func(s.flag, s.usage, func(value string) error {
+			// validate the value right away so the error points at the flag
+			if err := s.set(&Config{}, value); err != nil {
+				return err
+			}
+			flagValues = append(flagValues, func(c *Config) error { return s.set(c, value) })
+			return nil
+		})
+	}
+	if err := fs.Parse(args); err != nil {
+		return Config{}, err
+	}
+
+	cfg := Default()
+	if *configFile != "" {
+		if err := loadFile(&cfg, *configFile); err != nil {
+			return Config{}, err
+		}
+	}
+
+	for _, s := range settings {
+		value := getenv(s.env)
+		if value == "" {
+			continue
+		}
+		if err := s.set(&cfg, value); err != nil {
+			return Config{}, fmt.Errorf("invalid %s: %w", s.env, err)
+		}
+	}
+
+	for _, apply := range flagValues {
+		if err := apply(&cfg); err != nil {
+			return Config{}, err
+		}
+	}
+
+	return cfg, cfg.Validate()
+}
+
+// loadFile decode the yaml file over cfg, unknown keys are rejected so typos do not go unnoticed
+func loadFile(cfg *Config, path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading config file: %w", err)
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	err = decoder.Decode(cfg)
+	if err != nil && err != io.EOF {
+		return fmt.Errorf("parsing config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// Print write the redacted config as yaml
+func Print(w io.Writer, cfg Config) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(cfg.Redacted()); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
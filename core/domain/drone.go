@@ -0,0 +1,176 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrorDroneNotFound = errors.New("drone not found")
+var ErrorDroneInvalid = errors.New("drone is invalid")
+var ErrorDroneInvalidStatus = errors.New("drone status must be one of available, maintenance or retired")
+var ErrorDroneRetired = errors.New("retired drones can not be changed")
+var ErrorDroneUnavailable = errors.New("drone is not available for flights")
+var ErrorDroneAltitudeExceeded = errors.New("estate has a tree the drone can not fly over")
+var ErrorMaxDistanceWithDrone = errors.New("max distance comes from the drone range, it can not be given with a drone")
+
+// MaxDroneNameLength bound drone names and models, they are labels for people
+const MaxDroneNameLength = 255
+
+type DroneStatus string
+
+const (
+	DroneAvailable   DroneStatus = "available"
+	DroneMaintenance DroneStatus = "maintenance"
+	DroneRetired     DroneStatus = "retired"
+)
+
+// Validate return ErrorDroneInvalidStatus for unknown statuses
+func (s DroneStatus) Validate() error {
+	switch s {
+	case DroneAvailable, DroneMaintenance, DroneRetired:
+		return nil
+	}
+	return ErrorDroneInvalidStatus
+}
+
+// DroneSpeed is how fast a drone flies in meters per second, Cruise between plots and Climb up or down
+type DroneSpeed struct {
+	Cruise float64
+	Climb  float64
+}
+
+// DroneProfile is the flight specification of a drone, missions keep a copy of the profile they were planned with
+// so later changes to the drone do not change them
+type DroneProfile struct {
+	MaxRange    int
+	MaxAltitude int
+	Speed       DroneSpeed
+}
+
+// Drone is an aircraft of the tenant fleet. MaxRange is the distance it flies on one battery, landing included,
+// and MaxAltitude the highest it can fly, both in meters
+type Drone struct {
+	ID          uuid.UUID
+	TenantID    uuid.UUID
+	Name        string
+	Model       string
+	MaxRange    int
+	MaxAltitude int
+	Speed       DroneSpeed
+	Status      DroneStatus
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// DroneUpdate change the specification or the status of a drone, nil fields are kept
+type DroneUpdate struct {
+	Name        *string
+	Model       *string
+	MaxRange    *int
+	MaxAltitude *int
+	CruiseSpeed *float64
+	ClimbSpeed  *float64
+	Status      *DroneStatus
+}
+
+// NewDrone create an available drone of the tenant fleet
+func NewDrone(tenantID uuid.UUID, name string, model string, maxRange int, maxAltitude int, speed DroneSpeed, createdAt time.Time) (*Drone, error) {
+	drone := &Drone{
+		ID:          uuid.New(),
+		TenantID:    tenantID,
+		Name:        strings.TrimSpace(name),
+		Model:       strings.TrimSpace(model),
+		MaxRange:    maxRange,
+		MaxAltitude: maxAltitude,
+		Speed:       speed,
+		Status:      DroneAvailable,
+		CreatedAt:   createdAt,
+		UpdatedAt:   createdAt,
+	}
+	return drone, drone.Validate()
+}
+
+// Validate check the drone is named, has a positive range, altitude and speeds and a known status
+func (d *Drone) Validate() error {
+	if d.Name == "" || len(d.Name) > MaxDroneNameLength {
+		return fmt.Errorf("%w: name must have between 1 and %d characters", ErrorDroneInvalid, MaxDroneNameLength)
+	}
+	if d.Model == "" || len(d.Model) > MaxDroneNameLength {
+		return fmt.Errorf("%w: model must have between 1 and %d characters", ErrorDroneInvalid, MaxDroneNameLength)
+	}
+	if d.MaxRange < 1 || d.MaxAltitude < 1 {
+		return fmt.Errorf("%w: max range and max altitude must be at least 1", ErrorDroneInvalid)
+	}
+	if !isFinite(d.Speed.Cruise) || !isFinite(d.Speed.Climb) || d.Speed.Cruise <= 0 || d.Speed.Climb <= 0 {
+		return fmt.Errorf("%w: cruise and climb speeds must be positive", ErrorDroneInvalid)
+	}
+	return d.Status.Validate()
+}
+
+// Apply change the drone with update at the given time, retired drones are final
+func (d *Drone) Apply(update DroneUpdate, at time.Time) error {
+	if d.Status == DroneRetired {
+		return ErrorDroneRetired
+	}
+
+	if update.Name != nil {
+		d.Name = strings.TrimSpace(*update.Name)
+	}
+	if update.Model != nil {
+		d.Model = strings.TrimSpace(*update.Model)
+	}
+	if update.MaxRange != nil {
+		d.MaxRange = *update.MaxRange
+	}
+	if update.MaxAltitude != nil {
+		d.MaxAltitude = *update.MaxAltitude
+	}
+	if update.CruiseSpeed != nil {
+		d.Speed.Cruise = *update.CruiseSpeed
+	}
+	if update.ClimbSpeed != nil {
+		d.Speed.Climb = *update.ClimbSpeed
+	}
+	if update.Status != nil {
+		d.Status = *update.Status
+	}
+	d.UpdatedAt = at
+	return d.Validate()
+}
+
+// CanFly check the drone is available and flies high enough to keep TreeClearance above every tree of the routes
+func (d *Drone) CanFly(droneRoutes []DroneRoute) error {
+	if d.Status != DroneAvailable {
+		return fmt.Errorf("%w: drone is %s", ErrorDroneUnavailable, d.Status)
+	}
+
+	for _, route := range droneRoutes {
+		if route.Altitude > d.MaxAltitude {
+			return fmt.Errorf("%w: plot (%d, %d) needs %d meters, the drone reaches %d",
+				ErrorDroneAltitudeExceeded, route.Plot.Col, route.Plot.Row, route.Altitude, d.MaxAltitude)
+		}
+	}
+	return nil
+}
+
+// Profile return the current flight specification of the drone
+func (d *Drone) Profile() DroneProfile {
+	return DroneProfile{MaxRange: d.MaxRange, MaxAltitude: d.MaxAltitude, Speed: d.Speed}
+}
+
+// FlightTime is how long the drone flies droneRoutes within its range, from takeoff to landing
+func (d *Drone) FlightTime(droneRoutes []DroneRoute) time.Duration {
+	flown := droneRoutes[:len(DroneWaypointsWithin(d.MaxRange, droneRoutes))]
+	profile := DroneAltitudeProfile(flown)
+	return d.flightTime(profile.Horizontal, profile.Vertical)
+}
+
+// flightTime is how long the drone flies horizontal meters at cruise speed and vertical meters at climb speed
+func (d *Drone) flightTime(horizontal int, vertical int) time.Duration {
+	seconds := float64(horizontal)/d.Speed.Cruise + float64(vertical)/d.Speed.Climb
+	return time.Duration(seconds * float64(time.Second)).Round(time.Second)
+}
@@ -1,14 +1,58 @@
 package domain
 
+import (
+	"errors"
+	"slices"
+	"time"
+)
+
+// GroundAltitude is the drone route altitude over plots without tree
+const GroundAltitude = 1
+
+// MaxRenderPlots bound the estates whose drone plan can be drawn, beyond it plots are too small to be seen
+const MaxRenderPlots = 250_000
+
+var ErrorDronePlanTooLarge = errors.New("estate has too many plots to render its drone plan")
+
 type DroneRoute struct {
 	Route    int
 	Plot     Plot
 	Altitude int
 }
 
+// DroneDistance is the distance to fly over every plot of an estate. Rest is only set for a limited flight distance
+// and FlightTime when the flight is planned for a drone, it covers the flight until Rest
 type DroneDistance struct {
+	Distance   int
+	Rest       *Plot
+	FlightTime *time.Duration
+}
+
+// DroneDistanceChange is the drone total distance of an estate before and after a write to its drone routes
+type DroneDistanceChange struct {
+	Previous int
+	Current  int
+}
+
+// Changed report whether the write changed the drone total distance
+func (c DroneDistanceChange) Changed() bool {
+	return c.Previous != c.Current
+}
+
+// DronePlan is everything needed to draw the drone plan of an estate, Rest is only set for a limited flight distance
+type DronePlan struct {
+	Estate Estate
+	Trees  []Tree
+	Routes []DroneRoute
+	Rest   *Plot
+}
+
+// DroneWaypoint is one plot of the drone route, Distance is flown from takeoff until reaching it at its altitude
+type DroneWaypoint struct {
+	Sequence int
+	Plot     Plot
+	Altitude int
 	Distance int
-	Rest     Plot
 }
 
 // DroneZigzagTraverse initialize drone route travel in zigzag based on estates width and length with altitude 1
@@ -32,7 +76,7 @@ func DroneZigzagTraverse(width int, length int) []DroneRoute {
 			result = append(result, DroneRoute{
 				Route:    i,
 				Plot:     Plot{Row: row, Col: col},
-				Altitude: 1,
+				Altitude: GroundAltitude,
 			})
 			col++
 		}
@@ -50,7 +94,7 @@ func DroneZigzagTraverse(width int, length int) []DroneRoute {
 			result = append(result, DroneRoute{
 				Route:    i,
 				Plot:     Plot{Row: row, Col: col},
-				Altitude: 1,
+				Altitude: GroundAltitude,
 			})
 			col--
 		}
@@ -61,6 +105,22 @@ func DroneZigzagTraverse(width int, length int) []DroneRoute {
 	return result
 }
 
+// DroneRoutesOverTrees is the zigzag drone route of an estate flying one above every tree, altitude 1 elsewhere
+func DroneRoutesOverTrees(width int, length int, trees []Tree) []DroneRoute {
+	altitudes := make(map[Plot]int, len(trees))
+	for _, tree := range trees {
+		altitudes[tree.Plot] = tree.DroneAltitude()
+	}
+
+	routes := DroneZigzagTraverse(width, length)
+	for i := range routes {
+		if altitude, ok := altitudes[routes[i].Plot]; ok {
+			routes[i].Altitude = altitude
+		}
+	}
+	return routes
+}
+
 // DroneTotalDistance calculate drone vertical and horizontal movement distance to travel estate with tree
 func DroneTotalDistance(maxDistance *int, droneRoutes []DroneRoute) int {
 	horizontal := 0
@@ -96,3 +156,138 @@ func DroneTotalDistance(maxDistance *int, droneRoutes []DroneRoute) int {
 	return horizontal + vertical
 
 }
+
+// DroneWaypoints convert drone routes into waypoints in flight order, landing after the last one is not included
+func DroneWaypoints(droneRoutes []DroneRoute) []DroneWaypoint {
+	waypoints := make([]DroneWaypoint, 0, len(droneRoutes))
+	distance := 0
+
+	for i, route := range droneRoutes {
+		if i == 0 {
+			// takeoff
+			distance += route.Altitude
+		} else {
+			diff := route.Altitude - droneRoutes[i-1].Altitude
+			if diff < 0 {
+				diff *= -1
+			}
+			distance += DistanceBetweenPlot + diff
+		}
+
+		waypoints = append(waypoints, DroneWaypoint{
+			Sequence: i + 1,
+			Plot:     route.Plot,
+			Altitude: route.Altitude,
+			Distance: distance,
+		})
+	}
+
+	return waypoints
+}
+
+// DroneRestPlot is the plot the drone lands on when it can fly at most maxDistance, landing included.
+// It is the last plot of the route when the whole route fits, and the takeoff plot when not even the first one does
+func DroneRestPlot(maxDistance int, droneRoutes []DroneRoute) Plot {
+	waypoints := DroneWaypointsWithin(maxDistance, droneRoutes)
+	if len(waypoints) == 0 {
+		return Plot{}
+	}
+	return waypoints[len(waypoints)-1].Plot
+}
+
+// DroneWaypointsWithin is the waypoints flown with at most maxDistance, landing included.
+// The takeoff waypoint is always kept so the drone has somewhere to rest
+func DroneWaypointsWithin(maxDistance int, droneRoutes []DroneRoute) []DroneWaypoint {
+	waypoints := DroneWaypoints(droneRoutes)
+	for i, waypoint := range waypoints {
+		if i > 0 && waypoint.Distance+waypoint.Altitude > maxDistance {
+			return waypoints[:i]
+		}
+	}
+	return waypoints
+}
+
+// ProfileLargestClimbs is the number of climbs an altitude profile marks
+const ProfileLargestClimbs = 3
+
+// ProfilePoint is the drone altitude over Plot once it flew Distance horizontally
+type ProfilePoint struct {
+	Distance int
+	Altitude int
+	Plot     Plot
+}
+
+// Climb is the Height the drone gains to fly over Plot at Altitude, reached after Distance horizontally
+type Climb struct {
+	Distance int
+	Altitude int
+	Height   int
+	Plot     Plot
+}
+
+// AltitudeProfile is the side view of a drone route, from takeoff to landing.
+// Horizontal and Vertical add up to the route total distance
+type AltitudeProfile struct {
+	Points     []ProfilePoint
+	Climbs     []Climb
+	Horizontal int
+	Vertical   int
+}
+
+// VerticalShare is the part of the total distance flown climbing or descending
+func (p AltitudeProfile) VerticalShare() float64 {
+	if p.Horizontal+p.Vertical == 0 {
+		return 0
+	}
+	return float64(p.Vertical) / float64(p.Horizontal+p.Vertical)
+}
+
+// HorizontalShare is the part of the total distance flown from plot to plot
+func (p AltitudeProfile) HorizontalShare() float64 {
+	if p.Horizontal+p.Vertical == 0 {
+		return 0
+	}
+	return float64(p.Horizontal) / float64(p.Horizontal+p.Vertical)
+}
+
+// DroneAltitudeProfile build the altitude profile of drone routes with its largest climbs, takeoff excluded.
+// Plots flown at the same altitude as both of their neighbours are left out, the profile keeps its shape with far fewer points
+func DroneAltitudeProfile(droneRoutes []DroneRoute) AltitudeProfile {
+	var profile AltitudeProfile
+	if len(droneRoutes) == 0 {
+		return profile
+	}
+
+	first, last := droneRoutes[0], droneRoutes[len(droneRoutes)-1]
+	profile.Points = append(profile.Points, ProfilePoint{Plot: first.Plot})
+	var climbs []Climb
+	for i, route := range droneRoutes {
+		previous, next := 0, 0
+		if i > 0 {
+			previous = droneRoutes[i-1].Altitude
+			profile.Horizontal += DistanceBetweenPlot
+		}
+		if i+1 < len(droneRoutes) {
+			next = droneRoutes[i+1].Altitude
+		}
+
+		diff := route.Altitude - previous
+		if diff > 0 && i > 0 {
+			climbs = append(climbs, Climb{Distance: profile.Horizontal, Altitude: route.Altitude, Height: diff, Plot: route.Plot})
+		}
+		profile.Vertical += max(diff, -diff)
+
+		if i == 0 || i == len(droneRoutes)-1 || route.Altitude != previous || route.Altitude != next {
+			profile.Points = append(profile.Points, ProfilePoint{Distance: profile.Horizontal, Altitude: route.Altitude, Plot: route.Plot})
+		}
+	}
+	// landing
+	profile.Vertical += last.Altitude
+	profile.Points = append(profile.Points, ProfilePoint{Distance: profile.Horizontal, Plot: last.Plot})
+
+	slices.SortStableFunc(climbs, func(a, b Climb) int {
+		return b.Height - a.Height
+	})
+	profile.Climbs = climbs[:min(len(climbs), ProfileLargestClimbs)]
+	return profile
+}
@@ -110,3 +110,111 @@ func TestDroneTotalDistance(t *testing.T) {
 		})
 	}
 }
+
+func TestDroneWaypoints(t *testing.T) {
+	tests := []struct {
+		name        string
+		droneRoutes []DroneRoute
+		expected    []DroneWaypoint
+	}{
+		{
+			name: "Route with trees",
+			droneRoutes: []DroneRoute{
+				{Route: 1, Plot: Plot{Row: 1, Col: 1}, Altitude: 1},
+				{Route: 2, Plot: Plot{Row: 1, Col: 2}, Altitude: 6},
+				{Route: 3, Plot: Plot{Row: 1, Col: 3}, Altitude: 4},
+			},
+			expected: []DroneWaypoint{
+				{Sequence: 1, Plot: Plot{Row: 1, Col: 1}, Altitude: 1, Distance: 1},
+				{Sequence: 2, Plot: Plot{Row: 1, Col: 2}, Altitude: 6, Distance: 1 + DistanceBetweenPlot + 5},
+				{Sequence: 3, Plot: Plot{Row: 1, Col: 3}, Altitude: 4, Distance: 1 + 2*DistanceBetweenPlot + 5 + 2},
+			},
+		},
+		{
+			name:        "Empty route",
+			droneRoutes: []DroneRoute{},
+			expected:    []DroneWaypoint{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := DroneWaypoints(tt.droneRoutes)
+			assert.Equal(t, tt.expected, result)
+
+			// landing from the last waypoint completes the total distance
+			if len(result) > 0 {
+				last := result[len(result)-1]
+				assert.Equal(t, DroneTotalDistance(nil, tt.droneRoutes), last.Distance+last.Altitude)
+			}
+		})
+	}
+}
+
+func TestDroneRoutesOverTrees(t *testing.T) {
+	routes := DroneRoutesOverTrees(1, 3, []Tree{{Plot: Plot{Row: 1, Col: 2}, Height: 5}})
+	assert.Equal(t, []DroneRoute{
+		{Route: 1, Plot: Plot{Row: 1, Col: 1}, Altitude: 1},
+		{Route: 2, Plot: Plot{Row: 1, Col: 2}, Altitude: 6},
+		{Route: 3, Plot: Plot{Row: 1, Col: 3}, Altitude: 1},
+	}, routes)
+}
+
+func TestDroneRestPlot(t *testing.T) {
+	// waypoints reached at 1, 11, 25 and 39, landing adds their altitude
+	routes := []DroneRoute{
+		{Route: 1, Plot: Plot{Row: 1, Col: 1}, Altitude: 1},
+		{Route: 2, Plot: Plot{Row: 1, Col: 2}, Altitude: 1},
+		{Route: 3, Plot: Plot{Row: 2, Col: 2}, Altitude: 5},
+		{Route: 4, Plot: Plot{Row: 2, Col: 1}, Altitude: 1},
+	}
+
+	tests := []struct {
+		name        string
+		maxDistance int
+		expected    Plot
+	}{
+		{"not even the first plot", 0, Plot{Row: 1, Col: 1}},
+		{"lands before the tree", 29, Plot{Row: 1, Col: 2}},
+		{"lands on the tree", 30, Plot{Row: 2, Col: 2}},
+		{"whole route", 100, Plot{Row: 2, Col: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, DroneRestPlot(tt.maxDistance, routes))
+		})
+	}
+}
+
+func TestDroneAltitudeProfile(t *testing.T) {
+	altitudes := []int{1, 1, 1, 6, 1, 1, 3}
+	routes := make([]DroneRoute, len(altitudes))
+	for i, altitude := range altitudes {
+		routes[i] = DroneRoute{Route: i + 1, Plot: Plot{Row: 1, Col: i + 1}, Altitude: altitude}
+	}
+
+	profile := DroneAltitudeProfile(routes)
+	assert.Equal(t, []ProfilePoint{
+		{Distance: 0, Altitude: 0, Plot: Plot{Row: 1, Col: 1}},
+		{Distance: 0, Altitude: 1, Plot: Plot{Row: 1, Col: 1}},
+		// the second plot is flown at the altitude of both of its neighbours
+		{Distance: 20, Altitude: 1, Plot: Plot{Row: 1, Col: 3}},
+		{Distance: 30, Altitude: 6, Plot: Plot{Row: 1, Col: 4}},
+		{Distance: 40, Altitude: 1, Plot: Plot{Row: 1, Col: 5}},
+		{Distance: 50, Altitude: 1, Plot: Plot{Row: 1, Col: 6}},
+		{Distance: 60, Altitude: 3, Plot: Plot{Row: 1, Col: 7}},
+		{Distance: 60, Altitude: 0, Plot: Plot{Row: 1, Col: 7}},
+	}, profile.Points)
+	assert.Equal(t, []Climb{
+		{Distance: 30, Altitude: 6, Height: 5, Plot: Plot{Row: 1, Col: 4}},
+		{Distance: 60, Altitude: 3, Height: 2, Plot: Plot{Row: 1, Col: 7}},
+	}, profile.Climbs)
+	assert.Equal(t, 60, profile.Horizontal)
+	assert.Equal(t, 16, profile.Vertical)
+	assert.Equal(t, DroneTotalDistance(nil, routes), profile.Horizontal+profile.Vertical)
+	assert.InDelta(t, 16.0/76.0, profile.VerticalShare(), 1e-9)
+	assert.InDelta(t, 60.0/76.0, profile.HorizontalShare(), 1e-9)
+
+	assert.Equal(t, AltitudeProfile{}, DroneAltitudeProfile(nil))
+}
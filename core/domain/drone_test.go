@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDrone(t *testing.T) {
+	at := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	speed := DroneSpeed{Cruise: 10, Climb: 4}
+
+	tests := []struct {
+		name        string
+		droneName   string
+		maxRange    int
+		maxAltitude int
+		speed       DroneSpeed
+		wantErr     bool
+	}{
+		{"valid", " drone-7 ", 2000, 30, speed, false},
+		{"no name", "  ", 2000, 30, speed, true},
+		{"no range", "drone-7", 0, 30, speed, true},
+		{"no altitude", "drone-7", 2000, 0, speed, true},
+		{"no climb speed", "drone-7", 2000, 30, DroneSpeed{Cruise: 10}, true},
+		{"infinite speed", "drone-7", 2000, 30, DroneSpeed{Cruise: math.Inf(1), Climb: 4}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			drone, err := NewDrone(uuid.New(), tt.droneName, "T40", tt.maxRange, tt.maxAltitude, tt.speed, at)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrorDroneInvalid)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "drone-7", drone.Name)
+			assert.Equal(t, DroneAvailable, drone.Status)
+			assert.Equal(t, at, drone.UpdatedAt)
+		})
+	}
+}
+
+func TestDrone_Apply(t *testing.T) {
+	at := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	newDrone := func(status DroneStatus) *Drone {
+		return &Drone{Name: "drone-7", Model: "T40", MaxRange: 2000, MaxAltitude: 30, Speed: DroneSpeed{Cruise: 10, Climb: 4}, Status: status}
+	}
+	maxRange := 1500
+	retired := DroneRetired
+	unknown := DroneStatus("lost")
+
+	t.Run("Change kept fields", func(t *testing.T) {
+		drone := newDrone(DroneAvailable)
+		assert.NoError(t, drone.Apply(DroneUpdate{MaxRange: &maxRange, Status: &retired}, at))
+		assert.Equal(t, 1500, drone.MaxRange)
+		assert.Equal(t, 30, drone.MaxAltitude)
+		assert.Equal(t, DroneRetired, drone.Status)
+		assert.Equal(t, at, drone.UpdatedAt)
+	})
+
+	t.Run("Unknown status", func(t *testing.T) {
+		assert.Equal(t, ErrorDroneInvalidStatus, newDrone(DroneAvailable).Apply(DroneUpdate{Status: &unknown}, at))
+	})
+
+	t.Run("Retired is final", func(t *testing.T) {
+		assert.Equal(t, ErrorDroneRetired, newDrone(DroneRetired).Apply(DroneUpdate{MaxRange: &maxRange}, at))
+	})
+}
+
+func TestDrone_CanFly(t *testing.T) {
+	routes := []DroneRoute{
+		{Route: 1, Plot: Plot{Row: 1, Col: 1}, Altitude: 1},
+		{Route: 2, Plot: Plot{Row: 1, Col: 2}, Altitude: 11},
+	}
+
+	assert.NoError(t, (&Drone{MaxAltitude: 11, Status: DroneAvailable}).CanFly(routes))
+	assert.ErrorIs(t, (&Drone{MaxAltitude: 10, Status: DroneAvailable}).CanFly(routes), ErrorDroneAltitudeExceeded)
+	assert.ErrorIs(t, (&Drone{MaxAltitude: 30, Status: DroneMaintenance}).CanFly(routes), ErrorDroneUnavailable)
+}
+
+func TestDrone_FlightTime(t *testing.T) {
+	routes := []DroneRoute{
+		{Route: 1, Plot: Plot{Row: 1, Col: 1}, Altitude: 1},
+		{Route: 2, Plot: Plot{Row: 1, Col: 2}, Altitude: 5},
+		{Route: 3, Plot: Plot{Row: 1, Col: 3}, Altitude: 1},
+	}
+	drone := &Drone{MaxRange: 100, Speed: DroneSpeed{Cruise: 5, Climb: 2}}
+
+	// 20 meters at cruise speed and 10 meters up and down at climb speed
+	assert.Equal(t, 9*time.Second, drone.FlightTime(routes))
+
+	// the drone lands on the first plot when the second is out of range
+	drone.MaxRange = 12
+	assert.Equal(t, time.Second, drone.FlightTime(routes))
+}
@@ -7,11 +7,30 @@ import (
 )
 
 var ErrorEstatesNotFound = errors.New("estates not found")
+var ErrorVersionMismatch = errors.New("resource was modified by another request")
 
+// MaxEstateSize is the largest width and length of an estate
+const MaxEstateSize = 50000
+
+// InitialVersion is the version of newly created estates and trees, every update increment it by one
+const InitialVersion = 1
+
+// Estate belongs to one tenant, estates of other tenants are never visible.
+// Version moves on updates of the estate itself, TreesVersion on changes of its trees
 type Estate struct {
-	ID     uuid.UUID
-	Width  int
-	Length int
+	ID           uuid.UUID
+	TenantID     uuid.UUID
+	Width        int
+	Length       int
+	Version      int
+	TreesVersion int
+	// Sandbox is set on draft estates cloned from another estate to plan changes
+	Sandbox *Sandbox
+}
+
+// Contains report whether plot is inside the estate
+func (e *Estate) Contains(plot Plot) bool {
+	return plot.Col >= 1 && plot.Row >= 1 && plot.Col <= e.Length && plot.Row <= e.Width
 }
 
 type EstateStats struct {
@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrorEventStreamUnavailable = errors.New("event stream unavailable")
+
+type EventType string
+
+const (
+	EventEstateCreated            EventType = "estate.created"
+	EventEstateResized            EventType = "estate.resized"
+	EventEstateDeleted            EventType = "estate.deleted"
+	EventTreePlanted              EventType = "tree.planted"
+	EventTreeUpdated              EventType = "tree.updated"
+	EventTreeRemoved              EventType = "tree.removed"
+	EventDronePlanDistanceChanged EventType = "drone_plan.distance_changed"
+)
+
+// Event is a change that happened to an estate, emitted by the usecase layer after a successful write
+type Event struct {
+	ID         uuid.UUID
+	Type       EventType
+	TenantID   uuid.UUID
+	EstateID   uuid.UUID
+	OccurredAt time.Time
+	Data       any
+}
+
+type EstateEventData struct {
+	Width  int
+	Length int
+}
+
+type TreeEventData struct {
+	TreeID uuid.UUID
+	Plot   Plot
+	Height int
+}
+
+type DronePlanEventData struct {
+	Distance int
+}
+
+func NewEvent(eventType EventType, tenantID uuid.UUID, estateID uuid.UUID, data any) Event {
+	return Event{
+		ID:         uuid.New(),
+		Type:       eventType,
+		TenantID:   tenantID,
+		EstateID:   estateID,
+		OccurredAt: time.Now().UTC(),
+		Data:       data,
+	}
+}
+
+// DataMap flatten event data into the public representation shared by event stream and webhook payloads
+func (e Event) DataMap() map[string]any {
+	switch d := e.Data.(type) {
+	case map[string]any:
+		return d
+	case EstateEventData:
+		return map[string]any{"width": d.Width, "length": d.Length}
+	case TreeEventData:
+		return map[string]any{"tree_id": d.TreeID, "x": d.Plot.Col, "y": d.Plot.Row, "height": d.Height}
+	case DronePlanEventData:
+		return map[string]any{"distance": d.Distance}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrorIdempotencyKeyInvalid = errors.New("idempotency key must be 1 to 255 characters")
+var ErrorIdempotencyKeyReused = errors.New("idempotency key was already used with a different request")
+var ErrorIdempotencyRequestInProgress = errors.New("request with the same idempotency key is still in progress")
+
+const MaxIdempotencyKeyLength = 255
+
+// IdempotencyRecord remember the response of a request sent with an idempotency key,
+// StatusCode is zero while the original request is still in progress
+type IdempotencyRecord struct {
+	TenantID     uuid.UUID
+	Key          string
+	RequestHash  string
+	StatusCode   int
+	ResponseBody []byte
+	CreatedAt    time.Time
+	ExpiresAt    time.Time
+}
+
+func (r *IdempotencyRecord) IsCompleted() bool {
+	return r.StatusCode != 0
+}
+
+func ValidateIdempotencyKey(key string) error {
+	if key == "" || len(key) > MaxIdempotencyKeyLength {
+		return ErrorIdempotencyKeyInvalid
+	}
+	return nil
+}
+
+// HashIdempotencyRequest fingerprint the request so a key reused for a different request can be detected,
+// query is included since some routes like import take their options from it
+func HashIdempotencyRequest(method string, path string, query string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(method))
+	h.Write([]byte{0})
+	h.Write([]byte(path))
+	h.Write([]byte{0})
+	h.Write([]byte(query))
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
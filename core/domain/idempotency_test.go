@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateIdempotencyKey(t *testing.T) {
+	assert.NoError(t, ValidateIdempotencyKey("tablet-42-tree-7"))
+	assert.Equal(t, ErrorIdempotencyKeyInvalid, ValidateIdempotencyKey(""))
+	assert.Equal(t, ErrorIdempotencyKeyInvalid, ValidateIdempotencyKey(strings.Repeat("k", MaxIdempotencyKeyLength+1)))
+}
+
+func TestHashIdempotencyRequest(t *testing.T) {
+	hash := HashIdempotencyRequest("POST", "/estate", "", []byte(`{"width":10,"length":10}`))
+	assert.Len(t, hash, 64)
+	assert.Equal(t, hash, HashIdempotencyRequest("POST", "/estate", "", []byte(`{"width":10,"length":10}`)))
+	assert.NotEqual(t, hash, HashIdempotencyRequest("POST", "/estate", "", []byte(`{"width":10,"length":20}`)))
+	assert.NotEqual(t, hash, HashIdempotencyRequest("POST", "/estate/1/tree", "", []byte(`{"width":10,"length":10}`)))
+	assert.NotEqual(t, hash, HashIdempotencyRequest("POST", "/estate", "keep_ids=true", []byte(`{"width":10,"length":10}`)))
+}
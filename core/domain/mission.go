@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrorMissionNotFound = errors.New("mission not found")
+var ErrorMissionInvalidStatus = errors.New("mission status is not supported")
+var ErrorMissionInvalidTransition = errors.New("mission can not move from its current status to the requested one")
+var ErrorMissionNotPlanned = errors.New("mission drone and pilot can only be assigned while it is planned")
+var ErrorMissionUnassigned = errors.New("mission needs a drone and a pilot before it starts")
+var ErrorMissionFleetDrone = errors.New("drone of a mission planned for a fleet drone can not be changed")
+
+type MissionStatus string
+
+const (
+	MissionPlanned    MissionStatus = "planned"
+	MissionInProgress MissionStatus = "in_progress"
+	MissionCompleted  MissionStatus = "completed"
+	MissionAborted    MissionStatus = "aborted"
+)
+
+// missionTransitions is the statuses a mission can move to from each status, completed and aborted are final
+var missionTransitions = map[MissionStatus][]MissionStatus{
+	MissionPlanned:    {MissionInProgress, MissionAborted},
+	MissionInProgress: {MissionCompleted, MissionAborted},
+}
+
+// Validate return ErrorMissionInvalidStatus for unknown statuses
+func (s MissionStatus) Validate() error {
+	switch s {
+	case MissionPlanned, MissionInProgress, MissionCompleted, MissionAborted:
+		return nil
+	}
+	return ErrorMissionInvalidStatus
+}
+
+// Mission is a drone flight frozen from the drone plan of an estate when it was created,
+// later tree changes update the drone routes but never the waypoints of a mission.
+// Distance is the planned flight distance, landing included. DroneID and DroneProfile are set when the mission is planned
+// for a drone of the fleet, Drone is a label either way
+type Mission struct {
+	ID           uuid.UUID
+	EstateID     uuid.UUID
+	Status       MissionStatus
+	MaxDistance  *int
+	Distance     int
+	Waypoints    []DroneWaypoint
+	DroneID      *uuid.UUID
+	DroneProfile *DroneProfile
+	Drone        string
+	Pilot        string
+	CreatedAt    time.Time
+	StartedAt    *time.Time
+	FinishedAt   *time.Time
+}
+
+// MissionUpdate change the assignment and the status of a mission, nil fields are kept
+type MissionUpdate struct {
+	Status *MissionStatus
+	Drone  *string
+	Pilot  *string
+}
+
+// NewMission plan a mission over the drone routes of an estate, only the waypoints reachable within maxDistance are kept
+func NewMission(estateID uuid.UUID, droneRoutes []DroneRoute, maxDistance *int, createdAt time.Time) *Mission {
+	waypoints := DroneWaypoints(droneRoutes)
+	if maxDistance != nil {
+		waypoints = DroneWaypointsWithin(*maxDistance, droneRoutes)
+	}
+
+	distance := 0
+	if len(waypoints) > 0 {
+		last := waypoints[len(waypoints)-1]
+		distance = last.Distance + last.Altitude
+	}
+
+	return &Mission{
+		ID:          uuid.New(),
+		EstateID:    estateID,
+		Status:      MissionPlanned,
+		MaxDistance: maxDistance,
+		Distance:    distance,
+		Waypoints:   waypoints,
+		CreatedAt:   createdAt,
+	}
+}
+
+// Apply update the mission at the given time, the assignment is changed before the status
+// so a mission can be assigned and started at once. The drone label of a mission planned for a fleet drone is kept
+// since it names the drone the mission was planned with
+func (m *Mission) Apply(update MissionUpdate, at time.Time) error {
+	if update.Drone != nil || update.Pilot != nil {
+		if m.Status != MissionPlanned {
+			return ErrorMissionNotPlanned
+		}
+		if update.Drone != nil && m.DroneID != nil && *update.Drone != m.Drone {
+			return ErrorMissionFleetDrone
+		}
+		if update.Drone != nil {
+			m.Drone = *update.Drone
+		}
+		if update.Pilot != nil {
+			m.Pilot = *update.Pilot
+		}
+	}
+
+	if update.Status == nil || *update.Status == m.Status {
+		return nil
+	}
+	return m.Transition(*update.Status, at)
+}
+
+// Transition move the mission into status, starting stamps StartedAt and completing or aborting stamps FinishedAt
+func (m *Mission) Transition(status MissionStatus, at time.Time) error {
+	err := status.Validate()
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(missionTransitions[m.Status], status) {
+		return ErrorMissionInvalidTransition
+	}
+
+	switch status {
+	case MissionInProgress:
+		if m.Drone == "" || m.Pilot == "" {
+			return ErrorMissionUnassigned
+		}
+		m.StartedAt = &at
+	case MissionCompleted, MissionAborted:
+		m.FinishedAt = &at
+	}
+	m.Status = status
+	return nil
+}
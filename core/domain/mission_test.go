@@ -0,0 +1,151 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMission(t *testing.T) {
+	// waypoints reached at 1, 11, 25 and 39, landing adds their altitude
+	routes := []DroneRoute{
+		{Route: 1, Plot: Plot{Row: 1, Col: 1}, Altitude: 1},
+		{Route: 2, Plot: Plot{Row: 1, Col: 2}, Altitude: 1},
+		{Route: 3, Plot: Plot{Row: 2, Col: 2}, Altitude: 5},
+		{Route: 4, Plot: Plot{Row: 2, Col: 1}, Altitude: 1},
+	}
+	estateID := uuid.New()
+	createdAt := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+	maxDistance := 29
+
+	tests := []struct {
+		name              string
+		maxDistance       *int
+		expectedWaypoints int
+		expectedDistance  int
+	}{
+		{"whole route", nil, 4, 40},
+		{"limited distance", &maxDistance, 2, 12},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mission := NewMission(estateID, routes, tt.maxDistance, createdAt)
+
+			assert.NotEqual(t, uuid.Nil, mission.ID)
+			assert.Equal(t, estateID, mission.EstateID)
+			assert.Equal(t, MissionPlanned, mission.Status)
+			assert.Equal(t, tt.maxDistance, mission.MaxDistance)
+			assert.Len(t, mission.Waypoints, tt.expectedWaypoints)
+			assert.Equal(t, tt.expectedDistance, mission.Distance)
+			assert.Equal(t, createdAt, mission.CreatedAt)
+		})
+	}
+}
+
+func TestMission_Apply(t *testing.T) {
+	at := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	drone := "DJI Agras T40 #7"
+	pilot := "Budi"
+	droneID := uuid.New()
+	status := func(s MissionStatus) *MissionStatus { return &s }
+
+	tests := []struct {
+		name     string
+		mission  Mission
+		update   MissionUpdate
+		expected Mission
+		err      error
+	}{
+		{
+			name:     "Assign planned mission",
+			mission:  Mission{Status: MissionPlanned},
+			update:   MissionUpdate{Drone: &drone, Pilot: &pilot},
+			expected: Mission{Status: MissionPlanned, Drone: drone, Pilot: pilot},
+		},
+		{
+			name:     "Assign and start at once",
+			mission:  Mission{Status: MissionPlanned},
+			update:   MissionUpdate{Status: status(MissionInProgress), Drone: &drone, Pilot: &pilot},
+			expected: Mission{Status: MissionInProgress, Drone: drone, Pilot: pilot, StartedAt: &at},
+		},
+		{
+			name:     "Relabel fleet drone",
+			mission:  Mission{Status: MissionPlanned, DroneID: &droneID, Drone: "Agras #1"},
+			update:   MissionUpdate{Drone: &drone},
+			expected: Mission{Status: MissionPlanned, DroneID: &droneID, Drone: "Agras #1"},
+			err:      ErrorMissionFleetDrone,
+		},
+		{
+			name:     "Start fleet drone mission with its label",
+			mission:  Mission{Status: MissionPlanned, DroneID: &droneID, Drone: drone},
+			update:   MissionUpdate{Status: status(MissionInProgress), Drone: &drone, Pilot: &pilot},
+			expected: Mission{Status: MissionInProgress, DroneID: &droneID, Drone: drone, Pilot: pilot, StartedAt: &at},
+		},
+		{
+			name:     "Start without pilot",
+			mission:  Mission{Status: MissionPlanned, Drone: drone},
+			update:   MissionUpdate{Status: status(MissionInProgress)},
+			expected: Mission{Status: MissionPlanned, Drone: drone},
+			err:      ErrorMissionUnassigned,
+		},
+		{
+			name:     "Complete started mission",
+			mission:  Mission{Status: MissionInProgress, Drone: drone, Pilot: pilot, StartedAt: &at},
+			update:   MissionUpdate{Status: status(MissionCompleted)},
+			expected: Mission{Status: MissionCompleted, Drone: drone, Pilot: pilot, StartedAt: &at, FinishedAt: &at},
+		},
+		{
+			name:     "Abort planned mission",
+			mission:  Mission{Status: MissionPlanned},
+			update:   MissionUpdate{Status: status(MissionAborted)},
+			expected: Mission{Status: MissionAborted, FinishedAt: &at},
+		},
+		{
+			name:     "Same status is kept",
+			mission:  Mission{Status: MissionPlanned},
+			update:   MissionUpdate{Status: status(MissionPlanned)},
+			expected: Mission{Status: MissionPlanned},
+		},
+		{
+			name:     "Complete planned mission",
+			mission:  Mission{Status: MissionPlanned},
+			update:   MissionUpdate{Status: status(MissionCompleted)},
+			expected: Mission{Status: MissionPlanned},
+			err:      ErrorMissionInvalidTransition,
+		},
+		{
+			name:     "Restart aborted mission",
+			mission:  Mission{Status: MissionAborted, FinishedAt: &at},
+			update:   MissionUpdate{Status: status(MissionPlanned)},
+			expected: Mission{Status: MissionAborted, FinishedAt: &at},
+			err:      ErrorMissionInvalidTransition,
+		},
+		{
+			name:     "Reassign started mission",
+			mission:  Mission{Status: MissionInProgress, Drone: drone, Pilot: pilot},
+			update:   MissionUpdate{Pilot: &drone},
+			expected: Mission{Status: MissionInProgress, Drone: drone, Pilot: pilot},
+			err:      ErrorMissionNotPlanned,
+		},
+		{
+			name:     "Unknown status",
+			mission:  Mission{Status: MissionPlanned},
+			update:   MissionUpdate{Status: status("paused")},
+			expected: Mission{Status: MissionPlanned},
+			err:      ErrorMissionInvalidStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mission := tt.mission
+			err := mission.Apply(tt.update, at)
+
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.expected, mission)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrorUnauthenticated = errors.New("missing or invalid api key")
+var ErrorForbidden = errors.New("api key is not allowed to perform this action")
+var ErrorInvalidRole = errors.New("role must be one of viewer, planter or admin")
+var ErrorAPIKeyNotFound = errors.New("api key not found")
+
+const apiKeyPrefix = "est_"
+
+type Role string
+
+const (
+	RoleViewer  Role = "viewer"
+	RolePlanter Role = "planter"
+	RoleAdmin   Role = "admin"
+)
+
+// roleRank order roles so a higher role is allowed to do everything a lower role can
+var roleRank = map[Role]int{
+	RoleViewer:  1,
+	RolePlanter: 2,
+	RoleAdmin:   3,
+}
+
+func (r Role) IsValid() bool {
+	_, ok := roleRank[r]
+	return ok
+}
+
+// APIKey is stored only as sha256 hash, Prefix is kept to help identifying the key
+type APIKey struct {
+	ID        uuid.UUID
+	TenantID  uuid.UUID
+	Name      string
+	Prefix    string
+	KeyHash   string
+	Role      Role
+	EstateID  *uuid.UUID
+	CreatedAt time.Time
+	RevokedAt *time.Time
+}
+
+// Principal is the authenticated caller, EstateID nil means the role applies to all estates of its tenant
+type Principal struct {
+	KeyID    uuid.UUID
+	TenantID uuid.UUID
+	Role     Role
+	EstateID *uuid.UUID
+}
+
+type principalContextKey struct{}
+
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalContextKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
+
+// Can check role is at least the required role and the scope covers the estate,
+// estateID nil means the action is not bound to one estate so it requires an all estates scope
+func (p *Principal) Can(role Role, estateID *uuid.UUID) bool {
+	if roleRank[p.Role] < roleRank[role] {
+		return false
+	}
+	if p.EstateID == nil {
+		return true
+	}
+	return estateID != nil && *estateID == *p.EstateID
+}
+
+// Authorize check principal in context is allowed to act with role on estate,
+// the principal is returned so the caller can scope its work to the principal tenant
+func Authorize(ctx context.Context, role Role, estateID *uuid.UUID) (*Principal, error) {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return nil, ErrorUnauthenticated
+	}
+	if !principal.Can(role, estateID) {
+		return nil, ErrorForbidden
+	}
+	return principal, nil
+}
+
+// GenerateAPIKey create random api key, the raw key is only shown once to the caller
+func GenerateAPIKey() (string, error) {
+	secret := make([]byte, 32)
+	_, err := rand.Read(secret)
+	if err != nil {
+		return "", err
+	}
+	return apiKeyPrefix + base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// HashAPIKey hash api key with sha256, api keys are long random values so a slow hash is not needed
+func HashAPIKey(rawKey string) string {
+	sum := sha256.Sum256([]byte(rawKey))
+	return hex.EncodeToString(sum[:])
+}
+
+// APIKeyPrefix return first characters of the key that are safe to display
+func APIKeyPrefix(rawKey string) string {
+	if len(rawKey) <= len(apiKeyPrefix)+4 {
+		return rawKey
+	}
+	return rawKey[:len(apiKeyPrefix)+4]
+}
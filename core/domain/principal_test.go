@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrincipal_Can(t *testing.T) {
+	estateID := uuid.New()
+	otherEstateID := uuid.New()
+
+	tests := []struct {
+		name      string
+		principal Principal
+		role      Role
+		estateID  *uuid.UUID
+		expected  bool
+	}{
+		{
+			name:      "Global admin on any estate",
+			principal: Principal{Role: RoleAdmin},
+			role:      RolePlanter,
+			estateID:  &estateID,
+			expected:  true,
+		},
+		{
+			name:      "Global admin on all estates action",
+			principal: Principal{Role: RoleAdmin},
+			role:      RoleAdmin,
+			estateID:  nil,
+			expected:  true,
+		},
+		{
+			name:      "Viewer cannot plant",
+			principal: Principal{Role: RoleViewer},
+			role:      RolePlanter,
+			estateID:  &estateID,
+			expected:  false,
+		},
+		{
+			name:      "Scoped planter on own estate",
+			principal: Principal{Role: RolePlanter, EstateID: &estateID},
+			role:      RoleViewer,
+			estateID:  &estateID,
+			expected:  true,
+		},
+		{
+			name:      "Scoped admin on other estate",
+			principal: Principal{Role: RoleAdmin, EstateID: &estateID},
+			role:      RoleViewer,
+			estateID:  &otherEstateID,
+			expected:  false,
+		},
+		{
+			name:      "Scoped admin on all estates action",
+			principal: Principal{Role: RoleAdmin, EstateID: &estateID},
+			role:      RoleAdmin,
+			estateID:  nil,
+			expected:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.principal.Can(tt.role, tt.estateID))
+		})
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	estateID := uuid.New()
+
+	_, err := Authorize(context.Background(), RoleViewer, &estateID)
+	assert.Equal(t, ErrorUnauthenticated, err)
+
+	principal := &Principal{TenantID: uuid.New(), Role: RoleViewer}
+	ctx := WithPrincipal(context.Background(), principal)
+	got, err := Authorize(ctx, RoleViewer, &estateID)
+	assert.NoError(t, err)
+	assert.Equal(t, principal, got)
+
+	_, err = Authorize(ctx, RoleAdmin, &estateID)
+	assert.Equal(t, ErrorForbidden, err)
+}
+
+func TestGenerateAPIKey(t *testing.T) {
+	key, err := GenerateAPIKey()
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(key, "est_"))
+	assert.Len(t, HashAPIKey(key), 64)
+	assert.Equal(t, key[:8], APIKeyPrefix(key))
+
+	other, err := GenerateAPIKey()
+	assert.NoError(t, err)
+	assert.NotEqual(t, key, other)
+}
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrorEstateNotSandbox = errors.New("estate is not a sandbox")
+var ErrorSandboxStale = errors.New("source estate changed since the sandbox was cloned")
+
+// Sandbox links a draft estate to the estate it was cloned from, SourceEstateID is nil once the source is deleted.
+// SourceVersion and SourceTreesVersion are the versions of the source when it was cloned,
+// the sandbox is stale once the source moves past either
+type Sandbox struct {
+	SourceEstateID     *uuid.UUID
+	SourceVersion      int
+	SourceTreesVersion int
+	ClonedAt           time.Time
+}
+
+// TreeChanges turn the trees of a source estate into the trees of its sandbox, trees are matched by plot.
+// Updated and Removed are source trees at the version they were read, Updated carry the sandbox height
+type TreeChanges struct {
+	Planted []Tree
+	Updated []Tree
+	Removed []Tree
+}
+
+// EstatePlan is what a replanting is compared on
+type EstatePlan struct {
+	Stats         EstateStats
+	DroneDistance int
+}
+
+// SandboxDiff compare a sandbox with its source estate
+type SandboxDiff struct {
+	SourceEstateID uuid.UUID
+	Source         EstatePlan
+	Sandbox        EstatePlan
+	Changes        TreeChanges
+}
+
+// DiffTrees return the changes from source to sandbox trees, each change list follows the order of its input
+func DiffTrees(source []Tree, sandbox []Tree) TreeChanges {
+	sandboxPlots := make(map[Plot]Tree, len(sandbox))
+	for _, tree := range sandbox {
+		sandboxPlots[tree.Plot] = tree
+	}
+	sourcePlots := make(map[Plot]bool, len(source))
+
+	var changes TreeChanges
+	for _, tree := range source {
+		sourcePlots[tree.Plot] = true
+		planned, ok := sandboxPlots[tree.Plot]
+		switch {
+		case !ok:
+			changes.Removed = append(changes.Removed, tree)
+		case planned.Height != tree.Height:
+			tree.Height = planned.Height
+			changes.Updated = append(changes.Updated, tree)
+		}
+	}
+	for _, tree := range sandbox {
+		if !sourcePlots[tree.Plot] {
+			tree.Version = InitialVersion
+			changes.Planted = append(changes.Planted, tree)
+		}
+	}
+	return changes
+}
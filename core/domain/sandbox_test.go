@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffTrees(t *testing.T) {
+	kept := Tree{ID: uuid.New(), Plot: Plot{Row: 1, Col: 1}, Height: 5, Version: 2}
+	grown := Tree{ID: uuid.New(), Plot: Plot{Row: 1, Col: 2}, Height: 5, Version: 3}
+	cut := Tree{ID: uuid.New(), Plot: Plot{Row: 2, Col: 1}, Height: 20, Version: 1}
+	planted := Tree{ID: uuid.New(), Plot: Plot{Row: 2, Col: 2}, Height: 1, Version: 4}
+
+	tests := []struct {
+		name    string
+		source  []Tree
+		sandbox []Tree
+		expect  TreeChanges
+	}{
+		{
+			name:    "No changes",
+			source:  []Tree{kept},
+			sandbox: []Tree{{ID: uuid.New(), Plot: kept.Plot, Height: kept.Height, Version: 1}},
+			expect:  TreeChanges{},
+		},
+		{
+			name:   "Planted, updated and removed",
+			source: []Tree{kept, grown, cut},
+			sandbox: []Tree{
+				{ID: uuid.New(), Plot: kept.Plot, Height: kept.Height},
+				{ID: uuid.New(), Plot: grown.Plot, Height: 9},
+				planted,
+			},
+			expect: TreeChanges{
+				// updates keep the source id and version
+				Updated: []Tree{{ID: grown.ID, Plot: grown.Plot, Height: 9, Version: 3}},
+				Removed: []Tree{cut},
+				Planted: []Tree{{ID: planted.ID, Plot: planted.Plot, Height: 1, Version: InitialVersion}},
+			},
+		},
+		{
+			name:   "Replaced on another plot",
+			source: []Tree{cut},
+			sandbox: []Tree{
+				{ID: cut.ID, Plot: planted.Plot, Height: cut.Height},
+			},
+			expect: TreeChanges{
+				Removed: []Tree{cut},
+				Planted: []Tree{{ID: cut.ID, Plot: planted.Plot, Height: cut.Height, Version: InitialVersion}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expect, DiffTrees(tt.source, tt.sandbox))
+		})
+	}
+}
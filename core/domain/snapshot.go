@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SnapshotVersion is the version of the snapshot format written by exports, imports only accept it
+const SnapshotVersion = 1
+
+var ErrorSnapshotInvalid = errors.New("invalid snapshot")
+var ErrorSnapshotVersionUnsupported = errors.New("unsupported snapshot version")
+var ErrorSnapshotConflict = errors.New("estate or tree of the snapshot already exists")
+
+// EstateSnapshot is an estate with everything needed to recreate it in another environment.
+// Drone routes are part of it for offline use, imports recompute them from the trees
+type EstateSnapshot struct {
+	Version      int
+	ExportedAt   time.Time
+	Estate       Estate
+	Trees        []Tree
+	Measurements []TreeMeasurement
+	DroneRoutes  []DroneRoute
+}
+
+// Validate check the snapshot against the rules applied when the estate was built through the api,
+// the first broken rule is returned wrapping ErrorSnapshotInvalid
+func (s *EstateSnapshot) Validate() error {
+	if s.Version != SnapshotVersion {
+		return fmt.Errorf("%w %d, expected %d", ErrorSnapshotVersionUnsupported, s.Version, SnapshotVersion)
+	}
+
+	estate := s.Estate
+	if estate.ID == uuid.Nil {
+		return invalidSnapshot("estate id is required")
+	}
+	if estate.Width < 1 || estate.Width > MaxEstateSize || estate.Length < 1 || estate.Length > MaxEstateSize {
+		return invalidSnapshot("estate width and length must be between 1 and %d", MaxEstateSize)
+	}
+	if estate.Version < InitialVersion {
+		return invalidSnapshot("estate version must be at least %d", InitialVersion)
+	}
+
+	treeIDs := make(map[uuid.UUID]bool, len(s.Trees))
+	plots := make(map[Plot]bool, len(s.Trees))
+	for i, tree := range s.Trees {
+		switch {
+		case tree.ID == uuid.Nil:
+			return invalidSnapshot("trees[%d] id is required", i)
+		case treeIDs[tree.ID]:
+			return invalidSnapshot("trees[%d] id %s is duplicated", i, tree.ID)
+		case !tree.IsValidTreePlot(&estate):
+			return invalidSnapshot("trees[%d] plot is out of the estate", i)
+		case plots[tree.Plot]:
+			return invalidSnapshot("trees[%d] plot is already planted", i)
+		case tree.Height < MinTreeHeight || tree.Height > MaxTreeHeight:
+			return invalidSnapshot("trees[%d] height must be between %d and %d", i, MinTreeHeight, MaxTreeHeight)
+		case tree.Version < InitialVersion:
+			return invalidSnapshot("trees[%d] version must be at least %d", i, InitialVersion)
+		}
+		treeIDs[tree.ID] = true
+		plots[tree.Plot] = true
+	}
+
+	for i, measurement := range s.Measurements {
+		switch {
+		case !treeIDs[measurement.TreeID]:
+			return invalidSnapshot("measurements[%d] tree is not part of the snapshot", i)
+		case measurement.Height < MinTreeHeight || measurement.Height > MaxTreeHeight:
+			return invalidSnapshot("measurements[%d] height must be between %d and %d", i, MinTreeHeight, MaxTreeHeight)
+		case measurement.MeasuredAt.IsZero():
+			return invalidSnapshot("measurements[%d] measured at is required", i)
+		}
+	}
+
+	// routes are optional, when given they must be the ones the trees produce
+	if len(s.DroneRoutes) > 0 && !sameDroneRoutes(s.DroneRoutes, DroneRoutesOverTrees(estate.Width, estate.Length, s.Trees)) {
+		return invalidSnapshot("drone routes do not match the estate trees")
+	}
+
+	return nil
+}
+
+// WithNewIDs return a copy of the snapshot with new estate and tree ids, measurements follow their tree.
+// Versions start over since the copy is a new estate
+func (s *EstateSnapshot) WithNewIDs() *EstateSnapshot {
+	copied := *s
+	copied.Estate.ID = uuid.New()
+	copied.Estate.Version = InitialVersion
+	copied.Estate.TreesVersion = InitialVersion
+
+	treeIDs := make(map[uuid.UUID]uuid.UUID, len(s.Trees))
+	copied.Trees = make([]Tree, len(s.Trees))
+	for i, tree := range s.Trees {
+		treeIDs[tree.ID] = uuid.New()
+		tree.ID = treeIDs[tree.ID]
+		tree.Version = InitialVersion
+		copied.Trees[i] = tree
+	}
+
+	copied.Measurements = make([]TreeMeasurement, len(s.Measurements))
+	for i, measurement := range s.Measurements {
+		measurement.TreeID = treeIDs[measurement.TreeID]
+		copied.Measurements[i] = measurement
+	}
+	return &copied
+}
+
+func invalidSnapshot(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrorSnapshotInvalid, fmt.Sprintf(format, args...))
+}
+
+func sameDroneRoutes(a []DroneRoute, b []DroneRoute) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
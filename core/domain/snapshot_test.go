@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func validSnapshot() *EstateSnapshot {
+	treeID := uuid.New()
+	trees := []Tree{{ID: treeID, Plot: Plot{Row: 1, Col: 2}, Height: 5, Version: 2}}
+	return &EstateSnapshot{
+		Version: SnapshotVersion,
+		Estate:  Estate{ID: uuid.New(), Width: 2, Length: 2, Version: 1},
+		Trees:   trees,
+		Measurements: []TreeMeasurement{
+			{TreeID: treeID, Height: 3, MeasuredAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+			{TreeID: treeID, Height: 5, MeasuredAt: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		DroneRoutes: DroneRoutesOverTrees(2, 2, trees),
+	}
+}
+
+func TestEstateSnapshot_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		change  func(s *EstateSnapshot)
+		wantErr string
+	}{
+		{"valid", func(s *EstateSnapshot) {}, ""},
+		{"without drone routes", func(s *EstateSnapshot) { s.DroneRoutes = nil }, ""},
+		{"unsupported version", func(s *EstateSnapshot) { s.Version = 2 }, "unsupported snapshot version 2, expected 1"},
+		{"missing estate id", func(s *EstateSnapshot) { s.Estate.ID = uuid.Nil }, "estate id is required"},
+		{"estate too large", func(s *EstateSnapshot) { s.Estate.Width = MaxEstateSize + 1 }, "estate width and length"},
+		{"estate version", func(s *EstateSnapshot) { s.Estate.Version = 0 }, "estate version"},
+		{"missing tree id", func(s *EstateSnapshot) { s.Trees[0].ID = uuid.Nil }, "trees[0] id is required"},
+		{"duplicated tree id", func(s *EstateSnapshot) {
+			s.Trees = append(s.Trees, Tree{ID: s.Trees[0].ID, Plot: Plot{Row: 2, Col: 2}, Height: 1, Version: 1})
+		}, "trees[1] id"},
+		{"tree out of the estate", func(s *EstateSnapshot) { s.Trees[0].Plot = Plot{Row: 3, Col: 1} }, "trees[0] plot is out of the estate"},
+		{"plot planted twice", func(s *EstateSnapshot) {
+			s.Trees = append(s.Trees, Tree{ID: uuid.New(), Plot: s.Trees[0].Plot, Height: 1, Version: 1})
+		}, "trees[1] plot is already planted"},
+		{"tree too tall", func(s *EstateSnapshot) { s.Trees[0].Height = MaxTreeHeight + 1 }, "trees[0] height"},
+		{"tree version", func(s *EstateSnapshot) { s.Trees[0].Version = 0 }, "trees[0] version"},
+		{"measurement of unknown tree", func(s *EstateSnapshot) { s.Measurements[1].TreeID = uuid.New() }, "measurements[1] tree"},
+		{"measurement height", func(s *EstateSnapshot) { s.Measurements[0].Height = 0 }, "measurements[0] height"},
+		{"measurement time", func(s *EstateSnapshot) { s.Measurements[0].MeasuredAt = time.Time{} }, "measurements[0] measured at"},
+		{"drone routes of other trees", func(s *EstateSnapshot) { s.DroneRoutes[0].Altitude = 9 }, "drone routes do not match"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := validSnapshot()
+			tt.change(s)
+
+			err := s.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+			if s.Version == SnapshotVersion {
+				assert.ErrorIs(t, err, ErrorSnapshotInvalid)
+			} else {
+				assert.ErrorIs(t, err, ErrorSnapshotVersionUnsupported)
+			}
+		})
+	}
+}
+
+func TestEstateSnapshot_WithNewIDs(t *testing.T) {
+	s := validSnapshot()
+	copied := s.WithNewIDs()
+
+	assert.NotEqual(t, s.Estate.ID, copied.Estate.ID)
+	assert.Equal(t, InitialVersion, copied.Estate.Version)
+	assert.NotEqual(t, s.Trees[0].ID, copied.Trees[0].ID)
+	assert.Equal(t, InitialVersion, copied.Trees[0].Version)
+	assert.Equal(t, s.Trees[0].Plot, copied.Trees[0].Plot)
+	for _, measurement := range copied.Measurements {
+		assert.Equal(t, copied.Trees[0].ID, measurement.TreeID)
+	}
+	assert.NoError(t, copied.Validate())
+
+	// the original is left untouched
+	assert.Equal(t, s.Trees[0].ID, s.Measurements[0].TreeID)
+	assert.Equal(t, 2, s.Trees[0].Version)
+}
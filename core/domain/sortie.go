@@ -0,0 +1,186 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+var ErrorChargingPadInvalid = errors.New("charging pads are invalid")
+var ErrorNoChargingPads = errors.New("estate has no charging pads")
+var ErrorPlotOutOfPadRange = errors.New("plot is out of the drone range from every charging pad")
+var ErrorSortiePlanTooLarge = errors.New("estate has too many plots to list its sorties")
+
+const (
+	// MaxChargingPads bound the charging pads of one estate
+	MaxChargingPads = 100
+
+	// MaxSortiePlots bound the estates whose sortie plan is listed, every plot is a waypoint of the response
+	MaxSortiePlots = 250_000
+)
+
+// Sortie is one flight on one battery, from takeoff on Pad to landing on LandingPad.
+// Waypoints are the plots of the drone route covered in flight order, Sequence is their route number and
+// Distance is flown from takeoff, ferry to the first one included. FerryDistance is flown to and from the pads
+type Sortie struct {
+	Sequence      int
+	Pad           Plot
+	LandingPad    Plot
+	Waypoints     []DroneWaypoint
+	Distance      int
+	FerryDistance int
+	FlightTime    time.Duration
+}
+
+// SortiePlan cover every plot of the drone route with sorties flown one after the other,
+// the battery is swapped on the landing pad of a sortie and the next one takes off from there
+type SortiePlan struct {
+	Sorties       []Sortie
+	Distance      int
+	FerryDistance int
+	FlightTime    time.Duration
+}
+
+// BatterySwaps is the number of batteries swapped between the sorties
+func (p SortiePlan) BatterySwaps() int {
+	return max(len(p.Sorties)-1, 0)
+}
+
+// ValidateChargingPads check pads are distinct plots of estate, at most MaxChargingPads
+func ValidateChargingPads(estate *Estate, pads []Plot) error {
+	if len(pads) > MaxChargingPads {
+		return fmt.Errorf("%w: at most %d charging pads are accepted", ErrorChargingPadInvalid, MaxChargingPads)
+	}
+
+	seen := make(map[Plot]bool, len(pads))
+	for _, pad := range pads {
+		if !estate.Contains(pad) {
+			return fmt.Errorf("%w: plot (%d, %d) is outside of the estate", ErrorChargingPadInvalid, pad.Col, pad.Row)
+		}
+		if seen[pad] {
+			return fmt.Errorf("%w: plot (%d, %d) is given twice", ErrorChargingPadInvalid, pad.Col, pad.Row)
+		}
+		seen[pad] = true
+	}
+	return nil
+}
+
+// leg is a flight between two plots split into horizontal and vertical meters
+type leg struct {
+	horizontal int
+	vertical   int
+}
+
+func (l leg) distance() int {
+	return l.horizontal + l.vertical
+}
+
+// ferryLeg is the flight from plot at altitude to another plot at another altitude outside of the drone route.
+// The drone flies straight at ferryAltitude, the highest of the route, so it clears every tree on the way
+func ferryLeg(from Plot, fromAltitude int, to Plot, toAltitude int, ferryAltitude int) leg {
+	if from == to {
+		return leg{vertical: max(fromAltitude-toAltitude, toAltitude-fromAltitude)}
+	}
+	horizontal := math.Hypot(float64(from.Col-to.Col), float64(from.Row-to.Row)) * DistanceBetweenPlot
+	return leg{
+		horizontal: int(math.Ceil(horizontal)),
+		vertical:   ferryAltitude - fromAltitude + ferryAltitude - toAltitude,
+	}
+}
+
+// NewSortiePlan split droneRoutes into the sorties drone flies within its range between pads.
+// Sorties are planned greedily: each one covers as many plots as it can and lands on the pad in range with the shortest
+// ferry to the next plot, the first one takes off from the pad nearest to the first plot. ErrorPlotOutOfPadRange is returned when a plot
+// can not be reached and left within the range from the pad the drone is on
+func NewSortiePlan(drone *Drone, pads []Plot, droneRoutes []DroneRoute) (SortiePlan, error) {
+	var plan SortiePlan
+	if len(pads) == 0 {
+		return plan, ErrorNoChargingPads
+	}
+	if len(droneRoutes) == 0 {
+		return plan, nil
+	}
+
+	ferryAltitude := 0
+	for _, route := range droneRoutes {
+		ferryAltitude = max(ferryAltitude, route.Altitude)
+	}
+
+	// ferry legs are the same both ways, so the nearest pad to land on from a plot is also the nearest to take off to it.
+	// A sortie can end on a plot when it still reaches the nearest pad from there
+	landingPads := make([]Plot, len(droneRoutes))
+	landingLegs := make([]leg, len(droneRoutes))
+	for i, route := range droneRoutes {
+		for j, pad := range pads {
+			ferry := ferryLeg(route.Plot, route.Altitude, pad, 0, ferryAltitude)
+			if j == 0 || ferry.distance() < landingLegs[i].distance() {
+				landingPads[i], landingLegs[i] = pad, ferry
+			}
+		}
+	}
+
+	pad := landingPads[0]
+	for start := 0; start < len(droneRoutes); {
+		takeoff := ferryLeg(pad, 0, droneRoutes[start].Plot, droneRoutes[start].Altitude, ferryAltitude)
+
+		flown, last, lastFlown := takeoff, -1, leg{}
+		for i := start; i < len(droneRoutes); i++ {
+			if i > start {
+				diff := droneRoutes[i].Altitude - droneRoutes[i-1].Altitude
+				flown.horizontal += DistanceBetweenPlot
+				flown.vertical += max(diff, -diff)
+			}
+			if flown.distance() > drone.MaxRange {
+				break
+			}
+			if flown.distance()+landingLegs[i].distance() <= drone.MaxRange {
+				last, lastFlown = i, flown
+			}
+		}
+
+		if last < 0 {
+			plot := droneRoutes[start].Plot
+			return SortiePlan{}, fmt.Errorf("%w: plot (%d, %d)", ErrorPlotOutOfPadRange, plot.Col, plot.Row)
+		}
+
+		waypoints := DroneWaypoints(droneRoutes[start : last+1])
+		for i := range waypoints {
+			waypoints[i].Sequence = droneRoutes[start+i].Route
+			// waypoints distance starts with the takeoff to the first altitude, the ferry leg replaces it
+			waypoints[i].Distance += takeoff.distance() - droneRoutes[start].Altitude
+		}
+
+		landingPad, landing := landingPads[last], landingLegs[last]
+		if last+1 < len(droneRoutes) {
+			// among the pads in range, land where the ferry to the next plot after the battery swap is the shortest
+			next := droneRoutes[last+1]
+			ferry := landing.distance() + ferryLeg(landingPad, 0, next.Plot, next.Altitude, ferryAltitude).distance()
+			for _, candidate := range pads {
+				candidateLanding := ferryLeg(droneRoutes[last].Plot, droneRoutes[last].Altitude, candidate, 0, ferryAltitude)
+				candidateFerry := candidateLanding.distance() + ferryLeg(candidate, 0, next.Plot, next.Altitude, ferryAltitude).distance()
+				if lastFlown.distance()+candidateLanding.distance() <= drone.MaxRange && candidateFerry < ferry {
+					landingPad, landing, ferry = candidate, candidateLanding, candidateFerry
+				}
+			}
+		}
+
+		sortie := Sortie{
+			Sequence:      len(plan.Sorties) + 1,
+			Pad:           pad,
+			LandingPad:    landingPad,
+			Waypoints:     waypoints,
+			Distance:      lastFlown.distance() + landing.distance(),
+			FerryDistance: takeoff.distance() + landing.distance(),
+			FlightTime:    drone.flightTime(lastFlown.horizontal+landing.horizontal, lastFlown.vertical+landing.vertical),
+		}
+		plan.Sorties = append(plan.Sorties, sortie)
+		plan.Distance += sortie.Distance
+		plan.FerryDistance += sortie.FerryDistance
+		plan.FlightTime += sortie.FlightTime
+
+		pad = sortie.LandingPad
+		start = last + 1
+	}
+	return plan, nil
+}
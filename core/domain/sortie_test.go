@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateChargingPads(t *testing.T) {
+	estate := &Estate{Width: 2, Length: 3}
+
+	tests := []struct {
+		name    string
+		pads    []Plot
+		wantErr bool
+	}{
+		{"valid", []Plot{{Row: 1, Col: 1}, {Row: 2, Col: 3}}, false},
+		{"none", nil, false},
+		{"outside", []Plot{{Row: 3, Col: 1}}, true},
+		{"twice", []Plot{{Row: 1, Col: 1}, {Row: 1, Col: 1}}, true},
+		{"too many", make([]Plot, MaxChargingPads+1), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateChargingPads(estate, tt.pads)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrorChargingPadInvalid)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_ferryLeg(t *testing.T) {
+	assert.Equal(t, leg{vertical: 4}, ferryLeg(Plot{Row: 1, Col: 1}, 0, Plot{Row: 1, Col: 1}, 4, 6))
+	// straight over the estate at the ferry altitude, 22.36 meters rounded up
+	assert.Equal(t, leg{horizontal: 23, vertical: 10}, ferryLeg(Plot{Row: 1, Col: 1}, 0, Plot{Row: 2, Col: 3}, 2, 6))
+}
+
+func TestNewSortiePlan(t *testing.T) {
+	drone := &Drone{MaxRange: 25, Speed: DroneSpeed{Cruise: 10, Climb: 1}}
+	routes := DroneZigzagTraverse(1, 5)
+
+	t.Run("One sortie", func(t *testing.T) {
+		long := &Drone{MaxRange: 1000, Speed: drone.Speed}
+		plan, err := NewSortiePlan(long, []Plot{{Row: 1, Col: 1}}, routes)
+		assert.NoError(t, err)
+		if assert.Len(t, plan.Sorties, 1) {
+			// the drone plan distance, landing included, and 40 meters back to the pad
+			assert.Equal(t, DroneTotalDistance(nil, routes)+40, plan.Distance)
+			assert.Equal(t, 42, plan.FerryDistance)
+			assert.Len(t, plan.Sorties[0].Waypoints, 5)
+		}
+		assert.Equal(t, 0, plan.BatterySwaps())
+	})
+
+	t.Run("Battery swaps", func(t *testing.T) {
+		pads := []Plot{{Row: 1, Col: 1}, {Row: 1, Col: 3}, {Row: 1, Col: 5}}
+		plan, err := NewSortiePlan(drone, pads, routes)
+		assert.NoError(t, err)
+		assert.Equal(t, []Sortie{
+			{
+				Sequence:   1,
+				Pad:        Plot{Row: 1, Col: 1},
+				LandingPad: Plot{Row: 1, Col: 3},
+				Waypoints: []DroneWaypoint{
+					{Sequence: 1, Plot: Plot{Row: 1, Col: 1}, Altitude: 1, Distance: 1},
+					{Sequence: 2, Plot: Plot{Row: 1, Col: 2}, Altitude: 1, Distance: 11},
+					{Sequence: 3, Plot: Plot{Row: 1, Col: 3}, Altitude: 1, Distance: 21},
+				},
+				Distance:      22,
+				FerryDistance: 2,
+				FlightTime:    4 * time.Second,
+			},
+			{
+				Sequence:   2,
+				Pad:        Plot{Row: 1, Col: 3},
+				LandingPad: Plot{Row: 1, Col: 5},
+				Waypoints: []DroneWaypoint{
+					{Sequence: 4, Plot: Plot{Row: 1, Col: 4}, Altitude: 1, Distance: 11},
+					{Sequence: 5, Plot: Plot{Row: 1, Col: 5}, Altitude: 1, Distance: 21},
+				},
+				Distance:      22,
+				FerryDistance: 12,
+				FlightTime:    4 * time.Second,
+			},
+		}, plan.Sorties)
+		assert.Equal(t, 44, plan.Distance)
+		assert.Equal(t, 14, plan.FerryDistance)
+		assert.Equal(t, 8*time.Second, plan.FlightTime)
+		assert.Equal(t, 1, plan.BatterySwaps())
+	})
+
+	t.Run("Plot out of range", func(t *testing.T) {
+		_, err := NewSortiePlan(drone, []Plot{{Row: 1, Col: 1}}, routes)
+		assert.ErrorIs(t, err, ErrorPlotOutOfPadRange)
+		assert.ErrorContains(t, err, "plot (3, 1)")
+	})
+
+	t.Run("No pads", func(t *testing.T) {
+		_, err := NewSortiePlan(drone, nil, routes)
+		assert.Equal(t, ErrorNoChargingPads, err)
+	})
+}
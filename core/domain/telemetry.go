@@ -0,0 +1,183 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+)
+
+var ErrorTelemetryInvalid = errors.New("telemetry is invalid")
+var ErrorTelemetryNotFound = errors.New("telemetry not found")
+var ErrorMissionNotFlown = errors.New("mission has not started, telemetry is only recorded once it is in progress")
+
+const (
+	// MaxTelemetrySamples bound the samples recorded for one mission
+	MaxTelemetrySamples = 200_000
+
+	// ClearanceTolerance is how far in meters the altitude over a tree can be from the planned one before it is reported
+	ClearanceTolerance = 1.0
+
+	// MaxReportedPlots bound the missed plots and clearance deviations listed in a telemetry report
+	MaxReportedPlots = 100
+)
+
+// TelemetrySample is a drone position recorded during a mission flight. X and Y are meters from the center
+// of plot (1, 1) along the estate length and width, so plot centers are DistanceBetweenPlot apart
+type TelemetrySample struct {
+	RecordedAt time.Time
+	X          float64
+	Y          float64
+	Altitude   float64
+}
+
+// ClearanceDeviation is a tree plot the drone flew over at Altitude, the lowest of its samples,
+// further than ClearanceTolerance from the PlannedAltitude
+type ClearanceDeviation struct {
+	Plot            Plot
+	TreeHeight      int
+	PlannedAltitude int
+	Altitude        float64
+}
+
+// Clearance is how high above the tree the drone flew, negative when it flew through it
+func (d ClearanceDeviation) Clearance() float64 {
+	return d.Altitude - float64(d.TreeHeight)
+}
+
+// TelemetryReport compare the flight recorded by telemetry with the planned mission.
+// Plots are visited when at least one sample is over them, MissedPlots and Deviations are cut at MaxReportedPlots
+type TelemetryReport struct {
+	Samples         int
+	OutsideSamples  int
+	PlannedPlots    int
+	VisitedPlots    int
+	MissedPlots     []Plot
+	Deviations      []ClearanceDeviation
+	DeviationCount  int
+	PlannedDistance int
+	ActualDistance  int
+}
+
+// Coverage is the share of planned plots visited, between 0 and 1
+func (r TelemetryReport) Coverage() float64 {
+	if r.PlannedPlots == 0 {
+		return 0
+	}
+	return float64(r.VisitedPlots) / float64(r.PlannedPlots)
+}
+
+// ValidateTelemetry check samples are timed with a finite position and a non negative altitude
+func ValidateTelemetry(samples []TelemetrySample) error {
+	if len(samples) == 0 || len(samples) > MaxTelemetrySamples {
+		return fmt.Errorf("%w: between 1 and %d samples are accepted", ErrorTelemetryInvalid, MaxTelemetrySamples)
+	}
+
+	for i, sample := range samples {
+		if sample.RecordedAt.IsZero() {
+			return fmt.Errorf("%w: sample %d has no time", ErrorTelemetryInvalid, i+1)
+		}
+		if !isFinite(sample.X) || !isFinite(sample.Y) || !isFinite(sample.Altitude) || sample.Altitude < 0 {
+			return fmt.Errorf("%w: sample %d has an invalid position or altitude", ErrorTelemetryInvalid, i+1)
+		}
+	}
+	return nil
+}
+
+// SortTelemetry order samples by time, samples recorded at the same time keep their order
+func SortTelemetry(samples []TelemetrySample) {
+	slices.SortStableFunc(samples, func(a, b TelemetrySample) int {
+		return a.RecordedAt.Compare(b.RecordedAt)
+	})
+}
+
+// PlotAt is the plot under position x, y in meters, false when the position is outside of the estate
+func (e *Estate) PlotAt(x float64, y float64) (Plot, bool) {
+	col := math.Round(x/DistanceBetweenPlot) + 1
+	row := math.Round(y/DistanceBetweenPlot) + 1
+	if col < 1 || row < 1 || col > float64(e.Length) || row > float64(e.Width) {
+		return Plot{}, false
+	}
+	return Plot{Row: int(row), Col: int(col)}, true
+}
+
+// NewTelemetryReport compare samples in flight order with the waypoints of mission over estate.
+// Consecutive samples over the same plot are one route at their highest altitude, so the actual distance
+// is measured with DroneTotalDistance like the planned one
+func NewTelemetryReport(estate Estate, mission Mission, samples []TelemetrySample) TelemetryReport {
+	report := TelemetryReport{
+		Samples:      len(samples),
+		PlannedPlots: len(mission.Waypoints),
+	}
+
+	lowest := map[Plot]float64{}
+	var flown []DroneRoute
+	for _, sample := range samples {
+		plot, ok := estate.PlotAt(sample.X, sample.Y)
+		if !ok {
+			report.OutsideSamples++
+			continue
+		}
+
+		if altitude, seen := lowest[plot]; !seen || sample.Altitude < altitude {
+			lowest[plot] = sample.Altitude
+		}
+
+		altitude := int(math.Round(sample.Altitude))
+		if last := len(flown) - 1; last >= 0 && flown[last].Plot == plot {
+			flown[last].Altitude = max(flown[last].Altitude, altitude)
+			continue
+		}
+		flown = append(flown, DroneRoute{Route: len(flown) + 1, Plot: plot, Altitude: altitude})
+	}
+
+	planned := make([]DroneRoute, 0, len(mission.Waypoints))
+	for _, waypoint := range mission.Waypoints {
+		planned = append(planned, DroneRoute{Route: waypoint.Sequence, Plot: waypoint.Plot, Altitude: waypoint.Altitude})
+
+		altitude, visited := lowest[waypoint.Plot]
+		if !visited {
+			if len(report.MissedPlots) < MaxReportedPlots {
+				report.MissedPlots = append(report.MissedPlots, waypoint.Plot)
+			}
+			continue
+		}
+		report.VisitedPlots++
+
+		// the drone only climbs above the ground altitude over trees
+		if waypoint.Altitude > GroundAltitude && math.Abs(altitude-float64(waypoint.Altitude)) > ClearanceTolerance {
+			report.Deviations = append(report.Deviations, ClearanceDeviation{
+				Plot:            waypoint.Plot,
+				TreeHeight:      waypoint.Altitude - TreeClearance,
+				PlannedAltitude: waypoint.Altitude,
+				Altitude:        altitude,
+			})
+		}
+	}
+
+	// the lowest clearances are the riskiest, they are listed first
+	slices.SortStableFunc(report.Deviations, func(a, b ClearanceDeviation) int {
+		return cmpFloat(a.Clearance(), b.Clearance())
+	})
+	report.DeviationCount = len(report.Deviations)
+	report.Deviations = report.Deviations[:min(len(report.Deviations), MaxReportedPlots)]
+
+	report.PlannedDistance = DroneTotalDistance(nil, planned)
+	report.ActualDistance = DroneTotalDistance(nil, flown)
+	return report
+}
+
+func isFinite(value float64) bool {
+	return !math.IsNaN(value) && !math.IsInf(value, 0)
+}
+
+func cmpFloat(a float64, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
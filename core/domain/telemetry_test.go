@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTelemetry(t *testing.T) {
+	at := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		samples []TelemetrySample
+		wantErr bool
+	}{
+		{"valid", []TelemetrySample{{RecordedAt: at, X: -3, Y: 12.5, Altitude: 0}}, false},
+		{"empty", nil, true},
+		{"no time", []TelemetrySample{{X: 1, Y: 1, Altitude: 1}}, true},
+		{"not a number", []TelemetrySample{{RecordedAt: at, X: math.NaN(), Y: 1, Altitude: 1}}, true},
+		{"infinite", []TelemetrySample{{RecordedAt: at, X: 1, Y: math.Inf(1), Altitude: 1}}, true},
+		{"below ground", []TelemetrySample{{RecordedAt: at, X: 1, Y: 1, Altitude: -0.5}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTelemetry(tt.samples)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrorTelemetryInvalid)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSortTelemetry(t *testing.T) {
+	at := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	samples := []TelemetrySample{
+		{RecordedAt: at.Add(time.Second), X: 10},
+		{RecordedAt: at, X: 0},
+		{RecordedAt: at.Add(time.Second), X: 20},
+	}
+
+	SortTelemetry(samples)
+	assert.Equal(t, []float64{0, 10, 20}, []float64{samples[0].X, samples[1].X, samples[2].X})
+}
+
+func TestEstate_PlotAt(t *testing.T) {
+	estate := Estate{Length: 3, Width: 2}
+
+	tests := []struct {
+		name     string
+		x, y     float64
+		expected Plot
+		inside   bool
+	}{
+		{"center of first plot", 0, 0, Plot{Row: 1, Col: 1}, true},
+		{"closer to the next column", 5.2, 4.9, Plot{Row: 1, Col: 2}, true},
+		{"last plot", 20, 10, Plot{Row: 2, Col: 3}, true},
+		{"before the first plot", -5.1, 0, Plot{}, false},
+		{"past the width", 0, 15.1, Plot{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plot, inside := estate.PlotAt(tt.x, tt.y)
+			assert.Equal(t, tt.expected, plot)
+			assert.Equal(t, tt.inside, inside)
+		})
+	}
+}
+
+func TestNewTelemetryReport(t *testing.T) {
+	at := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	estate := Estate{Length: 3, Width: 1}
+	// a tree of 5 meters on the second plot, planned distance is 20 horizontal and 1+5+5+1 vertical
+	mission := Mission{
+		Waypoints: []DroneWaypoint{
+			{Sequence: 1, Plot: Plot{Row: 1, Col: 1}, Altitude: 1, Distance: 1},
+			{Sequence: 2, Plot: Plot{Row: 1, Col: 2}, Altitude: 6, Distance: 16},
+			{Sequence: 3, Plot: Plot{Row: 1, Col: 3}, Altitude: 1, Distance: 31},
+		},
+	}
+
+	t.Run("partial flight too low over the tree", func(t *testing.T) {
+		samples := []TelemetrySample{
+			{RecordedAt: at, X: 0, Y: 0, Altitude: 1},
+			{RecordedAt: at.Add(time.Second), X: 9, Y: 0.5, Altitude: 3},
+			{RecordedAt: at.Add(2 * time.Second), X: 11, Y: 0, Altitude: 6.4},
+			{RecordedAt: at.Add(3 * time.Second), X: 100, Y: 0, Altitude: 5},
+		}
+
+		report := NewTelemetryReport(estate, mission, samples)
+
+		assert.Equal(t, TelemetryReport{
+			Samples:        4,
+			OutsideSamples: 1,
+			PlannedPlots:   3,
+			VisitedPlots:   2,
+			MissedPlots:    []Plot{{Row: 1, Col: 3}},
+			Deviations: []ClearanceDeviation{
+				{Plot: Plot{Row: 1, Col: 2}, TreeHeight: 5, PlannedAltitude: 6, Altitude: 3},
+			},
+			DeviationCount:  1,
+			PlannedDistance: 32,
+			// 10 horizontal, 1 takeoff, 5 up to the highest sample over the tree and 6 landing
+			ActualDistance: 22,
+		}, report)
+		assert.InDelta(t, 2.0/3, report.Coverage(), 1e-9)
+		assert.Equal(t, -2.0, report.Deviations[0].Clearance())
+	})
+
+	t.Run("whole flight within tolerance", func(t *testing.T) {
+		samples := []TelemetrySample{
+			{RecordedAt: at, X: 0, Y: 0, Altitude: 1.2},
+			{RecordedAt: at.Add(time.Second), X: 10, Y: 0, Altitude: 5.5},
+			{RecordedAt: at.Add(2 * time.Second), X: 20, Y: 0, Altitude: 0.8},
+		}
+
+		report := NewTelemetryReport(estate, mission, samples)
+
+		assert.Equal(t, 3, report.VisitedPlots)
+		assert.Empty(t, report.MissedPlots)
+		assert.Empty(t, report.Deviations)
+		assert.Equal(t, 1.0, report.Coverage())
+		assert.Equal(t, report.PlannedDistance, report.ActualDistance)
+	})
+}
@@ -2,19 +2,43 @@ package domain
 
 import (
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 var ErrorTreeAlreadyExists = errors.New("tree already exists")
 var ErrorTreePlotOutOfBound = errors.New("tree plot out of bound")
+var ErrorTreeNotFound = errors.New("tree not found")
 
 type Tree struct {
-	ID     uuid.UUID
-	Plot   Plot
-	Height int
+	ID      uuid.UUID
+	Plot    Plot
+	Height  int
+	Version int
+}
+
+// MinTreeHeight and MaxTreeHeight bound the height of a tree
+const (
+	MinTreeHeight = 1
+	MaxTreeHeight = 30
+)
+
+// TreeClearance is how high above a tree the drone flies
+const TreeClearance = 1
+
+// TreeMeasurement is a tree height reading, recorded when the tree is planted and on every height update
+type TreeMeasurement struct {
+	TreeID     uuid.UUID
+	Height     int
+	MeasuredAt time.Time
 }
 
 func (t *Tree) IsValidTreePlot(estate *Estate) bool {
 	return t.Plot.Col >= 1 && t.Plot.Row >= 1 && t.Plot.Col <= estate.Length && t.Plot.Row <= estate.Width
 }
+
+// DroneAltitude is the drone route altitude over the tree plot, TreeClearance above the tree
+func (t *Tree) DroneAltitude() int {
+	return t.Height + TreeClearance
+}
@@ -0,0 +1,149 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrorWebhookNotFound = errors.New("webhook not found")
+var ErrorWebhookInvalidURL = errors.New("webhook url must be an absolute http or https url")
+var ErrorWebhookPrivateHost = errors.New("webhook url must not point to a loopback, private or link-local host")
+var ErrorWebhookInvalidEventType = errors.New("webhook event type is not supported")
+var ErrorWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+
+const (
+	// MaxWebhookAttempts is the number of delivery attempts before a delivery is moved into dead letters
+	MaxWebhookAttempts = 8
+
+	webhookBaseBackoff = 30 * time.Second
+	webhookMaxBackoff  = time.Hour
+)
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryDead      WebhookDeliveryStatus = "dead"
+)
+
+var WebhookEventTypes = []EventType{
+	EventEstateCreated,
+	EventEstateResized,
+	EventEstateDeleted,
+	EventTreePlanted,
+	EventTreeUpdated,
+	EventTreeRemoved,
+}
+
+// WebhookSubscription subscribe a url to events of one estate, or every estate of its tenant when EstateID is nil.
+// Empty EventTypes means every event type
+type WebhookSubscription struct {
+	ID         uuid.UUID
+	TenantID   uuid.UUID
+	EstateID   *uuid.UUID
+	URL        string
+	Secret     string
+	EventTypes []EventType
+	CreatedAt  time.Time
+}
+
+type WebhookDelivery struct {
+	ID            uuid.UUID
+	Subscription  WebhookSubscription
+	Event         Event
+	Status        WebhookDeliveryStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+}
+
+func (w *WebhookSubscription) Validate() error {
+	u, err := url.Parse(w.URL)
+	if err != nil || !u.IsAbs() || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrorWebhookInvalidURL
+	}
+
+	// host names resolving to such addresses are only known when the delivery is sent, the sender rejects them there
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return ErrorWebhookPrivateHost
+	}
+	if ip := net.ParseIP(host); ip != nil && !IsPublicIP(ip) {
+		return ErrorWebhookPrivateHost
+	}
+
+	for _, eventType := range w.EventTypes {
+		if !slices.Contains(WebhookEventTypes, eventType) {
+			return ErrorWebhookInvalidEventType
+		}
+	}
+	return nil
+}
+
+// IsPublicIP report whether webhooks may be sent to ip, loopback, private, link-local and unspecified addresses
+// are rejected so a tenant can not make the dispatcher reach the internal network or cloud metadata endpoints
+func IsPublicIP(ip net.IP) bool {
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsUnspecified())
+}
+
+func (w *WebhookSubscription) Matches(event Event) bool {
+	if w.TenantID != event.TenantID {
+		return false
+	}
+	if w.EstateID != nil && *w.EstateID != event.EstateID {
+		return false
+	}
+	return len(w.EventTypes) == 0 || slices.Contains(w.EventTypes, event.Type)
+}
+
+// RecordFailure increase attempts and schedule the next attempt with exponential backoff,
+// delivery is marked dead once it reach MaxWebhookAttempts
+func (d *WebhookDelivery) RecordFailure(err error, now time.Time) {
+	d.Attempts++
+	d.LastError = err.Error()
+
+	if d.Attempts >= MaxWebhookAttempts {
+		d.Status = WebhookDeliveryDead
+		return
+	}
+	d.Status = WebhookDeliveryPending
+	d.NextAttemptAt = now.Add(WebhookRetryBackoff(d.Attempts))
+}
+
+func (d *WebhookDelivery) RecordSuccess() {
+	d.Attempts++
+	d.LastError = ""
+	d.Status = WebhookDeliveryDelivered
+}
+
+// WebhookRetryBackoff return delay before the next attempt, doubling from 30s and capped at 1 hour
+func WebhookRetryBackoff(attempts int) time.Duration {
+	backoff := webhookBaseBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= webhookMaxBackoff {
+			return webhookMaxBackoff
+		}
+	}
+	return backoff
+}
+
+// SignWebhookPayload sign payload with HMAC-SHA256 of "timestamp.payload" so receivers can verify origin and reject replays
+func SignWebhookPayload(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp)
+	mac.Write(payload)
+	return fmt.Sprintf("t=%d,v1=%s", timestamp, hex.EncodeToString(mac.Sum(nil)))
+}
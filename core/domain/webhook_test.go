@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookSubscription_Validate(t *testing.T) {
+	tests := []struct {
+		name         string
+		subscription WebhookSubscription
+		expected     error
+	}{
+		{
+			name:         "Valid https url",
+			subscription: WebhookSubscription{URL: "https://erp.example.com/hooks", EventTypes: []EventType{EventTreePlanted}},
+			expected:     nil,
+		},
+		{
+			name:         "Relative url",
+			subscription: WebhookSubscription{URL: "/hooks"},
+			expected:     ErrorWebhookInvalidURL,
+		},
+		{
+			name:         "Unsupported scheme",
+			subscription: WebhookSubscription{URL: "ftp://erp.example.com/hooks"},
+			expected:     ErrorWebhookInvalidURL,
+		},
+		{
+			name:         "Loopback host",
+			subscription: WebhookSubscription{URL: "http://localhost:8080/hooks"},
+			expected:     ErrorWebhookPrivateHost,
+		},
+		{
+			name:         "Link-local address",
+			subscription: WebhookSubscription{URL: "http://169.254.169.254/latest/meta-data"},
+			expected:     ErrorWebhookPrivateHost,
+		},
+		{
+			name:         "Private address",
+			subscription: WebhookSubscription{URL: "https://10.0.0.8/hooks"},
+			expected:     ErrorWebhookPrivateHost,
+		},
+		{
+			name:         "Loopback ipv6 address",
+			subscription: WebhookSubscription{URL: "http://[::1]/hooks"},
+			expected:     ErrorWebhookPrivateHost,
+		},
+		{
+			name:         "Public address",
+			subscription: WebhookSubscription{URL: "https://203.0.113.10/hooks"},
+			expected:     nil,
+		},
+		{
+			name:         "Unsupported event type",
+			subscription: WebhookSubscription{URL: "https://erp.example.com/hooks", EventTypes: []EventType{"tree.cut"}},
+			expected:     ErrorWebhookInvalidEventType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.subscription.Validate())
+		})
+	}
+}
+
+func TestWebhookSubscription_Matches(t *testing.T) {
+	tenantID := uuid.New()
+	estateID := uuid.New()
+	otherEstateID := uuid.New()
+	event := NewEvent(EventTreePlanted, tenantID, estateID, TreeEventData{})
+
+	tests := []struct {
+		name         string
+		subscription WebhookSubscription
+		expected     bool
+	}{
+		{
+			name:         "Global subscription for all events",
+			subscription: WebhookSubscription{TenantID: tenantID},
+			expected:     true,
+		},
+		{
+			name:         "Global subscription of other tenant",
+			subscription: WebhookSubscription{TenantID: uuid.New()},
+			expected:     false,
+		},
+		{
+			name:         "Same estate with event type filter",
+			subscription: WebhookSubscription{TenantID: tenantID, EstateID: &estateID, EventTypes: []EventType{EventTreePlanted, EventTreeRemoved}},
+			expected:     true,
+		},
+		{
+			name:         "Other estate",
+			subscription: WebhookSubscription{TenantID: tenantID, EstateID: &otherEstateID},
+			expected:     false,
+		},
+		{
+			name:         "Event type filtered out",
+			subscription: WebhookSubscription{TenantID: tenantID, EventTypes: []EventType{EventTreeRemoved}},
+			expected:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.subscription.Matches(event))
+		})
+	}
+}
+
+func TestWebhookDelivery_RecordFailure(t *testing.T) {
+	now := time.Now()
+
+	delivery := WebhookDelivery{Status: WebhookDeliveryPending}
+	delivery.RecordFailure(errors.New("timeout"), now)
+	assert.Equal(t, WebhookDeliveryPending, delivery.Status)
+	assert.Equal(t, 1, delivery.Attempts)
+	assert.Equal(t, "timeout", delivery.LastError)
+	assert.Equal(t, now.Add(30*time.Second), delivery.NextAttemptAt)
+
+	delivery.Attempts = MaxWebhookAttempts - 1
+	delivery.RecordFailure(errors.New("timeout"), now)
+	assert.Equal(t, WebhookDeliveryDead, delivery.Status)
+
+	delivery.RecordSuccess()
+	assert.Equal(t, WebhookDeliveryDelivered, delivery.Status)
+	assert.Empty(t, delivery.LastError)
+}
+
+func TestWebhookRetryBackoff(t *testing.T) {
+	assert.Equal(t, 30*time.Second, WebhookRetryBackoff(1))
+	assert.Equal(t, 60*time.Second, WebhookRetryBackoff(2))
+	assert.Equal(t, 4*time.Minute, WebhookRetryBackoff(4))
+	assert.Equal(t, time.Hour, WebhookRetryBackoff(20))
+}
+
+func TestSignWebhookPayload(t *testing.T) {
+	signature := SignWebhookPayload("secret", 1700000000, []byte(`{"id":"1"}`))
+	assert.Equal(t, "t=1700000000,v1=086f6aff7bd084c98679825129c5a64dbad88c760016d6d2c0fb123f27951d54", signature)
+}
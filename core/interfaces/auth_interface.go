@@ -0,0 +1,25 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+)
+
+type AuthUsecase interface {
+	Authenticate(ctx context.Context, rawKey string) (*domain.Principal, error)
+	CreateAPIKey(ctx context.Context, name string, role domain.Role, estateID *uuid.UUID) (*domain.APIKey, string, error)
+	ListAPIKeys(ctx context.Context) ([]domain.APIKey, error)
+	RevokeAPIKey(ctx context.Context, keyID uuid.UUID) error
+	EnsureAPIKey(ctx context.Context, tenantID uuid.UUID, name string, rawKey string, role domain.Role) error
+}
+
+type APIKeyRepository interface {
+	CreateAPIKey(ctx context.Context, key *domain.APIKey) error
+	GetAPIKey(ctx context.Context, tenantID uuid.UUID, keyID uuid.UUID) (*domain.APIKey, error)
+	GetAPIKeyByHash(ctx context.Context, keyHash string) (*domain.APIKey, error)
+	GetAPIKeys(ctx context.Context, tenantID uuid.UUID) ([]domain.APIKey, error)
+	RevokeAPIKey(ctx context.Context, tenantID uuid.UUID, keyID uuid.UUID, revokedAt time.Time) (bool, error)
+}
@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+)
+
+type DroneUsecase interface {
+	CreateDrone(ctx context.Context, drone *domain.Drone) (*domain.Drone, error)
+	ListDrones(ctx context.Context, status *domain.DroneStatus, limit int, offset int) ([]domain.Drone, error)
+	GetDrone(ctx context.Context, droneID uuid.UUID) (*domain.Drone, error)
+	UpdateDrone(ctx context.Context, droneID uuid.UUID, update domain.DroneUpdate) (*domain.Drone, error)
+}
+
+// DroneRepository scope every drone of the fleet to tenantID, drones of other tenants are treated as not found
+type DroneRepository interface {
+	CreateDrone(ctx context.Context, drone *domain.Drone) error
+	ListDrones(ctx context.Context, tenantID uuid.UUID, status *domain.DroneStatus, limit int, offset int) ([]domain.Drone, error)
+	GetDrone(ctx context.Context, tenantID uuid.UUID, droneID uuid.UUID) (*domain.Drone, error)
+	UpdateDrone(ctx context.Context, drone *domain.Drone) error
+}
@@ -0,0 +1,183 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: core/interfaces/drone_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=core/interfaces/drone_interface.go -destination=core/interfaces/drone_interface_mock.go -package=interfaces
+//
+
+// Package interfaces is a generated GoMock package.
+package interfaces
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/SawitProRecruitment/EstateService/core/domain"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDroneUsecase is a mock of DroneUsecase interface.
+type MockDroneUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDroneUsecaseMockRecorder
+}
+
+// MockDroneUsecaseMockRecorder is the mock recorder for MockDroneUsecase.
+type MockDroneUsecaseMockRecorder struct {
+	mock *MockDroneUsecase
+}
+
+// NewMockDroneUsecase creates a new mock instance.
+func NewMockDroneUsecase(ctrl *gomock.Controller) *MockDroneUsecase {
+	mock := &MockDroneUsecase{ctrl: ctrl}
+	mock.recorder = &MockDroneUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDroneUsecase) EXPECT() *MockDroneUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreateDrone mocks base method.
+func (m *MockDroneUsecase) CreateDrone(ctx context.Context, drone *domain.Drone) (*domain.Drone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDrone", ctx, drone)
+	ret0, _ := ret[0].(*domain.Drone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDrone indicates an expected call of CreateDrone.
+func (mr *MockDroneUsecaseMockRecorder) CreateDrone(ctx, drone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDrone", reflect.TypeOf((*MockDroneUsecase)(nil).CreateDrone), ctx, drone)
+}
+
+// GetDrone mocks base method.
+func (m *MockDroneUsecase) GetDrone(ctx context.Context, droneID uuid.UUID) (*domain.Drone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDrone", ctx, droneID)
+	ret0, _ := ret[0].(*domain.Drone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDrone indicates an expected call of GetDrone.
+func (mr *MockDroneUsecaseMockRecorder) GetDrone(ctx, droneID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDrone", reflect.TypeOf((*MockDroneUsecase)(nil).GetDrone), ctx, droneID)
+}
+
+// ListDrones mocks base method.
+func (m *MockDroneUsecase) ListDrones(ctx context.Context, status *domain.DroneStatus, limit, offset int) ([]domain.Drone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDrones", ctx, status, limit, offset)
+	ret0, _ := ret[0].([]domain.Drone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDrones indicates an expected call of ListDrones.
+func (mr *MockDroneUsecaseMockRecorder) ListDrones(ctx, status, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDrones", reflect.TypeOf((*MockDroneUsecase)(nil).ListDrones), ctx, status, limit, offset)
+}
+
+// UpdateDrone mocks base method.
+func (m *MockDroneUsecase) UpdateDrone(ctx context.Context, droneID uuid.UUID, update domain.DroneUpdate) (*domain.Drone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDrone", ctx, droneID, update)
+	ret0, _ := ret[0].(*domain.Drone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDrone indicates an expected call of UpdateDrone.
+func (mr *MockDroneUsecaseMockRecorder) UpdateDrone(ctx, droneID, update any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDrone", reflect.TypeOf((*MockDroneUsecase)(nil).UpdateDrone), ctx, droneID, update)
+}
+
+// MockDroneRepository is a mock of DroneRepository interface.
+type MockDroneRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDroneRepositoryMockRecorder
+}
+
+// MockDroneRepositoryMockRecorder is the mock recorder for MockDroneRepository.
+type MockDroneRepositoryMockRecorder struct {
+	mock *MockDroneRepository
+}
+
+// NewMockDroneRepository creates a new mock instance.
+func NewMockDroneRepository(ctrl *gomock.Controller) *MockDroneRepository {
+	mock := &MockDroneRepository{ctrl: ctrl}
+	mock.recorder = &MockDroneRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDroneRepository) EXPECT() *MockDroneRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateDrone mocks base method.
+func (m *MockDroneRepository) CreateDrone(ctx context.Context, drone *domain.Drone) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDrone", ctx, drone)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDrone indicates an expected call of CreateDrone.
+func (mr *MockDroneRepositoryMockRecorder) CreateDrone(ctx, drone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDrone", reflect.TypeOf((*MockDroneRepository)(nil).CreateDrone), ctx, drone)
+}
+
+// GetDrone mocks base method.
+func (m *MockDroneRepository) GetDrone(ctx context.Context, tenantID, droneID uuid.UUID) (*domain.Drone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDrone", ctx, tenantID, droneID)
+	ret0, _ := ret[0].(*domain.Drone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDrone indicates an expected call of GetDrone.
+func (mr *MockDroneRepositoryMockRecorder) GetDrone(ctx, tenantID, droneID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDrone", reflect.TypeOf((*MockDroneRepository)(nil).GetDrone), ctx, tenantID, droneID)
+}
+
+// ListDrones mocks base method.
+func (m *MockDroneRepository) ListDrones(ctx context.Context, tenantID uuid.UUID, status *domain.DroneStatus, limit, offset int) ([]domain.Drone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDrones", ctx, tenantID, status, limit, offset)
+	ret0, _ := ret[0].([]domain.Drone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDrones indicates an expected call of ListDrones.
+func (mr *MockDroneRepositoryMockRecorder) ListDrones(ctx, tenantID, status, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDrones", reflect.TypeOf((*MockDroneRepository)(nil).ListDrones), ctx, tenantID, status, limit, offset)
+}
+
+// UpdateDrone mocks base method.
+func (m *MockDroneRepository) UpdateDrone(ctx context.Context, drone *domain.Drone) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDrone", ctx, drone)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDrone indicates an expected call of UpdateDrone.
+func (mr *MockDroneRepositoryMockRecorder) UpdateDrone(ctx, drone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDrone", reflect.TypeOf((*MockDroneRepository)(nil).UpdateDrone), ctx, drone)
+}
@@ -10,14 +10,68 @@ import (
 type EstateUsecase interface {
 	CreateEstate(ctx context.Context, width int, length int) (*domain.Estate, error)
 	CreateTree(ctx context.Context, estateID uuid.UUID, plot domain.Plot, height int) (*domain.Tree, error)
+	GetEstate(ctx context.Context, estateID uuid.UUID) (*domain.Estate, error)
+	ListEstates(ctx context.Context, limit int, offset int) ([]domain.Estate, error)
+	DeleteEstate(ctx context.Context, estateID uuid.UUID) error
+	ResizeEstate(ctx context.Context, estateID uuid.UUID, width int, length int, expectedVersion int) (*domain.Estate, error)
+	GetTree(ctx context.Context, estateID uuid.UUID, treeID uuid.UUID) (*domain.Tree, error)
+	ListTrees(ctx context.Context, estateID uuid.UUID) ([]domain.Tree, error)
+	UpdateTreeHeight(ctx context.Context, estateID uuid.UUID, treeID uuid.UUID, height int, expectedVersion int) (*domain.Tree, error)
 	GetEstateStats(ctx context.Context, estateID uuid.UUID) (*domain.EstateStats, error)
-	GetDroneDistance(ctx context.Context, estateID uuid.UUID, maxDistance *int) (*domain.DroneDistance, error)
+	GetDroneDistance(ctx context.Context, estateID uuid.UUID, maxDistance *int, droneID *uuid.UUID) (*domain.DroneDistance, error)
+	GetDroneWaypoints(ctx context.Context, estateID uuid.UUID) ([]domain.DroneWaypoint, error)
+	GetDronePlan(ctx context.Context, estateID uuid.UUID, maxDistance *int, droneID *uuid.UUID) (*domain.DronePlan, error)
+	GetDroneAltitudeProfile(ctx context.Context, estateID uuid.UUID) (*domain.AltitudeProfile, error)
+	SubscribeEstateEvents(ctx context.Context, estateID uuid.UUID, lastEventID string) (<-chan domain.Event, error)
+	ListTreesByEstates(ctx context.Context, estateIDs []uuid.UUID) (map[uuid.UUID][]domain.Tree, error)
+	GetEstatesStats(ctx context.Context, estateIDs []uuid.UUID) (map[uuid.UUID]domain.EstateStats, error)
+	GetDroneDistances(ctx context.Context, estateIDs []uuid.UUID, maxDistance *int) (map[uuid.UUID]domain.DroneDistance, error)
+	ListTreeMeasurements(ctx context.Context, estateIDs []uuid.UUID, treeIDs []uuid.UUID) (map[uuid.UUID][]domain.TreeMeasurement, error)
+	ExportEstate(ctx context.Context, estateID uuid.UUID) (*domain.EstateSnapshot, error)
+	ImportEstate(ctx context.Context, snapshot *domain.EstateSnapshot, keepIDs bool) (*domain.Estate, error)
+	DeleteTree(ctx context.Context, estateID uuid.UUID, treeID uuid.UUID) error
+	CloneEstate(ctx context.Context, estateID uuid.UUID) (*domain.Estate, error)
+	DiffSandbox(ctx context.Context, sandboxID uuid.UUID) (*domain.SandboxDiff, error)
+	PromoteSandbox(ctx context.Context, sandboxID uuid.UUID) (*domain.Estate, error)
+	CreateMission(ctx context.Context, estateID uuid.UUID, maxDistance *int, droneID *uuid.UUID, drone string, pilot string) (*domain.Mission, error)
+	ListMissions(ctx context.Context, estateID uuid.UUID, status *domain.MissionStatus, limit int, offset int) ([]domain.Mission, error)
+	GetMission(ctx context.Context, estateID uuid.UUID, missionID uuid.UUID) (*domain.Mission, error)
+	UpdateMission(ctx context.Context, estateID uuid.UUID, missionID uuid.UUID, update domain.MissionUpdate) (*domain.Mission, error)
+	RecordTelemetry(ctx context.Context, estateID uuid.UUID, missionID uuid.UUID, samples []domain.TelemetrySample) (*domain.TelemetryReport, error)
+	GetTelemetryReport(ctx context.Context, estateID uuid.UUID, missionID uuid.UUID) (*domain.TelemetryReport, error)
+	ReplaceChargingPads(ctx context.Context, estateID uuid.UUID, pads []domain.Plot) ([]domain.Plot, error)
+	ListChargingPads(ctx context.Context, estateID uuid.UUID) ([]domain.Plot, error)
+	GetSortiePlan(ctx context.Context, estateID uuid.UUID, droneID uuid.UUID) (*domain.SortiePlan, error)
 }
 
+// EstateRepository scope every estate read and write to tenantID, estates of other tenants are treated as not found.
+// Update methods compare and swap on expectedVersion and return ErrorVersionMismatch when the row was changed in between
 type EstateRepository interface {
-	CreateEstateAndDroneRoute(ctx context.Context, estate *domain.Estate, droneRoutes []domain.DroneRoute) error
-	CreateTreeAndUpdateDroneRoute(ctx context.Context, estateID uuid.UUID, droneRouteAltitude int, tree *domain.Tree) error
-	GetEstateAndStats(ctx context.Context, estateID uuid.UUID) (*domain.Estate, *domain.EstateStats, error)
-	GetDroneRoutes(ctx context.Context, estateID uuid.UUID) ([]domain.DroneRoute, error)
-	GetEstateAndTree(ctx context.Context, estateID uuid.UUID, plot domain.Plot) (*domain.Estate, *domain.Tree, error)
+	CreateEstateAndDroneRoute(ctx context.Context, estate *domain.Estate, droneRoutes []domain.DroneRoute, outbox []domain.Event) error
+	CreateTreeAndUpdateDroneRoute(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, droneRouteAltitude int, tree *domain.Tree, outbox []domain.Event) (domain.DroneDistanceChange, error)
+	GetEstateAndStats(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID) (*domain.Estate, *domain.EstateStats, error)
+	GetDroneRoutes(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID) ([]domain.DroneRoute, error)
+	GetEstateAndTree(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, plot domain.Plot) (*domain.Estate, *domain.Tree, error)
+	GetTree(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, treeID uuid.UUID) (*domain.Tree, error)
+	ListEstates(ctx context.Context, tenantID uuid.UUID, limit int, offset int) ([]domain.Estate, error)
+	ListTrees(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID) ([]domain.Tree, error)
+	DeleteEstate(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, outbox []domain.Event) error
+	ResizeEstateAndDroneRoute(ctx context.Context, tenantID uuid.UUID, estate *domain.Estate, expectedVersion int, droneRoutes []domain.DroneRoute, outbox []domain.Event) (domain.DroneDistanceChange, error)
+	UpdateTreeAndDroneRoute(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, tree *domain.Tree, expectedVersion int, outbox []domain.Event) (domain.DroneDistanceChange, error)
+	ListTreesByEstates(ctx context.Context, tenantID uuid.UUID, estateIDs []uuid.UUID) (map[uuid.UUID][]domain.Tree, error)
+	GetEstatesStats(ctx context.Context, tenantID uuid.UUID, estateIDs []uuid.UUID) (map[uuid.UUID]domain.EstateStats, error)
+	GetDroneRoutesByEstates(ctx context.Context, tenantID uuid.UUID, estateIDs []uuid.UUID) (map[uuid.UUID][]domain.DroneRoute, error)
+	ListTreeMeasurements(ctx context.Context, tenantID uuid.UUID, estateIDs []uuid.UUID, treeIDs []uuid.UUID) (map[uuid.UUID][]domain.TreeMeasurement, error)
+	GetEstateSnapshot(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID) (*domain.EstateSnapshot, error)
+	ImportEstate(ctx context.Context, snapshot *domain.EstateSnapshot, droneRoutes []domain.DroneRoute, outbox []domain.Event) error
+	DeleteTreeAndDroneRoute(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, tree *domain.Tree, outbox []domain.Event) (domain.DroneDistanceChange, error)
+	PromoteSandbox(ctx context.Context, tenantID uuid.UUID, sandbox *domain.Estate, changes domain.TreeChanges, outbox []domain.Event) (domain.DroneDistanceChange, error)
+	CreateMission(ctx context.Context, tenantID uuid.UUID, mission *domain.Mission) error
+	ListMissions(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, status *domain.MissionStatus, limit int, offset int) ([]domain.Mission, error)
+	GetMission(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, missionID uuid.UUID) (*domain.Mission, error)
+	UpdateMission(ctx context.Context, tenantID uuid.UUID, mission *domain.Mission, expectedStatus domain.MissionStatus) error
+	ReplaceMissionTelemetry(ctx context.Context, tenantID uuid.UUID, mission *domain.Mission, samples []domain.TelemetrySample) error
+	GetMissionTelemetry(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, missionID uuid.UUID) ([]domain.TelemetrySample, error)
+	ReplaceChargingPads(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, pads []domain.Plot) error
+	ListChargingPads(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID) ([]domain.Plot, error)
 }
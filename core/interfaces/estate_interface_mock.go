@@ -41,6 +41,21 @@ func (m *MockEstateUsecase) EXPECT() *MockEstateUsecaseMockRecorder {
 	return m.recorder
 }
 
+// CloneEstate mocks base method.
+func (m *MockEstateUsecase) CloneEstate(ctx context.Context, estateID uuid.UUID) (*domain.Estate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloneEstate", ctx, estateID)
+	ret0, _ := ret[0].(*domain.Estate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloneEstate indicates an expected call of CloneEstate.
+func (mr *MockEstateUsecaseMockRecorder) CloneEstate(ctx, estateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneEstate", reflect.TypeOf((*MockEstateUsecase)(nil).CloneEstate), ctx, estateID)
+}
+
 // CreateEstate mocks base method.
 func (m *MockEstateUsecase) CreateEstate(ctx context.Context, width, length int) (*domain.Estate, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +71,21 @@ func (mr *MockEstateUsecaseMockRecorder) CreateEstate(ctx, width, length any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEstate", reflect.TypeOf((*MockEstateUsecase)(nil).CreateEstate), ctx, width, length)
 }
 
+// CreateMission mocks base method.
+func (m *MockEstateUsecase) CreateMission(ctx context.Context, estateID uuid.UUID, maxDistance *int, droneID *uuid.UUID, drone, pilot string) (*domain.Mission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMission", ctx, estateID, maxDistance, droneID, drone, pilot)
+	ret0, _ := ret[0].(*domain.Mission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMission indicates an expected call of CreateMission.
+func (mr *MockEstateUsecaseMockRecorder) CreateMission(ctx, estateID, maxDistance, droneID, drone, pilot any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMission", reflect.TypeOf((*MockEstateUsecase)(nil).CreateMission), ctx, estateID, maxDistance, droneID, drone, pilot)
+}
+
 // CreateTree mocks base method.
 func (m *MockEstateUsecase) CreateTree(ctx context.Context, estateID uuid.UUID, plot domain.Plot, height int) (*domain.Tree, error) {
 	m.ctrl.T.Helper()
@@ -71,19 +101,152 @@ func (mr *MockEstateUsecaseMockRecorder) CreateTree(ctx, estateID, plot, height
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTree", reflect.TypeOf((*MockEstateUsecase)(nil).CreateTree), ctx, estateID, plot, height)
 }
 
+// DeleteEstate mocks base method.
+func (m *MockEstateUsecase) DeleteEstate(ctx context.Context, estateID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEstate", ctx, estateID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEstate indicates an expected call of DeleteEstate.
+func (mr *MockEstateUsecaseMockRecorder) DeleteEstate(ctx, estateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEstate", reflect.TypeOf((*MockEstateUsecase)(nil).DeleteEstate), ctx, estateID)
+}
+
+// DeleteTree mocks base method.
+func (m *MockEstateUsecase) DeleteTree(ctx context.Context, estateID, treeID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTree", ctx, estateID, treeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTree indicates an expected call of DeleteTree.
+func (mr *MockEstateUsecaseMockRecorder) DeleteTree(ctx, estateID, treeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTree", reflect.TypeOf((*MockEstateUsecase)(nil).DeleteTree), ctx, estateID, treeID)
+}
+
+// DiffSandbox mocks base method.
+func (m *MockEstateUsecase) DiffSandbox(ctx context.Context, sandboxID uuid.UUID) (*domain.SandboxDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffSandbox", ctx, sandboxID)
+	ret0, _ := ret[0].(*domain.SandboxDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffSandbox indicates an expected call of DiffSandbox.
+func (mr *MockEstateUsecaseMockRecorder) DiffSandbox(ctx, sandboxID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffSandbox", reflect.TypeOf((*MockEstateUsecase)(nil).DiffSandbox), ctx, sandboxID)
+}
+
+// ExportEstate mocks base method.
+func (m *MockEstateUsecase) ExportEstate(ctx context.Context, estateID uuid.UUID) (*domain.EstateSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportEstate", ctx, estateID)
+	ret0, _ := ret[0].(*domain.EstateSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportEstate indicates an expected call of ExportEstate.
+func (mr *MockEstateUsecaseMockRecorder) ExportEstate(ctx, estateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportEstate", reflect.TypeOf((*MockEstateUsecase)(nil).ExportEstate), ctx, estateID)
+}
+
+// GetDroneAltitudeProfile mocks base method.
+func (m *MockEstateUsecase) GetDroneAltitudeProfile(ctx context.Context, estateID uuid.UUID) (*domain.AltitudeProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDroneAltitudeProfile", ctx, estateID)
+	ret0, _ := ret[0].(*domain.AltitudeProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDroneAltitudeProfile indicates an expected call of GetDroneAltitudeProfile.
+func (mr *MockEstateUsecaseMockRecorder) GetDroneAltitudeProfile(ctx, estateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDroneAltitudeProfile", reflect.TypeOf((*MockEstateUsecase)(nil).GetDroneAltitudeProfile), ctx, estateID)
+}
+
 // GetDroneDistance mocks base method.
-func (m *MockEstateUsecase) GetDroneDistance(ctx context.Context, estateID uuid.UUID, maxDistance *int) (*domain.DroneDistance, error) {
+func (m *MockEstateUsecase) GetDroneDistance(ctx context.Context, estateID uuid.UUID, maxDistance *int, droneID *uuid.UUID) (*domain.DroneDistance, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDroneDistance", ctx, estateID, maxDistance)
+	ret := m.ctrl.Call(m, "GetDroneDistance", ctx, estateID, maxDistance, droneID)
 	ret0, _ := ret[0].(*domain.DroneDistance)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetDroneDistance indicates an expected call of GetDroneDistance.
-func (mr *MockEstateUsecaseMockRecorder) GetDroneDistance(ctx, estateID, maxDistance any) *gomock.Call {
+func (mr *MockEstateUsecaseMockRecorder) GetDroneDistance(ctx, estateID, maxDistance, droneID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDroneDistance", reflect.TypeOf((*MockEstateUsecase)(nil).GetDroneDistance), ctx, estateID, maxDistance, droneID)
+}
+
+// GetDroneDistances mocks base method.
+func (m *MockEstateUsecase) GetDroneDistances(ctx context.Context, estateIDs []uuid.UUID, maxDistance *int) (map[uuid.UUID]domain.DroneDistance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDroneDistances", ctx, estateIDs, maxDistance)
+	ret0, _ := ret[0].(map[uuid.UUID]domain.DroneDistance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDroneDistances indicates an expected call of GetDroneDistances.
+func (mr *MockEstateUsecaseMockRecorder) GetDroneDistances(ctx, estateIDs, maxDistance any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDroneDistances", reflect.TypeOf((*MockEstateUsecase)(nil).GetDroneDistances), ctx, estateIDs, maxDistance)
+}
+
+// GetDronePlan mocks base method.
+func (m *MockEstateUsecase) GetDronePlan(ctx context.Context, estateID uuid.UUID, maxDistance *int, droneID *uuid.UUID) (*domain.DronePlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDronePlan", ctx, estateID, maxDistance, droneID)
+	ret0, _ := ret[0].(*domain.DronePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDronePlan indicates an expected call of GetDronePlan.
+func (mr *MockEstateUsecaseMockRecorder) GetDronePlan(ctx, estateID, maxDistance, droneID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDronePlan", reflect.TypeOf((*MockEstateUsecase)(nil).GetDronePlan), ctx, estateID, maxDistance, droneID)
+}
+
+// GetDroneWaypoints mocks base method.
+func (m *MockEstateUsecase) GetDroneWaypoints(ctx context.Context, estateID uuid.UUID) ([]domain.DroneWaypoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDroneWaypoints", ctx, estateID)
+	ret0, _ := ret[0].([]domain.DroneWaypoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDroneWaypoints indicates an expected call of GetDroneWaypoints.
+func (mr *MockEstateUsecaseMockRecorder) GetDroneWaypoints(ctx, estateID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDroneDistance", reflect.TypeOf((*MockEstateUsecase)(nil).GetDroneDistance), ctx, estateID, maxDistance)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDroneWaypoints", reflect.TypeOf((*MockEstateUsecase)(nil).GetDroneWaypoints), ctx, estateID)
+}
+
+// GetEstate mocks base method.
+func (m *MockEstateUsecase) GetEstate(ctx context.Context, estateID uuid.UUID) (*domain.Estate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEstate", ctx, estateID)
+	ret0, _ := ret[0].(*domain.Estate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEstate indicates an expected call of GetEstate.
+func (mr *MockEstateUsecaseMockRecorder) GetEstate(ctx, estateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEstate", reflect.TypeOf((*MockEstateUsecase)(nil).GetEstate), ctx, estateID)
 }
 
 // GetEstateStats mocks base method.
@@ -101,6 +264,291 @@ func (mr *MockEstateUsecaseMockRecorder) GetEstateStats(ctx, estateID any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEstateStats", reflect.TypeOf((*MockEstateUsecase)(nil).GetEstateStats), ctx, estateID)
 }
 
+// GetEstatesStats mocks base method.
+func (m *MockEstateUsecase) GetEstatesStats(ctx context.Context, estateIDs []uuid.UUID) (map[uuid.UUID]domain.EstateStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEstatesStats", ctx, estateIDs)
+	ret0, _ := ret[0].(map[uuid.UUID]domain.EstateStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEstatesStats indicates an expected call of GetEstatesStats.
+func (mr *MockEstateUsecaseMockRecorder) GetEstatesStats(ctx, estateIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEstatesStats", reflect.TypeOf((*MockEstateUsecase)(nil).GetEstatesStats), ctx, estateIDs)
+}
+
+// GetMission mocks base method.
+func (m *MockEstateUsecase) GetMission(ctx context.Context, estateID, missionID uuid.UUID) (*domain.Mission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMission", ctx, estateID, missionID)
+	ret0, _ := ret[0].(*domain.Mission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMission indicates an expected call of GetMission.
+func (mr *MockEstateUsecaseMockRecorder) GetMission(ctx, estateID, missionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMission", reflect.TypeOf((*MockEstateUsecase)(nil).GetMission), ctx, estateID, missionID)
+}
+
+// GetSortiePlan mocks base method.
+func (m *MockEstateUsecase) GetSortiePlan(ctx context.Context, estateID, droneID uuid.UUID) (*domain.SortiePlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSortiePlan", ctx, estateID, droneID)
+	ret0, _ := ret[0].(*domain.SortiePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSortiePlan indicates an expected call of GetSortiePlan.
+func (mr *MockEstateUsecaseMockRecorder) GetSortiePlan(ctx, estateID, droneID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSortiePlan", reflect.TypeOf((*MockEstateUsecase)(nil).GetSortiePlan), ctx, estateID, droneID)
+}
+
+// GetTelemetryReport mocks base method.
+func (m *MockEstateUsecase) GetTelemetryReport(ctx context.Context, estateID, missionID uuid.UUID) (*domain.TelemetryReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTelemetryReport", ctx, estateID, missionID)
+	ret0, _ := ret[0].(*domain.TelemetryReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTelemetryReport indicates an expected call of GetTelemetryReport.
+func (mr *MockEstateUsecaseMockRecorder) GetTelemetryReport(ctx, estateID, missionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTelemetryReport", reflect.TypeOf((*MockEstateUsecase)(nil).GetTelemetryReport), ctx, estateID, missionID)
+}
+
+// GetTree mocks base method.
+func (m *MockEstateUsecase) GetTree(ctx context.Context, estateID, treeID uuid.UUID) (*domain.Tree, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTree", ctx, estateID, treeID)
+	ret0, _ := ret[0].(*domain.Tree)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTree indicates an expected call of GetTree.
+func (mr *MockEstateUsecaseMockRecorder) GetTree(ctx, estateID, treeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTree", reflect.TypeOf((*MockEstateUsecase)(nil).GetTree), ctx, estateID, treeID)
+}
+
+// ImportEstate mocks base method.
+func (m *MockEstateUsecase) ImportEstate(ctx context.Context, snapshot *domain.EstateSnapshot, keepIDs bool) (*domain.Estate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportEstate", ctx, snapshot, keepIDs)
+	ret0, _ := ret[0].(*domain.Estate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportEstate indicates an expected call of ImportEstate.
+func (mr *MockEstateUsecaseMockRecorder) ImportEstate(ctx, snapshot, keepIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportEstate", reflect.TypeOf((*MockEstateUsecase)(nil).ImportEstate), ctx, snapshot, keepIDs)
+}
+
+// ListChargingPads mocks base method.
+func (m *MockEstateUsecase) ListChargingPads(ctx context.Context, estateID uuid.UUID) ([]domain.Plot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChargingPads", ctx, estateID)
+	ret0, _ := ret[0].([]domain.Plot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChargingPads indicates an expected call of ListChargingPads.
+func (mr *MockEstateUsecaseMockRecorder) ListChargingPads(ctx, estateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChargingPads", reflect.TypeOf((*MockEstateUsecase)(nil).ListChargingPads), ctx, estateID)
+}
+
+// ListEstates mocks base method.
+func (m *MockEstateUsecase) ListEstates(ctx context.Context, limit, offset int) ([]domain.Estate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEstates", ctx, limit, offset)
+	ret0, _ := ret[0].([]domain.Estate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEstates indicates an expected call of ListEstates.
+func (mr *MockEstateUsecaseMockRecorder) ListEstates(ctx, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEstates", reflect.TypeOf((*MockEstateUsecase)(nil).ListEstates), ctx, limit, offset)
+}
+
+// ListMissions mocks base method.
+func (m *MockEstateUsecase) ListMissions(ctx context.Context, estateID uuid.UUID, status *domain.MissionStatus, limit, offset int) ([]domain.Mission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMissions", ctx, estateID, status, limit, offset)
+	ret0, _ := ret[0].([]domain.Mission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMissions indicates an expected call of ListMissions.
+func (mr *MockEstateUsecaseMockRecorder) ListMissions(ctx, estateID, status, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMissions", reflect.TypeOf((*MockEstateUsecase)(nil).ListMissions), ctx, estateID, status, limit, offset)
+}
+
+// ListTreeMeasurements mocks base method.
+func (m *MockEstateUsecase) ListTreeMeasurements(ctx context.Context, estateIDs, treeIDs []uuid.UUID) (map[uuid.UUID][]domain.TreeMeasurement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTreeMeasurements", ctx, estateIDs, treeIDs)
+	ret0, _ := ret[0].(map[uuid.UUID][]domain.TreeMeasurement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTreeMeasurements indicates an expected call of ListTreeMeasurements.
+func (mr *MockEstateUsecaseMockRecorder) ListTreeMeasurements(ctx, estateIDs, treeIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTreeMeasurements", reflect.TypeOf((*MockEstateUsecase)(nil).ListTreeMeasurements), ctx, estateIDs, treeIDs)
+}
+
+// ListTrees mocks base method.
+func (m *MockEstateUsecase) ListTrees(ctx context.Context, estateID uuid.UUID) ([]domain.Tree, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrees", ctx, estateID)
+	ret0, _ := ret[0].([]domain.Tree)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrees indicates an expected call of ListTrees.
+func (mr *MockEstateUsecaseMockRecorder) ListTrees(ctx, estateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrees", reflect.TypeOf((*MockEstateUsecase)(nil).ListTrees), ctx, estateID)
+}
+
+// ListTreesByEstates mocks base method.
+func (m *MockEstateUsecase) ListTreesByEstates(ctx context.Context, estateIDs []uuid.UUID) (map[uuid.UUID][]domain.Tree, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTreesByEstates", ctx, estateIDs)
+	ret0, _ := ret[0].(map[uuid.UUID][]domain.Tree)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTreesByEstates indicates an expected call of ListTreesByEstates.
+func (mr *MockEstateUsecaseMockRecorder) ListTreesByEstates(ctx, estateIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTreesByEstates", reflect.TypeOf((*MockEstateUsecase)(nil).ListTreesByEstates), ctx, estateIDs)
+}
+
+// PromoteSandbox mocks base method.
+func (m *MockEstateUsecase) PromoteSandbox(ctx context.Context, sandboxID uuid.UUID) (*domain.Estate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromoteSandbox", ctx, sandboxID)
+	ret0, _ := ret[0].(*domain.Estate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PromoteSandbox indicates an expected call of PromoteSandbox.
+func (mr *MockEstateUsecaseMockRecorder) PromoteSandbox(ctx, sandboxID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoteSandbox", reflect.TypeOf((*MockEstateUsecase)(nil).PromoteSandbox), ctx, sandboxID)
+}
+
+// RecordTelemetry mocks base method.
+func (m *MockEstateUsecase) RecordTelemetry(ctx context.Context, estateID, missionID uuid.UUID, samples []domain.TelemetrySample) (*domain.TelemetryReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordTelemetry", ctx, estateID, missionID, samples)
+	ret0, _ := ret[0].(*domain.TelemetryReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordTelemetry indicates an expected call of RecordTelemetry.
+func (mr *MockEstateUsecaseMockRecorder) RecordTelemetry(ctx, estateID, missionID, samples any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordTelemetry", reflect.TypeOf((*MockEstateUsecase)(nil).RecordTelemetry), ctx, estateID, missionID, samples)
+}
+
+// ReplaceChargingPads mocks base method.
+func (m *MockEstateUsecase) ReplaceChargingPads(ctx context.Context, estateID uuid.UUID, pads []domain.Plot) ([]domain.Plot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceChargingPads", ctx, estateID, pads)
+	ret0, _ := ret[0].([]domain.Plot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceChargingPads indicates an expected call of ReplaceChargingPads.
+func (mr *MockEstateUsecaseMockRecorder) ReplaceChargingPads(ctx, estateID, pads any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceChargingPads", reflect.TypeOf((*MockEstateUsecase)(nil).ReplaceChargingPads), ctx, estateID, pads)
+}
+
+// ResizeEstate mocks base method.
+func (m *MockEstateUsecase) ResizeEstate(ctx context.Context, estateID uuid.UUID, width, length, expectedVersion int) (*domain.Estate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResizeEstate", ctx, estateID, width, length, expectedVersion)
+	ret0, _ := ret[0].(*domain.Estate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResizeEstate indicates an expected call of ResizeEstate.
+func (mr *MockEstateUsecaseMockRecorder) ResizeEstate(ctx, estateID, width, length, expectedVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeEstate", reflect.TypeOf((*MockEstateUsecase)(nil).ResizeEstate), ctx, estateID, width, length, expectedVersion)
+}
+
+// SubscribeEstateEvents mocks base method.
+func (m *MockEstateUsecase) SubscribeEstateEvents(ctx context.Context, estateID uuid.UUID, lastEventID string) (<-chan domain.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeEstateEvents", ctx, estateID, lastEventID)
+	ret0, _ := ret[0].(<-chan domain.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeEstateEvents indicates an expected call of SubscribeEstateEvents.
+func (mr *MockEstateUsecaseMockRecorder) SubscribeEstateEvents(ctx, estateID, lastEventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeEstateEvents", reflect.TypeOf((*MockEstateUsecase)(nil).SubscribeEstateEvents), ctx, estateID, lastEventID)
+}
+
+// UpdateMission mocks base method.
+func (m *MockEstateUsecase) UpdateMission(ctx context.Context, estateID, missionID uuid.UUID, update domain.MissionUpdate) (*domain.Mission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMission", ctx, estateID, missionID, update)
+	ret0, _ := ret[0].(*domain.Mission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMission indicates an expected call of UpdateMission.
+func (mr *MockEstateUsecaseMockRecorder) UpdateMission(ctx, estateID, missionID, update any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMission", reflect.TypeOf((*MockEstateUsecase)(nil).UpdateMission), ctx, estateID, missionID, update)
+}
+
+// UpdateTreeHeight mocks base method.
+func (m *MockEstateUsecase) UpdateTreeHeight(ctx context.Context, estateID, treeID uuid.UUID, height, expectedVersion int) (*domain.Tree, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTreeHeight", ctx, estateID, treeID, height, expectedVersion)
+	ret0, _ := ret[0].(*domain.Tree)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTreeHeight indicates an expected call of UpdateTreeHeight.
+func (mr *MockEstateUsecaseMockRecorder) UpdateTreeHeight(ctx, estateID, treeID, height, expectedVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTreeHeight", reflect.TypeOf((*MockEstateUsecase)(nil).UpdateTreeHeight), ctx, estateID, treeID, height, expectedVersion)
+}
+
 // MockEstateRepository is a mock of EstateRepository interface.
 type MockEstateRepository struct {
 	ctrl     *gomock.Controller
@@ -125,52 +573,111 @@ func (m *MockEstateRepository) EXPECT() *MockEstateRepositoryMockRecorder {
 }
 
 // CreateEstateAndDroneRoute mocks base method.
-func (m *MockEstateRepository) CreateEstateAndDroneRoute(ctx context.Context, estate *domain.Estate, droneRoutes []domain.DroneRoute) error {
+func (m *MockEstateRepository) CreateEstateAndDroneRoute(ctx context.Context, estate *domain.Estate, droneRoutes []domain.DroneRoute, outbox []domain.Event) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateEstateAndDroneRoute", ctx, estate, droneRoutes)
+	ret := m.ctrl.Call(m, "CreateEstateAndDroneRoute", ctx, estate, droneRoutes, outbox)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateEstateAndDroneRoute indicates an expected call of CreateEstateAndDroneRoute.
-func (mr *MockEstateRepositoryMockRecorder) CreateEstateAndDroneRoute(ctx, estate, droneRoutes any) *gomock.Call {
+func (mr *MockEstateRepositoryMockRecorder) CreateEstateAndDroneRoute(ctx, estate, droneRoutes, outbox any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEstateAndDroneRoute", reflect.TypeOf((*MockEstateRepository)(nil).CreateEstateAndDroneRoute), ctx, estate, droneRoutes)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEstateAndDroneRoute", reflect.TypeOf((*MockEstateRepository)(nil).CreateEstateAndDroneRoute), ctx, estate, droneRoutes, outbox)
 }
 
-// CreateTreeAndUpdateDroneRoute mocks base method.
-func (m *MockEstateRepository) CreateTreeAndUpdateDroneRoute(ctx context.Context, estateID uuid.UUID, droneRouteAltitude int, tree *domain.Tree) error {
+// CreateMission mocks base method.
+func (m *MockEstateRepository) CreateMission(ctx context.Context, tenantID uuid.UUID, mission *domain.Mission) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTreeAndUpdateDroneRoute", ctx, estateID, droneRouteAltitude, tree)
+	ret := m.ctrl.Call(m, "CreateMission", ctx, tenantID, mission)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// CreateMission indicates an expected call of CreateMission.
+func (mr *MockEstateRepositoryMockRecorder) CreateMission(ctx, tenantID, mission any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMission", reflect.TypeOf((*MockEstateRepository)(nil).CreateMission), ctx, tenantID, mission)
+}
+
+// CreateTreeAndUpdateDroneRoute mocks base method.
+func (m *MockEstateRepository) CreateTreeAndUpdateDroneRoute(ctx context.Context, tenantID, estateID uuid.UUID, droneRouteAltitude int, tree *domain.Tree, outbox []domain.Event) (domain.DroneDistanceChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTreeAndUpdateDroneRoute", ctx, tenantID, estateID, droneRouteAltitude, tree, outbox)
+	ret0, _ := ret[0].(domain.DroneDistanceChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // CreateTreeAndUpdateDroneRoute indicates an expected call of CreateTreeAndUpdateDroneRoute.
-func (mr *MockEstateRepositoryMockRecorder) CreateTreeAndUpdateDroneRoute(ctx, estateID, droneRouteAltitude, tree any) *gomock.Call {
+func (mr *MockEstateRepositoryMockRecorder) CreateTreeAndUpdateDroneRoute(ctx, tenantID, estateID, droneRouteAltitude, tree, outbox any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTreeAndUpdateDroneRoute", reflect.TypeOf((*MockEstateRepository)(nil).CreateTreeAndUpdateDroneRoute), ctx, estateID, droneRouteAltitude, tree)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTreeAndUpdateDroneRoute", reflect.TypeOf((*MockEstateRepository)(nil).CreateTreeAndUpdateDroneRoute), ctx, tenantID, estateID, droneRouteAltitude, tree, outbox)
+}
+
+// DeleteEstate mocks base method.
+func (m *MockEstateRepository) DeleteEstate(ctx context.Context, tenantID, estateID uuid.UUID, outbox []domain.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEstate", ctx, tenantID, estateID, outbox)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEstate indicates an expected call of DeleteEstate.
+func (mr *MockEstateRepositoryMockRecorder) DeleteEstate(ctx, tenantID, estateID, outbox any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEstate", reflect.TypeOf((*MockEstateRepository)(nil).DeleteEstate), ctx, tenantID, estateID, outbox)
+}
+
+// DeleteTreeAndDroneRoute mocks base method.
+func (m *MockEstateRepository) DeleteTreeAndDroneRoute(ctx context.Context, tenantID, estateID uuid.UUID, tree *domain.Tree, outbox []domain.Event) (domain.DroneDistanceChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTreeAndDroneRoute", ctx, tenantID, estateID, tree, outbox)
+	ret0, _ := ret[0].(domain.DroneDistanceChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTreeAndDroneRoute indicates an expected call of DeleteTreeAndDroneRoute.
+func (mr *MockEstateRepositoryMockRecorder) DeleteTreeAndDroneRoute(ctx, tenantID, estateID, tree, outbox any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTreeAndDroneRoute", reflect.TypeOf((*MockEstateRepository)(nil).DeleteTreeAndDroneRoute), ctx, tenantID, estateID, tree, outbox)
 }
 
 // GetDroneRoutes mocks base method.
-func (m *MockEstateRepository) GetDroneRoutes(ctx context.Context, estateID uuid.UUID) ([]domain.DroneRoute, error) {
+func (m *MockEstateRepository) GetDroneRoutes(ctx context.Context, tenantID, estateID uuid.UUID) ([]domain.DroneRoute, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDroneRoutes", ctx, estateID)
+	ret := m.ctrl.Call(m, "GetDroneRoutes", ctx, tenantID, estateID)
 	ret0, _ := ret[0].([]domain.DroneRoute)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetDroneRoutes indicates an expected call of GetDroneRoutes.
-func (mr *MockEstateRepositoryMockRecorder) GetDroneRoutes(ctx, estateID any) *gomock.Call {
+func (mr *MockEstateRepositoryMockRecorder) GetDroneRoutes(ctx, tenantID, estateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDroneRoutes", reflect.TypeOf((*MockEstateRepository)(nil).GetDroneRoutes), ctx, tenantID, estateID)
+}
+
+// GetDroneRoutesByEstates mocks base method.
+func (m *MockEstateRepository) GetDroneRoutesByEstates(ctx context.Context, tenantID uuid.UUID, estateIDs []uuid.UUID) (map[uuid.UUID][]domain.DroneRoute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDroneRoutesByEstates", ctx, tenantID, estateIDs)
+	ret0, _ := ret[0].(map[uuid.UUID][]domain.DroneRoute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDroneRoutesByEstates indicates an expected call of GetDroneRoutesByEstates.
+func (mr *MockEstateRepositoryMockRecorder) GetDroneRoutesByEstates(ctx, tenantID, estateIDs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDroneRoutes", reflect.TypeOf((*MockEstateRepository)(nil).GetDroneRoutes), ctx, estateID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDroneRoutesByEstates", reflect.TypeOf((*MockEstateRepository)(nil).GetDroneRoutesByEstates), ctx, tenantID, estateIDs)
 }
 
 // GetEstateAndStats mocks base method.
-func (m *MockEstateRepository) GetEstateAndStats(ctx context.Context, estateID uuid.UUID) (*domain.Estate, *domain.EstateStats, error) {
+func (m *MockEstateRepository) GetEstateAndStats(ctx context.Context, tenantID, estateID uuid.UUID) (*domain.Estate, *domain.EstateStats, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEstateAndStats", ctx, estateID)
+	ret := m.ctrl.Call(m, "GetEstateAndStats", ctx, tenantID, estateID)
 	ret0, _ := ret[0].(*domain.Estate)
 	ret1, _ := ret[1].(*domain.EstateStats)
 	ret2, _ := ret[2].(error)
@@ -178,15 +685,15 @@ func (m *MockEstateRepository) GetEstateAndStats(ctx context.Context, estateID u
 }
 
 // GetEstateAndStats indicates an expected call of GetEstateAndStats.
-func (mr *MockEstateRepositoryMockRecorder) GetEstateAndStats(ctx, estateID any) *gomock.Call {
+func (mr *MockEstateRepositoryMockRecorder) GetEstateAndStats(ctx, tenantID, estateID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEstateAndStats", reflect.TypeOf((*MockEstateRepository)(nil).GetEstateAndStats), ctx, estateID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEstateAndStats", reflect.TypeOf((*MockEstateRepository)(nil).GetEstateAndStats), ctx, tenantID, estateID)
 }
 
 // GetEstateAndTree mocks base method.
-func (m *MockEstateRepository) GetEstateAndTree(ctx context.Context, estateID uuid.UUID, plot domain.Plot) (*domain.Estate, *domain.Tree, error) {
+func (m *MockEstateRepository) GetEstateAndTree(ctx context.Context, tenantID, estateID uuid.UUID, plot domain.Plot) (*domain.Estate, *domain.Tree, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEstateAndTree", ctx, estateID, plot)
+	ret := m.ctrl.Call(m, "GetEstateAndTree", ctx, tenantID, estateID, plot)
 	ret0, _ := ret[0].(*domain.Estate)
 	ret1, _ := ret[1].(*domain.Tree)
 	ret2, _ := ret[2].(error)
@@ -194,7 +701,273 @@ func (m *MockEstateRepository) GetEstateAndTree(ctx context.Context, estateID uu
 }
 
 // GetEstateAndTree indicates an expected call of GetEstateAndTree.
-func (mr *MockEstateRepositoryMockRecorder) GetEstateAndTree(ctx, estateID, plot any) *gomock.Call {
+func (mr *MockEstateRepositoryMockRecorder) GetEstateAndTree(ctx, tenantID, estateID, plot any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEstateAndTree", reflect.TypeOf((*MockEstateRepository)(nil).GetEstateAndTree), ctx, tenantID, estateID, plot)
+}
+
+// GetEstateSnapshot mocks base method.
+func (m *MockEstateRepository) GetEstateSnapshot(ctx context.Context, tenantID, estateID uuid.UUID) (*domain.EstateSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEstateSnapshot", ctx, tenantID, estateID)
+	ret0, _ := ret[0].(*domain.EstateSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEstateSnapshot indicates an expected call of GetEstateSnapshot.
+func (mr *MockEstateRepositoryMockRecorder) GetEstateSnapshot(ctx, tenantID, estateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEstateSnapshot", reflect.TypeOf((*MockEstateRepository)(nil).GetEstateSnapshot), ctx, tenantID, estateID)
+}
+
+// GetEstatesStats mocks base method.
+func (m *MockEstateRepository) GetEstatesStats(ctx context.Context, tenantID uuid.UUID, estateIDs []uuid.UUID) (map[uuid.UUID]domain.EstateStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEstatesStats", ctx, tenantID, estateIDs)
+	ret0, _ := ret[0].(map[uuid.UUID]domain.EstateStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEstatesStats indicates an expected call of GetEstatesStats.
+func (mr *MockEstateRepositoryMockRecorder) GetEstatesStats(ctx, tenantID, estateIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEstatesStats", reflect.TypeOf((*MockEstateRepository)(nil).GetEstatesStats), ctx, tenantID, estateIDs)
+}
+
+// GetMission mocks base method.
+func (m *MockEstateRepository) GetMission(ctx context.Context, tenantID, estateID, missionID uuid.UUID) (*domain.Mission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMission", ctx, tenantID, estateID, missionID)
+	ret0, _ := ret[0].(*domain.Mission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMission indicates an expected call of GetMission.
+func (mr *MockEstateRepositoryMockRecorder) GetMission(ctx, tenantID, estateID, missionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMission", reflect.TypeOf((*MockEstateRepository)(nil).GetMission), ctx, tenantID, estateID, missionID)
+}
+
+// GetMissionTelemetry mocks base method.
+func (m *MockEstateRepository) GetMissionTelemetry(ctx context.Context, tenantID, estateID, missionID uuid.UUID) ([]domain.TelemetrySample, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMissionTelemetry", ctx, tenantID, estateID, missionID)
+	ret0, _ := ret[0].([]domain.TelemetrySample)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMissionTelemetry indicates an expected call of GetMissionTelemetry.
+func (mr *MockEstateRepositoryMockRecorder) GetMissionTelemetry(ctx, tenantID, estateID, missionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMissionTelemetry", reflect.TypeOf((*MockEstateRepository)(nil).GetMissionTelemetry), ctx, tenantID, estateID, missionID)
+}
+
+// GetTree mocks base method.
+func (m *MockEstateRepository) GetTree(ctx context.Context, tenantID, estateID, treeID uuid.UUID) (*domain.Tree, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTree", ctx, tenantID, estateID, treeID)
+	ret0, _ := ret[0].(*domain.Tree)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTree indicates an expected call of GetTree.
+func (mr *MockEstateRepositoryMockRecorder) GetTree(ctx, tenantID, estateID, treeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTree", reflect.TypeOf((*MockEstateRepository)(nil).GetTree), ctx, tenantID, estateID, treeID)
+}
+
+// ImportEstate mocks base method.
+func (m *MockEstateRepository) ImportEstate(ctx context.Context, snapshot *domain.EstateSnapshot, droneRoutes []domain.DroneRoute, outbox []domain.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportEstate", ctx, snapshot, droneRoutes, outbox)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportEstate indicates an expected call of ImportEstate.
+func (mr *MockEstateRepositoryMockRecorder) ImportEstate(ctx, snapshot, droneRoutes, outbox any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportEstate", reflect.TypeOf((*MockEstateRepository)(nil).ImportEstate), ctx, snapshot, droneRoutes, outbox)
+}
+
+// ListChargingPads mocks base method.
+func (m *MockEstateRepository) ListChargingPads(ctx context.Context, tenantID, estateID uuid.UUID) ([]domain.Plot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChargingPads", ctx, tenantID, estateID)
+	ret0, _ := ret[0].([]domain.Plot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChargingPads indicates an expected call of ListChargingPads.
+func (mr *MockEstateRepositoryMockRecorder) ListChargingPads(ctx, tenantID, estateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChargingPads", reflect.TypeOf((*MockEstateRepository)(nil).ListChargingPads), ctx, tenantID, estateID)
+}
+
+// ListEstates mocks base method.
+func (m *MockEstateRepository) ListEstates(ctx context.Context, tenantID uuid.UUID, limit, offset int) ([]domain.Estate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEstates", ctx, tenantID, limit, offset)
+	ret0, _ := ret[0].([]domain.Estate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEstates indicates an expected call of ListEstates.
+func (mr *MockEstateRepositoryMockRecorder) ListEstates(ctx, tenantID, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEstates", reflect.TypeOf((*MockEstateRepository)(nil).ListEstates), ctx, tenantID, limit, offset)
+}
+
+// ListMissions mocks base method.
+func (m *MockEstateRepository) ListMissions(ctx context.Context, tenantID, estateID uuid.UUID, status *domain.MissionStatus, limit, offset int) ([]domain.Mission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMissions", ctx, tenantID, estateID, status, limit, offset)
+	ret0, _ := ret[0].([]domain.Mission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMissions indicates an expected call of ListMissions.
+func (mr *MockEstateRepositoryMockRecorder) ListMissions(ctx, tenantID, estateID, status, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMissions", reflect.TypeOf((*MockEstateRepository)(nil).ListMissions), ctx, tenantID, estateID, status, limit, offset)
+}
+
+// ListTreeMeasurements mocks base method.
+func (m *MockEstateRepository) ListTreeMeasurements(ctx context.Context, tenantID uuid.UUID, estateIDs, treeIDs []uuid.UUID) (map[uuid.UUID][]domain.TreeMeasurement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTreeMeasurements", ctx, tenantID, estateIDs, treeIDs)
+	ret0, _ := ret[0].(map[uuid.UUID][]domain.TreeMeasurement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTreeMeasurements indicates an expected call of ListTreeMeasurements.
+func (mr *MockEstateRepositoryMockRecorder) ListTreeMeasurements(ctx, tenantID, estateIDs, treeIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTreeMeasurements", reflect.TypeOf((*MockEstateRepository)(nil).ListTreeMeasurements), ctx, tenantID, estateIDs, treeIDs)
+}
+
+// ListTrees mocks base method.
+func (m *MockEstateRepository) ListTrees(ctx context.Context, tenantID, estateID uuid.UUID) ([]domain.Tree, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrees", ctx, tenantID, estateID)
+	ret0, _ := ret[0].([]domain.Tree)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrees indicates an expected call of ListTrees.
+func (mr *MockEstateRepositoryMockRecorder) ListTrees(ctx, tenantID, estateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrees", reflect.TypeOf((*MockEstateRepository)(nil).ListTrees), ctx, tenantID, estateID)
+}
+
+// ListTreesByEstates mocks base method.
+func (m *MockEstateRepository) ListTreesByEstates(ctx context.Context, tenantID uuid.UUID, estateIDs []uuid.UUID) (map[uuid.UUID][]domain.Tree, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTreesByEstates", ctx, tenantID, estateIDs)
+	ret0, _ := ret[0].(map[uuid.UUID][]domain.Tree)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTreesByEstates indicates an expected call of ListTreesByEstates.
+func (mr *MockEstateRepositoryMockRecorder) ListTreesByEstates(ctx, tenantID, estateIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTreesByEstates", reflect.TypeOf((*MockEstateRepository)(nil).ListTreesByEstates), ctx, tenantID, estateIDs)
+}
+
+// PromoteSandbox mocks base method.
+func (m *MockEstateRepository) PromoteSandbox(ctx context.Context, tenantID uuid.UUID, sandbox *domain.Estate, changes domain.TreeChanges, outbox []domain.Event) (domain.DroneDistanceChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromoteSandbox", ctx, tenantID, sandbox, changes, outbox)
+	ret0, _ := ret[0].(domain.DroneDistanceChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PromoteSandbox indicates an expected call of PromoteSandbox.
+func (mr *MockEstateRepositoryMockRecorder) PromoteSandbox(ctx, tenantID, sandbox, changes, outbox any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoteSandbox", reflect.TypeOf((*MockEstateRepository)(nil).PromoteSandbox), ctx, tenantID, sandbox, changes, outbox)
+}
+
+// ReplaceChargingPads mocks base method.
+func (m *MockEstateRepository) ReplaceChargingPads(ctx context.Context, tenantID, estateID uuid.UUID, pads []domain.Plot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceChargingPads", ctx, tenantID, estateID, pads)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceChargingPads indicates an expected call of ReplaceChargingPads.
+func (mr *MockEstateRepositoryMockRecorder) ReplaceChargingPads(ctx, tenantID, estateID, pads any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceChargingPads", reflect.TypeOf((*MockEstateRepository)(nil).ReplaceChargingPads), ctx, tenantID, estateID, pads)
+}
+
+// ReplaceMissionTelemetry mocks base method.
+func (m *MockEstateRepository) ReplaceMissionTelemetry(ctx context.Context, tenantID uuid.UUID, mission *domain.Mission, samples []domain.TelemetrySample) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceMissionTelemetry", ctx, tenantID, mission, samples)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceMissionTelemetry indicates an expected call of ReplaceMissionTelemetry.
+func (mr *MockEstateRepositoryMockRecorder) ReplaceMissionTelemetry(ctx, tenantID, mission, samples any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceMissionTelemetry", reflect.TypeOf((*MockEstateRepository)(nil).ReplaceMissionTelemetry), ctx, tenantID, mission, samples)
+}
+
+// ResizeEstateAndDroneRoute mocks base method.
+func (m *MockEstateRepository) ResizeEstateAndDroneRoute(ctx context.Context, tenantID uuid.UUID, estate *domain.Estate, expectedVersion int, droneRoutes []domain.DroneRoute, outbox []domain.Event) (domain.DroneDistanceChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResizeEstateAndDroneRoute", ctx, tenantID, estate, expectedVersion, droneRoutes, outbox)
+	ret0, _ := ret[0].(domain.DroneDistanceChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResizeEstateAndDroneRoute indicates an expected call of ResizeEstateAndDroneRoute.
+func (mr *MockEstateRepositoryMockRecorder) ResizeEstateAndDroneRoute(ctx, tenantID, estate, expectedVersion, droneRoutes, outbox any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeEstateAndDroneRoute", reflect.TypeOf((*MockEstateRepository)(nil).ResizeEstateAndDroneRoute), ctx, tenantID, estate, expectedVersion, droneRoutes, outbox)
+}
+
+// UpdateMission mocks base method.
+func (m *MockEstateRepository) UpdateMission(ctx context.Context, tenantID uuid.UUID, mission *domain.Mission, expectedStatus domain.MissionStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMission", ctx, tenantID, mission, expectedStatus)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMission indicates an expected call of UpdateMission.
+func (mr *MockEstateRepositoryMockRecorder) UpdateMission(ctx, tenantID, mission, expectedStatus any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMission", reflect.TypeOf((*MockEstateRepository)(nil).UpdateMission), ctx, tenantID, mission, expectedStatus)
+}
+
+// UpdateTreeAndDroneRoute mocks base method.
+func (m *MockEstateRepository) UpdateTreeAndDroneRoute(ctx context.Context, tenantID, estateID uuid.UUID, tree *domain.Tree, expectedVersion int, outbox []domain.Event) (domain.DroneDistanceChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTreeAndDroneRoute", ctx, tenantID, estateID, tree, expectedVersion, outbox)
+	ret0, _ := ret[0].(domain.DroneDistanceChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTreeAndDroneRoute indicates an expected call of UpdateTreeAndDroneRoute.
+func (mr *MockEstateRepositoryMockRecorder) UpdateTreeAndDroneRoute(ctx, tenantID, estateID, tree, expectedVersion, outbox any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEstateAndTree", reflect.TypeOf((*MockEstateRepository)(nil).GetEstateAndTree), ctx, estateID, plot)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTreeAndDroneRoute", reflect.TypeOf((*MockEstateRepository)(nil).UpdateTreeAndDroneRoute), ctx, tenantID, estateID, tree, expectedVersion, outbox)
 }
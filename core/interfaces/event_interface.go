@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+)
+
+type EventBroker interface {
+	Publish(ctx context.Context, event domain.Event)
+	Subscribe(ctx context.Context, estateID uuid.UUID, lastEventID string) (<-chan domain.Event, error)
+}
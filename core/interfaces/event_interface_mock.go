@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: core/interfaces/event_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=core/interfaces/event_interface.go -destination=core/interfaces/event_interface_mock.go -package=interfaces
+//
+
+// Package interfaces is a generated GoMock package.
+package interfaces
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/SawitProRecruitment/EstateService/core/domain"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEventBroker is a mock of EventBroker interface.
+type MockEventBroker struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventBrokerMockRecorder
+}
+
+// MockEventBrokerMockRecorder is the mock recorder for MockEventBroker.
+type MockEventBrokerMockRecorder struct {
+	mock *MockEventBroker
+}
+
+// NewMockEventBroker creates a new mock instance.
+func NewMockEventBroker(ctrl *gomock.Controller) *MockEventBroker {
+	mock := &MockEventBroker{ctrl: ctrl}
+	mock.recorder = &MockEventBrokerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventBroker) EXPECT() *MockEventBrokerMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockEventBroker) Publish(ctx context.Context, event domain.Event) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Publish", ctx, event)
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockEventBrokerMockRecorder) Publish(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockEventBroker)(nil).Publish), ctx, event)
+}
+
+// Subscribe mocks base method.
+func (m *MockEventBroker) Subscribe(ctx context.Context, estateID uuid.UUID, lastEventID string) (<-chan domain.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, estateID, lastEventID)
+	ret0, _ := ret[0].(<-chan domain.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockEventBrokerMockRecorder) Subscribe(ctx, estateID, lastEventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockEventBroker)(nil).Subscribe), ctx, estateID, lastEventID)
+}
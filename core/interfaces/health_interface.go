@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+)
+
+// ReadinessChecker report an error while a dependency of the service cannot serve requests
+type ReadinessChecker interface {
+	CheckReadiness(ctx context.Context) error
+}
@@ -0,0 +1,54 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: core/interfaces/health_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=core/interfaces/health_interface.go -destination=core/interfaces/health_interface_mock.go -package=interfaces
+//
+
+// Package interfaces is a generated GoMock package.
+package interfaces
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReadinessChecker is a mock of ReadinessChecker interface.
+type MockReadinessChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockReadinessCheckerMockRecorder
+}
+
+// MockReadinessCheckerMockRecorder is the mock recorder for MockReadinessChecker.
+type MockReadinessCheckerMockRecorder struct {
+	mock *MockReadinessChecker
+}
+
+// NewMockReadinessChecker creates a new mock instance.
+func NewMockReadinessChecker(ctrl *gomock.Controller) *MockReadinessChecker {
+	mock := &MockReadinessChecker{ctrl: ctrl}
+	mock.recorder = &MockReadinessCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReadinessChecker) EXPECT() *MockReadinessCheckerMockRecorder {
+	return m.recorder
+}
+
+// CheckReadiness mocks base method.
+func (m *MockReadinessChecker) CheckReadiness(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckReadiness", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckReadiness indicates an expected call of CheckReadiness.
+func (mr *MockReadinessCheckerMockRecorder) CheckReadiness(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckReadiness", reflect.TypeOf((*MockReadinessChecker)(nil).CheckReadiness), ctx)
+}
@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+)
+
+type IdempotencyUsecase interface {
+	Begin(ctx context.Context, key string, requestHash string) (*domain.IdempotencyRecord, error)
+	Complete(ctx context.Context, key string, statusCode int, responseBody []byte) error
+	PurgeExpired(ctx context.Context) error
+}
+
+type IdempotencyRepository interface {
+	ReserveIdempotencyKey(ctx context.Context, record *domain.IdempotencyRecord) (bool, error)
+	GetIdempotencyRecord(ctx context.Context, tenantID uuid.UUID, key string) (*domain.IdempotencyRecord, error)
+	CompleteIdempotencyRecord(ctx context.Context, tenantID uuid.UUID, key string, statusCode int, responseBody []byte) error
+	DeleteIdempotencyRecord(ctx context.Context, tenantID uuid.UUID, key string) error
+	DeleteExpiredIdempotencyRecords(ctx context.Context, now time.Time) error
+}
@@ -0,0 +1,181 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: core/interfaces/idempotency_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=core/interfaces/idempotency_interface.go -destination=core/interfaces/idempotency_interface_mock.go -package=interfaces
+//
+
+// Package interfaces is a generated GoMock package.
+package interfaces
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/SawitProRecruitment/EstateService/core/domain"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIdempotencyUsecase is a mock of IdempotencyUsecase interface.
+type MockIdempotencyUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyUsecaseMockRecorder
+}
+
+// MockIdempotencyUsecaseMockRecorder is the mock recorder for MockIdempotencyUsecase.
+type MockIdempotencyUsecaseMockRecorder struct {
+	mock *MockIdempotencyUsecase
+}
+
+// NewMockIdempotencyUsecase creates a new mock instance.
+func NewMockIdempotencyUsecase(ctrl *gomock.Controller) *MockIdempotencyUsecase {
+	mock := &MockIdempotencyUsecase{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotencyUsecase) EXPECT() *MockIdempotencyUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Begin mocks base method.
+func (m *MockIdempotencyUsecase) Begin(ctx context.Context, key, requestHash string) (*domain.IdempotencyRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Begin", ctx, key, requestHash)
+	ret0, _ := ret[0].(*domain.IdempotencyRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Begin indicates an expected call of Begin.
+func (mr *MockIdempotencyUsecaseMockRecorder) Begin(ctx, key, requestHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Begin", reflect.TypeOf((*MockIdempotencyUsecase)(nil).Begin), ctx, key, requestHash)
+}
+
+// Complete mocks base method.
+func (m *MockIdempotencyUsecase) Complete(ctx context.Context, key string, statusCode int, responseBody []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, key, statusCode, responseBody)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockIdempotencyUsecaseMockRecorder) Complete(ctx, key, statusCode, responseBody any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockIdempotencyUsecase)(nil).Complete), ctx, key, statusCode, responseBody)
+}
+
+// PurgeExpired mocks base method.
+func (m *MockIdempotencyUsecase) PurgeExpired(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeExpired", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeExpired indicates an expected call of PurgeExpired.
+func (mr *MockIdempotencyUsecaseMockRecorder) PurgeExpired(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeExpired", reflect.TypeOf((*MockIdempotencyUsecase)(nil).PurgeExpired), ctx)
+}
+
+// MockIdempotencyRepository is a mock of IdempotencyRepository interface.
+type MockIdempotencyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyRepositoryMockRecorder
+}
+
+// MockIdempotencyRepositoryMockRecorder is the mock recorder for MockIdempotencyRepository.
+type MockIdempotencyRepositoryMockRecorder struct {
+	mock *MockIdempotencyRepository
+}
+
+// NewMockIdempotencyRepository creates a new mock instance.
+func NewMockIdempotencyRepository(ctrl *gomock.Controller) *MockIdempotencyRepository {
+	mock := &MockIdempotencyRepository{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotencyRepository) EXPECT() *MockIdempotencyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CompleteIdempotencyRecord mocks base method.
+func (m *MockIdempotencyRepository) CompleteIdempotencyRecord(ctx context.Context, tenantID uuid.UUID, key string, statusCode int, responseBody []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteIdempotencyRecord", ctx, tenantID, key, statusCode, responseBody)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteIdempotencyRecord indicates an expected call of CompleteIdempotencyRecord.
+func (mr *MockIdempotencyRepositoryMockRecorder) CompleteIdempotencyRecord(ctx, tenantID, key, statusCode, responseBody any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteIdempotencyRecord", reflect.TypeOf((*MockIdempotencyRepository)(nil).CompleteIdempotencyRecord), ctx, tenantID, key, statusCode, responseBody)
+}
+
+// DeleteExpiredIdempotencyRecords mocks base method.
+func (m *MockIdempotencyRepository) DeleteExpiredIdempotencyRecords(ctx context.Context, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredIdempotencyRecords", ctx, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpiredIdempotencyRecords indicates an expected call of DeleteExpiredIdempotencyRecords.
+func (mr *MockIdempotencyRepositoryMockRecorder) DeleteExpiredIdempotencyRecords(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredIdempotencyRecords", reflect.TypeOf((*MockIdempotencyRepository)(nil).DeleteExpiredIdempotencyRecords), ctx, now)
+}
+
+// DeleteIdempotencyRecord mocks base method.
+func (m *MockIdempotencyRepository) DeleteIdempotencyRecord(ctx context.Context, tenantID uuid.UUID, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIdempotencyRecord", ctx, tenantID, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIdempotencyRecord indicates an expected call of DeleteIdempotencyRecord.
+func (mr *MockIdempotencyRepositoryMockRecorder) DeleteIdempotencyRecord(ctx, tenantID, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIdempotencyRecord", reflect.TypeOf((*MockIdempotencyRepository)(nil).DeleteIdempotencyRecord), ctx, tenantID, key)
+}
+
+// GetIdempotencyRecord mocks base method.
+func (m *MockIdempotencyRepository) GetIdempotencyRecord(ctx context.Context, tenantID uuid.UUID, key string) (*domain.IdempotencyRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIdempotencyRecord", ctx, tenantID, key)
+	ret0, _ := ret[0].(*domain.IdempotencyRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIdempotencyRecord indicates an expected call of GetIdempotencyRecord.
+func (mr *MockIdempotencyRepositoryMockRecorder) GetIdempotencyRecord(ctx, tenantID, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdempotencyRecord", reflect.TypeOf((*MockIdempotencyRepository)(nil).GetIdempotencyRecord), ctx, tenantID, key)
+}
+
+// ReserveIdempotencyKey mocks base method.
+func (m *MockIdempotencyRepository) ReserveIdempotencyKey(ctx context.Context, record *domain.IdempotencyRecord) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveIdempotencyKey", ctx, record)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveIdempotencyKey indicates an expected call of ReserveIdempotencyKey.
+func (mr *MockIdempotencyRepositoryMockRecorder) ReserveIdempotencyKey(ctx, record any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveIdempotencyKey", reflect.TypeOf((*MockIdempotencyRepository)(nil).ReserveIdempotencyKey), ctx, record)
+}
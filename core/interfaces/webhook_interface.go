@@ -0,0 +1,40 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+)
+
+type WebhookUsecase interface {
+	CreateWebhook(ctx context.Context, subscription *domain.WebhookSubscription) (*domain.WebhookSubscription, error)
+	ListWebhooks(ctx context.Context) ([]domain.WebhookSubscription, error)
+	DeleteWebhook(ctx context.Context, webhookID uuid.UUID) error
+	ListDeadLetters(ctx context.Context) ([]domain.WebhookDelivery, error)
+	RetryDeadLetter(ctx context.Context, deliveryID uuid.UUID) error
+	DispatchWebhooks(ctx context.Context) error
+	PurgeOutboxEvents(ctx context.Context) error
+}
+
+type WebhookRepository interface {
+	EstateExists(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID) (bool, error)
+	CreateWebhookSubscription(ctx context.Context, subscription *domain.WebhookSubscription) error
+	GetWebhookSubscriptions(ctx context.Context, tenantID uuid.UUID) ([]domain.WebhookSubscription, error)
+	GetAllWebhookSubscriptions(ctx context.Context) ([]domain.WebhookSubscription, error)
+	GetWebhookSubscription(ctx context.Context, tenantID uuid.UUID, webhookID uuid.UUID) (*domain.WebhookSubscription, error)
+	DeleteWebhookSubscription(ctx context.Context, tenantID uuid.UUID, webhookID uuid.UUID) (bool, error)
+	ClaimPendingOutboxEvents(ctx context.Context, now time.Time, lockedUntil time.Time, limit int) ([]domain.Event, error)
+	CreateWebhookDeliveries(ctx context.Context, processedEventIDs []uuid.UUID, deliveries []domain.WebhookDelivery) error
+	ClaimDueWebhookDeliveries(ctx context.Context, now time.Time, lockedUntil time.Time, limit int) ([]domain.WebhookDelivery, error)
+	UpdateWebhookDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error
+	GetDeadWebhookDeliveries(ctx context.Context, tenantID uuid.UUID) ([]domain.WebhookDelivery, error)
+	GetWebhookDelivery(ctx context.Context, tenantID uuid.UUID, deliveryID uuid.UUID) (*domain.WebhookDelivery, error)
+	RetryDeadWebhookDelivery(ctx context.Context, tenantID uuid.UUID, deliveryID uuid.UUID, now time.Time) (bool, error)
+	DeleteProcessedOutboxEvents(ctx context.Context, processedBefore time.Time) error
+}
+
+type WebhookSender interface {
+	Send(ctx context.Context, delivery *domain.WebhookDelivery) error
+}
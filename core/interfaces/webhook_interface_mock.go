@@ -0,0 +1,410 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: core/interfaces/webhook_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=core/interfaces/webhook_interface.go -destination=core/interfaces/webhook_interface_mock.go -package=interfaces
+//
+
+// Package interfaces is a generated GoMock package.
+package interfaces
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/SawitProRecruitment/EstateService/core/domain"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookUsecase is a mock of WebhookUsecase interface.
+type MockWebhookUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookUsecaseMockRecorder
+}
+
+// MockWebhookUsecaseMockRecorder is the mock recorder for MockWebhookUsecase.
+type MockWebhookUsecaseMockRecorder struct {
+	mock *MockWebhookUsecase
+}
+
+// NewMockWebhookUsecase creates a new mock instance.
+func NewMockWebhookUsecase(ctrl *gomock.Controller) *MockWebhookUsecase {
+	mock := &MockWebhookUsecase{ctrl: ctrl}
+	mock.recorder = &MockWebhookUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookUsecase) EXPECT() *MockWebhookUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreateWebhook mocks base method.
+func (m *MockWebhookUsecase) CreateWebhook(ctx context.Context, subscription *domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook", ctx, subscription)
+	ret0, _ := ret[0].(*domain.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockWebhookUsecaseMockRecorder) CreateWebhook(ctx, subscription any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockWebhookUsecase)(nil).CreateWebhook), ctx, subscription)
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockWebhookUsecase) DeleteWebhook(ctx context.Context, webhookID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", ctx, webhookID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockWebhookUsecaseMockRecorder) DeleteWebhook(ctx, webhookID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockWebhookUsecase)(nil).DeleteWebhook), ctx, webhookID)
+}
+
+// DispatchWebhooks mocks base method.
+func (m *MockWebhookUsecase) DispatchWebhooks(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DispatchWebhooks", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DispatchWebhooks indicates an expected call of DispatchWebhooks.
+func (mr *MockWebhookUsecaseMockRecorder) DispatchWebhooks(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispatchWebhooks", reflect.TypeOf((*MockWebhookUsecase)(nil).DispatchWebhooks), ctx)
+}
+
+// ListDeadLetters mocks base method.
+func (m *MockWebhookUsecase) ListDeadLetters(ctx context.Context) ([]domain.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeadLetters", ctx)
+	ret0, _ := ret[0].([]domain.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeadLetters indicates an expected call of ListDeadLetters.
+func (mr *MockWebhookUsecaseMockRecorder) ListDeadLetters(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetters", reflect.TypeOf((*MockWebhookUsecase)(nil).ListDeadLetters), ctx)
+}
+
+// ListWebhooks mocks base method.
+func (m *MockWebhookUsecase) ListWebhooks(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhooks", ctx)
+	ret0, _ := ret[0].([]domain.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhooks indicates an expected call of ListWebhooks.
+func (mr *MockWebhookUsecaseMockRecorder) ListWebhooks(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhooks", reflect.TypeOf((*MockWebhookUsecase)(nil).ListWebhooks), ctx)
+}
+
+// PurgeOutboxEvents mocks base method.
+func (m *MockWebhookUsecase) PurgeOutboxEvents(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeOutboxEvents", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeOutboxEvents indicates an expected call of PurgeOutboxEvents.
+func (mr *MockWebhookUsecaseMockRecorder) PurgeOutboxEvents(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeOutboxEvents", reflect.TypeOf((*MockWebhookUsecase)(nil).PurgeOutboxEvents), ctx)
+}
+
+// RetryDeadLetter mocks base method.
+func (m *MockWebhookUsecase) RetryDeadLetter(ctx context.Context, deliveryID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryDeadLetter", ctx, deliveryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetryDeadLetter indicates an expected call of RetryDeadLetter.
+func (mr *MockWebhookUsecaseMockRecorder) RetryDeadLetter(ctx, deliveryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryDeadLetter", reflect.TypeOf((*MockWebhookUsecase)(nil).RetryDeadLetter), ctx, deliveryID)
+}
+
+// MockWebhookRepository is a mock of WebhookRepository interface.
+type MockWebhookRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookRepositoryMockRecorder
+}
+
+// MockWebhookRepositoryMockRecorder is the mock recorder for MockWebhookRepository.
+type MockWebhookRepositoryMockRecorder struct {
+	mock *MockWebhookRepository
+}
+
+// NewMockWebhookRepository creates a new mock instance.
+func NewMockWebhookRepository(ctrl *gomock.Controller) *MockWebhookRepository {
+	mock := &MockWebhookRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookRepository) EXPECT() *MockWebhookRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimDueWebhookDeliveries mocks base method.
+func (m *MockWebhookRepository) ClaimDueWebhookDeliveries(ctx context.Context, now, lockedUntil time.Time, limit int) ([]domain.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDueWebhookDeliveries", ctx, now, lockedUntil, limit)
+	ret0, _ := ret[0].([]domain.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDueWebhookDeliveries indicates an expected call of ClaimDueWebhookDeliveries.
+func (mr *MockWebhookRepositoryMockRecorder) ClaimDueWebhookDeliveries(ctx, now, lockedUntil, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDueWebhookDeliveries", reflect.TypeOf((*MockWebhookRepository)(nil).ClaimDueWebhookDeliveries), ctx, now, lockedUntil, limit)
+}
+
+// ClaimPendingOutboxEvents mocks base method.
+func (m *MockWebhookRepository) ClaimPendingOutboxEvents(ctx context.Context, now, lockedUntil time.Time, limit int) ([]domain.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimPendingOutboxEvents", ctx, now, lockedUntil, limit)
+	ret0, _ := ret[0].([]domain.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimPendingOutboxEvents indicates an expected call of ClaimPendingOutboxEvents.
+func (mr *MockWebhookRepositoryMockRecorder) ClaimPendingOutboxEvents(ctx, now, lockedUntil, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimPendingOutboxEvents", reflect.TypeOf((*MockWebhookRepository)(nil).ClaimPendingOutboxEvents), ctx, now, lockedUntil, limit)
+}
+
+// CreateWebhookDeliveries mocks base method.
+func (m *MockWebhookRepository) CreateWebhookDeliveries(ctx context.Context, processedEventIDs []uuid.UUID, deliveries []domain.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookDeliveries", ctx, processedEventIDs, deliveries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWebhookDeliveries indicates an expected call of CreateWebhookDeliveries.
+func (mr *MockWebhookRepositoryMockRecorder) CreateWebhookDeliveries(ctx, processedEventIDs, deliveries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookDeliveries", reflect.TypeOf((*MockWebhookRepository)(nil).CreateWebhookDeliveries), ctx, processedEventIDs, deliveries)
+}
+
+// CreateWebhookSubscription mocks base method.
+func (m *MockWebhookRepository) CreateWebhookSubscription(ctx context.Context, subscription *domain.WebhookSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookSubscription", ctx, subscription)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWebhookSubscription indicates an expected call of CreateWebhookSubscription.
+func (mr *MockWebhookRepositoryMockRecorder) CreateWebhookSubscription(ctx, subscription any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookSubscription", reflect.TypeOf((*MockWebhookRepository)(nil).CreateWebhookSubscription), ctx, subscription)
+}
+
+// DeleteProcessedOutboxEvents mocks base method.
+func (m *MockWebhookRepository) DeleteProcessedOutboxEvents(ctx context.Context, processedBefore time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProcessedOutboxEvents", ctx, processedBefore)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProcessedOutboxEvents indicates an expected call of DeleteProcessedOutboxEvents.
+func (mr *MockWebhookRepositoryMockRecorder) DeleteProcessedOutboxEvents(ctx, processedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProcessedOutboxEvents", reflect.TypeOf((*MockWebhookRepository)(nil).DeleteProcessedOutboxEvents), ctx, processedBefore)
+}
+
+// DeleteWebhookSubscription mocks base method.
+func (m *MockWebhookRepository) DeleteWebhookSubscription(ctx context.Context, tenantID, webhookID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhookSubscription", ctx, tenantID, webhookID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWebhookSubscription indicates an expected call of DeleteWebhookSubscription.
+func (mr *MockWebhookRepositoryMockRecorder) DeleteWebhookSubscription(ctx, tenantID, webhookID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhookSubscription", reflect.TypeOf((*MockWebhookRepository)(nil).DeleteWebhookSubscription), ctx, tenantID, webhookID)
+}
+
+// EstateExists mocks base method.
+func (m *MockWebhookRepository) EstateExists(ctx context.Context, tenantID, estateID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EstateExists", ctx, tenantID, estateID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstateExists indicates an expected call of EstateExists.
+func (mr *MockWebhookRepositoryMockRecorder) EstateExists(ctx, tenantID, estateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstateExists", reflect.TypeOf((*MockWebhookRepository)(nil).EstateExists), ctx, tenantID, estateID)
+}
+
+// GetAllWebhookSubscriptions mocks base method.
+func (m *MockWebhookRepository) GetAllWebhookSubscriptions(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllWebhookSubscriptions", ctx)
+	ret0, _ := ret[0].([]domain.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllWebhookSubscriptions indicates an expected call of GetAllWebhookSubscriptions.
+func (mr *MockWebhookRepositoryMockRecorder) GetAllWebhookSubscriptions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllWebhookSubscriptions", reflect.TypeOf((*MockWebhookRepository)(nil).GetAllWebhookSubscriptions), ctx)
+}
+
+// GetDeadWebhookDeliveries mocks base method.
+func (m *MockWebhookRepository) GetDeadWebhookDeliveries(ctx context.Context, tenantID uuid.UUID) ([]domain.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadWebhookDeliveries", ctx, tenantID)
+	ret0, _ := ret[0].([]domain.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadWebhookDeliveries indicates an expected call of GetDeadWebhookDeliveries.
+func (mr *MockWebhookRepositoryMockRecorder) GetDeadWebhookDeliveries(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadWebhookDeliveries", reflect.TypeOf((*MockWebhookRepository)(nil).GetDeadWebhookDeliveries), ctx, tenantID)
+}
+
+// GetWebhookDelivery mocks base method.
+func (m *MockWebhookRepository) GetWebhookDelivery(ctx context.Context, tenantID, deliveryID uuid.UUID) (*domain.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookDelivery", ctx, tenantID, deliveryID)
+	ret0, _ := ret[0].(*domain.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookDelivery indicates an expected call of GetWebhookDelivery.
+func (mr *MockWebhookRepositoryMockRecorder) GetWebhookDelivery(ctx, tenantID, deliveryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookDelivery", reflect.TypeOf((*MockWebhookRepository)(nil).GetWebhookDelivery), ctx, tenantID, deliveryID)
+}
+
+// GetWebhookSubscription mocks base method.
+func (m *MockWebhookRepository) GetWebhookSubscription(ctx context.Context, tenantID, webhookID uuid.UUID) (*domain.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookSubscription", ctx, tenantID, webhookID)
+	ret0, _ := ret[0].(*domain.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookSubscription indicates an expected call of GetWebhookSubscription.
+func (mr *MockWebhookRepositoryMockRecorder) GetWebhookSubscription(ctx, tenantID, webhookID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookSubscription", reflect.TypeOf((*MockWebhookRepository)(nil).GetWebhookSubscription), ctx, tenantID, webhookID)
+}
+
+// GetWebhookSubscriptions mocks base method.
+func (m *MockWebhookRepository) GetWebhookSubscriptions(ctx context.Context, tenantID uuid.UUID) ([]domain.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookSubscriptions", ctx, tenantID)
+	ret0, _ := ret[0].([]domain.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookSubscriptions indicates an expected call of GetWebhookSubscriptions.
+func (mr *MockWebhookRepositoryMockRecorder) GetWebhookSubscriptions(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookSubscriptions", reflect.TypeOf((*MockWebhookRepository)(nil).GetWebhookSubscriptions), ctx, tenantID)
+}
+
+// RetryDeadWebhookDelivery mocks base method.
+func (m *MockWebhookRepository) RetryDeadWebhookDelivery(ctx context.Context, tenantID, deliveryID uuid.UUID, now time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryDeadWebhookDelivery", ctx, tenantID, deliveryID, now)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryDeadWebhookDelivery indicates an expected call of RetryDeadWebhookDelivery.
+func (mr *MockWebhookRepositoryMockRecorder) RetryDeadWebhookDelivery(ctx, tenantID, deliveryID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryDeadWebhookDelivery", reflect.TypeOf((*MockWebhookRepository)(nil).RetryDeadWebhookDelivery), ctx, tenantID, deliveryID, now)
+}
+
+// UpdateWebhookDelivery mocks base method.
+func (m *MockWebhookRepository) UpdateWebhookDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhookDelivery", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWebhookDelivery indicates an expected call of UpdateWebhookDelivery.
+func (mr *MockWebhookRepositoryMockRecorder) UpdateWebhookDelivery(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhookDelivery", reflect.TypeOf((*MockWebhookRepository)(nil).UpdateWebhookDelivery), ctx, delivery)
+}
+
+// MockWebhookSender is a mock of WebhookSender interface.
+type MockWebhookSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookSenderMockRecorder
+}
+
+// MockWebhookSenderMockRecorder is the mock recorder for MockWebhookSender.
+type MockWebhookSenderMockRecorder struct {
+	mock *MockWebhookSender
+}
+
+// NewMockWebhookSender creates a new mock instance.
+func NewMockWebhookSender(ctrl *gomock.Controller) *MockWebhookSender {
+	mock := &MockWebhookSender{ctrl: ctrl}
+	mock.recorder = &MockWebhookSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookSender) EXPECT() *MockWebhookSenderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockWebhookSender) Send(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockWebhookSenderMockRecorder) Send(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockWebhookSender)(nil).Send), ctx, delivery)
+}
@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/google/uuid"
+)
+
+type authUsecase struct {
+	apiKeyRepository interfaces.APIKeyRepository
+	now              func() time.Time
+}
+
+func NewAuthUsecase(repo interfaces.APIKeyRepository) *authUsecase {
+	return &authUsecase{
+		apiKeyRepository: repo,
+		now:              time.Now,
+	}
+}
+
+// Authenticate resolve raw api key into principal, unknown and revoked keys are unauthenticated
+func (a *authUsecase) Authenticate(ctx context.Context, rawKey string) (*domain.Principal, error) {
+	if rawKey == "" {
+		return nil, domain.ErrorUnauthenticated
+	}
+
+	key, err := a.apiKeyRepository.GetAPIKeyByHash(ctx, domain.HashAPIKey(rawKey))
+	if err != nil {
+		return nil, err
+	}
+
+	if key == nil || key.RevokedAt != nil {
+		return nil, domain.ErrorUnauthenticated
+	}
+
+	return &domain.Principal{
+		KeyID:    key.ID,
+		TenantID: key.TenantID,
+		Role:     key.Role,
+		EstateID: key.EstateID,
+	}, nil
+}
+
+// CreateAPIKey create api key in the caller tenant with role on one estate or all estates when estateID is nil.
+// Caller must be admin of the same scope, the raw key is returned once and only its hash is stored
+func (a *authUsecase) CreateAPIKey(ctx context.Context, name string, role domain.Role, estateID *uuid.UUID) (*domain.APIKey, string, error) {
+	principal, err := domain.Authorize(ctx, domain.RoleAdmin, estateID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if !role.IsValid() {
+		return nil, "", domain.ErrorInvalidRole
+	}
+
+	rawKey, err := domain.GenerateAPIKey()
+	if err != nil {
+		return nil, "", err
+	}
+
+	key := a.newAPIKey(principal.TenantID, name, rawKey, role, estateID)
+	err = a.apiKeyRepository.CreateAPIKey(ctx, key)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return key, rawKey, nil
+}
+
+// ListAPIKeys list api keys the caller is admin of
+func (a *authUsecase) ListAPIKeys(ctx context.Context) ([]domain.APIKey, error) {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrorUnauthenticated
+	}
+	if !principal.Can(domain.RoleAdmin, principal.EstateID) {
+		return nil, domain.ErrorForbidden
+	}
+
+	keys, err := a.apiKeyRepository.GetAPIKeys(ctx, principal.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []domain.APIKey{}
+	for _, key := range keys {
+		if principal.Can(domain.RoleAdmin, key.EstateID) {
+			result = append(result, key)
+		}
+	}
+	return result, nil
+}
+
+// RevokeAPIKey revoke api key, caller must be admin of the key scope
+func (a *authUsecase) RevokeAPIKey(ctx context.Context, keyID uuid.UUID) error {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return domain.ErrorUnauthenticated
+	}
+
+	key, err := a.apiKeyRepository.GetAPIKey(ctx, principal.TenantID, keyID)
+	if err != nil {
+		return err
+	}
+
+	if key == nil {
+		return domain.ErrorAPIKeyNotFound
+	}
+
+	if !principal.Can(domain.RoleAdmin, key.EstateID) {
+		return domain.ErrorForbidden
+	}
+
+	revoked, err := a.apiKeyRepository.RevokeAPIKey(ctx, principal.TenantID, keyID, a.now().UTC())
+	if err != nil {
+		return err
+	}
+
+	if !revoked {
+		return domain.ErrorAPIKeyNotFound
+	}
+
+	return nil
+}
+
+// EnsureAPIKey store operator provided key for all estates of the tenant if it does not exist yet,
+// used to bootstrap the first admin of a tenant
+func (a *authUsecase) EnsureAPIKey(ctx context.Context, tenantID uuid.UUID, name string, rawKey string, role domain.Role) error {
+	if !role.IsValid() {
+		return domain.ErrorInvalidRole
+	}
+
+	return a.apiKeyRepository.CreateAPIKey(ctx, a.newAPIKey(tenantID, name, rawKey, role, nil))
+}
+
+func (a *authUsecase) newAPIKey(tenantID uuid.UUID, name string, rawKey string, role domain.Role, estateID *uuid.UUID) *domain.APIKey {
+	return &domain.APIKey{
+		ID:        uuid.New(),
+		TenantID:  tenantID,
+		Name:      name,
+		Prefix:    domain.APIKeyPrefix(rawKey),
+		KeyHash:   domain.HashAPIKey(rawKey),
+		Role:      role,
+		EstateID:  estateID,
+		CreatedAt: a.now().UTC(),
+	}
+}
@@ -0,0 +1,229 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// testTenantID is the tenant of principals used in usecase tests
+var testTenantID = uuid.New()
+
+// adminContext return context of an admin of all estates of testTenantID
+func adminContext() context.Context {
+	return domain.WithPrincipal(context.Background(), &domain.Principal{TenantID: testTenantID, Role: domain.RoleAdmin})
+}
+
+func Test_authUsecase_Authenticate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := interfaces.NewMockAPIKeyRepository(ctrl)
+	u := NewAuthUsecase(repo)
+	ctx := context.Background()
+
+	estateID := uuid.New()
+	keyID := uuid.New()
+	revokedAt := time.Now()
+
+	tests := []struct {
+		name   string
+		rawKey string
+		mock   func()
+		expect func() (*domain.Principal, error)
+	}{
+		{
+			name:   "Valid key",
+			rawKey: "est_valid",
+			mock: func() {
+				repo.EXPECT().GetAPIKeyByHash(ctx, domain.HashAPIKey("est_valid")).Return(&domain.APIKey{ID: keyID, TenantID: testTenantID, Role: domain.RolePlanter, EstateID: &estateID}, nil)
+			},
+			expect: func() (*domain.Principal, error) {
+				return &domain.Principal{KeyID: keyID, TenantID: testTenantID, Role: domain.RolePlanter, EstateID: &estateID}, nil
+			},
+		},
+		{
+			name:   "Empty key",
+			rawKey: "",
+			mock:   func() {},
+			expect: func() (*domain.Principal, error) {
+				return nil, domain.ErrorUnauthenticated
+			},
+		},
+		{
+			name:   "Unknown key",
+			rawKey: "est_unknown",
+			mock: func() {
+				repo.EXPECT().GetAPIKeyByHash(ctx, gomock.Any()).Return(nil, nil)
+			},
+			expect: func() (*domain.Principal, error) {
+				return nil, domain.ErrorUnauthenticated
+			},
+		},
+		{
+			name:   "Revoked key",
+			rawKey: "est_revoked",
+			mock: func() {
+				repo.EXPECT().GetAPIKeyByHash(ctx, gomock.Any()).Return(&domain.APIKey{ID: keyID, Role: domain.RoleAdmin, RevokedAt: &revokedAt}, nil)
+			},
+			expect: func() (*domain.Principal, error) {
+				return nil, domain.ErrorUnauthenticated
+			},
+		},
+		{
+			name:   "Repository error",
+			rawKey: "est_valid",
+			mock: func() {
+				repo.EXPECT().GetAPIKeyByHash(ctx, gomock.Any()).Return(nil, errors.New("repo error"))
+			},
+			expect: func() (*domain.Principal, error) {
+				return nil, errors.New("repo error")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			got, err := u.Authenticate(ctx, tt.rawKey)
+			gotExpect, errExpect := tt.expect()
+			assert.Equal(t, gotExpect, got)
+			assert.Equal(t, errExpect, err)
+		})
+	}
+}
+
+func Test_authUsecase_CreateAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := interfaces.NewMockAPIKeyRepository(ctrl)
+	u := NewAuthUsecase(repo)
+
+	estateID := uuid.New()
+	otherEstateID := uuid.New()
+	estateAdmin := domain.WithPrincipal(context.Background(), &domain.Principal{TenantID: testTenantID, Role: domain.RoleAdmin, EstateID: &estateID})
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		role      domain.Role
+		estateID  *uuid.UUID
+		mock      func()
+		wantError error
+	}{
+		{
+			name:     "Global admin create key for all estates",
+			ctx:      adminContext(),
+			role:     domain.RoleViewer,
+			estateID: nil,
+			mock: func() {
+				repo.EXPECT().CreateAPIKey(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:     "Estate admin create key for own estate",
+			ctx:      estateAdmin,
+			role:     domain.RolePlanter,
+			estateID: &estateID,
+			mock: func() {
+				repo.EXPECT().CreateAPIKey(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:      "Estate admin create key for other estate",
+			ctx:       estateAdmin,
+			role:      domain.RolePlanter,
+			estateID:  &otherEstateID,
+			mock:      func() {},
+			wantError: domain.ErrorForbidden,
+		},
+		{
+			name:      "Invalid role",
+			ctx:       adminContext(),
+			role:      domain.Role("owner"),
+			mock:      func() {},
+			wantError: domain.ErrorInvalidRole,
+		},
+		{
+			name:      "Unauthenticated",
+			ctx:       context.Background(),
+			role:      domain.RoleViewer,
+			mock:      func() {},
+			wantError: domain.ErrorUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			key, rawKey, err := u.CreateAPIKey(tt.ctx, "tablet", tt.role, tt.estateID)
+			assert.Equal(t, tt.wantError, err)
+			if tt.wantError == nil {
+				assert.Equal(t, domain.HashAPIKey(rawKey), key.KeyHash)
+				assert.Equal(t, testTenantID, key.TenantID)
+				assert.Equal(t, tt.role, key.Role)
+				assert.Equal(t, tt.estateID, key.EstateID)
+			}
+		})
+	}
+}
+
+func Test_authUsecase_ListAndRevokeAPIKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := interfaces.NewMockAPIKeyRepository(ctrl)
+	u := NewAuthUsecase(repo)
+
+	estateID := uuid.New()
+	estateAdmin := domain.WithPrincipal(context.Background(), &domain.Principal{TenantID: testTenantID, Role: domain.RoleAdmin, EstateID: &estateID})
+	globalKey := domain.APIKey{ID: uuid.New(), TenantID: testTenantID, Role: domain.RoleAdmin}
+	estateKey := domain.APIKey{ID: uuid.New(), TenantID: testTenantID, Role: domain.RoleViewer, EstateID: &estateID}
+
+	repo.EXPECT().GetAPIKeys(estateAdmin, testTenantID).Return([]domain.APIKey{globalKey, estateKey}, nil)
+	keys, err := u.ListAPIKeys(estateAdmin)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.APIKey{estateKey}, keys)
+
+	repo.EXPECT().GetAPIKey(estateAdmin, testTenantID, globalKey.ID).Return(&globalKey, nil)
+	assert.Equal(t, domain.ErrorForbidden, u.RevokeAPIKey(estateAdmin, globalKey.ID))
+
+	repo.EXPECT().GetAPIKey(estateAdmin, testTenantID, estateKey.ID).Return(&estateKey, nil)
+	repo.EXPECT().RevokeAPIKey(estateAdmin, testTenantID, estateKey.ID, gomock.Any()).Return(true, nil)
+	assert.NoError(t, u.RevokeAPIKey(estateAdmin, estateKey.ID))
+
+	// keys of other tenants are not found by the tenant scoped lookup
+	repo.EXPECT().GetAPIKey(estateAdmin, testTenantID, gomock.Any()).Return(nil, nil)
+	assert.Equal(t, domain.ErrorAPIKeyNotFound, u.RevokeAPIKey(estateAdmin, uuid.New()))
+
+	assert.Equal(t, domain.ErrorUnauthenticated, u.RevokeAPIKey(context.Background(), estateKey.ID))
+}
+
+func Test_authUsecase_EnsureAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := interfaces.NewMockAPIKeyRepository(ctrl)
+	u := NewAuthUsecase(repo)
+	ctx := context.Background()
+
+	repo.EXPECT().CreateAPIKey(ctx, gomock.Any()).Do(func(_ context.Context, key *domain.APIKey) {
+		assert.Equal(t, testTenantID, key.TenantID)
+		assert.Equal(t, domain.HashAPIKey("est_bootstrap"), key.KeyHash)
+		assert.Equal(t, domain.RoleAdmin, key.Role)
+		assert.Nil(t, key.EstateID)
+	}).Return(nil)
+
+	assert.NoError(t, u.EnsureAPIKey(ctx, testTenantID, "bootstrap", "est_bootstrap", domain.RoleAdmin))
+	assert.Equal(t, domain.ErrorInvalidRole, u.EnsureAPIKey(ctx, testTenantID, "bootstrap", "est_bootstrap", domain.Role("root")))
+}
@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/google/uuid"
+)
+
+// droneUsecase manage the drone fleet of a tenant, drones are not bound to an estate
+type droneUsecase struct {
+	droneRepository interfaces.DroneRepository
+}
+
+func NewDroneUsecase(repo interfaces.DroneRepository) *droneUsecase {
+	return &droneUsecase{
+		droneRepository: repo,
+	}
+}
+
+// CreateDrone add a drone to the fleet of the caller tenant, it starts available
+func (d *droneUsecase) CreateDrone(ctx context.Context, drone *domain.Drone) (*domain.Drone, error) {
+	principal, err := domain.Authorize(ctx, domain.RoleAdmin, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := domain.NewDrone(principal.TenantID, drone.Name, drone.Model, drone.MaxRange, drone.MaxAltitude, drone.Speed, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.droneRepository.CreateDrone(ctx, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ListDrones list a page of drones of the caller tenant fleet oldest first, of every status when status is nil
+func (d *droneUsecase) ListDrones(ctx context.Context, status *domain.DroneStatus, limit int, offset int) ([]domain.Drone, error) {
+	principal, err := domain.Authorize(ctx, domain.RoleViewer, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if status != nil {
+		err = status.Validate()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return d.droneRepository.ListDrones(ctx, principal.TenantID, status, limit, offset)
+}
+
+// GetDrone get a drone of the caller tenant fleet
+func (d *droneUsecase) GetDrone(ctx context.Context, droneID uuid.UUID) (*domain.Drone, error) {
+	principal, err := domain.Authorize(ctx, domain.RoleViewer, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	drone, err := d.droneRepository.GetDrone(ctx, principal.TenantID, droneID)
+	if err != nil {
+		return nil, err
+	}
+
+	if drone == nil {
+		return nil, domain.ErrorDroneNotFound
+	}
+
+	return drone, nil
+}
+
+// UpdateDrone change the specification or the status of a drone of the caller tenant fleet,
+// missions already planned for it keep their waypoints
+func (d *droneUsecase) UpdateDrone(ctx context.Context, droneID uuid.UUID, update domain.DroneUpdate) (*domain.Drone, error) {
+	principal, err := domain.Authorize(ctx, domain.RoleAdmin, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	drone, err := d.droneRepository.GetDrone(ctx, principal.TenantID, droneID)
+	if err != nil {
+		return nil, err
+	}
+
+	if drone == nil {
+		return nil, domain.ErrorDroneNotFound
+	}
+
+	err = drone.Apply(update, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.droneRepository.UpdateDrone(ctx, drone)
+	if err != nil {
+		return nil, err
+	}
+
+	return drone, nil
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_droneUsecase_CreateDrone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockDroneRepository(ctrl)
+	d := NewDroneUsecase(mockRepo)
+	drone := &domain.Drone{Name: " drone-7 ", Model: "T40", MaxRange: 2000, MaxAltitude: 30, Speed: domain.DroneSpeed{Cruise: 10, Climb: 4}}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo.EXPECT().CreateDrone(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, created *domain.Drone) error {
+				assert.Equal(t, testTenantID, created.TenantID)
+				return nil
+			})
+
+		got, err := d.CreateDrone(adminContext(), drone)
+		assert.NoError(t, err)
+		assert.NotEqual(t, uuid.Nil, got.ID)
+		assert.Equal(t, "drone-7", got.Name)
+		assert.Equal(t, domain.DroneAvailable, got.Status)
+	})
+
+	t.Run("Invalid drone", func(t *testing.T) {
+		invalid := *drone
+		invalid.MaxRange = 0
+
+		_, err := d.CreateDrone(adminContext(), &invalid)
+		assert.ErrorIs(t, err, domain.ErrorDroneInvalid)
+	})
+
+	t.Run("Viewer is forbidden", func(t *testing.T) {
+		viewer := domain.WithPrincipal(context.Background(), &domain.Principal{TenantID: testTenantID, Role: domain.RoleViewer})
+
+		_, err := d.CreateDrone(viewer, drone)
+		assert.ErrorIs(t, err, domain.ErrorForbidden)
+	})
+}
+
+func Test_droneUsecase_UpdateDrone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockDroneRepository(ctrl)
+	d := NewDroneUsecase(mockRepo)
+	droneID := uuid.New()
+	newDrone := func(status domain.DroneStatus) *domain.Drone {
+		return &domain.Drone{ID: droneID, Name: "drone-7", Model: "T40", MaxRange: 2000, MaxAltitude: 30,
+			Speed: domain.DroneSpeed{Cruise: 10, Climb: 4}, Status: status}
+	}
+	maintenance := domain.DroneMaintenance
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo.EXPECT().GetDrone(gomock.Any(), testTenantID, droneID).Return(newDrone(domain.DroneAvailable), nil)
+		mockRepo.EXPECT().UpdateDrone(gomock.Any(), gomock.Any()).Return(nil)
+
+		got, err := d.UpdateDrone(adminContext(), droneID, domain.DroneUpdate{Status: &maintenance})
+		assert.NoError(t, err)
+		assert.Equal(t, domain.DroneMaintenance, got.Status)
+	})
+
+	t.Run("Retired drone", func(t *testing.T) {
+		mockRepo.EXPECT().GetDrone(gomock.Any(), testTenantID, droneID).Return(newDrone(domain.DroneRetired), nil)
+
+		_, err := d.UpdateDrone(adminContext(), droneID, domain.DroneUpdate{Status: &maintenance})
+		assert.Equal(t, domain.ErrorDroneRetired, err)
+	})
+
+	t.Run("Drone not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetDrone(gomock.Any(), testTenantID, droneID).Return(nil, nil)
+
+		_, err := d.UpdateDrone(adminContext(), droneID, domain.DroneUpdate{Status: &maintenance})
+		assert.Equal(t, domain.ErrorDroneNotFound, err)
+	})
+}
@@ -2,6 +2,8 @@ package usecase
 
 import (
 	"context"
+	"slices"
+	"time"
 
 	"github.com/SawitProRecruitment/EstateService/core/domain"
 	"github.com/SawitProRecruitment/EstateService/core/interfaces"
@@ -10,40 +12,83 @@ import (
 
 type estateUsecase struct {
 	estateRepository interfaces.EstateRepository
+	droneRepository  interfaces.DroneRepository
+	eventBroker      interfaces.EventBroker
 }
 
-func NewEstateUsecase(repo interfaces.EstateRepository) *estateUsecase {
-	return &estateUsecase{
+type EstateUsecaseOptions func(*estateUsecase)
+
+// WithEventBroker publish estate events to broker after every successful write
+func WithEventBroker(broker interfaces.EventBroker) EstateUsecaseOptions {
+	return func(e *estateUsecase) {
+		e.eventBroker = broker
+	}
+}
+
+// WithDroneRepository read fleet drones from repo for the drone plans, missions and sorties flown with them,
+// every fleet drone is not found without it
+func WithDroneRepository(repo interfaces.DroneRepository) EstateUsecaseOptions {
+	return func(e *estateUsecase) {
+		e.droneRepository = repo
+	}
+}
+
+func NewEstateUsecase(repo interfaces.EstateRepository, opts ...EstateUsecaseOptions) *estateUsecase {
+	estateUsecase := &estateUsecase{
 		estateRepository: repo,
 	}
+	for _, opt := range opts {
+		opt(estateUsecase)
+	}
+	return estateUsecase
 }
 
-// CreateEstate create estate and initialize drone routes that are covering all state with altitude 1
+// CreateEstate create estate in the caller tenant and initialize drone routes that are covering all state with altitude 1
 func (e *estateUsecase) CreateEstate(ctx context.Context, width int, length int) (*domain.Estate, error) {
+	principal, err := domain.Authorize(ctx, domain.RoleAdmin, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	estate := &domain.Estate{
-		ID:     uuid.New(),
-		Width:  width,
-		Length: length,
+		ID:       uuid.New(),
+		TenantID: principal.TenantID,
+		Width:    width,
+		Length:   length,
+		Version:  domain.InitialVersion,
 	}
 
 	droneRoutes := domain.DroneZigzagTraverse(width, length)
-	err := e.estateRepository.CreateEstateAndDroneRoute(ctx, estate, droneRoutes)
+	event := domain.NewEvent(domain.EventEstateCreated, estate.TenantID, estate.ID, domain.EstateEventData{
+		Width:  estate.Width,
+		Length: estate.Length,
+	})
+
+	err = e.estateRepository.CreateEstateAndDroneRoute(ctx, estate, droneRoutes, []domain.Event{event})
 	if err != nil {
 		return nil, err
 	}
 
+	e.publish(ctx, event)
+
 	return estate, nil
 }
 
 // CreateTree create tree and adjust drone routes altitude to cover tree height
 func (e *estateUsecase) CreateTree(ctx context.Context, estateID uuid.UUID, plot domain.Plot, height int) (*domain.Tree, error) {
+	principal, err := domain.Authorize(ctx, domain.RolePlanter, &estateID)
+	if err != nil {
+		return nil, err
+	}
+
 	tree := &domain.Tree{
-		ID:     uuid.New(),
-		Plot:   plot,
-		Height: height,
+		ID:      uuid.New(),
+		Plot:    plot,
+		Height:  height,
+		Version: domain.InitialVersion,
 	}
 
-	estate, existingTree, err := e.estateRepository.GetEstateAndTree(ctx, estateID, plot)
+	estate, existingTree, err := e.estateRepository.GetEstateAndTree(ctx, principal.TenantID, estateID, plot)
 	if err != nil {
 		return nil, err
 	}
@@ -52,6 +97,7 @@ func (e *estateUsecase) CreateTree(ctx context.Context, estateID uuid.UUID, plot
 		return nil, domain.ErrorEstatesNotFound
 	}
 
+	// fast path only, concurrent plantings on the same plot are rejected by the repository with the same error
 	if existingTree != nil && existingTree.ID != uuid.Nil {
 		return nil, domain.ErrorTreeAlreadyExists
 	}
@@ -60,17 +106,197 @@ func (e *estateUsecase) CreateTree(ctx context.Context, estateID uuid.UUID, plot
 		return nil, domain.ErrorTreePlotOutOfBound
 	}
 
-	err = e.estateRepository.CreateTreeAndUpdateDroneRoute(ctx, estateID, tree.Height+1, tree)
+	event := domain.NewEvent(domain.EventTreePlanted, principal.TenantID, estateID, domain.TreeEventData{
+		TreeID: tree.ID,
+		Plot:   tree.Plot,
+		Height: tree.Height,
+	})
+
+	// event is written into outbox in the same transaction, so webhooks are only sent for committed trees
+	distanceChange, err := e.estateRepository.CreateTreeAndUpdateDroneRoute(ctx, principal.TenantID, estateID, tree.DroneAltitude(), tree, []domain.Event{event})
+	if err != nil {
+		return nil, err
+	}
+
+	e.publish(ctx, event)
+	e.publishDroneDistance(ctx, principal.TenantID, estateID, distanceChange)
+
+	return tree, nil
+}
+
+// GetEstate get estate of the caller tenant, its version is used for optimistic concurrency of updates
+func (e *estateUsecase) GetEstate(ctx context.Context, estateID uuid.UUID) (*domain.Estate, error) {
+	principal, err := domain.Authorize(ctx, domain.RoleViewer, &estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	estate, _, err := e.estateRepository.GetEstateAndStats(ctx, principal.TenantID, estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if estate == nil {
+		return nil, domain.ErrorEstatesNotFound
+	}
+
+	return estate, nil
+}
+
+// ListEstates list a page of estates of the caller tenant, it requires a key valid for all estates
+func (e *estateUsecase) ListEstates(ctx context.Context, limit int, offset int) ([]domain.Estate, error) {
+	principal, err := domain.Authorize(ctx, domain.RoleViewer, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.estateRepository.ListEstates(ctx, principal.TenantID, limit, offset)
+}
+
+// DeleteEstate delete estate of the caller tenant together with its trees and drone routes
+func (e *estateUsecase) DeleteEstate(ctx context.Context, estateID uuid.UUID) error {
+	principal, err := domain.Authorize(ctx, domain.RoleAdmin, &estateID)
+	if err != nil {
+		return err
+	}
+
+	event := domain.NewEvent(domain.EventEstateDeleted, principal.TenantID, estateID, nil)
+
+	err = e.estateRepository.DeleteEstate(ctx, principal.TenantID, estateID, []domain.Event{event})
+	if err != nil {
+		return err
+	}
+
+	e.publish(ctx, event)
+
+	return nil
+}
+
+// ResizeEstate change estate width and length when it is still at expectedVersion.
+// Drone routes are rebuilt for the new size, trees outside of the new size are rejected by the repository
+func (e *estateUsecase) ResizeEstate(ctx context.Context, estateID uuid.UUID, width int, length int, expectedVersion int) (*domain.Estate, error) {
+	principal, err := domain.Authorize(ctx, domain.RoleAdmin, &estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	estate, _, err := e.estateRepository.GetEstateAndStats(ctx, principal.TenantID, estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if estate == nil {
+		return nil, domain.ErrorEstatesNotFound
+	}
+
+	if estate.Version != expectedVersion {
+		return nil, domain.ErrorVersionMismatch
+	}
+
+	estate.Width = width
+	estate.Length = length
+	event := domain.NewEvent(domain.EventEstateResized, principal.TenantID, estateID, domain.EstateEventData{
+		Width:  estate.Width,
+		Length: estate.Length,
+	})
+
+	distanceChange, err := e.estateRepository.ResizeEstateAndDroneRoute(ctx, principal.TenantID, estate, expectedVersion, domain.DroneZigzagTraverse(width, length), []domain.Event{event})
+	if err != nil {
+		return nil, err
+	}
+	estate.Version = expectedVersion + 1
+
+	e.publish(ctx, event)
+	e.publishDroneDistance(ctx, principal.TenantID, estateID, distanceChange)
+
+	return estate, nil
+}
+
+// GetTree get tree of an estate of the caller tenant
+func (e *estateUsecase) GetTree(ctx context.Context, estateID uuid.UUID, treeID uuid.UUID) (*domain.Tree, error) {
+	principal, err := domain.Authorize(ctx, domain.RoleViewer, &estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := e.estateRepository.GetTree(ctx, principal.TenantID, estateID, treeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if tree == nil {
+		return nil, domain.ErrorTreeNotFound
+	}
+
+	return tree, nil
+}
+
+// ListTrees list trees of an estate of the caller tenant ordered by plot
+func (e *estateUsecase) ListTrees(ctx context.Context, estateID uuid.UUID) ([]domain.Tree, error) {
+	principal, err := domain.Authorize(ctx, domain.RoleViewer, &estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	estate, _, err := e.estateRepository.GetEstateAndStats(ctx, principal.TenantID, estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if estate == nil {
+		return nil, domain.ErrorEstatesNotFound
+	}
+
+	return e.estateRepository.ListTrees(ctx, principal.TenantID, estateID)
+}
+
+// UpdateTreeHeight change tree height when it is still at expectedVersion and adjust drone route altitude over it
+func (e *estateUsecase) UpdateTreeHeight(ctx context.Context, estateID uuid.UUID, treeID uuid.UUID, height int, expectedVersion int) (*domain.Tree, error) {
+	principal, err := domain.Authorize(ctx, domain.RolePlanter, &estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := e.estateRepository.GetTree(ctx, principal.TenantID, estateID, treeID)
 	if err != nil {
 		return nil, err
 	}
 
+	if tree == nil {
+		return nil, domain.ErrorTreeNotFound
+	}
+
+	if tree.Version != expectedVersion {
+		return nil, domain.ErrorVersionMismatch
+	}
+
+	tree.Height = height
+	event := domain.NewEvent(domain.EventTreeUpdated, principal.TenantID, estateID, domain.TreeEventData{
+		TreeID: tree.ID,
+		Plot:   tree.Plot,
+		Height: tree.Height,
+	})
+
+	distanceChange, err := e.estateRepository.UpdateTreeAndDroneRoute(ctx, principal.TenantID, estateID, tree, expectedVersion, []domain.Event{event})
+	if err != nil {
+		return nil, err
+	}
+	tree.Version = expectedVersion + 1
+
+	e.publish(ctx, event)
+	e.publishDroneDistance(ctx, principal.TenantID, estateID, distanceChange)
+
 	return tree, nil
 }
 
 // GetEstateStats get estate stats of count, min, max and median of all tree
 func (e *estateUsecase) GetEstateStats(ctx context.Context, estateID uuid.UUID) (*domain.EstateStats, error) {
-	estate, stats, err := e.estateRepository.GetEstateAndStats(ctx, estateID)
+	principal, err := domain.Authorize(ctx, domain.RoleViewer, &estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	estate, stats, err := e.estateRepository.GetEstateAndStats(ctx, principal.TenantID, estateID)
 	if err != nil {
 		return nil, err
 	}
@@ -86,9 +312,58 @@ func (e *estateUsecase) GetEstateStats(ctx context.Context, estateID uuid.UUID)
 	return stats, nil
 }
 
-// GetDroneDistance get drone total distance to cover all estates plot
-func (e *estateUsecase) GetDroneDistance(ctx context.Context, estateID uuid.UUID, maxDistance *int) (*domain.DroneDistance, error) {
-	droneRoutes, err := e.estateRepository.GetDroneRoutes(ctx, estateID)
+// GetDroneDistance get drone total distance to cover all estates plot, with the plot the drone rests on
+// when maxDistance is given. When droneID is given the max distance is its range and its flight time is included
+func (e *estateUsecase) GetDroneDistance(ctx context.Context, estateID uuid.UUID, maxDistance *int, droneID *uuid.UUID) (*domain.DroneDistance, error) {
+	principal, err := domain.Authorize(ctx, domain.RoleViewer, &estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxDistance != nil && droneID != nil {
+		return nil, domain.ErrorMaxDistanceWithDrone
+	}
+
+	droneRoutes, err := e.estateRepository.GetDroneRoutes(ctx, principal.TenantID, estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if droneRoutes == nil {
+		return nil, domain.ErrorEstatesNotFound
+	}
+
+	var flightTime *time.Duration
+	if droneID != nil {
+		drone, err := e.getFlyingDrone(ctx, principal.TenantID, *droneID, droneRoutes)
+		if err != nil {
+			return nil, err
+		}
+		maxDistance = &drone.MaxRange
+		flown := drone.FlightTime(droneRoutes)
+		flightTime = &flown
+	}
+
+	distance := &domain.DroneDistance{
+		Distance:   domain.DroneTotalDistance(maxDistance, droneRoutes),
+		FlightTime: flightTime,
+	}
+	if maxDistance != nil {
+		rest := domain.DroneRestPlot(*maxDistance, droneRoutes)
+		distance.Rest = &rest
+	}
+
+	return distance, nil
+}
+
+// GetDroneWaypoints get the waypoints of the drone route over every estate plot in flight order
+func (e *estateUsecase) GetDroneWaypoints(ctx context.Context, estateID uuid.UUID) ([]domain.DroneWaypoint, error) {
+	principal, err := domain.Authorize(ctx, domain.RoleViewer, &estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	droneRoutes, err := e.estateRepository.GetDroneRoutes(ctx, principal.TenantID, estateID)
 	if err != nil {
 		return nil, err
 	}
@@ -97,9 +372,804 @@ func (e *estateUsecase) GetDroneDistance(ctx context.Context, estateID uuid.UUID
 		return nil, domain.ErrorEstatesNotFound
 	}
 
-	distance := domain.DroneTotalDistance(maxDistance, droneRoutes)
+	return domain.DroneWaypoints(droneRoutes), nil
+}
+
+// GetDroneAltitudeProfile get the altitude of the drone over the horizontal distance flown, from takeoff to landing
+func (e *estateUsecase) GetDroneAltitudeProfile(ctx context.Context, estateID uuid.UUID) (*domain.AltitudeProfile, error) {
+	principal, err := domain.Authorize(ctx, domain.RoleViewer, &estateID)
+	if err != nil {
+		return nil, err
+	}
 
-	return &domain.DroneDistance{
-		Distance: distance,
-	}, nil
+	droneRoutes, err := e.estateRepository.GetDroneRoutes(ctx, principal.TenantID, estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if droneRoutes == nil {
+		return nil, domain.ErrorEstatesNotFound
+	}
+
+	profile := domain.DroneAltitudeProfile(droneRoutes)
+	return &profile, nil
+}
+
+// GetDronePlan get the estate, its trees and drone routes to draw its drone plan,
+// with the plot the drone rests on when maxDistance is given, or the range of the drone when droneID is given
+func (e *estateUsecase) GetDronePlan(ctx context.Context, estateID uuid.UUID, maxDistance *int, droneID *uuid.UUID) (*domain.DronePlan, error) {
+	principal, err := domain.Authorize(ctx, domain.RoleViewer, &estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxDistance != nil && droneID != nil {
+		return nil, domain.ErrorMaxDistanceWithDrone
+	}
+
+	estate, _, err := e.estateRepository.GetEstateAndStats(ctx, principal.TenantID, estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if estate == nil {
+		return nil, domain.ErrorEstatesNotFound
+	}
+
+	if estate.Width*estate.Length > domain.MaxRenderPlots {
+		return nil, domain.ErrorDronePlanTooLarge
+	}
+
+	trees, err := e.estateRepository.ListTrees(ctx, principal.TenantID, estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	droneRoutes, err := e.estateRepository.GetDroneRoutes(ctx, principal.TenantID, estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if droneID != nil {
+		drone, err := e.getFlyingDrone(ctx, principal.TenantID, *droneID, droneRoutes)
+		if err != nil {
+			return nil, err
+		}
+		maxDistance = &drone.MaxRange
+	}
+
+	plan := &domain.DronePlan{Estate: *estate, Trees: trees, Routes: droneRoutes}
+	if maxDistance != nil {
+		rest := domain.DroneRestPlot(*maxDistance, droneRoutes)
+		plan.Rest = &rest
+	}
+	return plan, nil
+}
+
+// SubscribeEstateEvents subscribe to estate events, resuming after lastEventID when it is given
+func (e *estateUsecase) SubscribeEstateEvents(ctx context.Context, estateID uuid.UUID, lastEventID string) (<-chan domain.Event, error) {
+	principal, err := domain.Authorize(ctx, domain.RoleViewer, &estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.eventBroker == nil {
+		return nil, domain.ErrorEventStreamUnavailable
+	}
+
+	estate, _, err := e.estateRepository.GetEstateAndStats(ctx, principal.TenantID, estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if estate == nil {
+		return nil, domain.ErrorEstatesNotFound
+	}
+
+	return e.eventBroker.Subscribe(ctx, estateID, lastEventID)
+}
+
+// ListTreesByEstates list trees of many estates of the caller tenant in one repository call, ordered by plot.
+// Unknown estates and estates without trees are absent from the result
+func (e *estateUsecase) ListTreesByEstates(ctx context.Context, estateIDs []uuid.UUID) (map[uuid.UUID][]domain.Tree, error) {
+	principal, err := authorizeEstates(ctx, domain.RoleViewer, estateIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.estateRepository.ListTreesByEstates(ctx, principal.TenantID, estateIDs)
+}
+
+// GetEstatesStats get stats of many estates of the caller tenant in one repository call,
+// estates without trees have zero stats and unknown estates are absent from the result
+func (e *estateUsecase) GetEstatesStats(ctx context.Context, estateIDs []uuid.UUID) (map[uuid.UUID]domain.EstateStats, error) {
+	principal, err := authorizeEstates(ctx, domain.RoleViewer, estateIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.estateRepository.GetEstatesStats(ctx, principal.TenantID, estateIDs)
+}
+
+// GetDroneDistances get drone total distance of many estates of the caller tenant in one repository call,
+// unknown estates are absent from the result
+func (e *estateUsecase) GetDroneDistances(ctx context.Context, estateIDs []uuid.UUID, maxDistance *int) (map[uuid.UUID]domain.DroneDistance, error) {
+	principal, err := authorizeEstates(ctx, domain.RoleViewer, estateIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	droneRoutes, err := e.estateRepository.GetDroneRoutesByEstates(ctx, principal.TenantID, estateIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	distances := make(map[uuid.UUID]domain.DroneDistance, len(droneRoutes))
+	for estateID, routes := range droneRoutes {
+		distances[estateID] = domain.DroneDistance{Distance: domain.DroneTotalDistance(maxDistance, routes)}
+	}
+	return distances, nil
+}
+
+// ListTreeMeasurements list height measurements of trees within estates of the caller tenant, oldest first.
+// Trees without measurements are absent from the result
+func (e *estateUsecase) ListTreeMeasurements(ctx context.Context, estateIDs []uuid.UUID, treeIDs []uuid.UUID) (map[uuid.UUID][]domain.TreeMeasurement, error) {
+	principal, err := authorizeEstates(ctx, domain.RoleViewer, estateIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.estateRepository.ListTreeMeasurements(ctx, principal.TenantID, estateIDs, treeIDs)
+}
+
+// ExportEstate take a snapshot of an estate of the caller tenant with its trees, measurements and drone routes
+func (e *estateUsecase) ExportEstate(ctx context.Context, estateID uuid.UUID) (*domain.EstateSnapshot, error) {
+	principal, err := domain.Authorize(ctx, domain.RoleViewer, &estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := e.estateRepository.GetEstateSnapshot(ctx, principal.TenantID, estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if snapshot == nil {
+		return nil, domain.ErrorEstatesNotFound
+	}
+
+	snapshot.Version = domain.SnapshotVersion
+	snapshot.ExportedAt = time.Now().UTC()
+	return snapshot, nil
+}
+
+// ImportEstate recreate an estate from a snapshot in the caller tenant, with new ids unless keepIDs is set.
+// Drone routes are rebuilt from the trees, and measurements are kept as the tree history
+func (e *estateUsecase) ImportEstate(ctx context.Context, snapshot *domain.EstateSnapshot, keepIDs bool) (*domain.Estate, error) {
+	principal, err := domain.Authorize(ctx, domain.RoleAdmin, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = snapshot.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	// the caller snapshot is left untouched
+	if keepIDs {
+		copied := *snapshot
+		snapshot = &copied
+	} else {
+		snapshot = snapshot.WithNewIDs()
+	}
+	event := domain.NewEvent(domain.EventEstateCreated, principal.TenantID, snapshot.Estate.ID, domain.EstateEventData{
+		Width:  snapshot.Estate.Width,
+		Length: snapshot.Estate.Length,
+	})
+
+	return e.importSnapshot(ctx, principal.TenantID, snapshot, event)
+}
+
+// importSnapshot create the estate of snapshot in the tenant with event as its creation, drone routes are rebuilt from the trees
+func (e *estateUsecase) importSnapshot(ctx context.Context, tenantID uuid.UUID, snapshot *domain.EstateSnapshot, event domain.Event) (*domain.Estate, error) {
+	snapshot.Estate.TenantID = tenantID
+	estate := snapshot.Estate
+
+	droneRoutes := domain.DroneRoutesOverTrees(estate.Width, estate.Length, snapshot.Trees)
+	err := e.estateRepository.ImportEstate(ctx, snapshot, droneRoutes, []domain.Event{event})
+	if err != nil {
+		return nil, err
+	}
+
+	e.publish(ctx, event)
+
+	return &estate, nil
+}
+
+// DeleteTree remove tree of an estate of the caller tenant and lower the drone route over its plot
+func (e *estateUsecase) DeleteTree(ctx context.Context, estateID uuid.UUID, treeID uuid.UUID) error {
+	principal, err := domain.Authorize(ctx, domain.RolePlanter, &estateID)
+	if err != nil {
+		return err
+	}
+
+	tree, err := e.estateRepository.GetTree(ctx, principal.TenantID, estateID, treeID)
+	if err != nil {
+		return err
+	}
+
+	if tree == nil {
+		return domain.ErrorTreeNotFound
+	}
+
+	event := domain.NewEvent(domain.EventTreeRemoved, principal.TenantID, estateID, domain.TreeEventData{
+		TreeID: tree.ID,
+		Plot:   tree.Plot,
+		Height: tree.Height,
+	})
+
+	distanceChange, err := e.estateRepository.DeleteTreeAndDroneRoute(ctx, principal.TenantID, estateID, tree, []domain.Event{event})
+	if err != nil {
+		return err
+	}
+
+	e.publish(ctx, event)
+	e.publishDroneDistance(ctx, principal.TenantID, estateID, distanceChange)
+
+	return nil
+}
+
+// CloneEstate copy an estate of the caller tenant into a draft sandbox with new ids,
+// trees and their measurements included, to plan changes before promoting them to the source
+func (e *estateUsecase) CloneEstate(ctx context.Context, estateID uuid.UUID) (*domain.Estate, error) {
+	principal, err := domain.Authorize(ctx, domain.RoleAdmin, &estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := e.estateRepository.GetEstateSnapshot(ctx, principal.TenantID, estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if snapshot == nil {
+		return nil, domain.ErrorEstatesNotFound
+	}
+
+	sandbox := snapshot.WithNewIDs()
+	event := domain.NewEvent(domain.EventEstateCreated, principal.TenantID, sandbox.Estate.ID, domain.EstateEventData{
+		Width:  sandbox.Estate.Width,
+		Length: sandbox.Estate.Length,
+	})
+	// the source moving past the versions it was cloned at is what makes a promotion stale
+	sandbox.Estate.Sandbox = &domain.Sandbox{
+		SourceEstateID:     &estateID,
+		SourceVersion:      snapshot.Estate.Version,
+		SourceTreesVersion: snapshot.Estate.TreesVersion,
+		ClonedAt:           event.OccurredAt,
+	}
+
+	return e.importSnapshot(ctx, principal.TenantID, sandbox, event)
+}
+
+// DiffSandbox compare stats and drone distance of a sandbox with its source estate,
+// along with the tree changes a promotion would apply
+func (e *estateUsecase) DiffSandbox(ctx context.Context, sandboxID uuid.UUID) (*domain.SandboxDiff, error) {
+	principal, sandbox, sourceID, err := e.getSandbox(ctx, domain.RoleViewer, sandboxID)
+	if err != nil {
+		return nil, err
+	}
+
+	estateIDs := []uuid.UUID{sourceID, sandbox.ID}
+	trees, err := e.estateRepository.ListTreesByEstates(ctx, principal.TenantID, estateIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := e.estateRepository.GetEstatesStats(ctx, principal.TenantID, estateIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	droneRoutes, err := e.estateRepository.GetDroneRoutesByEstates(ctx, principal.TenantID, estateIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// the source was deleted in between
+	if _, ok := droneRoutes[sourceID]; !ok {
+		return nil, domain.ErrorEstatesNotFound
+	}
+
+	return &domain.SandboxDiff{
+		SourceEstateID: sourceID,
+		Source:         domain.EstatePlan{Stats: stats[sourceID], DroneDistance: domain.DroneTotalDistance(nil, droneRoutes[sourceID])},
+		Sandbox:        domain.EstatePlan{Stats: stats[sandbox.ID], DroneDistance: domain.DroneTotalDistance(nil, droneRoutes[sandbox.ID])},
+		Changes:        domain.DiffTrees(trees[sourceID], trees[sandbox.ID]),
+	}, nil
+}
+
+// PromoteSandbox apply the tree changes of a sandbox to its source estate and delete the sandbox.
+// The promotion is rejected with ErrorSandboxStale when the source changed since the clone, clone it again to start over
+func (e *estateUsecase) PromoteSandbox(ctx context.Context, sandboxID uuid.UUID) (*domain.Estate, error) {
+	principal, sandbox, sourceID, err := e.getSandbox(ctx, domain.RoleAdmin, sandboxID)
+	if err != nil {
+		return nil, err
+	}
+
+	source, _, err := e.estateRepository.GetEstateAndStats(ctx, principal.TenantID, sourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if source == nil {
+		return nil, domain.ErrorEstatesNotFound
+	}
+
+	trees, err := e.estateRepository.ListTreesByEstates(ctx, principal.TenantID, []uuid.UUID{sourceID, sandbox.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	changes := domain.DiffTrees(trees[sourceID], trees[sandbox.ID])
+	for _, tree := range changes.Planted {
+		if !tree.IsValidTreePlot(source) {
+			return nil, domain.ErrorTreePlotOutOfBound
+		}
+	}
+
+	events := make([]domain.Event, 0, len(changes.Planted)+len(changes.Updated)+len(changes.Removed)+1)
+	for _, change := range []struct {
+		eventType domain.EventType
+		trees     []domain.Tree
+	}{
+		{domain.EventTreeRemoved, changes.Removed},
+		{domain.EventTreeUpdated, changes.Updated},
+		{domain.EventTreePlanted, changes.Planted},
+	} {
+		for _, tree := range change.trees {
+			events = append(events, domain.NewEvent(change.eventType, principal.TenantID, sourceID, domain.TreeEventData{
+				TreeID: tree.ID,
+				Plot:   tree.Plot,
+				Height: tree.Height,
+			}))
+		}
+	}
+	events = append(events, domain.NewEvent(domain.EventEstateDeleted, principal.TenantID, sandbox.ID, nil))
+
+	distanceChange, err := e.estateRepository.PromoteSandbox(ctx, principal.TenantID, sandbox, changes, events)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, event := range events {
+		e.publish(ctx, event)
+	}
+	e.publishDroneDistance(ctx, principal.TenantID, sourceID, distanceChange)
+
+	return source, nil
+}
+
+// getSandbox get a sandbox of the caller tenant authorizing role on both the sandbox and its source
+func (e *estateUsecase) getSandbox(ctx context.Context, role domain.Role, sandboxID uuid.UUID) (*domain.Principal, *domain.Estate, uuid.UUID, error) {
+	principal, err := domain.Authorize(ctx, role, &sandboxID)
+	if err != nil {
+		return nil, nil, uuid.Nil, err
+	}
+
+	sandbox, _, err := e.estateRepository.GetEstateAndStats(ctx, principal.TenantID, sandboxID)
+	if err != nil {
+		return nil, nil, uuid.Nil, err
+	}
+
+	if sandbox == nil {
+		return nil, nil, uuid.Nil, domain.ErrorEstatesNotFound
+	}
+
+	if sandbox.Sandbox == nil {
+		return nil, nil, uuid.Nil, domain.ErrorEstateNotSandbox
+	}
+
+	// the source was deleted, the sandbox is only a draft now
+	if sandbox.Sandbox.SourceEstateID == nil {
+		return nil, nil, uuid.Nil, domain.ErrorEstatesNotFound
+	}
+	sourceID := *sandbox.Sandbox.SourceEstateID
+
+	_, err = domain.Authorize(ctx, role, &sourceID)
+	if err != nil {
+		return nil, nil, uuid.Nil, err
+	}
+
+	return principal, sandbox, sourceID, nil
+}
+
+// CreateMission plan a mission over the current drone routes of an estate of the caller tenant,
+// only the waypoints reachable within maxDistance are kept when it is given.
+// When droneID is given the mission is planned for that drone of the fleet within its range, and keeps its profile
+func (e *estateUsecase) CreateMission(ctx context.Context, estateID uuid.UUID, maxDistance *int, droneID *uuid.UUID, drone string, pilot string) (*domain.Mission, error) {
+	principal, err := domain.Authorize(ctx, domain.RolePlanter, &estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxDistance != nil && droneID != nil {
+		return nil, domain.ErrorMaxDistanceWithDrone
+	}
+
+	droneRoutes, err := e.estateRepository.GetDroneRoutes(ctx, principal.TenantID, estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if droneRoutes == nil {
+		return nil, domain.ErrorEstatesNotFound
+	}
+
+	var droneProfile *domain.DroneProfile
+	if droneID != nil {
+		fleetDrone, err := e.getFlyingDrone(ctx, principal.TenantID, *droneID, droneRoutes)
+		if err != nil {
+			return nil, err
+		}
+		profile := fleetDrone.Profile()
+		droneProfile = &profile
+		maxDistance = &fleetDrone.MaxRange
+		if drone == "" {
+			drone = fleetDrone.Name
+		}
+	}
+
+	mission := domain.NewMission(estateID, droneRoutes, maxDistance, time.Now().UTC())
+	mission.DroneID = droneID
+	mission.DroneProfile = droneProfile
+	mission.Drone = drone
+	mission.Pilot = pilot
+
+	err = e.estateRepository.CreateMission(ctx, principal.TenantID, mission)
+	if err != nil {
+		return nil, err
+	}
+
+	return mission, nil
+}
+
+// ListMissions list a page of missions of an estate of the caller tenant newest first, without their waypoints.
+// Missions of every status are listed when status is nil
+func (e *estateUsecase) ListMissions(ctx context.Context, estateID uuid.UUID, status *domain.MissionStatus, limit int, offset int) ([]domain.Mission, error) {
+	principal, err := domain.Authorize(ctx, domain.RoleViewer, &estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if status != nil {
+		err = status.Validate()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the repository lists nothing for unknown estates, check it exists to tell them apart
+	estate, _, err := e.estateRepository.GetEstateAndStats(ctx, principal.TenantID, estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if estate == nil {
+		return nil, domain.ErrorEstatesNotFound
+	}
+
+	return e.estateRepository.ListMissions(ctx, principal.TenantID, estateID, status, limit, offset)
+}
+
+// GetMission get a mission of an estate of the caller tenant with its waypoints
+func (e *estateUsecase) GetMission(ctx context.Context, estateID uuid.UUID, missionID uuid.UUID) (*domain.Mission, error) {
+	principal, err := domain.Authorize(ctx, domain.RoleViewer, &estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	mission, err := e.estateRepository.GetMission(ctx, principal.TenantID, estateID, missionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if mission == nil {
+		return nil, domain.ErrorMissionNotFound
+	}
+
+	return mission, nil
+}
+
+// UpdateMission assign a mission of an estate of the caller tenant and move it through its lifecycle.
+// The repository only saves it when no other request moved the mission since it was read
+func (e *estateUsecase) UpdateMission(ctx context.Context, estateID uuid.UUID, missionID uuid.UUID, update domain.MissionUpdate) (*domain.Mission, error) {
+	principal, err := domain.Authorize(ctx, domain.RolePlanter, &estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	mission, err := e.estateRepository.GetMission(ctx, principal.TenantID, estateID, missionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if mission == nil {
+		return nil, domain.ErrorMissionNotFound
+	}
+
+	expectedStatus := mission.Status
+	err = mission.Apply(update, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	// the fleet drone may have been retired or sent to maintenance, or the trees grown, since the mission was planned
+	if mission.DroneID != nil && expectedStatus != domain.MissionInProgress && mission.Status == domain.MissionInProgress {
+		droneRoutes, err := e.estateRepository.GetDroneRoutes(ctx, principal.TenantID, estateID)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = e.getFlyingDrone(ctx, principal.TenantID, *mission.DroneID, droneRoutes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = e.estateRepository.UpdateMission(ctx, principal.TenantID, mission, expectedStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	return mission, nil
+}
+
+// RecordTelemetry replace the telemetry of a flown mission of an estate of the caller tenant
+// and compare it with the mission plan. Samples are sorted by time before they are stored
+func (e *estateUsecase) RecordTelemetry(ctx context.Context, estateID uuid.UUID, missionID uuid.UUID, samples []domain.TelemetrySample) (*domain.TelemetryReport, error) {
+	principal, err := domain.Authorize(ctx, domain.RolePlanter, &estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = domain.ValidateTelemetry(samples)
+	if err != nil {
+		return nil, err
+	}
+
+	mission, estate, err := e.getFlownMission(ctx, principal.TenantID, estateID, missionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if mission.Status == domain.MissionPlanned {
+		return nil, domain.ErrorMissionNotFlown
+	}
+
+	domain.SortTelemetry(samples)
+	err = e.estateRepository.ReplaceMissionTelemetry(ctx, principal.TenantID, mission, samples)
+	if err != nil {
+		return nil, err
+	}
+
+	report := domain.NewTelemetryReport(*estate, *mission, samples)
+	return &report, nil
+}
+
+// GetTelemetryReport compare the recorded telemetry of a mission of an estate of the caller tenant with its plan
+func (e *estateUsecase) GetTelemetryReport(ctx context.Context, estateID uuid.UUID, missionID uuid.UUID) (*domain.TelemetryReport, error) {
+	principal, err := domain.Authorize(ctx, domain.RoleViewer, &estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	mission, estate, err := e.getFlownMission(ctx, principal.TenantID, estateID, missionID)
+	if err != nil {
+		return nil, err
+	}
+
+	samples, err := e.estateRepository.GetMissionTelemetry(ctx, principal.TenantID, estateID, missionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(samples) == 0 {
+		return nil, domain.ErrorTelemetryNotFound
+	}
+
+	report := domain.NewTelemetryReport(*estate, *mission, samples)
+	return &report, nil
+}
+
+// getFlownMission get a mission with its waypoints together with the estate it flies over, for telemetry
+func (e *estateUsecase) getFlownMission(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, missionID uuid.UUID) (*domain.Mission, *domain.Estate, error) {
+	mission, err := e.estateRepository.GetMission(ctx, tenantID, estateID, missionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if mission == nil {
+		return nil, nil, domain.ErrorMissionNotFound
+	}
+
+	estate, _, err := e.estateRepository.GetEstateAndStats(ctx, tenantID, estateID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// missions are deleted together with their estate, this only happens on a concurrent delete
+	if estate == nil {
+		return nil, nil, domain.ErrorMissionNotFound
+	}
+
+	return mission, estate, nil
+}
+
+// ReplaceChargingPads replace the charging pads of an estate of the caller tenant, pads are returned ordered by row then col
+func (e *estateUsecase) ReplaceChargingPads(ctx context.Context, estateID uuid.UUID, pads []domain.Plot) ([]domain.Plot, error) {
+	principal, err := domain.Authorize(ctx, domain.RolePlanter, &estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	estate, _, err := e.estateRepository.GetEstateAndStats(ctx, principal.TenantID, estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if estate == nil {
+		return nil, domain.ErrorEstatesNotFound
+	}
+
+	err = domain.ValidateChargingPads(estate, pads)
+	if err != nil {
+		return nil, err
+	}
+
+	pads = slices.Clone(pads)
+	slices.SortFunc(pads, func(a, b domain.Plot) int {
+		if a.Row != b.Row {
+			return a.Row - b.Row
+		}
+		return a.Col - b.Col
+	})
+
+	err = e.estateRepository.ReplaceChargingPads(ctx, principal.TenantID, estateID, pads)
+	if err != nil {
+		return nil, err
+	}
+
+	return pads, nil
+}
+
+// ListChargingPads list the charging pads of an estate of the caller tenant ordered by row then col
+func (e *estateUsecase) ListChargingPads(ctx context.Context, estateID uuid.UUID) ([]domain.Plot, error) {
+	principal, err := domain.Authorize(ctx, domain.RoleViewer, &estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	pads, err := e.estateRepository.ListChargingPads(ctx, principal.TenantID, estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if pads == nil {
+		return nil, domain.ErrorEstatesNotFound
+	}
+
+	return pads, nil
+}
+
+// GetSortiePlan plan the sorties a drone of the fleet flies between the charging pads of an estate of the caller tenant
+// to cover every plot. Pads left outside of the estate after it was resized smaller are not used
+func (e *estateUsecase) GetSortiePlan(ctx context.Context, estateID uuid.UUID, droneID uuid.UUID) (*domain.SortiePlan, error) {
+	principal, err := domain.Authorize(ctx, domain.RoleViewer, &estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	estate, _, err := e.estateRepository.GetEstateAndStats(ctx, principal.TenantID, estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if estate == nil {
+		return nil, domain.ErrorEstatesNotFound
+	}
+
+	if estate.Width*estate.Length > domain.MaxSortiePlots {
+		return nil, domain.ErrorSortiePlanTooLarge
+	}
+
+	pads, err := e.estateRepository.ListChargingPads(ctx, principal.TenantID, estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	pads = slices.DeleteFunc(pads, func(pad domain.Plot) bool {
+		return !estate.Contains(pad)
+	})
+	if len(pads) == 0 {
+		return nil, domain.ErrorNoChargingPads
+	}
+
+	droneRoutes, err := e.estateRepository.GetDroneRoutes(ctx, principal.TenantID, estateID)
+	if err != nil {
+		return nil, err
+	}
+
+	drone, err := e.getFlyingDrone(ctx, principal.TenantID, droneID, droneRoutes)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := domain.NewSortiePlan(drone, pads, droneRoutes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &plan, nil
+}
+
+// getFlyingDrone get a drone of the tenant fleet that is available and flies high enough over droneRoutes
+func (e *estateUsecase) getFlyingDrone(ctx context.Context, tenantID uuid.UUID, droneID uuid.UUID, droneRoutes []domain.DroneRoute) (*domain.Drone, error) {
+	if e.droneRepository == nil {
+		return nil, domain.ErrorDroneNotFound
+	}
+
+	drone, err := e.droneRepository.GetDrone(ctx, tenantID, droneID)
+	if err != nil {
+		return nil, err
+	}
+
+	if drone == nil {
+		return nil, domain.ErrorDroneNotFound
+	}
+
+	err = drone.CanFly(droneRoutes)
+	if err != nil {
+		return nil, err
+	}
+
+	return drone, nil
+}
+
+// authorizeEstates authorize the caller for every estate of a batch, the batch fails as a whole
+// when a single estate is not allowed so keys scoped to one estate only batch over that estate
+func authorizeEstates(ctx context.Context, role domain.Role, estateIDs []uuid.UUID) (*domain.Principal, error) {
+	if len(estateIDs) == 0 {
+		return domain.Authorize(ctx, role, nil)
+	}
+
+	var principal *domain.Principal
+	for i := range estateIDs {
+		var err error
+		principal, err = domain.Authorize(ctx, role, &estateIDs[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return principal, nil
+}
+
+func (e *estateUsecase) publish(ctx context.Context, event domain.Event) {
+	if e.eventBroker == nil {
+		return
+	}
+	e.eventBroker.Publish(ctx, event)
+}
+
+// publishDroneDistance publish the drone distance after a write to the drone routes, only when the write changed it.
+// The change is computed by the repository in the write transaction
+func (e *estateUsecase) publishDroneDistance(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, change domain.DroneDistanceChange) {
+	if !change.Changed() {
+		return
+	}
+
+	e.publish(ctx, domain.NewEvent(domain.EventDronePlanDistanceChanged, tenantID, estateID, domain.DronePlanEventData{
+		Distance: change.Current,
+	}))
 }
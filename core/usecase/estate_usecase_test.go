@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/SawitProRecruitment/EstateService/core/domain"
 	"github.com/SawitProRecruitment/EstateService/core/interfaces"
@@ -37,7 +38,7 @@ func Test_estateUsecase_CreateEstate(t *testing.T) {
 					{Route: 5, Plot: domain.Plot{Row: 3, Col: 1}, Altitude: 1},
 					{Route: 6, Plot: domain.Plot{Row: 3, Col: 2}, Altitude: 1},
 				}
-				mockRepo.EXPECT().CreateEstateAndDroneRoute(gomock.Any(), gomock.Any(), droneRoutes).Return(nil)
+				mockRepo.EXPECT().CreateEstateAndDroneRoute(gomock.Any(), gomock.Any(), droneRoutes, gomock.Any()).Return(nil)
 			},
 			expect: func() (*domain.Estate, error) {
 				return &domain.Estate{Width: 3, Length: 2}, nil
@@ -56,7 +57,7 @@ func Test_estateUsecase_CreateEstate(t *testing.T) {
 					{Route: 5, Plot: domain.Plot{Row: 2, Col: 2}, Altitude: 1},
 					{Route: 6, Plot: domain.Plot{Row: 2, Col: 1}, Altitude: 1},
 				}
-				mockRepo.EXPECT().CreateEstateAndDroneRoute(gomock.Any(), gomock.Any(), droneRoutes).Return(errors.New("failed to create estate"))
+				mockRepo.EXPECT().CreateEstateAndDroneRoute(gomock.Any(), gomock.Any(), droneRoutes, gomock.Any()).Return(errors.New("failed to create estate"))
 			},
 			expect: func() (*domain.Estate, error) {
 				return nil, errors.New("failed to create estate")
@@ -69,7 +70,7 @@ func Test_estateUsecase_CreateEstate(t *testing.T) {
 			tt.mock()
 
 			e := NewEstateUsecase(mockRepo)
-			got, err := e.CreateEstate(context.Background(), tt.width, tt.length)
+			got, err := e.CreateEstate(adminContext(), tt.width, tt.length)
 			gotExpect, errExpect := tt.expect()
 			if gotExpect != nil {
 				assert.Equal(t, gotExpect.Width, got.Width)
@@ -101,12 +102,12 @@ func Test_estateUsecase_CreateTree(t *testing.T) {
 			plot:     domain.Plot{Row: 2, Col: 3},
 			height:   20,
 			mock: func() {
-				mockRepo.EXPECT().GetEstateAndTree(gomock.Any(), gomock.Any(), gomock.Any()).Return(&domain.Estate{
+				mockRepo.EXPECT().GetEstateAndTree(gomock.Any(), testTenantID, gomock.Any(), gomock.Any()).Return(&domain.Estate{
 					ID:     [16]byte{12},
 					Width:  10,
 					Length: 10,
 				}, nil, nil)
-				mockRepo.EXPECT().CreateTreeAndUpdateDroneRoute(gomock.Any(), gomock.Any(), 21, gomock.Any()).Return(nil)
+				mockRepo.EXPECT().CreateTreeAndUpdateDroneRoute(gomock.Any(), testTenantID, gomock.Any(), 21, gomock.Any(), gomock.Any()).Return(domain.DroneDistanceChange{}, nil)
 			},
 			expect: func() (*domain.Tree, error) {
 				return &domain.Tree{
@@ -122,7 +123,7 @@ func Test_estateUsecase_CreateTree(t *testing.T) {
 			plot:     domain.Plot{Row: 2, Col: 3},
 			height:   20,
 			mock: func() {
-				mockRepo.EXPECT().GetEstateAndTree(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil, nil)
+				mockRepo.EXPECT().GetEstateAndTree(gomock.Any(), testTenantID, gomock.Any(), gomock.Any()).Return(nil, nil, nil)
 			},
 			expect: func() (*domain.Tree, error) {
 				return nil, domain.ErrorEstatesNotFound
@@ -134,7 +135,7 @@ func Test_estateUsecase_CreateTree(t *testing.T) {
 			plot:     domain.Plot{Row: 2, Col: 3},
 			height:   20,
 			mock: func() {
-				mockRepo.EXPECT().GetEstateAndTree(gomock.Any(), gomock.Any(), gomock.Any()).Return(&domain.Estate{}, &domain.Tree{ID: [16]byte{1}}, nil)
+				mockRepo.EXPECT().GetEstateAndTree(gomock.Any(), testTenantID, gomock.Any(), gomock.Any()).Return(&domain.Estate{}, &domain.Tree{ID: [16]byte{1}}, nil)
 			},
 			expect: func() (*domain.Tree, error) {
 				return nil, domain.ErrorTreeAlreadyExists
@@ -146,7 +147,7 @@ func Test_estateUsecase_CreateTree(t *testing.T) {
 			plot:     domain.Plot{Row: 2, Col: 3},
 			height:   20,
 			mock: func() {
-				mockRepo.EXPECT().GetEstateAndTree(gomock.Any(), gomock.Any(), gomock.Any()).Return(&domain.Estate{
+				mockRepo.EXPECT().GetEstateAndTree(gomock.Any(), testTenantID, gomock.Any(), gomock.Any()).Return(&domain.Estate{
 					ID:     [16]byte{12},
 					Width:  1,
 					Length: 1,
@@ -162,7 +163,7 @@ func Test_estateUsecase_CreateTree(t *testing.T) {
 			plot:     domain.Plot{Row: 2, Col: 3},
 			height:   20,
 			mock: func() {
-				mockRepo.EXPECT().GetEstateAndTree(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil, errors.New("failed get estate"))
+				mockRepo.EXPECT().GetEstateAndTree(gomock.Any(), testTenantID, gomock.Any(), gomock.Any()).Return(nil, nil, errors.New("failed get estate"))
 			},
 			expect: func() (*domain.Tree, error) {
 				return nil, errors.New("failed get estate")
@@ -174,12 +175,12 @@ func Test_estateUsecase_CreateTree(t *testing.T) {
 			plot:     domain.Plot{Row: 2, Col: 3},
 			height:   20,
 			mock: func() {
-				mockRepo.EXPECT().GetEstateAndTree(gomock.Any(), gomock.Any(), gomock.Any()).Return(&domain.Estate{
+				mockRepo.EXPECT().GetEstateAndTree(gomock.Any(), testTenantID, gomock.Any(), gomock.Any()).Return(&domain.Estate{
 					ID:     [16]byte{12},
 					Width:  10,
 					Length: 10,
 				}, nil, nil)
-				mockRepo.EXPECT().CreateTreeAndUpdateDroneRoute(gomock.Any(), gomock.Any(), 21, gomock.Any()).Return(errors.New("failed to create tree"))
+				mockRepo.EXPECT().CreateTreeAndUpdateDroneRoute(gomock.Any(), testTenantID, gomock.Any(), 21, gomock.Any(), gomock.Any()).Return(domain.DroneDistanceChange{}, errors.New("failed to create tree"))
 			},
 			expect: func() (*domain.Tree, error) {
 				return nil, errors.New("failed to create tree")
@@ -192,7 +193,7 @@ func Test_estateUsecase_CreateTree(t *testing.T) {
 			tt.mock()
 
 			e := NewEstateUsecase(mockRepo)
-			got, err := e.CreateTree(context.Background(), tt.estateID, tt.plot, tt.height)
+			got, err := e.CreateTree(adminContext(), tt.estateID, tt.plot, tt.height)
 			gotExpect, errExpect := tt.expect()
 			if gotExpect != nil {
 				assert.Equal(t, gotExpect.Height, got.Height)
@@ -205,6 +206,162 @@ func Test_estateUsecase_CreateTree(t *testing.T) {
 	}
 }
 
+func Test_estateUsecase_ResizeEstate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	estateID := uuid.New()
+	estate := func() *domain.Estate {
+		return &domain.Estate{ID: estateID, TenantID: testTenantID, Width: 10, Length: 10, Version: 2}
+	}
+
+	tests := []struct {
+		name            string
+		expectedVersion int
+		mock            func()
+		expect          func() (*domain.Estate, error)
+	}{
+		{
+			name:            "Success resizing estate",
+			expectedVersion: 2,
+			mock: func() {
+				mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, estateID).Return(estate(), nil, nil)
+				mockRepo.EXPECT().ResizeEstateAndDroneRoute(gomock.Any(), testTenantID, gomock.Any(), 2, domain.DroneZigzagTraverse(2, 3), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ uuid.UUID, _ *domain.Estate, _ int, _ []domain.DroneRoute, outbox []domain.Event) (domain.DroneDistanceChange, error) {
+						assert.Equal(t, domain.EventEstateResized, outbox[0].Type)
+						return domain.DroneDistanceChange{}, nil
+					})
+			},
+			expect: func() (*domain.Estate, error) {
+				return &domain.Estate{ID: estateID, TenantID: testTenantID, Width: 2, Length: 3, Version: 3}, nil
+			},
+		},
+		{
+			name:            "Stale version",
+			expectedVersion: 1,
+			mock: func() {
+				mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, estateID).Return(estate(), nil, nil)
+			},
+			expect: func() (*domain.Estate, error) {
+				return nil, domain.ErrorVersionMismatch
+			},
+		},
+		{
+			name:            "Estate changed concurrently",
+			expectedVersion: 2,
+			mock: func() {
+				mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, estateID).Return(estate(), nil, nil)
+				mockRepo.EXPECT().ResizeEstateAndDroneRoute(gomock.Any(), testTenantID, gomock.Any(), 2, gomock.Any(), gomock.Any()).Return(domain.DroneDistanceChange{}, domain.ErrorVersionMismatch)
+			},
+			expect: func() (*domain.Estate, error) {
+				return nil, domain.ErrorVersionMismatch
+			},
+		},
+		{
+			name:            "Estate not found",
+			expectedVersion: 2,
+			mock: func() {
+				mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, estateID).Return(nil, nil, nil)
+			},
+			expect: func() (*domain.Estate, error) {
+				return nil, domain.ErrorEstatesNotFound
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			e := NewEstateUsecase(mockRepo)
+			got, err := e.ResizeEstate(adminContext(), estateID, 2, 3, tt.expectedVersion)
+			gotExpect, errExpect := tt.expect()
+			assert.Equal(t, gotExpect, got)
+			assert.Equal(t, errExpect, err)
+		})
+	}
+}
+
+func Test_estateUsecase_UpdateTreeHeight(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	estateID := uuid.New()
+	treeID := uuid.New()
+	tree := func() *domain.Tree {
+		return &domain.Tree{ID: treeID, Plot: domain.Plot{Row: 2, Col: 3}, Height: 10, Version: 1}
+	}
+
+	tests := []struct {
+		name            string
+		expectedVersion int
+		mock            func()
+		expect          func() (*domain.Tree, error)
+	}{
+		{
+			name:            "Success updating tree",
+			expectedVersion: 1,
+			mock: func() {
+				mockRepo.EXPECT().GetTree(gomock.Any(), testTenantID, estateID, treeID).Return(tree(), nil)
+				mockRepo.EXPECT().UpdateTreeAndDroneRoute(gomock.Any(), testTenantID, estateID, gomock.Any(), 1, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ uuid.UUID, _ uuid.UUID, tree *domain.Tree, _ int, outbox []domain.Event) (domain.DroneDistanceChange, error) {
+						assert.Equal(t, 20, tree.Height)
+						assert.Equal(t, domain.EventTreeUpdated, outbox[0].Type)
+						return domain.DroneDistanceChange{}, nil
+					})
+			},
+			expect: func() (*domain.Tree, error) {
+				return &domain.Tree{ID: treeID, Plot: domain.Plot{Row: 2, Col: 3}, Height: 20, Version: 2}, nil
+			},
+		},
+		{
+			name:            "Stale version",
+			expectedVersion: 3,
+			mock: func() {
+				mockRepo.EXPECT().GetTree(gomock.Any(), testTenantID, estateID, treeID).Return(tree(), nil)
+			},
+			expect: func() (*domain.Tree, error) {
+				return nil, domain.ErrorVersionMismatch
+			},
+		},
+		{
+			name:            "Tree changed concurrently",
+			expectedVersion: 1,
+			mock: func() {
+				mockRepo.EXPECT().GetTree(gomock.Any(), testTenantID, estateID, treeID).Return(tree(), nil)
+				mockRepo.EXPECT().UpdateTreeAndDroneRoute(gomock.Any(), testTenantID, estateID, gomock.Any(), 1, gomock.Any()).Return(domain.DroneDistanceChange{}, domain.ErrorVersionMismatch)
+			},
+			expect: func() (*domain.Tree, error) {
+				return nil, domain.ErrorVersionMismatch
+			},
+		},
+		{
+			name:            "Tree not found",
+			expectedVersion: 1,
+			mock: func() {
+				mockRepo.EXPECT().GetTree(gomock.Any(), testTenantID, estateID, treeID).Return(nil, nil)
+			},
+			expect: func() (*domain.Tree, error) {
+				return nil, domain.ErrorTreeNotFound
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			e := NewEstateUsecase(mockRepo)
+			got, err := e.UpdateTreeHeight(adminContext(), estateID, treeID, 20, tt.expectedVersion)
+			gotExpect, errExpect := tt.expect()
+			assert.Equal(t, gotExpect, got)
+			assert.Equal(t, errExpect, err)
+		})
+	}
+}
+
 func Test_estateUsecase_GetEstateStats(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -220,7 +377,7 @@ func Test_estateUsecase_GetEstateStats(t *testing.T) {
 			name:     "Success creating tree",
 			estateID: [16]byte{12},
 			mock: func() {
-				mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), [16]byte{12}).Return(&domain.Estate{}, &domain.EstateStats{
+				mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, [16]byte{12}).Return(&domain.Estate{}, &domain.EstateStats{
 					Count:  3,
 					Max:    21,
 					Min:    4,
@@ -240,7 +397,7 @@ func Test_estateUsecase_GetEstateStats(t *testing.T) {
 			name:     "Error creating tree",
 			estateID: [16]byte{12},
 			mock: func() {
-				mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), [16]byte{12}).Return(nil, nil, errors.New("failed to get stats"))
+				mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, [16]byte{12}).Return(nil, nil, errors.New("failed to get stats"))
 			},
 			expect: func() (*domain.EstateStats, error) {
 				return nil, errors.New("failed to get stats")
@@ -253,7 +410,7 @@ func Test_estateUsecase_GetEstateStats(t *testing.T) {
 			tt.mock()
 
 			e := NewEstateUsecase(mockRepo)
-			got, err := e.GetEstateStats(context.Background(), tt.estateID)
+			got, err := e.GetEstateStats(adminContext(), tt.estateID)
 			gotExpect, errExpect := tt.expect()
 			assert.Equal(t, gotExpect, got)
 			assert.Equal(t, errExpect, err)
@@ -268,7 +425,7 @@ func Test_estateUsecase_GetDroneDistance(t *testing.T) {
 	repo := interfaces.NewMockEstateRepository(ctrl)
 	u := NewEstateUsecase(repo)
 
-	ctx := context.Background()
+	ctx := adminContext()
 	id := uuid.New()
 
 	tests := []struct {
@@ -279,7 +436,7 @@ func Test_estateUsecase_GetDroneDistance(t *testing.T) {
 		{
 			name: "success - multiple routes",
 			mock: func() {
-				repo.EXPECT().GetDroneRoutes(ctx, id).Return([]domain.DroneRoute{
+				repo.EXPECT().GetDroneRoutes(ctx, testTenantID, id).Return([]domain.DroneRoute{
 					{Altitude: 1}, {Altitude: 6}, {Altitude: 4}, {Altitude: 5}, {Altitude: 1},
 				}, nil)
 			},
@@ -292,7 +449,7 @@ func Test_estateUsecase_GetDroneDistance(t *testing.T) {
 		{
 			name: "failure - repo error",
 			mock: func() {
-				repo.EXPECT().GetDroneRoutes(ctx, id).Return(nil, errors.New("repo error"))
+				repo.EXPECT().GetDroneRoutes(ctx, testTenantID, id).Return(nil, errors.New("repo error"))
 			},
 			expect: func() (*domain.DroneDistance, error) {
 				return nil, errors.New("repo error")
@@ -304,10 +461,1437 @@ func Test_estateUsecase_GetDroneDistance(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mock()
 
-			got, err := u.GetDroneDistance(ctx, id, nil)
+			got, err := u.GetDroneDistance(ctx, id, nil, nil)
+			gotExpect, errExpect := tt.expect()
+			assert.Equal(t, gotExpect, got)
+			assert.Equal(t, errExpect, err)
+		})
+	}
+}
+
+func Test_estateUsecase_GetDroneWaypoints(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := interfaces.NewMockEstateRepository(ctrl)
+	u := NewEstateUsecase(repo)
+
+	ctx := adminContext()
+	id := uuid.New()
+
+	tests := []struct {
+		name   string
+		mock   func()
+		expect func() ([]domain.DroneWaypoint, error)
+	}{
+		{
+			name: "success",
+			mock: func() {
+				repo.EXPECT().GetDroneRoutes(ctx, testTenantID, id).Return([]domain.DroneRoute{
+					{Route: 1, Plot: domain.Plot{Row: 1, Col: 1}, Altitude: 1},
+					{Route: 2, Plot: domain.Plot{Row: 1, Col: 2}, Altitude: 6},
+				}, nil)
+			},
+			expect: func() ([]domain.DroneWaypoint, error) {
+				return []domain.DroneWaypoint{
+					{Sequence: 1, Plot: domain.Plot{Row: 1, Col: 1}, Altitude: 1, Distance: 1},
+					{Sequence: 2, Plot: domain.Plot{Row: 1, Col: 2}, Altitude: 6, Distance: 1 + domain.DistanceBetweenPlot + 5},
+				}, nil
+			},
+		},
+		{
+			name: "estate not found",
+			mock: func() {
+				repo.EXPECT().GetDroneRoutes(ctx, testTenantID, id).Return(nil, nil)
+			},
+			expect: func() ([]domain.DroneWaypoint, error) {
+				return nil, domain.ErrorEstatesNotFound
+			},
+		},
+		{
+			name: "repository error",
+			mock: func() {
+				repo.EXPECT().GetDroneRoutes(ctx, testTenantID, id).Return(nil, errors.New("db error"))
+			},
+			expect: func() ([]domain.DroneWaypoint, error) {
+				return nil, errors.New("db error")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			got, err := u.GetDroneWaypoints(ctx, id)
+			gotExpect, errExpect := tt.expect()
+			assert.Equal(t, gotExpect, got)
+			assert.Equal(t, errExpect, err)
+		})
+	}
+}
+
+func Test_estateUsecase_GetDroneAltitudeProfile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := interfaces.NewMockEstateRepository(ctrl)
+	u := NewEstateUsecase(repo)
+
+	ctx := adminContext()
+	id := uuid.New()
+	routes := []domain.DroneRoute{
+		{Route: 1, Plot: domain.Plot{Row: 1, Col: 1}, Altitude: 1},
+		{Route: 2, Plot: domain.Plot{Row: 1, Col: 2}, Altitude: 6},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		repo.EXPECT().GetDroneRoutes(ctx, testTenantID, id).Return(routes, nil)
+		got, err := u.GetDroneAltitudeProfile(ctx, id)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.DroneTotalDistance(nil, routes), got.Horizontal+got.Vertical)
+		assert.Equal(t, []domain.Climb{{Distance: domain.DistanceBetweenPlot, Altitude: 6, Height: 5, Plot: domain.Plot{Row: 1, Col: 2}}}, got.Climbs)
+	})
+
+	t.Run("estate not found", func(t *testing.T) {
+		repo.EXPECT().GetDroneRoutes(ctx, testTenantID, id).Return(nil, nil)
+		_, err := u.GetDroneAltitudeProfile(ctx, id)
+		assert.Equal(t, domain.ErrorEstatesNotFound, err)
+	})
+}
+
+func Test_estateUsecase_GetDronePlan(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := interfaces.NewMockEstateRepository(ctrl)
+	u := NewEstateUsecase(repo)
+
+	ctx := adminContext()
+	id := uuid.New()
+	estate := &domain.Estate{ID: id, Width: 1, Length: 2}
+	trees := []domain.Tree{{ID: uuid.New(), Plot: domain.Plot{Row: 1, Col: 2}, Height: 5}}
+	routes := domain.DroneRoutesOverTrees(1, 2, trees)
+	maxDistance := 5
+
+	tests := []struct {
+		name        string
+		maxDistance *int
+		mock        func()
+		expect      *domain.DronePlan
+		expectError error
+	}{
+		{
+			name: "success",
+			mock: func() {
+				repo.EXPECT().GetEstateAndStats(ctx, testTenantID, id).Return(estate, nil, nil)
+				repo.EXPECT().ListTrees(ctx, testTenantID, id).Return(trees, nil)
+				repo.EXPECT().GetDroneRoutes(ctx, testTenantID, id).Return(routes, nil)
+			},
+			expect: &domain.DronePlan{Estate: *estate, Trees: trees, Routes: routes},
+		},
+		{
+			name:        "rest before the tree",
+			maxDistance: &maxDistance,
+			mock: func() {
+				repo.EXPECT().GetEstateAndStats(ctx, testTenantID, id).Return(estate, nil, nil)
+				repo.EXPECT().ListTrees(ctx, testTenantID, id).Return(trees, nil)
+				repo.EXPECT().GetDroneRoutes(ctx, testTenantID, id).Return(routes, nil)
+			},
+			expect: &domain.DronePlan{Estate: *estate, Trees: trees, Routes: routes, Rest: &domain.Plot{Row: 1, Col: 1}},
+		},
+		{
+			name: "estate not found",
+			mock: func() {
+				repo.EXPECT().GetEstateAndStats(ctx, testTenantID, id).Return(nil, nil, nil)
+			},
+			expectError: domain.ErrorEstatesNotFound,
+		},
+		{
+			name: "too large",
+			mock: func() {
+				repo.EXPECT().GetEstateAndStats(ctx, testTenantID, id).Return(&domain.Estate{ID: id, Width: 1000, Length: 1000}, nil, nil)
+			},
+			expectError: domain.ErrorDronePlanTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			got, err := u.GetDronePlan(ctx, id, tt.maxDistance, nil)
+			assert.Equal(t, tt.expect, got)
+			assert.Equal(t, tt.expectError, err)
+		})
+	}
+}
+
+func Test_estateUsecase_PublishEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := interfaces.NewMockEstateRepository(ctrl)
+	broker := interfaces.NewMockEventBroker(ctrl)
+	u := NewEstateUsecase(repo, WithEventBroker(broker))
+
+	ctx := adminContext()
+
+	t.Run("estate created", func(t *testing.T) {
+		var outbox []domain.Event
+		repo.EXPECT().CreateEstateAndDroneRoute(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Do(func(_ context.Context, estate *domain.Estate, _ []domain.DroneRoute, events []domain.Event) {
+			assert.Equal(t, testTenantID, estate.TenantID)
+			outbox = events
+		}).Return(nil)
+		broker.EXPECT().Publish(ctx, gomock.Any()).Do(func(_ context.Context, event domain.Event) {
+			assert.Equal(t, domain.EventEstateCreated, event.Type)
+			assert.Equal(t, testTenantID, event.TenantID)
+			assert.Equal(t, domain.EstateEventData{Width: 2, Length: 3}, event.Data)
+			assert.Equal(t, []domain.Event{event}, outbox)
+		})
+
+		_, err := u.CreateEstate(ctx, 2, 3)
+		assert.NoError(t, err)
+	})
+
+	t.Run("tree planted and drone distance changed", func(t *testing.T) {
+		estateID := uuid.New()
+		repo.EXPECT().GetEstateAndTree(ctx, testTenantID, estateID, gomock.Any()).Return(&domain.Estate{ID: estateID, Width: 1, Length: 2}, nil, nil)
+		repo.EXPECT().CreateTreeAndUpdateDroneRoute(ctx, testTenantID, estateID, 6, gomock.Any(), gomock.Any()).
+			Return(domain.DroneDistanceChange{Previous: domain.DistanceBetweenPlot + 2, Current: domain.DistanceBetweenPlot + 12}, nil)
+
+		var events []domain.Event
+		broker.EXPECT().Publish(ctx, gomock.Any()).Do(func(_ context.Context, event domain.Event) {
+			events = append(events, event)
+		}).Times(2)
+
+		_, err := u.CreateTree(ctx, estateID, domain.Plot{Row: 1, Col: 2}, 5)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.EventTreePlanted, events[0].Type)
+		assert.Equal(t, domain.EventDronePlanDistanceChanged, events[1].Type)
+		assert.Equal(t, domain.DronePlanEventData{Distance: domain.DistanceBetweenPlot + 12}, events[1].Data)
+	})
+}
+
+func Test_estateUsecase_SubscribeEstateEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := interfaces.NewMockEstateRepository(ctrl)
+	broker := interfaces.NewMockEventBroker(ctrl)
+
+	ctx := adminContext()
+	id := uuid.New()
+	events := make(<-chan domain.Event)
+
+	tests := []struct {
+		name    string
+		usecase *estateUsecase
+		mock    func()
+		expect  func() (<-chan domain.Event, error)
+	}{
+		{
+			name:    "Success",
+			usecase: NewEstateUsecase(repo, WithEventBroker(broker)),
+			mock: func() {
+				repo.EXPECT().GetEstateAndStats(ctx, testTenantID, id).Return(&domain.Estate{ID: id}, nil, nil)
+				broker.EXPECT().Subscribe(ctx, id, "last").Return(events, nil)
+			},
+			expect: func() (<-chan domain.Event, error) {
+				return events, nil
+			},
+		},
+		{
+			name:    "Estate not found",
+			usecase: NewEstateUsecase(repo, WithEventBroker(broker)),
+			mock: func() {
+				repo.EXPECT().GetEstateAndStats(ctx, testTenantID, id).Return(nil, nil, nil)
+			},
+			expect: func() (<-chan domain.Event, error) {
+				return nil, domain.ErrorEstatesNotFound
+			},
+		},
+		{
+			name:    "Broker not configured",
+			usecase: NewEstateUsecase(repo),
+			mock:    func() {},
+			expect: func() (<-chan domain.Event, error) {
+				return nil, domain.ErrorEventStreamUnavailable
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			got, err := tt.usecase.SubscribeEstateEvents(ctx, id, "last")
 			gotExpect, errExpect := tt.expect()
 			assert.Equal(t, gotExpect, got)
 			assert.Equal(t, errExpect, err)
 		})
 	}
 }
+
+func Test_estateUsecase_Authorization(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := NewEstateUsecase(interfaces.NewMockEstateRepository(ctrl))
+
+	estateID := uuid.New()
+	otherEstateID := uuid.New()
+	viewer := domain.WithPrincipal(context.Background(), &domain.Principal{TenantID: testTenantID, Role: domain.RoleViewer, EstateID: &estateID})
+	planter := domain.WithPrincipal(context.Background(), &domain.Principal{TenantID: testTenantID, Role: domain.RolePlanter, EstateID: &estateID})
+
+	_, err := u.CreateEstate(context.Background(), 1, 1)
+	assert.Equal(t, domain.ErrorUnauthenticated, err)
+
+	_, err = u.CreateEstate(planter, 1, 1)
+	assert.Equal(t, domain.ErrorForbidden, err)
+
+	_, err = u.CreateTree(viewer, estateID, domain.Plot{Row: 1, Col: 1}, 10)
+	assert.Equal(t, domain.ErrorForbidden, err)
+
+	_, err = u.CreateTree(planter, otherEstateID, domain.Plot{Row: 1, Col: 1}, 10)
+	assert.Equal(t, domain.ErrorForbidden, err)
+
+	_, err = u.GetEstateStats(viewer, otherEstateID)
+	assert.Equal(t, domain.ErrorForbidden, err)
+
+	_, err = u.GetDroneDistance(viewer, otherEstateID, nil, nil)
+	assert.Equal(t, domain.ErrorForbidden, err)
+
+	_, err = u.GetDroneWaypoints(viewer, otherEstateID)
+	assert.Equal(t, domain.ErrorForbidden, err)
+
+	_, err = u.SubscribeEstateEvents(viewer, otherEstateID, "")
+	assert.Equal(t, domain.ErrorForbidden, err)
+
+	_, err = u.GetEstate(viewer, otherEstateID)
+	assert.Equal(t, domain.ErrorForbidden, err)
+
+	_, err = u.ResizeEstate(planter, estateID, 1, 1, 1)
+	assert.Equal(t, domain.ErrorForbidden, err)
+
+	_, err = u.GetTree(viewer, otherEstateID, uuid.New())
+	assert.Equal(t, domain.ErrorForbidden, err)
+
+	_, err = u.UpdateTreeHeight(viewer, estateID, uuid.New(), 10, 1)
+	assert.Equal(t, domain.ErrorForbidden, err)
+
+	// listing estates is not bound to one estate, keys scoped to one estate can not do it
+	_, err = u.ListEstates(viewer, 10, 0)
+	assert.Equal(t, domain.ErrorForbidden, err)
+
+	err = u.DeleteEstate(planter, estateID)
+	assert.Equal(t, domain.ErrorForbidden, err)
+
+	_, err = u.ListTrees(viewer, otherEstateID)
+	assert.Equal(t, domain.ErrorForbidden, err)
+
+	// a batch fails as a whole when one of its estates is not allowed
+	_, err = u.ListTreesByEstates(viewer, []uuid.UUID{estateID, otherEstateID})
+	assert.Equal(t, domain.ErrorForbidden, err)
+
+	_, err = u.GetEstatesStats(viewer, []uuid.UUID{otherEstateID})
+	assert.Equal(t, domain.ErrorForbidden, err)
+
+	_, err = u.GetDroneDistances(viewer, []uuid.UUID{otherEstateID}, nil)
+	assert.Equal(t, domain.ErrorForbidden, err)
+
+	_, err = u.ListTreeMeasurements(viewer, []uuid.UUID{otherEstateID}, []uuid.UUID{uuid.New()})
+	assert.Equal(t, domain.ErrorForbidden, err)
+
+	_, err = u.ListTreesByEstates(context.Background(), []uuid.UUID{estateID})
+	assert.Equal(t, domain.ErrorUnauthenticated, err)
+}
+
+func Test_estateUsecase_ListEstates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	e := NewEstateUsecase(mockRepo)
+	estates := []domain.Estate{{ID: [16]byte{1}, TenantID: testTenantID, Width: 1, Length: 2, Version: 1}}
+
+	mockRepo.EXPECT().ListEstates(gomock.Any(), testTenantID, 10, 20).Return(estates, nil)
+	got, err := e.ListEstates(adminContext(), 10, 20)
+	assert.NoError(t, err)
+	assert.Equal(t, estates, got)
+
+	mockRepo.EXPECT().ListEstates(gomock.Any(), testTenantID, 10, 0).Return(nil, errors.New("failed to list estates"))
+	_, err = e.ListEstates(adminContext(), 10, 0)
+	assert.Equal(t, errors.New("failed to list estates"), err)
+}
+
+func Test_estateUsecase_DeleteEstate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	mockBroker := interfaces.NewMockEventBroker(ctrl)
+	e := NewEstateUsecase(mockRepo, WithEventBroker(mockBroker))
+	estateID := uuid.New()
+
+	tests := []struct {
+		name   string
+		mock   func()
+		expect error
+	}{
+		{
+			name: "Success",
+			mock: func() {
+				mockRepo.EXPECT().DeleteEstate(gomock.Any(), testTenantID, estateID, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ uuid.UUID, _ uuid.UUID, outbox []domain.Event) error {
+						assert.Len(t, outbox, 1)
+						assert.Equal(t, domain.EventEstateDeleted, outbox[0].Type)
+						return nil
+					})
+				mockBroker.EXPECT().Publish(gomock.Any(), gomock.Any())
+			},
+		},
+		{
+			name: "Not found",
+			mock: func() {
+				mockRepo.EXPECT().DeleteEstate(gomock.Any(), testTenantID, estateID, gomock.Any()).Return(domain.ErrorEstatesNotFound)
+			},
+			expect: domain.ErrorEstatesNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			assert.Equal(t, tt.expect, e.DeleteEstate(adminContext(), estateID))
+		})
+	}
+}
+
+func Test_estateUsecase_ListTrees(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	e := NewEstateUsecase(mockRepo)
+	estateID := uuid.New()
+	trees := []domain.Tree{{ID: [16]byte{2}, Plot: domain.Plot{Row: 1, Col: 2}, Height: 10, Version: 1}}
+
+	tests := []struct {
+		name      string
+		mock      func()
+		expect    []domain.Tree
+		expectErr error
+	}{
+		{
+			name: "Success",
+			mock: func() {
+				mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, estateID).Return(&domain.Estate{ID: estateID}, nil, nil)
+				mockRepo.EXPECT().ListTrees(gomock.Any(), testTenantID, estateID).Return(trees, nil)
+			},
+			expect: trees,
+		},
+		{
+			name: "Estate not found",
+			mock: func() {
+				mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, estateID).Return(nil, nil, nil)
+			},
+			expectErr: domain.ErrorEstatesNotFound,
+		},
+		{
+			name: "Repository error",
+			mock: func() {
+				mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, estateID).Return(nil, nil, errors.New("failed to get estate"))
+			},
+			expectErr: errors.New("failed to get estate"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			got, err := e.ListTrees(adminContext(), estateID)
+			assert.Equal(t, tt.expect, got)
+			assert.Equal(t, tt.expectErr, err)
+		})
+	}
+}
+
+func Test_estateUsecase_Batch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	e := NewEstateUsecase(mockRepo)
+	estateID := uuid.New()
+	otherEstateID := uuid.New()
+	estateIDs := []uuid.UUID{estateID, otherEstateID}
+	treeID := uuid.New()
+
+	// keys scoped to one estate may batch over that estate only
+	scoped := domain.WithPrincipal(context.Background(), &domain.Principal{TenantID: testTenantID, Role: domain.RoleViewer, EstateID: &estateID})
+	trees := map[uuid.UUID][]domain.Tree{estateID: {{ID: treeID, Plot: domain.Plot{Row: 1, Col: 1}, Height: 10, Version: 1}}}
+	mockRepo.EXPECT().ListTreesByEstates(gomock.Any(), testTenantID, []uuid.UUID{estateID}).Return(trees, nil)
+	gotTrees, err := e.ListTreesByEstates(scoped, []uuid.UUID{estateID})
+	assert.NoError(t, err)
+	assert.Equal(t, trees, gotTrees)
+
+	stats := map[uuid.UUID]domain.EstateStats{estateID: {Count: 1, Max: 10, Min: 10, Median: 10}}
+	mockRepo.EXPECT().GetEstatesStats(gomock.Any(), testTenantID, estateIDs).Return(stats, nil)
+	gotStats, err := e.GetEstatesStats(adminContext(), estateIDs)
+	assert.NoError(t, err)
+	assert.Equal(t, stats, gotStats)
+
+	measurements := map[uuid.UUID][]domain.TreeMeasurement{treeID: {{TreeID: treeID, Height: 10}}}
+	mockRepo.EXPECT().ListTreeMeasurements(gomock.Any(), testTenantID, estateIDs, []uuid.UUID{treeID}).Return(measurements, nil)
+	gotMeasurements, err := e.ListTreeMeasurements(adminContext(), estateIDs, []uuid.UUID{treeID})
+	assert.NoError(t, err)
+	assert.Equal(t, measurements, gotMeasurements)
+
+	mockRepo.EXPECT().ListTreesByEstates(gomock.Any(), testTenantID, estateIDs).Return(nil, errors.New("db error"))
+	_, err = e.ListTreesByEstates(adminContext(), estateIDs)
+	assert.Equal(t, errors.New("db error"), err)
+}
+
+func Test_estateUsecase_GetDroneDistances(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	e := NewEstateUsecase(mockRepo)
+	estateID := uuid.New()
+	unknownEstateID := uuid.New()
+	estateIDs := []uuid.UUID{estateID, unknownEstateID}
+	routes := []domain.DroneRoute{
+		{Route: 1, Plot: domain.Plot{Row: 1, Col: 1}, Altitude: 1},
+		{Route: 2, Plot: domain.Plot{Row: 1, Col: 2}, Altitude: 6},
+	}
+	maxDistance := 5
+
+	tests := []struct {
+		name        string
+		maxDistance *int
+		mock        func()
+		expect      map[uuid.UUID]domain.DroneDistance
+		expectErr   error
+	}{
+		{
+			name: "Success",
+			mock: func() {
+				mockRepo.EXPECT().GetDroneRoutesByEstates(gomock.Any(), testTenantID, estateIDs).Return(map[uuid.UUID][]domain.DroneRoute{estateID: routes}, nil)
+			},
+			// the unknown estate is absent
+			expect: map[uuid.UUID]domain.DroneDistance{estateID: {Distance: domain.DroneTotalDistance(nil, routes)}},
+		},
+		{
+			name:        "Max distance",
+			maxDistance: &maxDistance,
+			mock: func() {
+				mockRepo.EXPECT().GetDroneRoutesByEstates(gomock.Any(), testTenantID, estateIDs).Return(map[uuid.UUID][]domain.DroneRoute{estateID: routes}, nil)
+			},
+			expect: map[uuid.UUID]domain.DroneDistance{estateID: {Distance: domain.DroneTotalDistance(&maxDistance, routes)}},
+		},
+		{
+			name: "Repository error",
+			mock: func() {
+				mockRepo.EXPECT().GetDroneRoutesByEstates(gomock.Any(), testTenantID, estateIDs).Return(nil, errors.New("db error"))
+			},
+			expectErr: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			got, err := e.GetDroneDistances(adminContext(), estateIDs, tt.maxDistance)
+			assert.Equal(t, tt.expect, got)
+			assert.Equal(t, tt.expectErr, err)
+		})
+	}
+}
+
+func Test_estateUsecase_ExportEstate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	e := NewEstateUsecase(mockRepo)
+	estateID := uuid.New()
+
+	mockRepo.EXPECT().GetEstateSnapshot(gomock.Any(), testTenantID, estateID).Return(&domain.EstateSnapshot{
+		Estate: domain.Estate{ID: estateID, Width: 2, Length: 1, Version: 3},
+	}, nil)
+	got, err := e.ExportEstate(adminContext(), estateID)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.SnapshotVersion, got.Version)
+	assert.False(t, got.ExportedAt.IsZero())
+	assert.Equal(t, 3, got.Estate.Version)
+
+	mockRepo.EXPECT().GetEstateSnapshot(gomock.Any(), testTenantID, estateID).Return(nil, nil)
+	_, err = e.ExportEstate(adminContext(), estateID)
+	assert.Equal(t, domain.ErrorEstatesNotFound, err)
+
+	mockRepo.EXPECT().GetEstateSnapshot(gomock.Any(), testTenantID, estateID).Return(nil, errors.New("db error"))
+	_, err = e.ExportEstate(adminContext(), estateID)
+	assert.Equal(t, errors.New("db error"), err)
+}
+
+func Test_estateUsecase_ImportEstate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	e := NewEstateUsecase(mockRepo)
+	estateID := uuid.New()
+	treeID := uuid.New()
+	planted := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	snapshot := func() *domain.EstateSnapshot {
+		return &domain.EstateSnapshot{
+			Version: domain.SnapshotVersion,
+			Estate:  domain.Estate{ID: estateID, Width: 1, Length: 2, Version: 4},
+			Trees:   []domain.Tree{{ID: treeID, Plot: domain.Plot{Row: 1, Col: 2}, Height: 12, Version: 2}},
+			Measurements: []domain.TreeMeasurement{
+				{TreeID: treeID, Height: 10, MeasuredAt: planted},
+				{TreeID: treeID, Height: 12, MeasuredAt: planted.Add(time.Hour)},
+			},
+		}
+	}
+	droneRoutes := []domain.DroneRoute{
+		{Route: 1, Plot: domain.Plot{Row: 1, Col: 1}, Altitude: 1},
+		{Route: 2, Plot: domain.Plot{Row: 1, Col: 2}, Altitude: 13},
+	}
+
+	t.Run("Keep ids", func(t *testing.T) {
+		mockRepo.EXPECT().ImportEstate(gomock.Any(), gomock.Any(), droneRoutes, gomock.Any()).
+			DoAndReturn(func(_ context.Context, got *domain.EstateSnapshot, _ []domain.DroneRoute, outbox []domain.Event) error {
+				assert.Equal(t, estateID, got.Estate.ID)
+				assert.Equal(t, testTenantID, got.Estate.TenantID)
+				assert.Equal(t, 4, got.Estate.Version)
+				assert.Equal(t, treeID, got.Trees[0].ID)
+				if assert.Len(t, got.Measurements, 2) {
+					assert.Equal(t, treeID, got.Measurements[0].TreeID)
+					assert.Equal(t, planted, got.Measurements[0].MeasuredAt)
+				}
+				if assert.Len(t, outbox, 1) {
+					assert.Equal(t, domain.EventEstateCreated, outbox[0].Type)
+				}
+				return nil
+			})
+		given := snapshot()
+		got, err := e.ImportEstate(adminContext(), given, true)
+		assert.NoError(t, err)
+		assert.Equal(t, estateID, got.ID)
+		assert.Equal(t, uuid.Nil, given.Estate.TenantID)
+	})
+
+	t.Run("New ids", func(t *testing.T) {
+		mockRepo.EXPECT().ImportEstate(gomock.Any(), gomock.Any(), droneRoutes, gomock.Any()).
+			DoAndReturn(func(_ context.Context, got *domain.EstateSnapshot, _ []domain.DroneRoute, _ []domain.Event) error {
+				assert.NotEqual(t, estateID, got.Estate.ID)
+				assert.Equal(t, domain.InitialVersion, got.Estate.Version)
+				assert.NotEqual(t, treeID, got.Trees[0].ID)
+				assert.Equal(t, got.Trees[0].ID, got.Measurements[0].TreeID)
+				return nil
+			})
+		got, err := e.ImportEstate(adminContext(), snapshot(), false)
+		assert.NoError(t, err)
+		assert.NotEqual(t, estateID, got.ID)
+	})
+
+	t.Run("Invalid snapshot", func(t *testing.T) {
+		given := snapshot()
+		given.Trees[0].Height = domain.MaxTreeHeight + 1
+		_, err := e.ImportEstate(adminContext(), given, false)
+		assert.ErrorIs(t, err, domain.ErrorSnapshotInvalid)
+	})
+
+	t.Run("Conflict", func(t *testing.T) {
+		mockRepo.EXPECT().ImportEstate(gomock.Any(), gomock.Any(), droneRoutes, gomock.Any()).Return(domain.ErrorSnapshotConflict)
+		_, err := e.ImportEstate(adminContext(), snapshot(), true)
+		assert.Equal(t, domain.ErrorSnapshotConflict, err)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		viewer := domain.WithPrincipal(context.Background(), &domain.Principal{TenantID: testTenantID, Role: domain.RoleViewer})
+		_, err := e.ImportEstate(viewer, snapshot(), false)
+		assert.ErrorIs(t, err, domain.ErrorForbidden)
+	})
+}
+
+func Test_estateUsecase_DeleteTree(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	mockBroker := interfaces.NewMockEventBroker(ctrl)
+	e := NewEstateUsecase(mockRepo, WithEventBroker(mockBroker))
+	estateID := uuid.New()
+	tree := &domain.Tree{ID: uuid.New(), Plot: domain.Plot{Row: 1, Col: 1}, Height: 5}
+
+	tests := []struct {
+		name   string
+		mock   func()
+		expect error
+	}{
+		{
+			name: "Success",
+			mock: func() {
+				mockRepo.EXPECT().GetTree(gomock.Any(), testTenantID, estateID, tree.ID).Return(tree, nil)
+				mockRepo.EXPECT().DeleteTreeAndDroneRoute(gomock.Any(), testTenantID, estateID, tree, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ uuid.UUID, _ uuid.UUID, _ *domain.Tree, outbox []domain.Event) (domain.DroneDistanceChange, error) {
+						if assert.Len(t, outbox, 1) {
+							assert.Equal(t, domain.EventTreeRemoved, outbox[0].Type)
+						}
+						return domain.DroneDistanceChange{Previous: 12, Current: 2}, nil
+					})
+				mockBroker.EXPECT().Publish(gomock.Any(), gomock.Any())
+				mockBroker.EXPECT().Publish(gomock.Any(), gomock.Any()).Do(func(_ context.Context, event domain.Event) {
+					assert.Equal(t, domain.EventDronePlanDistanceChanged, event.Type)
+					assert.Equal(t, domain.DronePlanEventData{Distance: 2}, event.Data)
+				})
+			},
+		},
+		{
+			name: "Drone distance unchanged",
+			mock: func() {
+				mockRepo.EXPECT().GetTree(gomock.Any(), testTenantID, estateID, tree.ID).Return(tree, nil)
+				mockRepo.EXPECT().DeleteTreeAndDroneRoute(gomock.Any(), testTenantID, estateID, tree, gomock.Any()).
+					Return(domain.DroneDistanceChange{Previous: 2, Current: 2}, nil)
+				mockBroker.EXPECT().Publish(gomock.Any(), gomock.Any()).Do(func(_ context.Context, event domain.Event) {
+					assert.Equal(t, domain.EventTreeRemoved, event.Type)
+				})
+			},
+		},
+		{
+			name: "Tree not found",
+			mock: func() {
+				mockRepo.EXPECT().GetTree(gomock.Any(), testTenantID, estateID, tree.ID).Return(nil, nil)
+			},
+			expect: domain.ErrorTreeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			assert.Equal(t, tt.expect, e.DeleteTree(adminContext(), estateID, tree.ID))
+		})
+	}
+}
+
+func Test_estateUsecase_CloneEstate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	e := NewEstateUsecase(mockRepo)
+	sourceID := uuid.New()
+	treeID := uuid.New()
+	snapshot := &domain.EstateSnapshot{
+		Version:      domain.SnapshotVersion,
+		Estate:       domain.Estate{ID: sourceID, TenantID: testTenantID, Width: 1, Length: 2, Version: 3, TreesVersion: 5},
+		Trees:        []domain.Tree{{ID: treeID, Plot: domain.Plot{Row: 1, Col: 2}, Height: 12, Version: 2}},
+		Measurements: []domain.TreeMeasurement{{TreeID: treeID, Height: 12, MeasuredAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateSnapshot(gomock.Any(), testTenantID, sourceID).Return(snapshot, nil)
+		mockRepo.EXPECT().ImportEstate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, got *domain.EstateSnapshot, _ []domain.DroneRoute, outbox []domain.Event) error {
+				assert.NotEqual(t, sourceID, got.Estate.ID)
+				assert.NotEqual(t, treeID, got.Trees[0].ID)
+				if assert.NotNil(t, got.Estate.Sandbox) {
+					assert.Equal(t, &sourceID, got.Estate.Sandbox.SourceEstateID)
+					assert.Equal(t, 3, got.Estate.Sandbox.SourceVersion)
+					assert.Equal(t, 5, got.Estate.Sandbox.SourceTreesVersion)
+					assert.Equal(t, outbox[0].OccurredAt, got.Estate.Sandbox.ClonedAt)
+				}
+				if assert.Len(t, got.Measurements, 1) {
+					assert.Equal(t, got.Trees[0].ID, got.Measurements[0].TreeID)
+				}
+				return nil
+			})
+		got, err := e.CloneEstate(adminContext(), sourceID)
+		assert.NoError(t, err)
+		assert.NotNil(t, got.Sandbox)
+		assert.Nil(t, snapshot.Estate.Sandbox)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateSnapshot(gomock.Any(), testTenantID, sourceID).Return(nil, nil)
+		_, err := e.CloneEstate(adminContext(), sourceID)
+		assert.Equal(t, domain.ErrorEstatesNotFound, err)
+	})
+
+	t.Run("Scoped to the source", func(t *testing.T) {
+		scoped := domain.WithPrincipal(context.Background(), &domain.Principal{TenantID: testTenantID, Role: domain.RoleAdmin, EstateID: &sourceID})
+		mockRepo.EXPECT().GetEstateSnapshot(gomock.Any(), testTenantID, sourceID).Return(snapshot, nil)
+		mockRepo.EXPECT().ImportEstate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		_, err := e.CloneEstate(scoped, sourceID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Scoped to another estate", func(t *testing.T) {
+		otherID := uuid.New()
+		scoped := domain.WithPrincipal(context.Background(), &domain.Principal{TenantID: testTenantID, Role: domain.RoleAdmin, EstateID: &otherID})
+		_, err := e.CloneEstate(scoped, sourceID)
+		assert.ErrorIs(t, err, domain.ErrorForbidden)
+	})
+}
+
+func Test_estateUsecase_DiffSandbox(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	e := NewEstateUsecase(mockRepo)
+	sourceID := uuid.New()
+	sandbox := &domain.Estate{ID: uuid.New(), Width: 1, Length: 2, Sandbox: &domain.Sandbox{SourceEstateID: &sourceID}}
+	estateIDs := []uuid.UUID{sourceID, sandbox.ID}
+	kept := domain.Tree{ID: uuid.New(), Plot: domain.Plot{Row: 1, Col: 1}, Height: 5}
+	planted := domain.Tree{ID: uuid.New(), Plot: domain.Plot{Row: 1, Col: 2}, Height: 10}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, sandbox.ID).Return(sandbox, nil, nil)
+		mockRepo.EXPECT().ListTreesByEstates(gomock.Any(), testTenantID, estateIDs).Return(map[uuid.UUID][]domain.Tree{
+			sourceID:   {kept},
+			sandbox.ID: {kept, planted},
+		}, nil)
+		mockRepo.EXPECT().GetEstatesStats(gomock.Any(), testTenantID, estateIDs).Return(map[uuid.UUID]domain.EstateStats{
+			sourceID:   {Count: 1, Max: 5, Min: 5, Median: 5},
+			sandbox.ID: {Count: 2, Max: 10, Min: 5, Median: 7},
+		}, nil)
+		mockRepo.EXPECT().GetDroneRoutesByEstates(gomock.Any(), testTenantID, estateIDs).Return(map[uuid.UUID][]domain.DroneRoute{
+			sourceID:   domain.DroneRoutesOverTrees(1, 2, []domain.Tree{kept}),
+			sandbox.ID: domain.DroneRoutesOverTrees(1, 2, []domain.Tree{kept, planted}),
+		}, nil)
+
+		got, err := e.DiffSandbox(adminContext(), sandbox.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, sourceID, got.SourceEstateID)
+		assert.Equal(t, 2, got.Sandbox.Stats.Count)
+		assert.Less(t, got.Source.DroneDistance, got.Sandbox.DroneDistance)
+		assert.Equal(t, domain.TreeChanges{Planted: []domain.Tree{{ID: planted.ID, Plot: planted.Plot, Height: 10, Version: domain.InitialVersion}}}, got.Changes)
+	})
+
+	t.Run("Not a sandbox", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, sourceID).Return(&domain.Estate{ID: sourceID}, nil, nil)
+		_, err := e.DiffSandbox(adminContext(), sourceID)
+		assert.Equal(t, domain.ErrorEstateNotSandbox, err)
+	})
+
+	t.Run("Source deleted", func(t *testing.T) {
+		orphan := &domain.Estate{ID: sandbox.ID, Sandbox: &domain.Sandbox{}}
+		mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, sandbox.ID).Return(orphan, nil, nil)
+		_, err := e.DiffSandbox(adminContext(), sandbox.ID)
+		assert.Equal(t, domain.ErrorEstatesNotFound, err)
+	})
+
+	t.Run("Source of another estate scope", func(t *testing.T) {
+		scoped := domain.WithPrincipal(context.Background(), &domain.Principal{TenantID: testTenantID, Role: domain.RoleViewer, EstateID: &sandbox.ID})
+		mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, sandbox.ID).Return(sandbox, nil, nil)
+		_, err := e.DiffSandbox(scoped, sandbox.ID)
+		assert.ErrorIs(t, err, domain.ErrorForbidden)
+	})
+}
+
+func Test_estateUsecase_PromoteSandbox(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	mockBroker := interfaces.NewMockEventBroker(ctrl)
+	e := NewEstateUsecase(mockRepo, WithEventBroker(mockBroker))
+	source := &domain.Estate{ID: uuid.New(), Width: 1, Length: 2, Version: 2}
+	sandbox := &domain.Estate{ID: uuid.New(), Width: 2, Length: 2, Sandbox: &domain.Sandbox{SourceEstateID: &source.ID}}
+	estateIDs := []uuid.UUID{source.ID, sandbox.ID}
+	removed := domain.Tree{ID: uuid.New(), Plot: domain.Plot{Row: 1, Col: 1}, Height: 5, Version: 3}
+	updated := domain.Tree{ID: uuid.New(), Plot: domain.Plot{Row: 1, Col: 2}, Height: 10, Version: 2}
+
+	t.Run("Success", func(t *testing.T) {
+		planned := updated
+		planned.Height = 20
+		mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, sandbox.ID).Return(sandbox, nil, nil)
+		mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, source.ID).Return(source, nil, nil)
+		mockRepo.EXPECT().ListTreesByEstates(gomock.Any(), testTenantID, estateIDs).Return(map[uuid.UUID][]domain.Tree{
+			source.ID:  {removed, updated},
+			sandbox.ID: {planned},
+		}, nil)
+		mockRepo.EXPECT().PromoteSandbox(gomock.Any(), testTenantID, sandbox, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uuid.UUID, _ *domain.Estate, changes domain.TreeChanges, outbox []domain.Event) (domain.DroneDistanceChange, error) {
+				assert.Equal(t, []domain.Tree{removed}, changes.Removed)
+				if assert.Len(t, changes.Updated, 1) {
+					assert.Equal(t, 20, changes.Updated[0].Height)
+					assert.Equal(t, 2, changes.Updated[0].Version)
+				}
+				if assert.Len(t, outbox, 3) {
+					assert.Equal(t, domain.EventTreeRemoved, outbox[0].Type)
+					assert.Equal(t, source.ID, outbox[0].EstateID)
+					assert.Equal(t, domain.EventTreeUpdated, outbox[1].Type)
+					assert.Equal(t, domain.EventEstateDeleted, outbox[2].Type)
+					assert.Equal(t, sandbox.ID, outbox[2].EstateID)
+				}
+				return domain.DroneDistanceChange{Previous: domain.DistanceBetweenPlot + 22, Current: domain.DistanceBetweenPlot + 42}, nil
+			})
+		mockBroker.EXPECT().Publish(gomock.Any(), gomock.Any()).Times(3)
+		mockBroker.EXPECT().Publish(gomock.Any(), gomock.Any())
+
+		got, err := e.PromoteSandbox(adminContext(), sandbox.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, source, got)
+	})
+
+	t.Run("Planted out of the source bounds", func(t *testing.T) {
+		outside := domain.Tree{ID: uuid.New(), Plot: domain.Plot{Row: 2, Col: 1}, Height: 5}
+		mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, sandbox.ID).Return(sandbox, nil, nil)
+		mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, source.ID).Return(source, nil, nil)
+		mockRepo.EXPECT().ListTreesByEstates(gomock.Any(), testTenantID, estateIDs).Return(map[uuid.UUID][]domain.Tree{
+			sandbox.ID: {outside},
+		}, nil)
+
+		_, err := e.PromoteSandbox(adminContext(), sandbox.ID)
+		assert.Equal(t, domain.ErrorTreePlotOutOfBound, err)
+	})
+
+	t.Run("Stale", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, sandbox.ID).Return(sandbox, nil, nil)
+		mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, source.ID).Return(source, nil, nil)
+		mockRepo.EXPECT().ListTreesByEstates(gomock.Any(), testTenantID, estateIDs).Return(nil, nil)
+		mockRepo.EXPECT().PromoteSandbox(gomock.Any(), testTenantID, sandbox, gomock.Any(), gomock.Any()).Return(domain.DroneDistanceChange{}, domain.ErrorSandboxStale)
+
+		_, err := e.PromoteSandbox(adminContext(), sandbox.ID)
+		assert.Equal(t, domain.ErrorSandboxStale, err)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		viewer := domain.WithPrincipal(context.Background(), &domain.Principal{TenantID: testTenantID, Role: domain.RoleViewer})
+		_, err := e.PromoteSandbox(viewer, sandbox.ID)
+		assert.ErrorIs(t, err, domain.ErrorForbidden)
+	})
+}
+
+func Test_estateUsecase_CreateMission(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	mockDroneRepo := interfaces.NewMockDroneRepository(ctrl)
+	e := NewEstateUsecase(mockRepo, WithDroneRepository(mockDroneRepo))
+	estateID := uuid.New()
+	routes := []domain.DroneRoute{
+		{Route: 1, Plot: domain.Plot{Row: 1, Col: 1}, Altitude: 1},
+		{Route: 2, Plot: domain.Plot{Row: 1, Col: 2}, Altitude: 6},
+	}
+	maxDistance := 5
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo.EXPECT().GetDroneRoutes(gomock.Any(), testTenantID, estateID).Return(routes, nil)
+		mockRepo.EXPECT().CreateMission(gomock.Any(), testTenantID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uuid.UUID, mission *domain.Mission) error {
+				assert.Equal(t, estateID, mission.EstateID)
+				assert.Equal(t, domain.MissionPlanned, mission.Status)
+				return nil
+			})
+
+		got, err := e.CreateMission(adminContext(), estateID, nil, nil, "drone-7", "Budi")
+		assert.NoError(t, err)
+		assert.Len(t, got.Waypoints, 2)
+		assert.Equal(t, 22, got.Distance)
+		assert.Equal(t, "drone-7", got.Drone)
+		assert.Equal(t, "Budi", got.Pilot)
+	})
+
+	t.Run("Limited distance", func(t *testing.T) {
+		mockRepo.EXPECT().GetDroneRoutes(gomock.Any(), testTenantID, estateID).Return(routes, nil)
+		mockRepo.EXPECT().CreateMission(gomock.Any(), testTenantID, gomock.Any()).Return(nil)
+
+		got, err := e.CreateMission(adminContext(), estateID, &maxDistance, nil, "", "")
+		assert.NoError(t, err)
+		assert.Len(t, got.Waypoints, 1)
+		assert.Equal(t, 2, got.Distance)
+		assert.Equal(t, &maxDistance, got.MaxDistance)
+	})
+
+	t.Run("With drone", func(t *testing.T) {
+		drone := &domain.Drone{ID: uuid.New(), Name: "drone-7", MaxRange: 5, MaxAltitude: 10, Speed: domain.DroneSpeed{Cruise: 10, Climb: 4}, Status: domain.DroneAvailable}
+		mockRepo.EXPECT().GetDroneRoutes(gomock.Any(), testTenantID, estateID).Return(routes, nil)
+		mockDroneRepo.EXPECT().GetDrone(gomock.Any(), testTenantID, drone.ID).Return(drone, nil)
+		mockRepo.EXPECT().CreateMission(gomock.Any(), testTenantID, gomock.Any()).Return(nil)
+
+		got, err := e.CreateMission(adminContext(), estateID, nil, &drone.ID, "", "Budi")
+		assert.NoError(t, err)
+		assert.Len(t, got.Waypoints, 1)
+		assert.Equal(t, &drone.MaxRange, got.MaxDistance)
+		assert.Equal(t, &drone.ID, got.DroneID)
+		assert.Equal(t, &domain.DroneProfile{MaxRange: 5, MaxAltitude: 10, Speed: domain.DroneSpeed{Cruise: 10, Climb: 4}}, got.DroneProfile)
+		assert.Equal(t, "drone-7", got.Drone)
+	})
+
+	t.Run("Drone can not fly over trees", func(t *testing.T) {
+		drone := &domain.Drone{ID: uuid.New(), MaxRange: 100, MaxAltitude: 5, Status: domain.DroneAvailable}
+		mockRepo.EXPECT().GetDroneRoutes(gomock.Any(), testTenantID, estateID).Return(routes, nil)
+		mockDroneRepo.EXPECT().GetDrone(gomock.Any(), testTenantID, drone.ID).Return(drone, nil)
+
+		_, err := e.CreateMission(adminContext(), estateID, nil, &drone.ID, "", "")
+		assert.ErrorIs(t, err, domain.ErrorDroneAltitudeExceeded)
+	})
+
+	t.Run("Max distance with drone", func(t *testing.T) {
+		droneID := uuid.New()
+
+		_, err := e.CreateMission(adminContext(), estateID, &maxDistance, &droneID, "", "")
+		assert.Equal(t, domain.ErrorMaxDistanceWithDrone, err)
+	})
+
+	t.Run("Estate not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetDroneRoutes(gomock.Any(), testTenantID, estateID).Return(nil, nil)
+
+		_, err := e.CreateMission(adminContext(), estateID, nil, nil, "", "")
+		assert.Equal(t, domain.ErrorEstatesNotFound, err)
+	})
+}
+
+func Test_estateUsecase_ListMissions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	e := NewEstateUsecase(mockRepo)
+	estateID := uuid.New()
+	planned := domain.MissionPlanned
+	unknown := domain.MissionStatus("paused")
+	missions := []domain.Mission{{ID: uuid.New(), EstateID: estateID, Status: domain.MissionPlanned}}
+
+	tests := []struct {
+		name     string
+		status   *domain.MissionStatus
+		mock     func()
+		expected []domain.Mission
+		err      error
+	}{
+		{
+			name:   "Success",
+			status: &planned,
+			mock: func() {
+				mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, estateID).Return(&domain.Estate{ID: estateID}, nil, nil)
+				mockRepo.EXPECT().ListMissions(gomock.Any(), testTenantID, estateID, &planned, 10, 0).Return(missions, nil)
+			},
+			expected: missions,
+		},
+		{
+			name:   "Estate not found",
+			status: nil,
+			mock: func() {
+				mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, estateID).Return(nil, nil, nil)
+			},
+			err: domain.ErrorEstatesNotFound,
+		},
+		{
+			name:   "Unknown status",
+			status: &unknown,
+			mock:   func() {},
+			err:    domain.ErrorMissionInvalidStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			got, err := e.ListMissions(adminContext(), estateID, tt.status, 10, 0)
+			assert.Equal(t, tt.err, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func Test_estateUsecase_GetMission(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	e := NewEstateUsecase(mockRepo)
+	estateID := uuid.New()
+	mission := &domain.Mission{ID: uuid.New(), EstateID: estateID, Status: domain.MissionPlanned}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo.EXPECT().GetMission(gomock.Any(), testTenantID, estateID, mission.ID).Return(mission, nil)
+
+		got, err := e.GetMission(adminContext(), estateID, mission.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, mission, got)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetMission(gomock.Any(), testTenantID, estateID, mission.ID).Return(nil, nil)
+
+		_, err := e.GetMission(adminContext(), estateID, mission.ID)
+		assert.Equal(t, domain.ErrorMissionNotFound, err)
+	})
+}
+
+func Test_estateUsecase_UpdateMission(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	mockDroneRepo := interfaces.NewMockDroneRepository(ctrl)
+	e := NewEstateUsecase(mockRepo, WithDroneRepository(mockDroneRepo))
+	estateID := uuid.New()
+	missionID := uuid.New()
+	inProgress := domain.MissionInProgress
+	completed := domain.MissionCompleted
+	drone := "drone-7"
+	pilot := "Budi"
+	fleetDrone := &domain.Drone{ID: uuid.New(), Name: "Agras #1", MaxRange: 100, MaxAltitude: 50, Status: domain.DroneAvailable}
+
+	tests := []struct {
+		name     string
+		update   domain.MissionUpdate
+		mock     func()
+		expected domain.MissionStatus
+		err      error
+	}{
+		{
+			name:   "Assign and start",
+			update: domain.MissionUpdate{Status: &inProgress, Drone: &drone, Pilot: &pilot},
+			mock: func() {
+				mockRepo.EXPECT().GetMission(gomock.Any(), testTenantID, estateID, missionID).
+					Return(&domain.Mission{ID: missionID, EstateID: estateID, Status: domain.MissionPlanned}, nil)
+				mockRepo.EXPECT().UpdateMission(gomock.Any(), testTenantID, gomock.Any(), domain.MissionPlanned).
+					DoAndReturn(func(_ context.Context, _ uuid.UUID, mission *domain.Mission, _ domain.MissionStatus) error {
+						assert.Equal(t, domain.MissionInProgress, mission.Status)
+						assert.NotNil(t, mission.StartedAt)
+						return nil
+					})
+			},
+			expected: domain.MissionInProgress,
+		},
+		{
+			name:   "Start with fleet drone",
+			update: domain.MissionUpdate{Status: &inProgress, Pilot: &pilot},
+			mock: func() {
+				mockRepo.EXPECT().GetMission(gomock.Any(), testTenantID, estateID, missionID).
+					Return(&domain.Mission{ID: missionID, EstateID: estateID, Status: domain.MissionPlanned, DroneID: &fleetDrone.ID, Drone: fleetDrone.Name}, nil)
+				mockRepo.EXPECT().GetDroneRoutes(gomock.Any(), testTenantID, estateID).Return([]domain.DroneRoute{{Altitude: 6}}, nil)
+				mockDroneRepo.EXPECT().GetDrone(gomock.Any(), testTenantID, fleetDrone.ID).Return(fleetDrone, nil)
+				mockRepo.EXPECT().UpdateMission(gomock.Any(), testTenantID, gomock.Any(), domain.MissionPlanned).Return(nil)
+			},
+			expected: domain.MissionInProgress,
+		},
+		{
+			name:   "Start with fleet drone in maintenance",
+			update: domain.MissionUpdate{Status: &inProgress, Pilot: &pilot},
+			mock: func() {
+				mockRepo.EXPECT().GetMission(gomock.Any(), testTenantID, estateID, missionID).
+					Return(&domain.Mission{ID: missionID, EstateID: estateID, Status: domain.MissionPlanned, DroneID: &fleetDrone.ID, Drone: fleetDrone.Name}, nil)
+				mockRepo.EXPECT().GetDroneRoutes(gomock.Any(), testTenantID, estateID).Return([]domain.DroneRoute{{Altitude: 6}}, nil)
+				inMaintenance := *fleetDrone
+				inMaintenance.Status = domain.DroneMaintenance
+				mockDroneRepo.EXPECT().GetDrone(gomock.Any(), testTenantID, fleetDrone.ID).Return(&inMaintenance, nil)
+			},
+			err: domain.ErrorDroneUnavailable,
+		},
+		{
+			name:   "Relabel fleet drone",
+			update: domain.MissionUpdate{Drone: &drone},
+			mock: func() {
+				mockRepo.EXPECT().GetMission(gomock.Any(), testTenantID, estateID, missionID).
+					Return(&domain.Mission{ID: missionID, EstateID: estateID, Status: domain.MissionPlanned, DroneID: &fleetDrone.ID, Drone: fleetDrone.Name}, nil)
+			},
+			err: domain.ErrorMissionFleetDrone,
+		},
+		{
+			name:   "Invalid transition",
+			update: domain.MissionUpdate{Status: &completed},
+			mock: func() {
+				mockRepo.EXPECT().GetMission(gomock.Any(), testTenantID, estateID, missionID).
+					Return(&domain.Mission{ID: missionID, EstateID: estateID, Status: domain.MissionPlanned}, nil)
+			},
+			err: domain.ErrorMissionInvalidTransition,
+		},
+		{
+			name:   "Moved by another request",
+			update: domain.MissionUpdate{Status: &completed},
+			mock: func() {
+				mockRepo.EXPECT().GetMission(gomock.Any(), testTenantID, estateID, missionID).
+					Return(&domain.Mission{ID: missionID, EstateID: estateID, Status: domain.MissionInProgress, Drone: drone, Pilot: pilot}, nil)
+				mockRepo.EXPECT().UpdateMission(gomock.Any(), testTenantID, gomock.Any(), domain.MissionInProgress).
+					Return(domain.ErrorMissionInvalidTransition)
+			},
+			err: domain.ErrorMissionInvalidTransition,
+		},
+		{
+			name:   "Not found",
+			update: domain.MissionUpdate{Status: &completed},
+			mock: func() {
+				mockRepo.EXPECT().GetMission(gomock.Any(), testTenantID, estateID, missionID).Return(nil, nil)
+			},
+			err: domain.ErrorMissionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			got, err := e.UpdateMission(adminContext(), estateID, missionID, tt.update)
+			assert.ErrorIs(t, err, tt.err)
+			if tt.err == nil {
+				assert.Equal(t, tt.expected, got.Status)
+			}
+		})
+	}
+}
+
+func Test_estateUsecase_RecordTelemetry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	e := NewEstateUsecase(mockRepo)
+	estateID := uuid.New()
+	missionID := uuid.New()
+	at := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	estate := &domain.Estate{ID: estateID, Length: 2, Width: 1}
+	waypoints := []domain.DroneWaypoint{
+		{Sequence: 1, Plot: domain.Plot{Row: 1, Col: 1}, Altitude: 1, Distance: 1},
+		{Sequence: 2, Plot: domain.Plot{Row: 1, Col: 2}, Altitude: 1, Distance: 11},
+	}
+	// recorded out of order, stored sorted by time
+	samples := func() []domain.TelemetrySample {
+		return []domain.TelemetrySample{
+			{RecordedAt: at.Add(time.Second), X: 10, Y: 0, Altitude: 1},
+			{RecordedAt: at, X: 0, Y: 0, Altitude: 1},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		samples []domain.TelemetrySample
+		mock    func()
+		visited int
+		err     error
+	}{
+		{
+			name:    "Success",
+			samples: samples(),
+			mock: func() {
+				mockRepo.EXPECT().GetMission(gomock.Any(), testTenantID, estateID, missionID).
+					Return(&domain.Mission{ID: missionID, EstateID: estateID, Status: domain.MissionCompleted, Waypoints: waypoints}, nil)
+				mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, estateID).Return(estate, &domain.EstateStats{}, nil)
+				mockRepo.EXPECT().ReplaceMissionTelemetry(gomock.Any(), testTenantID, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ uuid.UUID, _ *domain.Mission, samples []domain.TelemetrySample) error {
+						assert.Equal(t, at, samples[0].RecordedAt)
+						return nil
+					})
+			},
+			visited: 2,
+		},
+		{
+			name:    "Mission still planned",
+			samples: samples(),
+			mock: func() {
+				mockRepo.EXPECT().GetMission(gomock.Any(), testTenantID, estateID, missionID).
+					Return(&domain.Mission{ID: missionID, EstateID: estateID, Status: domain.MissionPlanned, Waypoints: waypoints}, nil)
+				mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, estateID).Return(estate, &domain.EstateStats{}, nil)
+			},
+			err: domain.ErrorMissionNotFlown,
+		},
+		{
+			name:    "Mission not found",
+			samples: samples(),
+			mock: func() {
+				mockRepo.EXPECT().GetMission(gomock.Any(), testTenantID, estateID, missionID).Return(nil, nil)
+			},
+			err: domain.ErrorMissionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			got, err := e.RecordTelemetry(adminContext(), estateID, missionID, tt.samples)
+			assert.Equal(t, tt.err, err)
+			if tt.err == nil {
+				assert.Equal(t, tt.visited, got.VisitedPlots)
+				assert.Equal(t, got.PlannedDistance, got.ActualDistance)
+			}
+		})
+	}
+
+	t.Run("Invalid samples", func(t *testing.T) {
+		_, err := e.RecordTelemetry(adminContext(), estateID, missionID, nil)
+		assert.ErrorIs(t, err, domain.ErrorTelemetryInvalid)
+	})
+}
+
+func Test_estateUsecase_GetTelemetryReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	e := NewEstateUsecase(mockRepo)
+	estateID := uuid.New()
+	missionID := uuid.New()
+	mission := &domain.Mission{
+		ID:        missionID,
+		EstateID:  estateID,
+		Status:    domain.MissionAborted,
+		Waypoints: []domain.DroneWaypoint{{Sequence: 1, Plot: domain.Plot{Row: 1, Col: 1}, Altitude: 1, Distance: 1}},
+	}
+	estate := &domain.Estate{ID: estateID, Length: 1, Width: 1}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo.EXPECT().GetMission(gomock.Any(), testTenantID, estateID, missionID).Return(mission, nil)
+		mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, estateID).Return(estate, &domain.EstateStats{}, nil)
+		mockRepo.EXPECT().GetMissionTelemetry(gomock.Any(), testTenantID, estateID, missionID).
+			Return([]domain.TelemetrySample{{RecordedAt: time.Now(), X: 0, Y: 0, Altitude: 1}}, nil)
+
+		got, err := e.GetTelemetryReport(adminContext(), estateID, missionID)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, got.VisitedPlots)
+	})
+
+	t.Run("No telemetry", func(t *testing.T) {
+		mockRepo.EXPECT().GetMission(gomock.Any(), testTenantID, estateID, missionID).Return(mission, nil)
+		mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, estateID).Return(estate, &domain.EstateStats{}, nil)
+		mockRepo.EXPECT().GetMissionTelemetry(gomock.Any(), testTenantID, estateID, missionID).Return([]domain.TelemetrySample{}, nil)
+
+		_, err := e.GetTelemetryReport(adminContext(), estateID, missionID)
+		assert.Equal(t, domain.ErrorTelemetryNotFound, err)
+	})
+}
+
+func Test_estateUsecase_GetDroneDistance_WithDrone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	mockDroneRepo := interfaces.NewMockDroneRepository(ctrl)
+	e := NewEstateUsecase(mockRepo, WithDroneRepository(mockDroneRepo))
+	estateID := uuid.New()
+	routes := []domain.DroneRoute{
+		{Route: 1, Plot: domain.Plot{Row: 1, Col: 1}, Altitude: 1},
+		{Route: 2, Plot: domain.Plot{Row: 1, Col: 2}, Altitude: 6},
+	}
+	drone := &domain.Drone{
+		ID:          uuid.New(),
+		MaxRange:    100,
+		MaxAltitude: 10,
+		Speed:       domain.DroneSpeed{Cruise: 10, Climb: 4},
+		Status:      domain.DroneAvailable,
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo.EXPECT().GetDroneRoutes(gomock.Any(), testTenantID, estateID).Return(routes, nil)
+		mockDroneRepo.EXPECT().GetDrone(gomock.Any(), testTenantID, drone.ID).Return(drone, nil)
+
+		got, err := e.GetDroneDistance(adminContext(), estateID, nil, &drone.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, 22, got.Distance)
+		assert.Equal(t, &domain.Plot{Row: 1, Col: 2}, got.Rest)
+		// 10 meters at cruise speed and 12 meters up and down at climb speed
+		assert.Equal(t, 4*time.Second, *got.FlightTime)
+	})
+
+	t.Run("Drone in maintenance", func(t *testing.T) {
+		maintained := *drone
+		maintained.Status = domain.DroneMaintenance
+		mockRepo.EXPECT().GetDroneRoutes(gomock.Any(), testTenantID, estateID).Return(routes, nil)
+		mockDroneRepo.EXPECT().GetDrone(gomock.Any(), testTenantID, drone.ID).Return(&maintained, nil)
+
+		_, err := e.GetDroneDistance(adminContext(), estateID, nil, &drone.ID)
+		assert.ErrorIs(t, err, domain.ErrorDroneUnavailable)
+	})
+
+	t.Run("Drone not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetDroneRoutes(gomock.Any(), testTenantID, estateID).Return(routes, nil)
+		mockDroneRepo.EXPECT().GetDrone(gomock.Any(), testTenantID, drone.ID).Return(nil, nil)
+
+		_, err := e.GetDroneDistance(adminContext(), estateID, nil, &drone.ID)
+		assert.Equal(t, domain.ErrorDroneNotFound, err)
+	})
+}
+
+func Test_estateUsecase_ReplaceChargingPads(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	e := NewEstateUsecase(mockRepo)
+	estateID := uuid.New()
+	estate := &domain.Estate{ID: estateID, Width: 2, Length: 5}
+
+	t.Run("Success", func(t *testing.T) {
+		sorted := []domain.Plot{{Row: 1, Col: 4}, {Row: 2, Col: 1}}
+		mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, estateID).Return(estate, &domain.EstateStats{}, nil)
+		mockRepo.EXPECT().ReplaceChargingPads(gomock.Any(), testTenantID, estateID, sorted).Return(nil)
+
+		got, err := e.ReplaceChargingPads(adminContext(), estateID, []domain.Plot{{Row: 2, Col: 1}, {Row: 1, Col: 4}})
+		assert.NoError(t, err)
+		assert.Equal(t, sorted, got)
+	})
+
+	t.Run("Pad outside of the estate", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, estateID).Return(estate, &domain.EstateStats{}, nil)
+
+		_, err := e.ReplaceChargingPads(adminContext(), estateID, []domain.Plot{{Row: 3, Col: 1}})
+		assert.ErrorIs(t, err, domain.ErrorChargingPadInvalid)
+	})
+
+	t.Run("Estate not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, estateID).Return(nil, nil, nil)
+
+		_, err := e.ReplaceChargingPads(adminContext(), estateID, nil)
+		assert.Equal(t, domain.ErrorEstatesNotFound, err)
+	})
+}
+
+func Test_estateUsecase_GetSortiePlan(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	mockDroneRepo := interfaces.NewMockDroneRepository(ctrl)
+	e := NewEstateUsecase(mockRepo, WithDroneRepository(mockDroneRepo))
+	estateID := uuid.New()
+	estate := &domain.Estate{ID: estateID, Width: 1, Length: 5}
+	routes := domain.DroneZigzagTraverse(1, 5)
+	drone := &domain.Drone{ID: uuid.New(), MaxRange: 25, MaxAltitude: 10, Speed: domain.DroneSpeed{Cruise: 10, Climb: 1}, Status: domain.DroneAvailable}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, estateID).Return(estate, &domain.EstateStats{}, nil)
+		mockRepo.EXPECT().ListChargingPads(gomock.Any(), testTenantID, estateID).
+			Return([]domain.Plot{{Row: 1, Col: 1}, {Row: 1, Col: 3}, {Row: 1, Col: 5}}, nil)
+		mockRepo.EXPECT().GetDroneRoutes(gomock.Any(), testTenantID, estateID).Return(routes, nil)
+		mockDroneRepo.EXPECT().GetDrone(gomock.Any(), testTenantID, drone.ID).Return(drone, nil)
+
+		got, err := e.GetSortiePlan(adminContext(), estateID, drone.ID)
+		assert.NoError(t, err)
+		assert.Len(t, got.Sorties, 2)
+		assert.Equal(t, 1, got.BatterySwaps())
+	})
+
+	t.Run("Pads outside of the estate are not used", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, estateID).Return(estate, &domain.EstateStats{}, nil)
+		mockRepo.EXPECT().ListChargingPads(gomock.Any(), testTenantID, estateID).Return([]domain.Plot{{Row: 2, Col: 1}}, nil)
+
+		_, err := e.GetSortiePlan(adminContext(), estateID, drone.ID)
+		assert.Equal(t, domain.ErrorNoChargingPads, err)
+	})
+
+	t.Run("Plot out of range", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, estateID).Return(estate, &domain.EstateStats{}, nil)
+		mockRepo.EXPECT().ListChargingPads(gomock.Any(), testTenantID, estateID).Return([]domain.Plot{{Row: 1, Col: 1}}, nil)
+		mockRepo.EXPECT().GetDroneRoutes(gomock.Any(), testTenantID, estateID).Return(routes, nil)
+		mockDroneRepo.EXPECT().GetDrone(gomock.Any(), testTenantID, drone.ID).Return(drone, nil)
+
+		_, err := e.GetSortiePlan(adminContext(), estateID, drone.ID)
+		assert.ErrorIs(t, err, domain.ErrorPlotOutOfPadRange)
+	})
+
+	t.Run("Estate too large", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), testTenantID, estateID).
+			Return(&domain.Estate{ID: estateID, Width: 1000, Length: 1000}, &domain.EstateStats{}, nil)
+
+		_, err := e.GetSortiePlan(adminContext(), estateID, drone.ID)
+		assert.Equal(t, domain.ErrorSortiePlanTooLarge, err)
+	})
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+)
+
+type idempotencyUsecase struct {
+	idempotencyRepository interfaces.IdempotencyRepository
+	ttl                   time.Duration
+	now                   func() time.Time
+}
+
+func NewIdempotencyUsecase(repo interfaces.IdempotencyRepository, ttl time.Duration) *idempotencyUsecase {
+	return &idempotencyUsecase{
+		idempotencyRepository: repo,
+		ttl:                   ttl,
+		now:                   time.Now,
+	}
+}
+
+// Begin reserve idempotency key in the caller tenant. It return nil when the key is reserved and the request should be processed,
+// or the completed record when the request is a replay that should get the original response
+func (i *idempotencyUsecase) Begin(ctx context.Context, key string, requestHash string) (*domain.IdempotencyRecord, error) {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrorUnauthenticated
+	}
+
+	err := domain.ValidateIdempotencyKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	now := i.now().UTC()
+	reserved, err := i.idempotencyRepository.ReserveIdempotencyKey(ctx, &domain.IdempotencyRecord{
+		TenantID:    principal.TenantID,
+		Key:         key,
+		RequestHash: requestHash,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(i.ttl),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if reserved {
+		return nil, nil
+	}
+
+	record, err := i.idempotencyRepository.GetIdempotencyRecord(ctx, principal.TenantID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// a reused key is rejected whether the original request is still in progress or not
+	if record != nil && record.RequestHash != requestHash {
+		return nil, domain.ErrorIdempotencyKeyReused
+	}
+
+	// record is gone when the original request failed and released the key in between
+	if record == nil || !record.IsCompleted() {
+		return nil, domain.ErrorIdempotencyRequestInProgress
+	}
+
+	return record, nil
+}
+
+// Complete store the response of a reserved key. Server errors release the key instead,
+// so the client can retry the request with the same key
+func (i *idempotencyUsecase) Complete(ctx context.Context, key string, statusCode int, responseBody []byte) error {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return domain.ErrorUnauthenticated
+	}
+
+	if statusCode >= http.StatusInternalServerError {
+		return i.idempotencyRepository.DeleteIdempotencyRecord(ctx, principal.TenantID, key)
+	}
+
+	return i.idempotencyRepository.CompleteIdempotencyRecord(ctx, principal.TenantID, key, statusCode, responseBody)
+}
+
+// PurgeExpired delete records past their ttl, expired keys can be reused even before they are purged
+func (i *idempotencyUsecase) PurgeExpired(ctx context.Context) error {
+	return i.idempotencyRepository.DeleteExpiredIdempotencyRecords(ctx, i.now().UTC())
+}
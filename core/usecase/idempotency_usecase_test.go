@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_idempotencyUsecase_Begin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := interfaces.NewMockIdempotencyRepository(ctrl)
+	u := NewIdempotencyUsecase(repo, time.Hour)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	u.now = func() time.Time { return now }
+	ctx := adminContext()
+
+	completed := &domain.IdempotencyRecord{TenantID: testTenantID, Key: "key", RequestHash: "hash", StatusCode: http.StatusCreated, ResponseBody: []byte(`{}`)}
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		key       string
+		mock      func()
+		expected  *domain.IdempotencyRecord
+		wantError error
+	}{
+		{
+			name: "Reserved",
+			ctx:  ctx,
+			key:  "key",
+			mock: func() {
+				repo.EXPECT().ReserveIdempotencyKey(ctx, &domain.IdempotencyRecord{
+					TenantID:    testTenantID,
+					Key:         "key",
+					RequestHash: "hash",
+					CreatedAt:   now,
+					ExpiresAt:   now.Add(time.Hour),
+				}).Return(true, nil)
+			},
+			expected: nil,
+		},
+		{
+			name: "Replay",
+			ctx:  ctx,
+			key:  "key",
+			mock: func() {
+				repo.EXPECT().ReserveIdempotencyKey(ctx, gomock.Any()).Return(false, nil)
+				repo.EXPECT().GetIdempotencyRecord(ctx, testTenantID, "key").Return(completed, nil)
+			},
+			expected: completed,
+		},
+		{
+			name: "Reused with different request",
+			ctx:  ctx,
+			key:  "key",
+			mock: func() {
+				repo.EXPECT().ReserveIdempotencyKey(ctx, gomock.Any()).Return(false, nil)
+				repo.EXPECT().GetIdempotencyRecord(ctx, testTenantID, "key").Return(&domain.IdempotencyRecord{RequestHash: "other", StatusCode: http.StatusCreated}, nil)
+			},
+			wantError: domain.ErrorIdempotencyKeyReused,
+		},
+		{
+			name: "Reused while in progress",
+			ctx:  ctx,
+			key:  "key",
+			mock: func() {
+				repo.EXPECT().ReserveIdempotencyKey(ctx, gomock.Any()).Return(false, nil)
+				repo.EXPECT().GetIdempotencyRecord(ctx, testTenantID, "key").Return(&domain.IdempotencyRecord{RequestHash: "other"}, nil)
+			},
+			wantError: domain.ErrorIdempotencyKeyReused,
+		},
+		{
+			name: "In progress",
+			ctx:  ctx,
+			key:  "key",
+			mock: func() {
+				repo.EXPECT().ReserveIdempotencyKey(ctx, gomock.Any()).Return(false, nil)
+				repo.EXPECT().GetIdempotencyRecord(ctx, testTenantID, "key").Return(&domain.IdempotencyRecord{RequestHash: "hash"}, nil)
+			},
+			wantError: domain.ErrorIdempotencyRequestInProgress,
+		},
+		{
+			name:      "Invalid key",
+			ctx:       ctx,
+			key:       "",
+			mock:      func() {},
+			wantError: domain.ErrorIdempotencyKeyInvalid,
+		},
+		{
+			name:      "Unauthenticated",
+			ctx:       context.Background(),
+			key:       "key",
+			mock:      func() {},
+			wantError: domain.ErrorUnauthenticated,
+		},
+		{
+			name: "Repository error",
+			ctx:  ctx,
+			key:  "key",
+			mock: func() {
+				repo.EXPECT().ReserveIdempotencyKey(ctx, gomock.Any()).Return(false, errors.New("repo error"))
+			},
+			wantError: errors.New("repo error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			got, err := u.Begin(tt.ctx, tt.key, "hash")
+			assert.Equal(t, tt.wantError, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func Test_idempotencyUsecase_Complete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := interfaces.NewMockIdempotencyRepository(ctrl)
+	u := NewIdempotencyUsecase(repo, time.Hour)
+	ctx := adminContext()
+
+	repo.EXPECT().CompleteIdempotencyRecord(ctx, testTenantID, "key", http.StatusBadRequest, []byte(`{"message":"tree already exists"}`)).Return(nil)
+	assert.NoError(t, u.Complete(ctx, "key", http.StatusBadRequest, []byte(`{"message":"tree already exists"}`)))
+
+	repo.EXPECT().DeleteIdempotencyRecord(ctx, testTenantID, "key").Return(nil)
+	assert.NoError(t, u.Complete(ctx, "key", http.StatusInternalServerError, nil))
+}
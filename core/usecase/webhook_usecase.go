@@ -0,0 +1,284 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/google/uuid"
+)
+
+const webhookDispatchBatchSize = 100
+
+// webhookClaimDuration is how long a dispatcher replica holds the events and deliveries it claimed,
+// long enough to send a whole batch with the default delivery timeout. Claims of a crashed replica expire after it
+const webhookClaimDuration = 20 * time.Minute
+
+type webhookUsecase struct {
+	webhookRepository interfaces.WebhookRepository
+	webhookSender     interfaces.WebhookSender
+	outboxRetention   time.Duration
+	now               func() time.Time
+}
+
+func NewWebhookUsecase(repo interfaces.WebhookRepository, sender interfaces.WebhookSender, outboxRetention time.Duration) *webhookUsecase {
+	return &webhookUsecase{
+		webhookRepository: repo,
+		webhookSender:     sender,
+		outboxRetention:   outboxRetention,
+		now:               time.Now,
+	}
+}
+
+// CreateWebhook validate and create webhook subscription in the caller tenant, its estate must belong to the tenant.
+// A random secret is generated when it is not given
+func (w *webhookUsecase) CreateWebhook(ctx context.Context, subscription *domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	principal, err := domain.Authorize(ctx, domain.RoleAdmin, subscription.EstateID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = subscription.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if subscription.EstateID != nil {
+		exists, err := w.webhookRepository.EstateExists(ctx, principal.TenantID, *subscription.EstateID)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, domain.ErrorEstatesNotFound
+		}
+	}
+
+	if subscription.Secret == "" {
+		subscription.Secret, err = generateWebhookSecret()
+		if err != nil {
+			return nil, err
+		}
+	}
+	subscription.ID = uuid.New()
+	subscription.TenantID = principal.TenantID
+	subscription.CreatedAt = w.now().UTC()
+
+	err = w.webhookRepository.CreateWebhookSubscription(ctx, subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+// ListWebhooks list webhook subscriptions of the caller tenant the caller is admin of
+func (w *webhookUsecase) ListWebhooks(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	principal, err := webhookAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := w.webhookRepository.GetWebhookSubscriptions(ctx, principal.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []domain.WebhookSubscription{}
+	for _, subscription := range subscriptions {
+		if principal.Can(domain.RoleAdmin, subscription.EstateID) {
+			result = append(result, subscription)
+		}
+	}
+	return result, nil
+}
+
+// DeleteWebhook delete webhook subscription and its pending deliveries, the caller must be admin of its estate
+func (w *webhookUsecase) DeleteWebhook(ctx context.Context, webhookID uuid.UUID) error {
+	principal, err := webhookAdmin(ctx)
+	if err != nil {
+		return err
+	}
+
+	subscription, err := w.webhookRepository.GetWebhookSubscription(ctx, principal.TenantID, webhookID)
+	if err != nil {
+		return err
+	}
+
+	if subscription == nil {
+		return domain.ErrorWebhookNotFound
+	}
+
+	_, err = domain.Authorize(ctx, domain.RoleAdmin, subscription.EstateID)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := w.webhookRepository.DeleteWebhookSubscription(ctx, principal.TenantID, webhookID)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return domain.ErrorWebhookNotFound
+	}
+
+	return nil
+}
+
+// ListDeadLetters list deliveries that failed after all attempts, of subscriptions the caller is admin of
+func (w *webhookUsecase) ListDeadLetters(ctx context.Context) ([]domain.WebhookDelivery, error) {
+	principal, err := webhookAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, err := w.webhookRepository.GetDeadWebhookDeliveries(ctx, principal.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []domain.WebhookDelivery{}
+	for _, delivery := range deliveries {
+		if principal.Can(domain.RoleAdmin, delivery.Subscription.EstateID) {
+			result = append(result, delivery)
+		}
+	}
+	return result, nil
+}
+
+// RetryDeadLetter schedule dead delivery to be sent again on the next dispatch,
+// the caller must be admin of the estate of its subscription
+func (w *webhookUsecase) RetryDeadLetter(ctx context.Context, deliveryID uuid.UUID) error {
+	principal, err := webhookAdmin(ctx)
+	if err != nil {
+		return err
+	}
+
+	delivery, err := w.webhookRepository.GetWebhookDelivery(ctx, principal.TenantID, deliveryID)
+	if err != nil {
+		return err
+	}
+
+	if delivery == nil {
+		return domain.ErrorWebhookDeliveryNotFound
+	}
+
+	_, err = domain.Authorize(ctx, domain.RoleAdmin, delivery.Subscription.EstateID)
+	if err != nil {
+		return err
+	}
+
+	retried, err := w.webhookRepository.RetryDeadWebhookDelivery(ctx, principal.TenantID, deliveryID, w.now().UTC())
+	if err != nil {
+		return err
+	}
+
+	if !retried {
+		return domain.ErrorWebhookDeliveryNotFound
+	}
+
+	return nil
+}
+
+// webhookAdmin return the caller principal when it is admin of its scope, either the tenant or one estate.
+// Subscriptions and deliveries are then authorized against the estate of the subscription
+func webhookAdmin(ctx context.Context) (*domain.Principal, error) {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrorUnauthenticated
+	}
+	return domain.Authorize(ctx, domain.RoleAdmin, principal.EstateID)
+}
+
+// DispatchWebhooks fan out committed outbox events into deliveries for matching subscriptions,
+// then send deliveries that are due. Failed deliveries are retried with backoff until they are dead.
+// Events and deliveries are claimed first, so replicas dispatching concurrently do not send them twice.
+// It is run by the background dispatcher, not on behalf of a caller, so it is not authorized
+func (w *webhookUsecase) DispatchWebhooks(ctx context.Context) error {
+	err := w.fanOutOutboxEvents(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := w.now().UTC()
+	deliveries, err := w.webhookRepository.ClaimDueWebhookDeliveries(ctx, now, now.Add(webhookClaimDuration), webhookDispatchBatchSize)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for i := range deliveries {
+		delivery := &deliveries[i]
+
+		err := w.webhookSender.Send(ctx, delivery)
+		if err != nil {
+			delivery.RecordFailure(err, now)
+		} else {
+			delivery.RecordSuccess()
+		}
+
+		err = w.webhookRepository.UpdateWebhookDelivery(ctx, delivery)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (w *webhookUsecase) fanOutOutboxEvents(ctx context.Context) error {
+	now := w.now().UTC()
+	events, err := w.webhookRepository.ClaimPendingOutboxEvents(ctx, now, now.Add(webhookClaimDuration), webhookDispatchBatchSize)
+	if err != nil {
+		return err
+	}
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	subscriptions, err := w.webhookRepository.GetAllWebhookSubscriptions(ctx)
+	if err != nil {
+		return err
+	}
+
+	eventIDs := make([]uuid.UUID, 0, len(events))
+	deliveries := []domain.WebhookDelivery{}
+	for _, event := range events {
+		eventIDs = append(eventIDs, event.ID)
+		for _, subscription := range subscriptions {
+			if !subscription.Matches(event) {
+				continue
+			}
+			deliveries = append(deliveries, domain.WebhookDelivery{
+				ID:            uuid.New(),
+				Subscription:  subscription,
+				Event:         event,
+				Status:        domain.WebhookDeliveryPending,
+				NextAttemptAt: now,
+			})
+		}
+	}
+
+	return w.webhookRepository.CreateWebhookDeliveries(ctx, eventIDs, deliveries)
+}
+
+// PurgeOutboxEvents delete outbox events fanned out longer than the retention ago together with their delivered deliveries.
+// Events with a pending or dead delivery are kept so they can still be sent or retried from the dead-letter list.
+// Event streams replay from the broker history, not from the outbox, so the purge does not shorten their replay
+func (w *webhookUsecase) PurgeOutboxEvents(ctx context.Context) error {
+	return w.webhookRepository.DeleteProcessedOutboxEvents(ctx, w.now().UTC().Add(-w.outboxRetention))
+}
+
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	_, err := rand.Read(secret)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
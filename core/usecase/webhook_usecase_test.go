@@ -0,0 +1,277 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_webhookUsecase_CreateWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := interfaces.NewMockWebhookRepository(ctrl)
+	u := NewWebhookUsecase(repo, interfaces.NewMockWebhookSender(ctrl), time.Hour)
+	ctx := adminContext()
+	estateID := uuid.New()
+
+	tests := []struct {
+		name         string
+		subscription *domain.WebhookSubscription
+		mock         func()
+		wantError    error
+	}{
+		{
+			name:         "Success with generated secret",
+			subscription: &domain.WebhookSubscription{URL: "https://erp.example.com/hooks"},
+			mock: func() {
+				repo.EXPECT().CreateWebhookSubscription(ctx, gomock.Any()).Return(nil)
+			},
+			wantError: nil,
+		},
+		{
+			name:         "Success for an estate",
+			subscription: &domain.WebhookSubscription{URL: "https://erp.example.com/hooks", EstateID: &estateID},
+			mock: func() {
+				repo.EXPECT().EstateExists(ctx, testTenantID, estateID).Return(true, nil)
+				repo.EXPECT().CreateWebhookSubscription(ctx, gomock.Any()).Return(nil)
+			},
+			wantError: nil,
+		},
+		{
+			name:         "Estate of another tenant",
+			subscription: &domain.WebhookSubscription{URL: "https://erp.example.com/hooks", EstateID: &estateID},
+			mock: func() {
+				repo.EXPECT().EstateExists(ctx, testTenantID, estateID).Return(false, nil)
+			},
+			wantError: domain.ErrorEstatesNotFound,
+		},
+		{
+			name:         "Invalid url",
+			subscription: &domain.WebhookSubscription{URL: "erp"},
+			mock:         func() {},
+			wantError:    domain.ErrorWebhookInvalidURL,
+		},
+		{
+			name:         "Private host",
+			subscription: &domain.WebhookSubscription{URL: "http://169.254.169.254/latest/meta-data"},
+			mock:         func() {},
+			wantError:    domain.ErrorWebhookPrivateHost,
+		},
+		{
+			name:         "Repository error",
+			subscription: &domain.WebhookSubscription{URL: "https://erp.example.com/hooks", Secret: "secret"},
+			mock: func() {
+				repo.EXPECT().CreateWebhookSubscription(ctx, gomock.Any()).Return(errors.New("repo error"))
+			},
+			wantError: errors.New("repo error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			got, err := u.CreateWebhook(ctx, tt.subscription)
+			assert.Equal(t, tt.wantError, err)
+			if tt.wantError == nil {
+				assert.NotEqual(t, uuid.Nil, got.ID)
+				assert.Equal(t, testTenantID, got.TenantID)
+				assert.Len(t, got.Secret, 64)
+			}
+		})
+	}
+}
+
+func Test_webhookUsecase_DeleteWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := interfaces.NewMockWebhookRepository(ctrl)
+	u := NewWebhookUsecase(repo, interfaces.NewMockWebhookSender(ctrl), time.Hour)
+	ctx := adminContext()
+	estateID := uuid.New()
+	estateAdmin := domain.WithPrincipal(context.Background(), &domain.Principal{TenantID: testTenantID, Role: domain.RoleAdmin, EstateID: &estateID})
+	global := &domain.WebhookSubscription{ID: uuid.New(), TenantID: testTenantID}
+	scoped := &domain.WebhookSubscription{ID: uuid.New(), TenantID: testTenantID, EstateID: &estateID}
+
+	repo.EXPECT().GetWebhookSubscription(ctx, testTenantID, global.ID).Return(global, nil)
+	repo.EXPECT().DeleteWebhookSubscription(ctx, testTenantID, global.ID).Return(true, nil)
+	assert.NoError(t, u.DeleteWebhook(ctx, global.ID))
+
+	repo.EXPECT().GetWebhookSubscription(ctx, testTenantID, global.ID).Return(nil, nil)
+	assert.Equal(t, domain.ErrorWebhookNotFound, u.DeleteWebhook(ctx, global.ID))
+
+	repo.EXPECT().GetWebhookSubscription(estateAdmin, testTenantID, scoped.ID).Return(scoped, nil)
+	repo.EXPECT().DeleteWebhookSubscription(estateAdmin, testTenantID, scoped.ID).Return(true, nil)
+	assert.NoError(t, u.DeleteWebhook(estateAdmin, scoped.ID))
+
+	repo.EXPECT().GetWebhookSubscription(estateAdmin, testTenantID, global.ID).Return(global, nil)
+	assert.Equal(t, domain.ErrorForbidden, u.DeleteWebhook(estateAdmin, global.ID))
+
+	viewer := domain.WithPrincipal(context.Background(), &domain.Principal{TenantID: testTenantID, Role: domain.RoleViewer})
+	assert.Equal(t, domain.ErrorForbidden, u.DeleteWebhook(viewer, global.ID))
+}
+
+func Test_webhookUsecase_RetryDeadLetter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := interfaces.NewMockWebhookRepository(ctrl)
+	u := NewWebhookUsecase(repo, interfaces.NewMockWebhookSender(ctrl), time.Hour)
+	ctx := adminContext()
+	estateID := uuid.New()
+	estateAdmin := domain.WithPrincipal(context.Background(), &domain.Principal{TenantID: testTenantID, Role: domain.RoleAdmin, EstateID: &estateID})
+	global := &domain.WebhookDelivery{ID: uuid.New(), Status: domain.WebhookDeliveryDead}
+	scoped := &domain.WebhookDelivery{ID: uuid.New(), Status: domain.WebhookDeliveryDead, Subscription: domain.WebhookSubscription{EstateID: &estateID}}
+
+	repo.EXPECT().GetWebhookDelivery(ctx, testTenantID, global.ID).Return(global, nil)
+	repo.EXPECT().RetryDeadWebhookDelivery(ctx, testTenantID, global.ID, gomock.Any()).Return(true, nil)
+	assert.NoError(t, u.RetryDeadLetter(ctx, global.ID))
+
+	repo.EXPECT().GetWebhookDelivery(ctx, testTenantID, global.ID).Return(global, nil)
+	repo.EXPECT().RetryDeadWebhookDelivery(ctx, testTenantID, global.ID, gomock.Any()).Return(false, nil)
+	assert.Equal(t, domain.ErrorWebhookDeliveryNotFound, u.RetryDeadLetter(ctx, global.ID))
+
+	repo.EXPECT().GetWebhookDelivery(ctx, testTenantID, global.ID).Return(nil, nil)
+	assert.Equal(t, domain.ErrorWebhookDeliveryNotFound, u.RetryDeadLetter(ctx, global.ID))
+
+	repo.EXPECT().GetWebhookDelivery(estateAdmin, testTenantID, scoped.ID).Return(scoped, nil)
+	repo.EXPECT().RetryDeadWebhookDelivery(estateAdmin, testTenantID, scoped.ID, gomock.Any()).Return(true, nil)
+	assert.NoError(t, u.RetryDeadLetter(estateAdmin, scoped.ID))
+
+	repo.EXPECT().GetWebhookDelivery(estateAdmin, testTenantID, global.ID).Return(global, nil)
+	assert.Equal(t, domain.ErrorForbidden, u.RetryDeadLetter(estateAdmin, global.ID))
+
+	assert.Equal(t, domain.ErrorUnauthenticated, u.RetryDeadLetter(context.Background(), global.ID))
+}
+
+func Test_webhookUsecase_ListDeadLetters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := interfaces.NewMockWebhookRepository(ctrl)
+	u := NewWebhookUsecase(repo, interfaces.NewMockWebhookSender(ctrl), time.Hour)
+	estateID := uuid.New()
+	otherEstateID := uuid.New()
+	estateAdmin := domain.WithPrincipal(context.Background(), &domain.Principal{TenantID: testTenantID, Role: domain.RoleAdmin, EstateID: &estateID})
+	global := domain.WebhookDelivery{ID: uuid.New(), Status: domain.WebhookDeliveryDead}
+	scoped := domain.WebhookDelivery{ID: uuid.New(), Status: domain.WebhookDeliveryDead, Subscription: domain.WebhookSubscription{EstateID: &estateID}}
+	other := domain.WebhookDelivery{ID: uuid.New(), Status: domain.WebhookDeliveryDead, Subscription: domain.WebhookSubscription{EstateID: &otherEstateID}}
+
+	repo.EXPECT().GetDeadWebhookDeliveries(gomock.Any(), testTenantID).Return([]domain.WebhookDelivery{global, scoped, other}, nil).Times(2)
+
+	got, err := u.ListDeadLetters(adminContext())
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.WebhookDelivery{global, scoped, other}, got)
+
+	got, err = u.ListDeadLetters(estateAdmin)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.WebhookDelivery{scoped}, got)
+}
+
+func Test_webhookUsecase_ListWebhooks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := interfaces.NewMockWebhookRepository(ctrl)
+	u := NewWebhookUsecase(repo, interfaces.NewMockWebhookSender(ctrl), time.Hour)
+
+	estateID := uuid.New()
+	estateAdmin := domain.WithPrincipal(context.Background(), &domain.Principal{TenantID: testTenantID, Role: domain.RoleAdmin, EstateID: &estateID})
+	global := domain.WebhookSubscription{ID: uuid.New(), TenantID: testTenantID}
+	scoped := domain.WebhookSubscription{ID: uuid.New(), TenantID: testTenantID, EstateID: &estateID}
+
+	repo.EXPECT().GetWebhookSubscriptions(estateAdmin, testTenantID).Return([]domain.WebhookSubscription{global, scoped}, nil)
+	got, err := u.ListWebhooks(estateAdmin)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.WebhookSubscription{scoped}, got)
+
+	viewer := domain.WithPrincipal(context.Background(), &domain.Principal{TenantID: testTenantID, Role: domain.RoleViewer})
+	_, err = u.ListWebhooks(viewer)
+	assert.Equal(t, domain.ErrorForbidden, err)
+}
+
+func Test_webhookUsecase_DispatchWebhooks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := interfaces.NewMockWebhookRepository(ctrl)
+	sender := interfaces.NewMockWebhookSender(ctrl)
+	u := NewWebhookUsecase(repo, sender, time.Hour)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	u.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	estateID := uuid.New()
+	otherEstateID := uuid.New()
+	event := domain.NewEvent(domain.EventTreePlanted, testTenantID, estateID, domain.TreeEventData{})
+	global := domain.WebhookSubscription{ID: uuid.New(), TenantID: testTenantID}
+	other := domain.WebhookSubscription{ID: uuid.New(), TenantID: testTenantID, EstateID: &otherEstateID}
+	otherTenant := domain.WebhookSubscription{ID: uuid.New(), TenantID: uuid.New()}
+
+	t.Run("fan out matching subscriptions and send due deliveries", func(t *testing.T) {
+		repo.EXPECT().ClaimPendingOutboxEvents(ctx, now, now.Add(webhookClaimDuration), webhookDispatchBatchSize).Return([]domain.Event{event}, nil)
+		repo.EXPECT().GetAllWebhookSubscriptions(ctx).Return([]domain.WebhookSubscription{global, other, otherTenant}, nil)
+		repo.EXPECT().CreateWebhookDeliveries(ctx, []uuid.UUID{event.ID}, gomock.Any()).Do(func(_ context.Context, _ []uuid.UUID, deliveries []domain.WebhookDelivery) {
+			assert.Len(t, deliveries, 1)
+			assert.Equal(t, global.ID, deliveries[0].Subscription.ID)
+			assert.Equal(t, now, deliveries[0].NextAttemptAt)
+		}).Return(nil)
+
+		succeed := domain.WebhookDelivery{ID: uuid.New(), Status: domain.WebhookDeliveryPending}
+		failed := domain.WebhookDelivery{ID: uuid.New(), Status: domain.WebhookDeliveryPending, Attempts: domain.MaxWebhookAttempts - 1}
+		repo.EXPECT().ClaimDueWebhookDeliveries(ctx, now, now.Add(webhookClaimDuration), webhookDispatchBatchSize).Return([]domain.WebhookDelivery{succeed, failed}, nil)
+		sender.EXPECT().Send(ctx, gomock.Any()).Return(nil)
+		sender.EXPECT().Send(ctx, gomock.Any()).Return(errors.New("connection refused"))
+
+		var updated []domain.WebhookDelivery
+		repo.EXPECT().UpdateWebhookDelivery(ctx, gomock.Any()).Do(func(_ context.Context, delivery *domain.WebhookDelivery) {
+			updated = append(updated, *delivery)
+		}).Return(nil).Times(2)
+
+		assert.NoError(t, u.DispatchWebhooks(ctx))
+		assert.Equal(t, domain.WebhookDeliveryDelivered, updated[0].Status)
+		assert.Equal(t, domain.WebhookDeliveryDead, updated[1].Status)
+		assert.Equal(t, "connection refused", updated[1].LastError)
+	})
+
+	t.Run("no pending events", func(t *testing.T) {
+		repo.EXPECT().ClaimPendingOutboxEvents(ctx, now, now.Add(webhookClaimDuration), webhookDispatchBatchSize).Return(nil, nil)
+		repo.EXPECT().ClaimDueWebhookDeliveries(ctx, now, now.Add(webhookClaimDuration), webhookDispatchBatchSize).Return(nil, nil)
+
+		assert.NoError(t, u.DispatchWebhooks(ctx))
+	})
+
+	t.Run("outbox error", func(t *testing.T) {
+		repo.EXPECT().ClaimPendingOutboxEvents(ctx, now, now.Add(webhookClaimDuration), webhookDispatchBatchSize).Return(nil, errors.New("repo error"))
+
+		assert.Equal(t, errors.New("repo error"), u.DispatchWebhooks(ctx))
+	})
+}
+
+func Test_webhookUsecase_PurgeOutboxEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := interfaces.NewMockWebhookRepository(ctrl)
+	u := NewWebhookUsecase(repo, interfaces.NewMockWebhookSender(ctrl), 24*time.Hour)
+	now := time.Date(2024, 3, 8, 12, 0, 0, 0, time.UTC)
+	u.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	repo.EXPECT().DeleteProcessedOutboxEvents(ctx, time.Date(2024, 3, 7, 12, 0, 0, 0, time.UTC)).Return(nil)
+	assert.NoError(t, u.PurgeOutboxEvents(ctx))
+
+	errDB := errors.New("db down")
+	repo.EXPECT().DeleteProcessedOutboxEvents(ctx, gomock.Any()).Return(errDB)
+	assert.Equal(t, errDB, u.PurgeOutboxEvents(ctx))
+}
@@ -5,25 +5,73 @@
 -- 3. How you name the fields.
 -- In this assignment we will use PostgreSQL as the database.
 
+-- Version of the schema applied by this script, readiness fails until it matches postgres.SchemaVersion.
+-- Bump both whenever the schema changes.
+CREATE TABLE schema_migrations (
+    version INTEGER PRIMARY KEY,
+    applied_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO schema_migrations (version) VALUES (13);
+
+-- Every plantation company sharing the deployment is a tenant, estates and api keys belong to exactly one tenant.
+CREATE TABLE tenants (
+    id UUID PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Default tenant for local development, docker-compose bootstraps its admin api key.
+INSERT INTO tenants (id, name) VALUES ('00000000-0000-0000-0000-000000000001', 'default');
+
 CREATE TABLE estates (
     id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL REFERENCES tenants (id),
     length INTEGER NOT NULL,
     width INTEGER NOT NULL,
+    -- incremented on every update of the estate, returned as ETag for optimistic concurrency
+    version INTEGER NOT NULL DEFAULT 1,
+    -- incremented on every change of the trees of the estate, only used to detect stale sandboxes
+    trees_version INTEGER NOT NULL DEFAULT 1,
+    -- set on sandboxes, draft copies of the source estate used to plan changes before promoting them.
+    -- source_version and source_trees_version are the versions of the source when it was cloned,
+    -- promoting is rejected once the source moved past either. Sandboxes are kept as drafts when their source is deleted
+    cloned_at TIMESTAMP,
+    source_estate_id UUID REFERENCES estates (id) ON DELETE SET NULL,
+    source_version INTEGER,
+    source_trees_version INTEGER,
     created_at TIMESTAMP NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMP NOT NULL DEFAULT NOW()
 );
 
+CREATE INDEX estates_tenant_idx ON estates (tenant_id);
+
 CREATE TABLE trees (
     id UUID PRIMARY KEY,
-    estate_id UUID NOT NULL,
+    estate_id UUID NOT NULL REFERENCES estates (id),
     row INTEGER NOT NULL,
     col INTEGER NOT NULL,
     height INTEGER NOT NULL,
+    version INTEGER NOT NULL DEFAULT 1,
     created_at TIMESTAMP NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
     UNIQUE (estate_id, row, col)
 );
 
+-- Height readings of a tree, recorded when it is planted and on every height update.
+-- They are deleted along with their tree.
+CREATE TABLE tree_measurements (
+    id BIGSERIAL PRIMARY KEY,
+    tree_id UUID NOT NULL REFERENCES trees (id) ON DELETE CASCADE,
+    estate_id UUID NOT NULL REFERENCES estates (id) ON DELETE CASCADE,
+    height INTEGER NOT NULL,
+    measured_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX tree_measurements_tree_idx ON tree_measurements (tree_id, measured_at);
+CREATE INDEX tree_measurements_estate_idx ON tree_measurements (estate_id, measured_at);
+
 CREATE TABLE drone_routes (
     route INTEGER NOT NULL,
     estate_id UUID NOT NULL,
@@ -66,3 +114,158 @@ AFTER INSERT OR UPDATE OR DELETE ON trees
 FOR EACH STATEMENT
 EXECUTE FUNCTION refresh_estate_stats_mv();
 
+
+-- Charging pads of an estate, drones take off from and land on them to swap batteries between sorties.
+CREATE TABLE charging_pads (
+    estate_id UUID NOT NULL REFERENCES estates (id) ON DELETE CASCADE,
+    row INTEGER NOT NULL,
+    col INTEGER NOT NULL,
+    PRIMARY KEY (estate_id, row, col)
+);
+
+
+-- Drones of the fleet of a tenant, they are retired instead of deleted so missions keep their drone.
+-- max_range and max_altitude are meters, speeds are meters per second.
+CREATE TABLE drones (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL REFERENCES tenants (id),
+    name VARCHAR(255) NOT NULL,
+    model VARCHAR(255) NOT NULL,
+    max_range INTEGER NOT NULL CHECK (max_range > 0),
+    max_altitude INTEGER NOT NULL CHECK (max_altitude > 0),
+    cruise_speed DOUBLE PRECISION NOT NULL CHECK (cruise_speed > 0),
+    climb_speed DOUBLE PRECISION NOT NULL CHECK (climb_speed > 0),
+    status VARCHAR(16) NOT NULL DEFAULT 'available' CHECK (status IN ('available', 'maintenance', 'retired')),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX drones_tenant_idx ON drones (tenant_id, created_at);
+
+
+-- Missions freeze the drone plan of an estate when they are created, their waypoints are copied
+-- so later tree changes to the estate do not change missions already planned.
+-- distance is the planned flight distance within max_distance, landing included.
+-- The drone_ profile columns copy the fleet drone the mission is planned for, they are all null otherwise.
+CREATE TABLE missions (
+    id UUID PRIMARY KEY,
+    estate_id UUID NOT NULL REFERENCES estates (id) ON DELETE CASCADE,
+    status VARCHAR(16) NOT NULL DEFAULT 'planned' CHECK (status IN ('planned', 'in_progress', 'completed', 'aborted')),
+    max_distance INTEGER,
+    distance INTEGER NOT NULL,
+    drone_id UUID REFERENCES drones (id),
+    drone_max_range INTEGER,
+    drone_max_altitude INTEGER,
+    drone_cruise_speed DOUBLE PRECISION,
+    drone_climb_speed DOUBLE PRECISION,
+    drone VARCHAR(255) NOT NULL DEFAULT '',
+    pilot VARCHAR(255) NOT NULL DEFAULT '',
+    started_at TIMESTAMP,
+    finished_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX missions_estate_idx ON missions (estate_id, created_at);
+
+CREATE TABLE mission_waypoints (
+    mission_id UUID NOT NULL REFERENCES missions (id) ON DELETE CASCADE,
+    sequence INTEGER NOT NULL,
+    row INTEGER NOT NULL,
+    col INTEGER NOT NULL,
+    altitude INTEGER NOT NULL,
+    distance INTEGER NOT NULL,
+    PRIMARY KEY (mission_id, sequence)
+);
+
+-- Telemetry samples recorded during a mission flight, x and y are meters from the center of plot (1, 1).
+-- Uploading telemetry again replaces the samples of the mission.
+CREATE TABLE mission_telemetry (
+    mission_id UUID NOT NULL REFERENCES missions (id) ON DELETE CASCADE,
+    sequence INTEGER NOT NULL,
+    recorded_at TIMESTAMP NOT NULL,
+    x DOUBLE PRECISION NOT NULL,
+    y DOUBLE PRECISION NOT NULL,
+    altitude DOUBLE PRECISION NOT NULL,
+    PRIMARY KEY (mission_id, sequence)
+);
+
+
+-- Transactional outbox, events are written in the same transaction as the estate or tree write
+-- and fanned out into webhook deliveries by the dispatcher once committed.
+-- locked_until is set by the dispatcher replica that claimed the event, others skip it until then.
+CREATE TABLE event_outbox (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    estate_id UUID NOT NULL,
+    type VARCHAR(64) NOT NULL,
+    payload JSONB NOT NULL,
+    occurred_at TIMESTAMP NOT NULL,
+    processed_at TIMESTAMP,
+    locked_until TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX event_outbox_pending_idx ON event_outbox (occurred_at) WHERE processed_at IS NULL;
+CREATE INDEX event_outbox_processed_idx ON event_outbox (processed_at) WHERE processed_at IS NOT NULL;
+
+-- estate_id is NULL for subscriptions to every estate of the tenant, empty event_types means every event type.
+CREATE TABLE webhook_subscriptions (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL REFERENCES tenants (id),
+    estate_id UUID REFERENCES estates (id) ON DELETE CASCADE,
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    event_types TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Deliveries with status 'dead' exhausted their attempts and form the dead-letter list.
+-- locked_until is set by the dispatcher replica sending the delivery, others skip it until then.
+CREATE TABLE webhook_deliveries (
+    id UUID PRIMARY KEY,
+    subscription_id UUID NOT NULL REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+    event_id UUID NOT NULL REFERENCES event_outbox (id),
+    status VARCHAR(16) NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    last_error TEXT,
+    locked_until TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (subscription_id, event_id)
+);
+
+CREATE INDEX webhook_deliveries_due_idx ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+CREATE INDEX webhook_deliveries_event_idx ON webhook_deliveries (event_id);
+
+-- API keys are stored as sha256 hash, the raw key is only shown once on creation.
+-- estate_id is NULL for keys that apply to all estates of the tenant.
+CREATE TABLE api_keys (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL REFERENCES tenants (id),
+    name VARCHAR(100) NOT NULL,
+    key_prefix VARCHAR(16) NOT NULL,
+    key_hash CHAR(64) NOT NULL UNIQUE,
+    role VARCHAR(16) NOT NULL CHECK (role IN ('viewer', 'planter', 'admin')),
+    estate_id UUID REFERENCES estates (id) ON DELETE CASCADE,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Idempotency keys remember the response of POST requests so client retries get the original response.
+-- status_code is NULL while the original request is still in progress.
+CREATE TABLE idempotency_keys (
+    tenant_id UUID NOT NULL REFERENCES tenants (id),
+    key VARCHAR(255) NOT NULL,
+    request_hash CHAR(64) NOT NULL,
+    status_code INTEGER,
+    response_body BYTEA,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (tenant_id, key)
+);
+
+CREATE INDEX idempotency_keys_expires_idx ON idempotency_keys (expires_at);
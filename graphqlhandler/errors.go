@@ -0,0 +1,54 @@
+package graphqlhandler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+)
+
+// domainErrors map domain errors into error codes, the first matching error wins.
+// Codes and messages are the ones of the REST problems
+var domainErrors = []struct {
+	err     error
+	code    string
+	message string
+}{
+	{domain.ErrorUnauthenticated, "unauthenticated", "Missing or invalid API key"},
+	{domain.ErrorForbidden, "forbidden", "API key is not allowed to perform this action"},
+	{domain.ErrorEstatesNotFound, "estate_not_found", "Estate not found"},
+	{domain.ErrorTreeNotFound, "tree_not_found", "Tree not found"},
+}
+
+// resolverError is returned by resolvers, its code is rendered into the extensions of the graphql error
+type resolverError struct {
+	code    string
+	message string
+}
+
+func (e *resolverError) Error() string {
+	return e.message
+}
+
+func (e *resolverError) Extensions() map[string]interface{} {
+	return map[string]interface{}{"code": e.code}
+}
+
+// invalidArgument reject an argument the same way the REST validator rejects a field
+func invalidArgument(argument string, format string, args ...any) error {
+	return &resolverError{code: "validation_failed", message: argument + " " + fmt.Sprintf(format, args...)}
+}
+
+// resolveError log err and convert it into a resolver error, internal errors never expose their message
+func resolveError(ctx context.Context, err error) error {
+	slog.ErrorContext(ctx, "error", "message", err.Error())
+
+	for _, de := range domainErrors {
+		if errors.Is(err, de.err) {
+			return &resolverError{code: de.code, message: de.message}
+		}
+	}
+	return &resolverError{code: "internal_error", message: "Internal server error"}
+}
@@ -0,0 +1,82 @@
+package graphqlhandler
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	graphql "github.com/graph-gophers/graphql-go"
+)
+
+//go:embed schema.graphql
+var schema string
+
+// Path is where the graphql endpoint is mounted
+const Path = "/graphql"
+
+// maxParallelism let every resolver of a page wait on its loader at once,
+// with the library default of 10 a batch would never hold more than 10 ids
+const maxParallelism = loaderMaxBatch
+
+type handler struct {
+	estateUsecase interfaces.EstateUsecase
+	maxDepth      int
+	schema        *graphql.Schema
+}
+
+type HandlerOptions func(*handler)
+
+// WithMaxDepth reject queries nesting deeper than depth
+func WithMaxDepth(depth int) HandlerOptions {
+	return func(h *handler) {
+		h.maxDepth = depth
+	}
+}
+
+// NewHandler serve graphql queries over the estate usecase, the caller principal is taken from the request context
+func NewHandler(estateUsecase interfaces.EstateUsecase, opts ...HandlerOptions) (*handler, error) {
+	h := &handler{
+		estateUsecase: estateUsecase,
+		maxDepth:      10,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	s, err := graphql.ParseSchema(schema, &queryResolver{estateUsecase: estateUsecase},
+		graphql.MaxDepth(h.maxDepth),
+		graphql.MaxParallelism(maxParallelism),
+	)
+	if err != nil {
+		return nil, err
+	}
+	h.schema = s
+	return h, nil
+}
+
+type request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// ServeHTTP execute a query with fresh loaders, results are never cached across requests
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]any{
+			"errors": []map[string]any{{"message": "invalid request body", "extensions": map[string]any{"code": "invalid_request"}}},
+		})
+		return
+	}
+
+	ctx := withLoaders(r.Context(), newLoaders(r.Context(), h.estateUsecase))
+	writeJSON(w, http.StatusOK, h.schema.Exec(ctx, req.Query, req.OperationName, req.Variables))
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,216 @@
+package graphqlhandler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type response struct {
+	Data   map[string]any `json:"data"`
+	Errors []struct {
+		Message    string         `json:"message"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+// execute post query as the admin of tenantID
+func execute(t *testing.T, h http.Handler, query string, variables map[string]any) (int, response) {
+	body, err := json.Marshal(map[string]any{"query": query, "variables": variables})
+	assert.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, Path, strings.NewReader(string(body)))
+	req = req.WithContext(domain.WithPrincipal(req.Context(), &domain.Principal{TenantID: uuid.New(), Role: domain.RoleAdmin}))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	var res response
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	return rec.Code, res
+}
+
+func Test_handler_Batching(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	h, err := NewHandler(mockUsecase)
+	assert.NoError(t, err)
+
+	estates := []domain.Estate{
+		{ID: uuid.New(), Width: 5, Length: 10, Version: 1},
+		{ID: uuid.New(), Width: 1, Length: 1, Version: 2},
+	}
+	estateIDs := []uuid.UUID{estates[0].ID, estates[1].ID}
+	tall := domain.Tree{ID: uuid.New(), Plot: domain.Plot{Row: 1, Col: 2}, Height: 20, Version: 2}
+	short := domain.Tree{ID: uuid.New(), Plot: domain.Plot{Row: 2, Col: 2}, Height: 3, Version: 1}
+	plantedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// a page of estates costs one usecase call per field, not one per estate
+	mockUsecase.EXPECT().ListEstates(gomock.Any(), 2, 0).Return(estates, nil)
+	mockUsecase.EXPECT().ListTreesByEstates(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, ids []uuid.UUID) (map[uuid.UUID][]domain.Tree, error) {
+			assert.ElementsMatch(t, estateIDs, ids)
+			return map[uuid.UUID][]domain.Tree{estates[0].ID: {tall, short}}, nil
+		})
+	mockUsecase.EXPECT().GetEstatesStats(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, ids []uuid.UUID) (map[uuid.UUID]domain.EstateStats, error) {
+			assert.ElementsMatch(t, estateIDs, ids)
+			return map[uuid.UUID]domain.EstateStats{
+				estates[0].ID: {Count: 2, Max: 20, Min: 3, Median: 11},
+				estates[1].ID: {},
+			}, nil
+		})
+	mockUsecase.EXPECT().GetDroneDistances(gomock.Any(), gomock.Any(), nil).
+		DoAndReturn(func(_ context.Context, ids []uuid.UUID, _ *int) (map[uuid.UUID]domain.DroneDistance, error) {
+			assert.ElementsMatch(t, estateIDs, ids)
+			return map[uuid.UUID]domain.DroneDistance{estates[0].ID: {Distance: 100}, estates[1].ID: {Distance: 2}}, nil
+		})
+	mockUsecase.EXPECT().ListTreeMeasurements(gomock.Any(), []uuid.UUID{estates[0].ID}, []uuid.UUID{tall.ID}).
+		Return(map[uuid.UUID][]domain.TreeMeasurement{tall.ID: {
+			{TreeID: tall.ID, Height: 10, MeasuredAt: plantedAt},
+			{TreeID: tall.ID, Height: 20, MeasuredAt: plantedAt.Add(time.Hour)},
+		}}, nil)
+
+	status, res := execute(t, h, `query($limit: Int) {
+		estates(limit: $limit) {
+			id width length version
+			trees(filter: {minHeight: 10}) { id x y height version measurements(limit: 1) { height measuredAt } }
+			stats { count max min median }
+			dronePlan { distance }
+		}
+	}`, map[string]any{"limit": 2})
+	assert.Equal(t, http.StatusOK, status)
+	assert.Empty(t, res.Errors)
+
+	expected := map[string]any{
+		"estates": []any{
+			map[string]any{
+				"id": estates[0].ID.String(), "width": 5.0, "length": 10.0, "version": 1.0,
+				"trees": []any{map[string]any{
+					"id": tall.ID.String(), "x": 2.0, "y": 1.0, "height": 20.0, "version": 2.0,
+					"measurements": []any{map[string]any{"height": 20.0, "measuredAt": "2024-01-01T01:00:00Z"}},
+				}},
+				"stats":     map[string]any{"count": 2.0, "max": 20.0, "min": 3.0, "median": 11.0},
+				"dronePlan": map[string]any{"distance": 100.0},
+			},
+			map[string]any{
+				"id": estates[1].ID.String(), "width": 1.0, "length": 1.0, "version": 2.0,
+				"trees":     []any{},
+				"stats":     map[string]any{"count": 0.0, "max": 0.0, "min": 0.0, "median": 0.0},
+				"dronePlan": map[string]any{"distance": 2.0},
+			},
+		},
+	}
+	assert.Equal(t, expected, res.Data)
+}
+
+func Test_handler_Errors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	h, err := NewHandler(mockUsecase, WithMaxDepth(3))
+	assert.NoError(t, err)
+	estateID := uuid.New()
+
+	tests := []struct {
+		name      string
+		mock      func()
+		query     string
+		wantData  map[string]any
+		wantCode  string
+		wantError string
+	}{
+		{
+			name: "Estate not found is null",
+			mock: func() {
+				mockUsecase.EXPECT().GetEstate(gomock.Any(), estateID).Return(nil, domain.ErrorEstatesNotFound)
+			},
+			query:    `{ estate(id: "` + estateID.String() + `") { id } }`,
+			wantData: map[string]any{"estate": nil},
+		},
+		{
+			name: "Forbidden",
+			mock: func() {
+				mockUsecase.EXPECT().GetEstate(gomock.Any(), estateID).Return(&domain.Estate{ID: estateID}, nil)
+				mockUsecase.EXPECT().GetEstatesStats(gomock.Any(), []uuid.UUID{estateID}).Return(nil, domain.ErrorForbidden)
+			},
+			query:     `{ estate(id: "` + estateID.String() + `") { stats { count } } }`,
+			wantData:  map[string]any{"estate": nil},
+			wantCode:  "forbidden",
+			wantError: "API key is not allowed to perform this action",
+		},
+		{
+			name:      "Invalid id",
+			mock:      func() {},
+			query:     `{ estate(id: "1") { id } }`,
+			wantData:  map[string]any{"estate": nil},
+			wantCode:  "validation_failed",
+			wantError: "id must be a uuid",
+		},
+		{
+			name:      "Limit out of range",
+			mock:      func() {},
+			query:     `{ estates(limit: 1001) { id } }`,
+			wantCode:  "validation_failed",
+			wantError: "limit must be between 1 and 1000",
+		},
+		{
+			name:      "Internal errors are hidden",
+			mock:      func() { mockUsecase.EXPECT().ListEstates(gomock.Any(), 100, 0).Return(nil, assert.AnError) },
+			query:     `{ estates { id } }`,
+			wantCode:  "internal_error",
+			wantError: "Internal server error",
+		},
+		{
+			name:      "Too deep",
+			mock:      func() {},
+			query:     `{ estates { trees { measurements { height } } } }`,
+			wantError: "exceeds max depth 3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			status, res := execute(t, h, tt.query, nil)
+			assert.Equal(t, http.StatusOK, status)
+			assert.Equal(t, tt.wantData, res.Data)
+			if tt.wantError == "" {
+				assert.Empty(t, res.Errors)
+				return
+			}
+			if assert.Len(t, res.Errors, 1) {
+				assert.Contains(t, res.Errors[0].Message, tt.wantError)
+				if tt.wantCode != "" {
+					assert.Equal(t, tt.wantCode, res.Errors[0].Extensions["code"])
+				}
+			}
+		})
+	}
+}
+
+func Test_handler_InvalidBody(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h, err := NewHandler(interfaces.NewMockEstateUsecase(ctrl))
+	assert.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, Path, strings.NewReader("{")))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "invalid_request")
+}
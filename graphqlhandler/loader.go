@@ -0,0 +1,83 @@
+package graphqlhandler
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// loader batch the Load calls made within wait of each other into one fetch and cache the result,
+// so resolving a field of every item of a list costs one usecase call instead of one per item.
+// It lives for a single request, results are never shared between callers
+type loader[K comparable, V any] struct {
+	fetch    func(keys []K) (map[K]V, error)
+	wait     time.Duration
+	maxBatch int
+
+	mu      sync.Mutex
+	pending *batch[K, V]
+	batches map[K]*batch[K, V]
+}
+
+type batch[K comparable, V any] struct {
+	keys   []K
+	once   sync.Once
+	done   chan struct{}
+	values map[K]V
+	err    error
+}
+
+func newLoader[K comparable, V any](wait time.Duration, maxBatch int, fetch func(keys []K) (map[K]V, error)) *loader[K, V] {
+	return &loader[K, V]{
+		fetch:    fetch,
+		wait:     wait,
+		maxBatch: maxBatch,
+		batches:  map[K]*batch[K, V]{},
+	}
+}
+
+// Load return the value fetched for key, found is false when the fetch did not return the key
+func (l *loader[K, V]) Load(ctx context.Context, key K) (value V, found bool, err error) {
+	l.mu.Lock()
+	b, ok := l.batches[key]
+	if !ok {
+		b = l.pending
+		if b == nil {
+			b = &batch[K, V]{done: make(chan struct{})}
+			l.pending = b
+			time.AfterFunc(l.wait, func() { l.dispatch(b) })
+		}
+		b.keys = append(b.keys, key)
+		l.batches[key] = b
+		if len(b.keys) >= l.maxBatch {
+			l.pending = nil
+			go l.dispatch(b)
+		}
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-b.done:
+	case <-ctx.Done():
+		return value, false, ctx.Err()
+	}
+	if b.err != nil {
+		return value, false, b.err
+	}
+	value, found = b.values[key]
+	return value, found, nil
+}
+
+// dispatch fetch a batch once, whichever of the wait timer or a full batch comes first
+func (l *loader[K, V]) dispatch(b *batch[K, V]) {
+	b.once.Do(func() {
+		l.mu.Lock()
+		if l.pending == b {
+			l.pending = nil
+		}
+		l.mu.Unlock()
+
+		b.values, b.err = l.fetch(b.keys)
+		close(b.done)
+	})
+}
@@ -0,0 +1,97 @@
+package graphqlhandler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_loader(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("batch concurrent loads into one fetch", func(t *testing.T) {
+		var fetches atomic.Int32
+		l := newLoader(10*time.Millisecond, 100, func(keys []int) (map[int]int, error) {
+			fetches.Add(1)
+			values := map[int]int{}
+			for _, key := range keys {
+				if key != 3 {
+					values[key] = key * 10
+				}
+			}
+			return values, nil
+		})
+
+		var wg sync.WaitGroup
+		for key := 0; key < 5; key++ {
+			wg.Add(1)
+			go func(key int) {
+				defer wg.Done()
+				value, found, err := l.Load(ctx, key)
+				assert.NoError(t, err)
+				assert.Equal(t, key != 3, found)
+				if found {
+					assert.Equal(t, key*10, value)
+				}
+			}(key)
+		}
+		wg.Wait()
+		assert.Equal(t, int32(1), fetches.Load())
+
+		// loaded keys are cached for the rest of the request
+		value, found, err := l.Load(ctx, 2)
+		assert.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, 20, value)
+		assert.Equal(t, int32(1), fetches.Load())
+	})
+
+	t.Run("full batch is fetched without waiting", func(t *testing.T) {
+		var batches [][]int
+		var mu sync.Mutex
+		l := newLoader(time.Hour, 2, func(keys []int) (map[int]int, error) {
+			mu.Lock()
+			batches = append(batches, keys)
+			mu.Unlock()
+			return map[int]int{}, nil
+		})
+
+		var wg sync.WaitGroup
+		for key := 0; key < 4; key++ {
+			wg.Add(1)
+			go func(key int) {
+				defer wg.Done()
+				_, _, err := l.Load(ctx, key)
+				assert.NoError(t, err)
+			}(key)
+		}
+		wg.Wait()
+		assert.Len(t, batches, 2)
+	})
+
+	t.Run("fetch error is returned to every key of the batch", func(t *testing.T) {
+		l := newLoader(time.Millisecond, 100, func(keys []int) (map[int]int, error) {
+			return nil, errors.New("fetch error")
+		})
+
+		_, found, err := l.Load(ctx, 1)
+		assert.EqualError(t, err, "fetch error")
+		assert.False(t, found)
+	})
+
+	t.Run("canceled context stops waiting", func(t *testing.T) {
+		l := newLoader(time.Hour, 100, func(keys []int) (map[int]int, error) {
+			return map[int]int{}, nil
+		})
+
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		_, _, err := l.Load(canceled, 1)
+		assert.Equal(t, context.Canceled, err)
+	})
+}
@@ -0,0 +1,121 @@
+package graphqlhandler
+
+import (
+	"context"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/google/uuid"
+)
+
+const (
+	// loaderWait is how long a loader waits for sibling resolvers before fetching
+	loaderWait = 2 * time.Millisecond
+	// loaderMaxBatch bound the ids sent to the usecase in one call
+	loaderMaxBatch = 500
+)
+
+// treeKey identify a tree together with its estate, authorization is checked per estate
+type treeKey struct {
+	estateID uuid.UUID
+	treeID   uuid.UUID
+}
+
+// droneKey identify a drone plan, the same estate is loaded once per maxDistance asked for
+type droneKey struct {
+	estateID    uuid.UUID
+	maxDistance int
+	limited     bool
+}
+
+// loaders of a single request, they fetch on behalf of the principal carried by the request context
+type loaders struct {
+	trees          *loader[uuid.UUID, []domain.Tree]
+	stats          *loader[uuid.UUID, domain.EstateStats]
+	droneDistances *loader[droneKey, domain.DroneDistance]
+	measurements   *loader[treeKey, []domain.TreeMeasurement]
+}
+
+func newLoaders(ctx context.Context, estateUsecase interfaces.EstateUsecase) *loaders {
+	return &loaders{
+		trees: newLoader(loaderWait, loaderMaxBatch, func(estateIDs []uuid.UUID) (map[uuid.UUID][]domain.Tree, error) {
+			return estateUsecase.ListTreesByEstates(ctx, estateIDs)
+		}),
+		stats: newLoader(loaderWait, loaderMaxBatch, func(estateIDs []uuid.UUID) (map[uuid.UUID]domain.EstateStats, error) {
+			return estateUsecase.GetEstatesStats(ctx, estateIDs)
+		}),
+		droneDistances: newLoader(loaderWait, loaderMaxBatch, func(keys []droneKey) (map[droneKey]domain.DroneDistance, error) {
+			return fetchDroneDistances(ctx, estateUsecase, keys)
+		}),
+		measurements: newLoader(loaderWait, loaderMaxBatch, func(keys []treeKey) (map[treeKey][]domain.TreeMeasurement, error) {
+			return fetchTreeMeasurements(ctx, estateUsecase, keys)
+		}),
+	}
+}
+
+// fetchDroneDistances make one usecase call per distinct maxDistance of the batch
+func fetchDroneDistances(ctx context.Context, estateUsecase interfaces.EstateUsecase, keys []droneKey) (map[droneKey]domain.DroneDistance, error) {
+	type limit struct {
+		maxDistance int
+		limited     bool
+	}
+	groups := map[limit][]uuid.UUID{}
+	for _, key := range keys {
+		l := limit{key.maxDistance, key.limited}
+		groups[l] = append(groups[l], key.estateID)
+	}
+
+	result := make(map[droneKey]domain.DroneDistance, len(keys))
+	for l, estateIDs := range groups {
+		var maxDistance *int
+		if l.limited {
+			maxDistance = &l.maxDistance
+		}
+
+		distances, err := estateUsecase.GetDroneDistances(ctx, estateIDs, maxDistance)
+		if err != nil {
+			return nil, err
+		}
+		for estateID, distance := range distances {
+			result[droneKey{estateID, l.maxDistance, l.limited}] = distance
+		}
+	}
+	return result, nil
+}
+
+// fetchTreeMeasurements load measurements of every tree of the batch across their estates in one usecase call
+func fetchTreeMeasurements(ctx context.Context, estateUsecase interfaces.EstateUsecase, keys []treeKey) (map[treeKey][]domain.TreeMeasurement, error) {
+	seen := map[uuid.UUID]bool{}
+	var estateIDs []uuid.UUID
+	treeIDs := make([]uuid.UUID, 0, len(keys))
+	for _, key := range keys {
+		if !seen[key.estateID] {
+			seen[key.estateID] = true
+			estateIDs = append(estateIDs, key.estateID)
+		}
+		treeIDs = append(treeIDs, key.treeID)
+	}
+
+	measurements, err := estateUsecase.ListTreeMeasurements(ctx, estateIDs, treeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[treeKey][]domain.TreeMeasurement, len(keys))
+	for _, key := range keys {
+		result[key] = measurements[key.treeID]
+	}
+	return result, nil
+}
+
+type loadersKey struct{}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	l, _ := ctx.Value(loadersKey{}).(*loaders)
+	return l
+}
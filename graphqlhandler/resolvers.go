@@ -0,0 +1,231 @@
+package graphqlhandler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/google/uuid"
+	graphql "github.com/graph-gophers/graphql-go"
+)
+
+// maxEstatesLimit is the page size limit of the REST api
+const maxEstatesLimit = 1000
+
+type queryResolver struct {
+	estateUsecase interfaces.EstateUsecase
+}
+
+func (q *queryResolver) Estates(ctx context.Context, args struct {
+	Limit  int32
+	Offset int32
+}) ([]*estateResolver, error) {
+	if args.Limit < 1 || args.Limit > maxEstatesLimit {
+		return nil, invalidArgument("limit", "must be between 1 and %d", maxEstatesLimit)
+	}
+	if args.Offset < 0 {
+		return nil, invalidArgument("offset", "must not be negative")
+	}
+
+	estates, err := q.estateUsecase.ListEstates(ctx, int(args.Limit), int(args.Offset))
+	if err != nil {
+		return nil, resolveError(ctx, err)
+	}
+
+	resolvers := make([]*estateResolver, 0, len(estates))
+	for _, estate := range estates {
+		resolvers = append(resolvers, &estateResolver{estate: estate})
+	}
+	return resolvers, nil
+}
+
+func (q *queryResolver) Estate(ctx context.Context, args struct{ ID graphql.ID }) (*estateResolver, error) {
+	estateID, err := uuid.Parse(string(args.ID))
+	if err != nil {
+		return nil, invalidArgument("id", "must be a uuid")
+	}
+
+	estate, err := q.estateUsecase.GetEstate(ctx, estateID)
+	if errors.Is(err, domain.ErrorEstatesNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, resolveError(ctx, err)
+	}
+	return &estateResolver{estate: *estate}, nil
+}
+
+type estateResolver struct {
+	estate domain.Estate
+}
+
+func (e *estateResolver) ID() graphql.ID {
+	return graphql.ID(e.estate.ID.String())
+}
+
+func (e *estateResolver) Width() int32 {
+	return int32(e.estate.Width)
+}
+
+func (e *estateResolver) Length() int32 {
+	return int32(e.estate.Length)
+}
+
+func (e *estateResolver) Version() int32 {
+	return int32(e.estate.Version)
+}
+
+func (e *estateResolver) Draft() bool {
+	return e.estate.Sandbox != nil
+}
+
+type treeFilter struct {
+	MinHeight *int32
+	MaxHeight *int32
+	X         *int32
+	Y         *int32
+}
+
+func (f *treeFilter) match(tree domain.Tree) bool {
+	if f == nil {
+		return true
+	}
+	return (f.MinHeight == nil || tree.Height >= int(*f.MinHeight)) &&
+		(f.MaxHeight == nil || tree.Height <= int(*f.MaxHeight)) &&
+		(f.X == nil || tree.Plot.Col == int(*f.X)) &&
+		(f.Y == nil || tree.Plot.Row == int(*f.Y))
+}
+
+func (e *estateResolver) Trees(ctx context.Context, args struct{ Filter *treeFilter }) ([]*treeResolver, error) {
+	trees, _, err := loadersFrom(ctx).trees.Load(ctx, e.estate.ID)
+	if err != nil {
+		return nil, resolveError(ctx, err)
+	}
+
+	resolvers := []*treeResolver{}
+	for _, tree := range trees {
+		if args.Filter.match(tree) {
+			resolvers = append(resolvers, &treeResolver{estateID: e.estate.ID, tree: tree})
+		}
+	}
+	return resolvers, nil
+}
+
+func (e *estateResolver) Stats(ctx context.Context) (*statsResolver, error) {
+	stats, found, err := loadersFrom(ctx).stats.Load(ctx, e.estate.ID)
+	if err != nil {
+		return nil, resolveError(ctx, err)
+	}
+	if !found {
+		return nil, resolveError(ctx, domain.ErrorEstatesNotFound)
+	}
+	return &statsResolver{stats: stats}, nil
+}
+
+func (e *estateResolver) DronePlan(ctx context.Context, args struct{ MaxDistance *int32 }) (*dronePlanResolver, error) {
+	key := droneKey{estateID: e.estate.ID}
+	if args.MaxDistance != nil {
+		key.maxDistance = int(*args.MaxDistance)
+		key.limited = true
+	}
+
+	distance, found, err := loadersFrom(ctx).droneDistances.Load(ctx, key)
+	if err != nil {
+		return nil, resolveError(ctx, err)
+	}
+	if !found {
+		return nil, resolveError(ctx, domain.ErrorEstatesNotFound)
+	}
+	return &dronePlanResolver{distance: distance}, nil
+}
+
+type treeResolver struct {
+	estateID uuid.UUID
+	tree     domain.Tree
+}
+
+func (t *treeResolver) ID() graphql.ID {
+	return graphql.ID(t.tree.ID.String())
+}
+
+func (t *treeResolver) X() int32 {
+	return int32(t.tree.Plot.Col)
+}
+
+func (t *treeResolver) Y() int32 {
+	return int32(t.tree.Plot.Row)
+}
+
+func (t *treeResolver) Height() int32 {
+	return int32(t.tree.Height)
+}
+
+func (t *treeResolver) Version() int32 {
+	return int32(t.tree.Version)
+}
+
+func (t *treeResolver) Measurements(ctx context.Context, args struct {
+	Since *graphql.Time
+	Limit *int32
+}) ([]*measurementResolver, error) {
+	if args.Limit != nil && *args.Limit < 0 {
+		return nil, invalidArgument("limit", "must not be negative")
+	}
+
+	measurements, _, err := loadersFrom(ctx).measurements.Load(ctx, treeKey{estateID: t.estateID, treeID: t.tree.ID})
+	if err != nil {
+		return nil, resolveError(ctx, err)
+	}
+
+	resolvers := []*measurementResolver{}
+	for _, measurement := range measurements {
+		if args.Since == nil || !measurement.MeasuredAt.Before(args.Since.Time) {
+			resolvers = append(resolvers, &measurementResolver{measurement: measurement})
+		}
+	}
+	if args.Limit != nil && len(resolvers) > int(*args.Limit) {
+		resolvers = resolvers[len(resolvers)-int(*args.Limit):]
+	}
+	return resolvers, nil
+}
+
+type measurementResolver struct {
+	measurement domain.TreeMeasurement
+}
+
+func (m *measurementResolver) Height() int32 {
+	return int32(m.measurement.Height)
+}
+
+func (m *measurementResolver) MeasuredAt() graphql.Time {
+	return graphql.Time{Time: m.measurement.MeasuredAt}
+}
+
+type statsResolver struct {
+	stats domain.EstateStats
+}
+
+func (s *statsResolver) Count() int32 {
+	return int32(s.stats.Count)
+}
+
+func (s *statsResolver) Max() int32 {
+	return int32(s.stats.Max)
+}
+
+func (s *statsResolver) Min() int32 {
+	return int32(s.stats.Min)
+}
+
+func (s *statsResolver) Median() int32 {
+	return int32(s.stats.Median)
+}
+
+type dronePlanResolver struct {
+	distance domain.DroneDistance
+}
+
+func (d *dronePlanResolver) Distance() int32 {
+	return int32(d.distance.Distance)
+}
@@ -0,0 +1,75 @@
+package grpchandler
+
+import (
+	"context"
+	"strings"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	estatev1 "github.com/SawitProRecruitment/EstateService/proto/estate/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataAPIKey is the metadata key of the api key, the gRPC counterpart of the X-API-Key header
+const MetadataAPIKey = "x-api-key"
+
+// authenticated report whether method needs an api key, health and reflection are open like the REST probes
+func authenticated(method string) bool {
+	return strings.HasPrefix(method, "/"+estatev1.EstateService_ServiceDesc.ServiceName+"/")
+}
+
+// authenticate put the principal of the api key metadata into ctx,
+// authorization itself is enforced by the usecase layer
+func authenticate(ctx context.Context, authUsecase interfaces.AuthUsecase) (context.Context, error) {
+	var rawKey string
+	if values := metadata.ValueFromIncomingContext(ctx, MetadataAPIKey); len(values) > 0 {
+		rawKey = values[0]
+	}
+
+	principal, err := authUsecase.Authenticate(ctx, rawKey)
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+	return domain.WithPrincipal(ctx, principal), nil
+}
+
+// UnaryAPIKeyAuth authenticate unary calls of the estate service
+func UnaryAPIKeyAuth(authUsecase interfaces.AuthUsecase) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !authenticated(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		ctx, err := authenticate(ctx, authUsecase)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAPIKeyAuth authenticate streaming calls of the estate service
+func StreamAPIKeyAuth(authUsecase interfaces.AuthUsecase) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !authenticated(info.FullMethod) {
+			return handler(srv, stream)
+		}
+
+		ctx, err := authenticate(stream.Context(), authUsecase)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &principalStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+// principalStream replace the context of a stream with the authenticated one
+type principalStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *principalStream) Context() context.Context {
+	return s.ctx
+}
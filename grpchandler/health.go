@@ -0,0 +1,47 @@
+package grpchandler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	estatev1 "github.com/SawitProRecruitment/EstateService/proto/estate/v1"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// healthServer answer the standard gRPC health check with the readiness of the service,
+// the overall server "" and the estate service share the same status. Watch is not supported
+type healthServer struct {
+	healthpb.UnimplementedHealthServer
+	readinessChecker interfaces.ReadinessChecker
+	timeout          time.Duration
+}
+
+// NewHealthServer fail checks when readinessChecker does not answer within timeout
+func NewHealthServer(readinessChecker interfaces.ReadinessChecker, timeout time.Duration) *healthServer {
+	return &healthServer{
+		readinessChecker: readinessChecker,
+		timeout:          timeout,
+	}
+}
+
+func (h *healthServer) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	switch req.GetService() {
+	case "", estatev1.EstateService_ServiceDesc.ServiceName:
+	default:
+		return nil, status.Errorf(codes.NotFound, "unknown service %q", req.GetService())
+	}
+
+	checkCtx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	err := h.readinessChecker.CheckReadiness(checkCtx)
+	if err != nil {
+		slog.Warn("not ready", "message", err.Error())
+		return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_NOT_SERVING}, nil
+	}
+	return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING}, nil
+}
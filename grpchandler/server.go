@@ -0,0 +1,145 @@
+// Package grpchandler serve the estate usecase over gRPC, the inbound adapter next to the REST handler.
+package grpchandler
+
+import (
+	"context"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	estatev1 "github.com/SawitProRecruitment/EstateService/proto/estate/v1"
+)
+
+// limits of the REST api, enforced there by the OpenAPI validator
+const (
+	maxEstateSize = domain.MaxEstateSize
+	maxTreeHeight = domain.MaxTreeHeight
+)
+
+type Server struct {
+	estatev1.UnimplementedEstateServiceServer
+	estateUsecase interfaces.EstateUsecase
+}
+
+func NewServer(estateUsecase interfaces.EstateUsecase) *Server {
+	return &Server{
+		estateUsecase: estateUsecase,
+	}
+}
+
+func (s *Server) CreateEstate(ctx context.Context, req *estatev1.CreateEstateRequest) (*estatev1.Estate, error) {
+	var v validator
+	v.between("width", req.GetWidth(), 1, maxEstateSize)
+	v.between("length", req.GetLength(), 1, maxEstateSize)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	estate, err := s.estateUsecase.CreateEstate(ctx, int(req.GetWidth()), int(req.GetLength()))
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	return &estatev1.Estate{
+		Id:      estate.ID.String(),
+		Width:   int32(estate.Width),
+		Length:  int32(estate.Length),
+		Version: int32(estate.Version),
+	}, nil
+}
+
+func (s *Server) CreateTree(ctx context.Context, req *estatev1.CreateTreeRequest) (*estatev1.Tree, error) {
+	var v validator
+	estateID := v.uuid("estate_id", req.GetEstateId())
+	v.between("x", req.GetX(), 1, maxEstateSize)
+	v.between("y", req.GetY(), 1, maxEstateSize)
+	v.between("height", req.GetHeight(), 1, maxTreeHeight)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	plot := domain.Plot{Row: int(req.GetY()), Col: int(req.GetX())}
+	tree, err := s.estateUsecase.CreateTree(ctx, estateID, plot, int(req.GetHeight()))
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	return &estatev1.Tree{
+		Id:       tree.ID.String(),
+		EstateId: estateID.String(),
+		X:        int32(tree.Plot.Col),
+		Y:        int32(tree.Plot.Row),
+		Height:   int32(tree.Height),
+		Version:  int32(tree.Version),
+	}, nil
+}
+
+func (s *Server) GetEstateStats(ctx context.Context, req *estatev1.GetEstateStatsRequest) (*estatev1.EstateStats, error) {
+	var v validator
+	estateID := v.uuid("estate_id", req.GetEstateId())
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	stats, err := s.estateUsecase.GetEstateStats(ctx, estateID)
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	return &estatev1.EstateStats{
+		Count:  int32(stats.Count),
+		Max:    int32(stats.Max),
+		Min:    int32(stats.Min),
+		Median: int32(stats.Median),
+	}, nil
+}
+
+func (s *Server) GetDroneDistance(ctx context.Context, req *estatev1.GetDroneDistanceRequest) (*estatev1.DroneDistance, error) {
+	var v validator
+	estateID := v.uuid("estate_id", req.GetEstateId())
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	var maxDistance *int
+	if req.MaxDistance != nil {
+		distance := int(req.GetMaxDistance())
+		maxDistance = &distance
+	}
+
+	distance, err := s.estateUsecase.GetDroneDistance(ctx, estateID, maxDistance, nil)
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	return &estatev1.DroneDistance{Distance: int32(distance.Distance)}, nil
+}
+
+// StreamDroneWaypoints send waypoints one message each, stopping when the client goes away
+func (s *Server) StreamDroneWaypoints(req *estatev1.StreamDroneWaypointsRequest, stream estatev1.EstateService_StreamDroneWaypointsServer) error {
+	ctx := stream.Context()
+
+	var v validator
+	estateID := v.uuid("estate_id", req.GetEstateId())
+	if err := v.err(); err != nil {
+		return err
+	}
+
+	waypoints, err := s.estateUsecase.GetDroneWaypoints(ctx, estateID)
+	if err != nil {
+		return statusError(ctx, err)
+	}
+
+	for _, waypoint := range waypoints {
+		err = stream.Send(&estatev1.DroneWaypoint{
+			Sequence: int32(waypoint.Sequence),
+			X:        int32(waypoint.Plot.Col),
+			Y:        int32(waypoint.Plot.Row),
+			Altitude: int32(waypoint.Altitude),
+			Distance: int32(waypoint.Distance),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
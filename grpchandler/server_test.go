@@ -0,0 +1,351 @@
+package grpchandler
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	estatev1 "github.com/SawitProRecruitment/EstateService/proto/estate/v1"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const testAPIKey = "est_test"
+
+var testPrincipal = &domain.Principal{TenantID: uuid.New(), Role: domain.RoleAdmin}
+
+// newTestConn serve the estate and health services on an in-memory listener, every call is authenticated with testAPIKey
+func newTestConn(t *testing.T, estateUsecase interfaces.EstateUsecase, readinessChecker interfaces.ReadinessChecker, authUsecase interfaces.AuthUsecase) *grpc.ClientConn {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(UnaryAPIKeyAuth(authUsecase)),
+		grpc.ChainStreamInterceptor(StreamAPIKeyAuth(authUsecase)),
+	)
+	estatev1.RegisterEstateServiceServer(server, NewServer(estateUsecase))
+	healthpb.RegisterHealthServer(server, NewHealthServer(readinessChecker, time.Second))
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// principalOf match contexts carrying the principal put by the auth interceptor
+func principalOf(principal *domain.Principal) gomock.Matcher {
+	return gomock.Cond(func(x any) bool {
+		got, ok := domain.PrincipalFromContext(x.(context.Context))
+		return ok && got == principal
+	})
+}
+
+func authContext() context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), MetadataAPIKey, testAPIKey)
+}
+
+func TestServer_CreateEstate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	estateUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	authUsecase := interfaces.NewMockAuthUsecase(ctrl)
+	authUsecase.EXPECT().Authenticate(gomock.Any(), testAPIKey).Return(testPrincipal, nil).AnyTimes()
+	client := estatev1.NewEstateServiceClient(newTestConn(t, estateUsecase, nil, authUsecase))
+
+	estateID := uuid.New()
+
+	tests := []struct {
+		name           string
+		req            *estatev1.CreateEstateRequest
+		mock           func()
+		want           *estatev1.Estate
+		wantCode       codes.Code
+		wantViolations []string
+	}{
+		{
+			name: "Success",
+			req:  &estatev1.CreateEstateRequest{Width: 10, Length: 20},
+			mock: func() {
+				estateUsecase.EXPECT().CreateEstate(principalOf(testPrincipal), 10, 20).
+					Return(&domain.Estate{ID: estateID, Width: 10, Length: 20, Version: domain.InitialVersion}, nil)
+			},
+			want: &estatev1.Estate{Id: estateID.String(), Width: 10, Length: 20, Version: domain.InitialVersion},
+		},
+		{
+			name:           "Every invalid field is reported",
+			req:            &estatev1.CreateEstateRequest{Width: 0, Length: 50001},
+			mock:           func() {},
+			wantCode:       codes.InvalidArgument,
+			wantViolations: []string{"width", "length"},
+		},
+		{
+			name: "Forbidden",
+			req:  &estatev1.CreateEstateRequest{Width: 10, Length: 20},
+			mock: func() {
+				estateUsecase.EXPECT().CreateEstate(gomock.Any(), 10, 20).Return(nil, domain.ErrorForbidden)
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "Internal error is not exposed",
+			req:  &estatev1.CreateEstateRequest{Width: 10, Length: 20},
+			mock: func() {
+				estateUsecase.EXPECT().CreateEstate(gomock.Any(), 10, 20).Return(nil, errors.New("connection refused"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			got, err := client.CreateEstate(authContext(), tt.req)
+			if tt.wantCode != codes.OK {
+				st := status.Convert(err)
+				assert.Equal(t, tt.wantCode, st.Code())
+				assert.NotContains(t, st.Message(), "connection refused")
+				assert.Equal(t, tt.wantViolations, violatedFields(st))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.String(), got.String())
+		})
+	}
+}
+
+func violatedFields(st *status.Status) []string {
+	var fields []string
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.GetFieldViolations() {
+				fields = append(fields, violation.GetField())
+			}
+		}
+	}
+	return fields
+}
+
+func TestServer_CreateTree(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	estateUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	authUsecase := interfaces.NewMockAuthUsecase(ctrl)
+	authUsecase.EXPECT().Authenticate(gomock.Any(), testAPIKey).Return(testPrincipal, nil).AnyTimes()
+	client := estatev1.NewEstateServiceClient(newTestConn(t, estateUsecase, nil, authUsecase))
+
+	estateID, treeID := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name     string
+		req      *estatev1.CreateTreeRequest
+		mock     func()
+		want     *estatev1.Tree
+		wantCode codes.Code
+	}{
+		{
+			name: "Success",
+			req:  &estatev1.CreateTreeRequest{EstateId: estateID.String(), X: 2, Y: 1, Height: 5},
+			mock: func() {
+				estateUsecase.EXPECT().CreateTree(gomock.Any(), estateID, domain.Plot{Row: 1, Col: 2}, 5).
+					Return(&domain.Tree{ID: treeID, Plot: domain.Plot{Row: 1, Col: 2}, Height: 5, Version: 1}, nil)
+			},
+			want: &estatev1.Tree{Id: treeID.String(), EstateId: estateID.String(), X: 2, Y: 1, Height: 5, Version: 1},
+		},
+		{
+			name:     "Invalid estate id",
+			req:      &estatev1.CreateTreeRequest{EstateId: "not-an-id", X: 2, Y: 1, Height: 5},
+			mock:     func() {},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Height too high",
+			req:      &estatev1.CreateTreeRequest{EstateId: estateID.String(), X: 2, Y: 1, Height: 31},
+			mock:     func() {},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "Tree already exists",
+			req:  &estatev1.CreateTreeRequest{EstateId: estateID.String(), X: 2, Y: 1, Height: 5},
+			mock: func() {
+				estateUsecase.EXPECT().CreateTree(gomock.Any(), estateID, domain.Plot{Row: 1, Col: 2}, 5).Return(nil, domain.ErrorTreeAlreadyExists)
+			},
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			name: "Estate not found",
+			req:  &estatev1.CreateTreeRequest{EstateId: estateID.String(), X: 2, Y: 1, Height: 5},
+			mock: func() {
+				estateUsecase.EXPECT().CreateTree(gomock.Any(), estateID, domain.Plot{Row: 1, Col: 2}, 5).Return(nil, domain.ErrorEstatesNotFound)
+			},
+			wantCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			got, err := client.CreateTree(authContext(), tt.req)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.want != nil {
+				assert.Equal(t, tt.want.String(), got.String())
+			}
+		})
+	}
+}
+
+func TestServer_GetEstateStatsAndDroneDistance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	estateUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	authUsecase := interfaces.NewMockAuthUsecase(ctrl)
+	authUsecase.EXPECT().Authenticate(gomock.Any(), testAPIKey).Return(testPrincipal, nil).AnyTimes()
+	client := estatev1.NewEstateServiceClient(newTestConn(t, estateUsecase, nil, authUsecase))
+
+	estateID := uuid.New()
+
+	estateUsecase.EXPECT().GetEstateStats(gomock.Any(), estateID).Return(&domain.EstateStats{Count: 3, Max: 20, Min: 10, Median: 10}, nil)
+	stats, err := client.GetEstateStats(authContext(), &estatev1.GetEstateStatsRequest{EstateId: estateID.String()})
+	require.NoError(t, err)
+	assert.Equal(t, (&estatev1.EstateStats{Count: 3, Max: 20, Min: 10, Median: 10}).String(), stats.String())
+
+	maxDistance := 30
+	estateUsecase.EXPECT().GetDroneDistance(gomock.Any(), estateID, &maxDistance, nil).Return(&domain.DroneDistance{Distance: 30}, nil)
+	limit := int32(30)
+	distance, err := client.GetDroneDistance(authContext(), &estatev1.GetDroneDistanceRequest{EstateId: estateID.String(), MaxDistance: &limit})
+	require.NoError(t, err)
+	assert.Equal(t, int32(30), distance.GetDistance())
+
+	estateUsecase.EXPECT().GetDroneDistance(gomock.Any(), estateID, nil, nil).Return(&domain.DroneDistance{Distance: 82}, nil)
+	distance, err = client.GetDroneDistance(authContext(), &estatev1.GetDroneDistanceRequest{EstateId: estateID.String()})
+	require.NoError(t, err)
+	assert.Equal(t, int32(82), distance.GetDistance())
+}
+
+func TestServer_StreamDroneWaypoints(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	estateUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	authUsecase := interfaces.NewMockAuthUsecase(ctrl)
+	authUsecase.EXPECT().Authenticate(gomock.Any(), testAPIKey).Return(testPrincipal, nil).AnyTimes()
+	client := estatev1.NewEstateServiceClient(newTestConn(t, estateUsecase, nil, authUsecase))
+
+	estateID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		estateUsecase.EXPECT().GetDroneWaypoints(principalOf(testPrincipal), estateID).Return([]domain.DroneWaypoint{
+			{Sequence: 1, Plot: domain.Plot{Row: 1, Col: 1}, Altitude: 1, Distance: 1},
+			{Sequence: 2, Plot: domain.Plot{Row: 1, Col: 2}, Altitude: 6, Distance: 16},
+		}, nil)
+
+		stream, err := client.StreamDroneWaypoints(authContext(), &estatev1.StreamDroneWaypointsRequest{EstateId: estateID.String()})
+		require.NoError(t, err)
+
+		var got []string
+		for {
+			waypoint, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			require.NoError(t, err)
+			got = append(got, waypoint.String())
+		}
+		assert.Equal(t, []string{
+			(&estatev1.DroneWaypoint{Sequence: 1, X: 1, Y: 1, Altitude: 1, Distance: 1}).String(),
+			(&estatev1.DroneWaypoint{Sequence: 2, X: 2, Y: 1, Altitude: 6, Distance: 16}).String(),
+		}, got)
+	})
+
+	t.Run("Estate not found", func(t *testing.T) {
+		estateUsecase.EXPECT().GetDroneWaypoints(gomock.Any(), estateID).Return(nil, domain.ErrorEstatesNotFound)
+
+		stream, err := client.StreamDroneWaypoints(authContext(), &estatev1.StreamDroneWaypointsRequest{EstateId: estateID.String()})
+		require.NoError(t, err)
+		_, err = stream.Recv()
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestAPIKeyAuth(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	estateUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	authUsecase := interfaces.NewMockAuthUsecase(ctrl)
+	readinessChecker := interfaces.NewMockReadinessChecker(ctrl)
+	conn := newTestConn(t, estateUsecase, readinessChecker, authUsecase)
+	client := estatev1.NewEstateServiceClient(conn)
+
+	authUsecase.EXPECT().Authenticate(gomock.Any(), "").Return(nil, domain.ErrorUnauthenticated).Times(2)
+
+	_, err := client.GetEstateStats(context.Background(), &estatev1.GetEstateStatsRequest{EstateId: uuid.NewString()})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	stream, err := client.StreamDroneWaypoints(context.Background(), &estatev1.StreamDroneWaypointsRequest{EstateId: uuid.NewString()})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// health checks are answered without api key
+	readinessChecker.EXPECT().CheckReadiness(gomock.Any()).Return(nil)
+	res, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, res.GetStatus())
+}
+
+func TestHealthServer_Check(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	readinessChecker := interfaces.NewMockReadinessChecker(ctrl)
+	health := NewHealthServer(readinessChecker, time.Second)
+
+	tests := []struct {
+		name       string
+		service    string
+		mock       func()
+		wantStatus healthpb.HealthCheckResponse_ServingStatus
+		wantCode   codes.Code
+	}{
+		{
+			name:    "Serving",
+			service: "estate.v1.EstateService",
+			mock: func() {
+				readinessChecker.EXPECT().CheckReadiness(gomock.Any()).Return(nil)
+			},
+			wantStatus: healthpb.HealthCheckResponse_SERVING,
+		},
+		{
+			name:    "Database down",
+			service: "",
+			mock: func() {
+				readinessChecker.EXPECT().CheckReadiness(gomock.Any()).Return(errors.New("connection refused"))
+			},
+			wantStatus: healthpb.HealthCheckResponse_NOT_SERVING,
+		},
+		{
+			name:     "Unknown service",
+			service:  "unknown.Service",
+			mock:     func() {},
+			wantCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			res, err := health.Check(context.Background(), &healthpb.HealthCheckRequest{Service: tt.service})
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantStatus, res.GetStatus())
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package grpchandler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// domainCodes map domain errors into status codes, the first matching error wins.
+// Messages are the titles of the REST problems
+var domainCodes = []struct {
+	err     error
+	code    codes.Code
+	message string
+}{
+	{domain.ErrorUnauthenticated, codes.Unauthenticated, "Missing or invalid API key"},
+	{domain.ErrorForbidden, codes.PermissionDenied, "API key is not allowed to perform this action"},
+	{domain.ErrorEstatesNotFound, codes.NotFound, "Estate not found"},
+	{domain.ErrorTreeNotFound, codes.NotFound, "Tree not found"},
+	{domain.ErrorTreeAlreadyExists, codes.AlreadyExists, "Tree already exists"},
+	{domain.ErrorTreePlotOutOfBound, codes.InvalidArgument, "Tree plot out of bound"},
+	{domain.ErrorVersionMismatch, codes.Aborted, "Resource was modified"},
+}
+
+// statusError log err and convert it into a status, internal errors never expose their message
+func statusError(ctx context.Context, err error) error {
+	method, _ := grpc.Method(ctx)
+	slog.Error("error", "message", err.Error(), "method", method)
+
+	for _, dc := range domainCodes {
+		if errors.Is(err, dc.err) {
+			return status.Error(dc.code, dc.message)
+		}
+	}
+	return status.Error(codes.Internal, "Internal server error")
+}
+
+// validator collect every invalid field of a request, like the REST validator does
+type validator struct {
+	violations []*errdetails.BadRequest_FieldViolation
+}
+
+func (v *validator) invalid(field string, format string, args ...any) {
+	v.violations = append(v.violations, &errdetails.BadRequest_FieldViolation{Field: field, Description: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) between(field string, value int32, min int32, max int32) {
+	if value < min || value > max {
+		v.invalid(field, "must be between %d and %d", min, max)
+	}
+}
+
+func (v *validator) uuid(field string, value string) uuid.UUID {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		v.invalid(field, "must be a uuid")
+	}
+	return id
+}
+
+// err return an InvalidArgument status carrying the field violations as BadRequest details
+func (v *validator) err() error {
+	if len(v.violations) == 0 {
+		return nil
+	}
+
+	st := status.New(codes.InvalidArgument, "Request validation failed")
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: v.violations})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/generated"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// Create an API key
+// (POST /api-keys)
+func (s *Server) PostApiKeys(ctx echo.Context) error {
+	var req generated.CreateApiKeyRequest
+
+	err := ctx.Bind(&req)
+	if err != nil {
+		return respondProblem(ctx, problemInvalidRequest, "", nil)
+	}
+
+	key, rawKey, err := s.authUsecase.CreateAPIKey(ctx.Request().Context(), req.Name, domain.Role(req.Role), req.EstateId)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	apiKey := toApiKey(*key)
+	return ctx.JSON(http.StatusCreated, generated.CreateApiKeyResponse{
+		Id:        apiKey.Id,
+		Name:      apiKey.Name,
+		Prefix:    apiKey.Prefix,
+		Role:      apiKey.Role,
+		EstateId:  apiKey.EstateId,
+		CreatedAt: apiKey.CreatedAt,
+		Key:       rawKey,
+	})
+}
+
+// List API keys
+// (GET /api-keys)
+func (s *Server) GetApiKeys(ctx echo.Context) error {
+	keys, err := s.authUsecase.ListAPIKeys(ctx.Request().Context())
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	apiKeys := make([]generated.ApiKey, 0, len(keys))
+	for _, key := range keys {
+		apiKeys = append(apiKeys, toApiKey(key))
+	}
+
+	return ctx.JSON(http.StatusOK, generated.ListApiKeysResponse{ApiKeys: apiKeys})
+}
+
+// Revoke an API key
+// (DELETE /api-keys/{id})
+func (s *Server) DeleteApiKeysId(ctx echo.Context, id uuid.UUID) error {
+	err := s.authUsecase.RevokeAPIKey(ctx.Request().Context(), id)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func toApiKey(key domain.APIKey) generated.ApiKey {
+	return generated.ApiKey{
+		Id:        key.ID,
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		Role:      generated.ApiKeyRole(key.Role),
+		EstateId:  key.EstateID,
+		CreatedAt: key.CreatedAt,
+		RevokedAt: key.RevokedAt,
+	}
+}
@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/SawitProRecruitment/EstateService/generated"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestServer_PostApiKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockAuthUsecase(ctrl)
+	srv := NewServer(interfaces.NewMockEstateUsecase(ctrl), WithAuthUsecase(mockUsecase))
+	e := echo.New()
+
+	estateID := uuid.New()
+	tests := []struct {
+		name         string
+		requestBody  []byte
+		mockFunc     func()
+		expectStatus int
+	}{
+		{
+			name:        "Success",
+			requestBody: []byte(`{"name": "field team", "role": "planter", "estate_id": "` + estateID.String() + `"}`),
+			mockFunc: func() {
+				mockUsecase.EXPECT().CreateAPIKey(gomock.Any(), "field team", domain.RolePlanter, &estateID).
+					Return(&domain.APIKey{ID: uuid.New(), Name: "field team", Role: domain.RolePlanter, EstateID: &estateID}, "est_raw", nil)
+			},
+			expectStatus: http.StatusCreated,
+		},
+		{
+			name:         "Invalid request body",
+			requestBody:  []byte(`{invalid-json}`),
+			mockFunc:     func() {},
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "Invalid role",
+			requestBody: []byte(`{"name": "field team", "role": "owner"}`),
+			mockFunc: func() {
+				mockUsecase.EXPECT().CreateAPIKey(gomock.Any(), "field team", domain.Role("owner"), nil).Return(nil, "", domain.ErrorInvalidRole)
+			},
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "Forbidden",
+			requestBody: []byte(`{"name": "field team", "role": "admin"}`),
+			mockFunc: func() {
+				mockUsecase.EXPECT().CreateAPIKey(gomock.Any(), "field team", domain.RoleAdmin, nil).Return(nil, "", domain.ErrorForbidden)
+			},
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name:        "Internal server error",
+			requestBody: []byte(`{"name": "field team", "role": "viewer"}`),
+			mockFunc: func() {
+				mockUsecase.EXPECT().CreateAPIKey(gomock.Any(), "field team", domain.RoleViewer, nil).Return(nil, "", errors.New("unexpected error"))
+			},
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api-keys", io.NopCloser(bytes.NewReader(tt.requestBody)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			tt.mockFunc()
+			assert.NoError(t, srv.PostApiKeys(ctx))
+			assert.Equal(t, tt.expectStatus, rec.Code)
+
+			if tt.expectStatus == http.StatusCreated {
+				var resp generated.CreateApiKeyResponse
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+				assert.Equal(t, "est_raw", resp.Key)
+			}
+		})
+	}
+}
+
+func TestServer_GetApiKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockAuthUsecase(ctrl)
+	srv := NewServer(interfaces.NewMockEstateUsecase(ctrl), WithAuthUsecase(mockUsecase))
+	e := echo.New()
+
+	mockUsecase.EXPECT().ListAPIKeys(gomock.Any()).Return([]domain.APIKey{{ID: uuid.New(), Role: domain.RoleAdmin}}, nil)
+	rec := httptest.NewRecorder()
+	assert.NoError(t, srv.GetApiKeys(e.NewContext(httptest.NewRequest(http.MethodGet, "/api-keys", nil), rec)))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	mockUsecase.EXPECT().ListAPIKeys(gomock.Any()).Return(nil, domain.ErrorUnauthenticated)
+	rec = httptest.NewRecorder()
+	assert.NoError(t, srv.GetApiKeys(e.NewContext(httptest.NewRequest(http.MethodGet, "/api-keys", nil), rec)))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestServer_DeleteApiKeysId(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockAuthUsecase(ctrl)
+	srv := NewServer(interfaces.NewMockEstateUsecase(ctrl), WithAuthUsecase(mockUsecase))
+	e := echo.New()
+	id := uuid.New()
+
+	tests := []struct {
+		name         string
+		err          error
+		expectStatus int
+	}{
+		{name: "Success", err: nil, expectStatus: http.StatusNoContent},
+		{name: "Not found", err: domain.ErrorAPIKeyNotFound, expectStatus: http.StatusNotFound},
+		{name: "Forbidden", err: domain.ErrorForbidden, expectStatus: http.StatusForbidden},
+		{name: "Internal server error", err: errors.New("unexpected error"), expectStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(httptest.NewRequest(http.MethodDelete, "/api-keys/"+id.String(), nil), rec)
+
+			mockUsecase.EXPECT().RevokeAPIKey(gomock.Any(), id).Return(tt.err)
+			assert.NoError(t, srv.DeleteApiKeysId(ctx, id))
+			assert.Equal(t, tt.expectStatus, rec.Code)
+		})
+	}
+}
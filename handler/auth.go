@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/labstack/echo/v4"
+)
+
+const HeaderAPIKey = "X-API-Key"
+
+// APIKeyAuth authenticate X-API-Key header and put the principal into request context,
+// authorization itself is enforced by the usecase layer
+func APIKeyAuth(authUsecase interfaces.AuthUsecase) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			req := ctx.Request()
+
+			principal, err := authUsecase.Authenticate(req.Context(), req.Header.Get(HeaderAPIKey))
+			if err != nil {
+				return respondError(ctx, err)
+			}
+
+			ctx.SetRequest(req.WithContext(domain.WithPrincipal(req.Context(), principal)))
+			return next(ctx)
+		}
+	}
+}
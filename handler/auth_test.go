@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAPIKeyAuth(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockAuthUsecase(ctrl)
+	e := echo.New()
+	principal := &domain.Principal{KeyID: uuid.New(), Role: domain.RoleViewer}
+
+	tests := []struct {
+		name         string
+		apiKey       string
+		mockFunc     func()
+		expectStatus int
+	}{
+		{
+			name:   "Authenticated",
+			apiKey: "est_valid",
+			mockFunc: func() {
+				mockUsecase.EXPECT().Authenticate(gomock.Any(), "est_valid").Return(principal, nil)
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:   "Missing or invalid key",
+			apiKey: "",
+			mockFunc: func() {
+				mockUsecase.EXPECT().Authenticate(gomock.Any(), "").Return(nil, domain.ErrorUnauthenticated)
+			},
+			expectStatus: http.StatusUnauthorized,
+		},
+		{
+			name:   "Internal server error",
+			apiKey: "est_valid",
+			mockFunc: func() {
+				mockUsecase.EXPECT().Authenticate(gomock.Any(), "est_valid").Return(nil, errors.New("unexpected error"))
+			},
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/webhooks", nil)
+			req.Header.Set(HeaderAPIKey, tt.apiKey)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			tt.mockFunc()
+			next := func(ctx echo.Context) error {
+				got, ok := domain.PrincipalFromContext(ctx.Request().Context())
+				assert.True(t, ok)
+				assert.Equal(t, principal, got)
+				return ctx.NoContent(http.StatusOK)
+			}
+
+			assert.NoError(t, APIKeyAuth(mockUsecase)(next)(ctx))
+			assert.Equal(t, tt.expectStatus, rec.Code)
+		})
+	}
+}
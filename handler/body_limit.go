@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// limitedRoutes take a whole snapshot as body, it is read into memory by the validator
+// and again by the idempotency middleware so it is bounded like a decompressed snapshot
+var limitedRoutes = map[string]bool{
+	http.MethodPost + " /estate/import": true,
+}
+
+// BodyLimit reject bodies of limitedRoutes larger than maxSnapshotSize as request_too_large.
+// It must run before request validation, which is the first to read the body
+func BodyLimit() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			req := ctx.Request()
+			if !limitedRoutes[req.Method+" "+ctx.Path()] {
+				return next(ctx)
+			}
+
+			if req.ContentLength > maxSnapshotSize {
+				return respondProblem(ctx, problemRequestTooLarge, "", nil)
+			}
+
+			// chunked bodies have no length, they fail once the limit is read
+			req.Body = http.MaxBytesReader(ctx.Response().Writer, req.Body, maxSnapshotSize)
+			return next(ctx)
+		}
+	}
+}
+
+// isRequestTooLarge report whether err comes from reading a body past the BodyLimit
+func isRequestTooLarge(err error) bool {
+	var maxBytesErr *http.MaxBytesError
+	return errors.As(err, &maxBytesErr)
+}
@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SawitProRecruitment/EstateService/generated"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBodyLimit(t *testing.T) {
+	defer func(size int64) { maxSnapshotSize = size }(maxSnapshotSize)
+	maxSnapshotSize = 8
+
+	e := echo.New()
+	e.HTTPErrorHandler = ProblemErrorHandler
+	e.Use(BodyLimit())
+	read := func(ctx echo.Context) error {
+		if _, err := io.ReadAll(ctx.Request().Body); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest).SetInternal(err)
+		}
+		return ctx.NoContent(http.StatusCreated)
+	}
+	e.POST("/estate/import", read)
+	e.POST("/estate", read)
+
+	tests := []struct {
+		name         string
+		path         string
+		body         []byte
+		chunked      bool
+		expectStatus int
+		expectCode   string
+	}{
+		{
+			name:         "Within limit",
+			path:         "/estate/import",
+			body:         []byte(`{}`),
+			expectStatus: http.StatusCreated,
+		},
+		{
+			name:         "Content length over limit",
+			path:         "/estate/import",
+			body:         []byte(`{"estate": {}}`),
+			expectStatus: http.StatusRequestEntityTooLarge,
+			expectCode:   "request_too_large",
+		},
+		{
+			name:         "Chunked body over limit",
+			path:         "/estate/import",
+			body:         []byte(`{"estate": {}}`),
+			chunked:      true,
+			expectStatus: http.StatusRequestEntityTooLarge,
+			expectCode:   "request_too_large",
+		},
+		{
+			name:         "Route without limit",
+			path:         "/estate",
+			body:         []byte(`{"width": 10, "length": 20}`),
+			expectStatus: http.StatusCreated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, tt.path, bytes.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if tt.chunked {
+				req.ContentLength = -1
+			}
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+			assert.Equal(t, tt.expectStatus, rec.Code)
+			if tt.expectCode != "" {
+				var p generated.Problem
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+				assert.Equal(t, tt.expectCode, p.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/generated"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// Add a drone to the fleet
+// (POST /drones)
+func (s *Server) PostDrones(ctx echo.Context) error {
+	var req generated.CreateDroneRequest
+
+	err := ctx.Bind(&req)
+	if err != nil {
+		return respondProblem(ctx, problemInvalidRequest, "", nil)
+	}
+
+	drone, err := s.droneUsecase.CreateDrone(ctx.Request().Context(), &domain.Drone{
+		Name:        req.Name,
+		Model:       req.Model,
+		MaxRange:    req.MaxRange,
+		MaxAltitude: req.MaxAltitude,
+		Speed:       domain.DroneSpeed{Cruise: req.CruiseSpeed, Climb: req.ClimbSpeed},
+	})
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusCreated, toDrone(drone))
+}
+
+// List drones of the fleet
+// (GET /drones)
+func (s *Server) GetDrones(ctx echo.Context, params generated.GetDronesParams) error {
+	limit := defaultListEstatesLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	offset := 0
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+	var status *domain.DroneStatus
+	if params.Status != nil {
+		value := domain.DroneStatus(*params.Status)
+		status = &value
+	}
+
+	drones, err := s.droneUsecase.ListDrones(ctx.Request().Context(), status, limit, offset)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	res := generated.ListDronesResponse{Drones: make([]generated.Drone, 0, len(drones))}
+	for i := range drones {
+		res.Drones = append(res.Drones, toDrone(&drones[i]))
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// Get a drone of the fleet
+// (GET /drones/{drone_id})
+func (s *Server) GetDronesDroneId(ctx echo.Context, droneId uuid.UUID) error {
+	drone, err := s.droneUsecase.GetDrone(ctx.Request().Context(), droneId)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, toDrone(drone))
+}
+
+// Change the specification or the status of a drone
+// (PATCH /drones/{drone_id})
+func (s *Server) PatchDronesDroneId(ctx echo.Context, droneId uuid.UUID) error {
+	var req generated.UpdateDroneRequest
+
+	err := ctx.Bind(&req)
+	if err != nil {
+		return respondProblem(ctx, problemInvalidRequest, "", nil)
+	}
+
+	update := domain.DroneUpdate{
+		Name:        req.Name,
+		Model:       req.Model,
+		MaxRange:    req.MaxRange,
+		MaxAltitude: req.MaxAltitude,
+		CruiseSpeed: req.CruiseSpeed,
+		ClimbSpeed:  req.ClimbSpeed,
+	}
+	if req.Status != nil {
+		status := domain.DroneStatus(*req.Status)
+		update.Status = &status
+	}
+
+	drone, err := s.droneUsecase.UpdateDrone(ctx.Request().Context(), droneId, update)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, toDrone(drone))
+}
+
+func toDrone(drone *domain.Drone) generated.Drone {
+	return generated.Drone{
+		Id:          drone.ID,
+		Name:        drone.Name,
+		Model:       drone.Model,
+		MaxRange:    drone.MaxRange,
+		MaxAltitude: drone.MaxAltitude,
+		CruiseSpeed: drone.Speed.Cruise,
+		ClimbSpeed:  drone.Speed.Climb,
+		Status:      generated.DroneStatus(drone.Status),
+		CreatedAt:   drone.CreatedAt,
+		UpdatedAt:   drone.UpdatedAt,
+	}
+}
@@ -0,0 +1,288 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/SawitProRecruitment/EstateService/generated"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestServer_PostDrones(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockDroneUsecase(ctrl)
+	srv := &Server{droneUsecase: mockUsecase}
+	e := echo.New()
+	at := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+	drone := &domain.Drone{
+		ID:          uuid.New(),
+		Name:        "drone-7",
+		Model:       "T40",
+		MaxRange:    2000,
+		MaxAltitude: 30,
+		Speed:       domain.DroneSpeed{Cruise: 10, Climb: 4},
+		Status:      domain.DroneAvailable,
+		CreatedAt:   at,
+		UpdatedAt:   at,
+	}
+
+	tests := []struct {
+		name         string
+		requestBody  []byte
+		mockFunc     func()
+		expectStatus int
+		expectCode   string
+		expectBody   string
+	}{
+		{
+			name:        "Success",
+			requestBody: []byte(`{"name":"drone-7","model":"T40","max_range":2000,"max_altitude":30,"cruise_speed":10,"climb_speed":4}`),
+			mockFunc: func() {
+				mockUsecase.EXPECT().CreateDrone(gomock.Any(), &domain.Drone{
+					Name: "drone-7", Model: "T40", MaxRange: 2000, MaxAltitude: 30, Speed: domain.DroneSpeed{Cruise: 10, Climb: 4},
+				}).Return(drone, nil)
+			},
+			expectStatus: http.StatusCreated,
+			expectBody: `{
+				"id":"` + drone.ID.String() + `","name":"drone-7","model":"T40","max_range":2000,"max_altitude":30,
+				"cruise_speed":10,"climb_speed":4,"status":"available",
+				"created_at":"2024-03-01T08:00:00Z","updated_at":"2024-03-01T08:00:00Z"
+			}`,
+		},
+		{
+			name:        "Invalid drone",
+			requestBody: []byte(`{"name":"drone-7","model":"T40","max_range":0,"max_altitude":30,"cruise_speed":10,"climb_speed":4}`),
+			mockFunc: func() {
+				mockUsecase.EXPECT().CreateDrone(gomock.Any(), gomock.Any()).Return(nil, domain.ErrorDroneInvalid)
+			},
+			expectStatus: http.StatusBadRequest,
+			expectCode:   "drone_invalid",
+		},
+		{
+			name:         "Invalid body",
+			requestBody:  []byte(`{"max_range":"far"}`),
+			mockFunc:     func() {},
+			expectStatus: http.StatusBadRequest,
+			expectCode:   "invalid_request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/drones", bytes.NewReader(tt.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			tt.mockFunc()
+			assert.NoError(t, srv.PostDrones(ctx))
+			assert.Equal(t, tt.expectStatus, rec.Code)
+			if tt.expectCode != "" {
+				var p generated.Problem
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+				assert.Equal(t, tt.expectCode, p.Code)
+			}
+			if tt.expectBody != "" {
+				assert.JSONEq(t, tt.expectBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestServer_GetDrones(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockDroneUsecase(ctrl)
+	srv := &Server{droneUsecase: mockUsecase}
+	e := echo.New()
+	droneID := uuid.New()
+	status := generated.Maintenance
+	maintenance := domain.DroneMaintenance
+	limit := 10
+
+	tests := []struct {
+		name         string
+		params       generated.GetDronesParams
+		mockFunc     func()
+		expectStatus int
+		expectCode   string
+		expectBody   string
+	}{
+		{
+			name:   "Filtered by status",
+			params: generated.GetDronesParams{Status: &status, Limit: &limit},
+			mockFunc: func() {
+				mockUsecase.EXPECT().ListDrones(gomock.Any(), &maintenance, 10, 0).Return([]domain.Drone{{ID: droneID, Status: maintenance}}, nil)
+			},
+			expectStatus: http.StatusOK,
+			expectBody: `{"drones":[{
+				"id":"` + droneID.String() + `","name":"","model":"","max_range":0,"max_altitude":0,
+				"cruise_speed":0,"climb_speed":0,"status":"maintenance",
+				"created_at":"0001-01-01T00:00:00Z","updated_at":"0001-01-01T00:00:00Z"
+			}]}`,
+		},
+		{
+			name: "Forbidden",
+			mockFunc: func() {
+				mockUsecase.EXPECT().ListDrones(gomock.Any(), nil, defaultListEstatesLimit, 0).Return(nil, domain.ErrorForbidden)
+			},
+			expectStatus: http.StatusForbidden,
+			expectCode:   "forbidden",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/drones", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			tt.mockFunc()
+			assert.NoError(t, srv.GetDrones(ctx, tt.params))
+			assert.Equal(t, tt.expectStatus, rec.Code)
+			if tt.expectCode != "" {
+				var p generated.Problem
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+				assert.Equal(t, tt.expectCode, p.Code)
+			}
+			if tt.expectBody != "" {
+				assert.JSONEq(t, tt.expectBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestServer_GetDronesDroneId(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockDroneUsecase(ctrl)
+	srv := &Server{droneUsecase: mockUsecase}
+	e := echo.New()
+	droneID := uuid.New()
+
+	tests := []struct {
+		name         string
+		mockFunc     func()
+		expectStatus int
+		expectCode   string
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				mockUsecase.EXPECT().GetDrone(gomock.Any(), droneID).Return(&domain.Drone{ID: droneID, Status: domain.DroneAvailable}, nil)
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Not found",
+			mockFunc: func() {
+				mockUsecase.EXPECT().GetDrone(gomock.Any(), droneID).Return(nil, domain.ErrorDroneNotFound)
+			},
+			expectStatus: http.StatusNotFound,
+			expectCode:   "drone_not_found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/drones/"+droneID.String(), nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			tt.mockFunc()
+			assert.NoError(t, srv.GetDronesDroneId(ctx, droneID))
+			assert.Equal(t, tt.expectStatus, rec.Code)
+			if tt.expectCode != "" {
+				var p generated.Problem
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+				assert.Equal(t, tt.expectCode, p.Code)
+			}
+		})
+	}
+}
+
+func TestServer_PatchDronesDroneId(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockDroneUsecase(ctrl)
+	srv := &Server{droneUsecase: mockUsecase}
+	e := echo.New()
+	droneID := uuid.New()
+	retired := domain.DroneRetired
+	maxRange := 1500
+
+	tests := []struct {
+		name         string
+		requestBody  []byte
+		mockFunc     func()
+		expectStatus int
+		expectCode   string
+		expectBody   string
+	}{
+		{
+			name:        "Success",
+			requestBody: []byte(`{"max_range":1500,"status":"retired"}`),
+			mockFunc: func() {
+				mockUsecase.EXPECT().UpdateDrone(gomock.Any(), droneID, domain.DroneUpdate{MaxRange: &maxRange, Status: &retired}).
+					Return(&domain.Drone{ID: droneID, MaxRange: 1500, Status: retired}, nil)
+			},
+			expectStatus: http.StatusOK,
+			expectBody: `{
+				"id":"` + droneID.String() + `","name":"","model":"","max_range":1500,"max_altitude":0,
+				"cruise_speed":0,"climb_speed":0,"status":"retired",
+				"created_at":"0001-01-01T00:00:00Z","updated_at":"0001-01-01T00:00:00Z"
+			}`,
+		},
+		{
+			name:        "Retired drone",
+			requestBody: []byte(`{"name":"drone-8"}`),
+			mockFunc: func() {
+				mockUsecase.EXPECT().UpdateDrone(gomock.Any(), droneID, gomock.Any()).Return(nil, domain.ErrorDroneRetired)
+			},
+			expectStatus: http.StatusConflict,
+			expectCode:   "drone_retired",
+		},
+		{
+			name:         "Invalid body",
+			requestBody:  []byte(`{"max_range":"far"}`),
+			mockFunc:     func() {},
+			expectStatus: http.StatusBadRequest,
+			expectCode:   "invalid_request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPatch, "/drones/"+droneID.String(), bytes.NewReader(tt.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			tt.mockFunc()
+			assert.NoError(t, srv.PatchDronesDroneId(ctx, droneID))
+			assert.Equal(t, tt.expectStatus, rec.Code)
+			if tt.expectCode != "" {
+				var p generated.Problem
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+				assert.Equal(t, tt.expectCode, p.Code)
+			}
+			if tt.expectBody != "" {
+				assert.JSONEq(t, tt.expectBody, rec.Body.String())
+			}
+		})
+	}
+}
@@ -1,30 +1,34 @@
 package handler
 
 import (
-	"errors"
+	"bytes"
+	"encoding/json"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/SawitProRecruitment/EstateService/core/domain"
 	"github.com/SawitProRecruitment/EstateService/generated"
+	"github.com/SawitProRecruitment/EstateService/render"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 )
 
 // Create an estate
 // (POST /estate)
-func (s *Server) PostEstate(ctx echo.Context) error {
+// Idempotency-Key is handled by the Idempotency middleware
+func (s *Server) PostEstate(ctx echo.Context, _ generated.PostEstateParams) error {
 	var req generated.CreateEstateRequest
 
 	err := ctx.Bind(&req)
 	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid request"})
+		return respondProblem(ctx, problemInvalidRequest, "", nil)
 	}
 
 	estate, err := s.estateUsecase.CreateEstate(ctx.Request().Context(), req.Width, req.Length)
 	if err != nil {
-		slog.Error("error", "message", err.Error())
-		return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: "Internal server error"})
+		return respondError(ctx, err)
 	}
 
 	return ctx.JSON(http.StatusCreated, generated.CreateEstateResponse{
@@ -32,27 +36,98 @@ func (s *Server) PostEstate(ctx echo.Context) error {
 	})
 }
 
+// defaultListEstatesLimit is the page size when limit is not given, the maximum is enforced by the request validator
+const defaultListEstatesLimit = 100
+
+// List estates
+// (GET /estate)
+func (s *Server) GetEstate(ctx echo.Context, params generated.GetEstateParams) error {
+	limit := defaultListEstatesLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	offset := 0
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	estates, err := s.estateUsecase.ListEstates(ctx.Request().Context(), limit, offset)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	res := generated.ListEstatesResponse{Estates: make([]generated.Estate, 0, len(estates))}
+	for i := range estates {
+		res.Estates = append(res.Estates, toEstate(&estates[i]))
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// Get an estate
+// (GET /estate/{id})
+func (s *Server) GetEstateId(ctx echo.Context, id uuid.UUID) error {
+	estate, err := s.estateUsecase.GetEstate(ctx.Request().Context(), id)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	ctx.Response().Header().Set(HeaderETag, formatETag(estate.Version))
+	return ctx.JSON(http.StatusOK, toEstate(estate))
+}
+
+// Resize an estate
+// (PATCH /estate/{id})
+func (s *Server) PatchEstateId(ctx echo.Context, id uuid.UUID, params generated.PatchEstateIdParams) error {
+	if params.IfMatch == nil {
+		return respondProblem(ctx, problemPreconditionRequired, "", nil)
+	}
+
+	version, ok := parseIfMatch(*params.IfMatch)
+	if !ok {
+		return respondError(ctx, domain.ErrorVersionMismatch)
+	}
+
+	var req generated.ResizeEstateRequest
+
+	err := ctx.Bind(&req)
+	if err != nil {
+		return respondProblem(ctx, problemInvalidRequest, "", nil)
+	}
+
+	estate, err := s.estateUsecase.ResizeEstate(ctx.Request().Context(), id, req.Width, req.Length, version)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	ctx.Response().Header().Set(HeaderETag, formatETag(estate.Version))
+	return ctx.JSON(http.StatusOK, toEstate(estate))
+}
+
+// Delete an estate
+// (DELETE /estate/{id})
+func (s *Server) DeleteEstateId(ctx echo.Context, id uuid.UUID) error {
+	err := s.estateUsecase.DeleteEstate(ctx.Request().Context(), id)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
 // Add a tree to an estate
 // (POST /estate/{id}/tree)
-func (s *Server) PostEstateIdTree(ctx echo.Context, id uuid.UUID) error {
+// Idempotency-Key is handled by the Idempotency middleware
+func (s *Server) PostEstateIdTree(ctx echo.Context, id uuid.UUID, _ generated.PostEstateIdTreeParams) error {
 	var req generated.CreateTreeRequest
 
 	err := ctx.Bind(&req)
 	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid request"})
+		return respondProblem(ctx, problemInvalidRequest, "", nil)
 	}
 
 	tree, err := s.estateUsecase.CreateTree(ctx.Request().Context(), id, domain.Plot{Row: req.Y, Col: req.X}, req.Height)
 	if err != nil {
-		slog.Error("error", "message", err.Error())
-		switch {
-		case errors.Is(err, domain.ErrorEstatesNotFound):
-			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: err.Error()})
-		case errors.Is(err, domain.ErrorTreeAlreadyExists), errors.Is(err, domain.ErrorTreePlotOutOfBound):
-			return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: err.Error()})
-		default:
-			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: "Internal server error"})
-		}
+		return respondError(ctx, err)
 	}
 
 	return ctx.JSON(http.StatusCreated, generated.CreateTreeResponse{
@@ -60,16 +135,78 @@ func (s *Server) PostEstateIdTree(ctx echo.Context, id uuid.UUID) error {
 	})
 }
 
+// List trees of an estate
+// (GET /estate/{id}/tree)
+func (s *Server) GetEstateIdTree(ctx echo.Context, id uuid.UUID) error {
+	trees, err := s.estateUsecase.ListTrees(ctx.Request().Context(), id)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	res := generated.ListTreesResponse{Trees: make([]generated.Tree, 0, len(trees))}
+	for i := range trees {
+		res.Trees = append(res.Trees, toTree(&trees[i]))
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// Get a tree of an estate
+// (GET /estate/{id}/tree/{tree_id})
+func (s *Server) GetEstateIdTreeTreeId(ctx echo.Context, id uuid.UUID, treeId uuid.UUID) error {
+	tree, err := s.estateUsecase.GetTree(ctx.Request().Context(), id, treeId)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	ctx.Response().Header().Set(HeaderETag, formatETag(tree.Version))
+	return ctx.JSON(http.StatusOK, toTree(tree))
+}
+
+// Update height of a tree
+// (PATCH /estate/{id}/tree/{tree_id})
+func (s *Server) PatchEstateIdTreeTreeId(ctx echo.Context, id uuid.UUID, treeId uuid.UUID, params generated.PatchEstateIdTreeTreeIdParams) error {
+	if params.IfMatch == nil {
+		return respondProblem(ctx, problemPreconditionRequired, "", nil)
+	}
+
+	version, ok := parseIfMatch(*params.IfMatch)
+	if !ok {
+		return respondError(ctx, domain.ErrorVersionMismatch)
+	}
+
+	var req generated.UpdateTreeRequest
+
+	err := ctx.Bind(&req)
+	if err != nil {
+		return respondProblem(ctx, problemInvalidRequest, "", nil)
+	}
+
+	tree, err := s.estateUsecase.UpdateTreeHeight(ctx.Request().Context(), id, treeId, req.Height, version)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	ctx.Response().Header().Set(HeaderETag, formatETag(tree.Version))
+	return ctx.JSON(http.StatusOK, toTree(tree))
+}
+
+// Remove a tree from an estate
+// (DELETE /estate/{id}/tree/{tree_id})
+func (s *Server) DeleteEstateIdTreeTreeId(ctx echo.Context, id uuid.UUID, treeId uuid.UUID) error {
+	err := s.estateUsecase.DeleteTree(ctx.Request().Context(), id, treeId)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
 // Get stats for trees in an estate
 // (GET /estate/{id}/stats)
 func (s *Server) GetEstateIdStats(ctx echo.Context, id uuid.UUID) error {
 	stats, err := s.estateUsecase.GetEstateStats(ctx.Request().Context(), id)
 	if err != nil {
-		slog.Error("error", "message", err.Error())
-		if errors.Is(err, domain.ErrorEstatesNotFound) {
-			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: err.Error()})
-		}
-		return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: "Internal server error"})
+		return respondError(ctx, err)
 	}
 
 	return ctx.JSON(http.StatusOK, generated.GetEstateTreeStatsResponse{
@@ -83,16 +220,178 @@ func (s *Server) GetEstateIdStats(ctx echo.Context, id uuid.UUID) error {
 // Get the sum distance of the drone monitoring travel in the estate
 // (GET /estate/{id}/drone-plan)
 func (s *Server) GetEstateIdDronePlan(ctx echo.Context, id uuid.UUID, params generated.GetEstateIdDronePlanParams) error {
-	droneDistance, err := s.estateUsecase.GetDroneDistance(ctx.Request().Context(), id, params.MaxDistance)
+	droneDistance, err := s.estateUsecase.GetDroneDistance(ctx.Request().Context(), id, params.MaxDistance, params.DroneId)
 	if err != nil {
-		slog.Error("error", "message", err.Error())
-		if errors.Is(err, domain.ErrorEstatesNotFound) {
-			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: err.Error()})
-		}
-		return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: "Internal server error"})
+		return respondError(ctx, err)
 	}
 
-	return ctx.JSON(http.StatusOK, generated.GetEstateDronePlanResponse{
+	res := generated.GetEstateDronePlanResponse{
 		Distance: &droneDistance.Distance,
-	})
+	}
+	if droneDistance.Rest != nil {
+		res.Rest = &struct {
+			X *int `json:"x,omitempty"`
+			Y *int `json:"y,omitempty"`
+		}{X: &droneDistance.Rest.Col, Y: &droneDistance.Rest.Row}
+	}
+	if droneDistance.FlightTime != nil {
+		seconds := int(droneDistance.FlightTime.Seconds())
+		res.FlightTime = &seconds
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// Get the altitude profile of the drone route
+// (GET /estate/{id}/drone-plan/profile)
+func (s *Server) GetEstateIdDronePlanProfile(ctx echo.Context, id uuid.UUID, params generated.GetEstateIdDronePlanProfileParams) error {
+	profile, err := s.estateUsecase.GetDroneAltitudeProfile(ctx.Request().Context(), id)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	if params.Format != nil && *params.Format == generated.GetEstateIdDronePlanProfileParamsFormatSvg {
+		var buf bytes.Buffer
+		err = render.ProfileSVG(&buf, profile)
+		if err != nil {
+			return respondError(ctx, err)
+		}
+		return ctx.Blob(http.StatusOK, render.MIMEImageSVG, buf.Bytes())
+	}
+
+	res := generated.AltitudeProfileResponse{
+		HorizontalDistance: profile.Horizontal,
+		VerticalDistance:   profile.Vertical,
+		VerticalShare:      float32(profile.VerticalShare()),
+		HorizontalShare:    float32(profile.HorizontalShare()),
+		Points:             make([]generated.ProfilePoint, 0, len(profile.Points)),
+		LargestClimbs:      make([]generated.Climb, 0, len(profile.Climbs)),
+	}
+	for _, point := range profile.Points {
+		res.Points = append(res.Points, generated.ProfilePoint{
+			Distance: point.Distance,
+			Altitude: point.Altitude,
+			X:        point.Plot.Col,
+			Y:        point.Plot.Row,
+		})
+	}
+	for _, climb := range profile.Climbs {
+		res.LargestClimbs = append(res.LargestClimbs, generated.Climb{
+			Distance: climb.Distance,
+			Altitude: climb.Altitude,
+			Height:   climb.Height,
+			X:        climb.Plot.Col,
+			Y:        climb.Plot.Row,
+		})
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// Draw the drone plan of an estate
+// (GET /estate/{id}/drone-plan/render)
+func (s *Server) GetEstateIdDronePlanRender(ctx echo.Context, id uuid.UUID, params generated.GetEstateIdDronePlanRenderParams) error {
+	plan, err := s.estateUsecase.GetDronePlan(ctx.Request().Context(), id, params.MaxDistance, params.DroneId)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	draw, contentType := render.SVG, render.MIMEImageSVG
+	if params.Format != nil && *params.Format == generated.GetEstateIdDronePlanRenderParamsFormatPng {
+		draw, contentType = render.PNG, render.MIMEImagePNG
+	}
+
+	// rendered plans are bounded in size, buffering them keeps errors reported as problems
+	var buf bytes.Buffer
+	err = draw(&buf, plan)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	return ctx.Blob(http.StatusOK, contentType, buf.Bytes())
+}
+
+// sseKeepAliveInterval keep idle connections open through proxies
+var sseKeepAliveInterval = 15 * time.Second
+
+// Stream estate events as Server-Sent Events
+// (GET /estate/{id}/events)
+func (s *Server) GetEstateIdEvents(ctx echo.Context, id uuid.UUID, params generated.GetEstateIdEventsParams) error {
+	lastEventID := ""
+	if params.LastEventID != nil {
+		lastEventID = *params.LastEventID
+	}
+
+	events, err := s.estateUsecase.SubscribeEstateEvents(ctx.Request().Context(), id, lastEventID)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	res := ctx.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	res.Header().Set(echo.HeaderConnection, "keep-alive")
+	res.WriteHeader(http.StatusOK)
+	res.Flush()
+
+	keepAlive := time.NewTicker(sseKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Request().Context().Done():
+			return nil
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(res, ": keep-alive\n\n"); err != nil {
+				return nil
+			}
+			res.Flush()
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+			data, err := json.Marshal(toEstateEvent(event))
+			if err != nil {
+				slog.Error("error", "message", err.Error())
+				continue
+			}
+			if _, err := fmt.Fprintf(res, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data); err != nil {
+				return nil
+			}
+			res.Flush()
+		}
+	}
+}
+
+func toEstateEvent(event domain.Event) generated.EstateEvent {
+	estateEvent := generated.EstateEvent{
+		Id:         event.ID,
+		Type:       generated.EstateEventType(event.Type),
+		EstateId:   event.EstateID,
+		OccurredAt: event.OccurredAt,
+	}
+	if data := event.DataMap(); data != nil {
+		estateEvent.Data = &data
+	}
+	return estateEvent
+}
+
+func toEstate(estate *domain.Estate) generated.Estate {
+	res := generated.Estate{
+		Id:     estate.ID,
+		Width:  estate.Width,
+		Length: estate.Length,
+		Draft:  estate.Sandbox != nil,
+	}
+	if estate.Sandbox != nil {
+		res.SourceEstateId = estate.Sandbox.SourceEstateID
+	}
+	return res
+}
+
+func toTree(tree *domain.Tree) generated.Tree {
+	return generated.Tree{
+		Id:     tree.ID,
+		X:      tree.Plot.Col,
+		Y:      tree.Plot.Row,
+		Height: tree.Height,
+	}
 }
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/SawitProRecruitment/EstateService/core/domain"
 	"github.com/SawitProRecruitment/EstateService/core/interfaces"
@@ -65,7 +66,7 @@ func TestServer_PostEstate(t *testing.T) {
 			ctx := e.NewContext(req, rec)
 
 			tt.mockFunc()
-			err := srv.PostEstate(ctx)
+			err := srv.PostEstate(ctx, generated.PostEstateParams{})
 			assert.NoError(t, err)
 			assert.Equal(t, tt.expectStatus, rec.Code)
 		})
@@ -95,6 +96,14 @@ func TestServer_PostEstateIdTree(t *testing.T) {
 			},
 			expectStatus: http.StatusCreated,
 		},
+		{
+			name:        "Forbidden",
+			requestBody: []byte(`{"x": 1, "y": 2, "height": 10}`),
+			mockFunc: func() {
+				mockUsecase.EXPECT().CreateTree(gomock.Any(), estateID, domain.Plot{Row: 2, Col: 1}, 10).Return(nil, domain.ErrorForbidden)
+			},
+			expectStatus: http.StatusForbidden,
+		},
 		{
 			name:        "Usecase error",
 			requestBody: []byte(`{"x": 1, "y": 2, "height": 10}`),
@@ -118,7 +127,7 @@ func TestServer_PostEstateIdTree(t *testing.T) {
 
 			tt.mockFunc()
 
-			assert.NoError(t, server.PostEstateIdTree(ctx, estateID))
+			assert.NoError(t, server.PostEstateIdTree(ctx, estateID, generated.PostEstateIdTreeParams{}))
 			assert.Equal(t, tt.expectStatus, rec.Code)
 		})
 	}
@@ -198,14 +207,14 @@ func TestServer_GetEstateIdDronePlan(t *testing.T) {
 		{
 			name: "Success",
 			mockFunc: func() {
-				mockUsecase.EXPECT().GetDroneDistance(gomock.Any(), estateID, gomock.Any()).Return(&domain.DroneDistance{Distance: 42}, nil)
+				mockUsecase.EXPECT().GetDroneDistance(gomock.Any(), estateID, gomock.Any(), gomock.Any()).Return(&domain.DroneDistance{Distance: 42}, nil)
 			},
 			expectStatus: http.StatusOK,
 		},
 		{
 			name: "Usecase error",
 			mockFunc: func() {
-				mockUsecase.EXPECT().GetDroneDistance(gomock.Any(), estateID, gomock.Any()).Return(nil, errors.New("usecase error"))
+				mockUsecase.EXPECT().GetDroneDistance(gomock.Any(), estateID, gomock.Any(), gomock.Any()).Return(nil, errors.New("usecase error"))
 			},
 			expectStatus: http.StatusInternalServerError,
 		},
@@ -227,3 +236,684 @@ func TestServer_GetEstateIdDronePlan(t *testing.T) {
 		})
 	}
 }
+
+func TestServer_GetEstateIdDronePlan_WithDrone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	server := &Server{estateUsecase: mockUsecase}
+	e := echo.New()
+	estateID := uuid.New()
+	droneID := uuid.New()
+	flightTime := 95 * time.Second
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().GetDroneDistance(gomock.Any(), estateID, nil, &droneID).
+			Return(&domain.DroneDistance{Distance: 90, Rest: &domain.Plot{Row: 2, Col: 3}, FlightTime: &flightTime}, nil)
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(httptest.NewRequest(http.MethodGet, "/estate/"+estateID.String()+"/drone-plan", nil), rec)
+
+		assert.NoError(t, server.GetEstateIdDronePlan(ctx, estateID, generated.GetEstateIdDronePlanParams{DroneId: &droneID}))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"distance":90,"rest":{"x":3,"y":2},"flight_time":95}`, rec.Body.String())
+	})
+
+	t.Run("Drone can not fly over trees", func(t *testing.T) {
+		mockUsecase.EXPECT().GetDroneDistance(gomock.Any(), estateID, nil, &droneID).Return(nil, domain.ErrorDroneAltitudeExceeded)
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(httptest.NewRequest(http.MethodGet, "/estate/"+estateID.String()+"/drone-plan", nil), rec)
+
+		assert.NoError(t, server.GetEstateIdDronePlan(ctx, estateID, generated.GetEstateIdDronePlanParams{DroneId: &droneID}))
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+		assert.Contains(t, rec.Body.String(), "drone_altitude_exceeded")
+	})
+}
+
+func TestServer_GetEstateIdDronePlanProfile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	server := &Server{estateUsecase: mockUsecase}
+	e := echo.New()
+
+	estateID := uuid.New()
+	profile := domain.DroneAltitudeProfile([]domain.DroneRoute{
+		{Route: 1, Plot: domain.Plot{Row: 1, Col: 1}, Altitude: 1},
+		{Route: 2, Plot: domain.Plot{Row: 1, Col: 2}, Altitude: 6},
+	})
+
+	t.Run("Json", func(t *testing.T) {
+		mockUsecase.EXPECT().GetDroneAltitudeProfile(gomock.Any(), estateID).Return(&profile, nil)
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(httptest.NewRequest(http.MethodGet, "/estate/"+estateID.String()+"/drone-plan/profile", nil), rec)
+
+		assert.NoError(t, server.GetEstateIdDronePlanProfile(ctx, estateID, generated.GetEstateIdDronePlanProfileParams{}))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{
+			"horizontal_distance": 10,
+			"vertical_distance": 12,
+			"vertical_share": 0.54545456,
+			"horizontal_share": 0.45454547,
+			"points": [
+				{"distance": 0, "altitude": 0, "x": 1, "y": 1},
+				{"distance": 0, "altitude": 1, "x": 1, "y": 1},
+				{"distance": 10, "altitude": 6, "x": 2, "y": 1},
+				{"distance": 10, "altitude": 0, "x": 2, "y": 1}
+			],
+			"largest_climbs": [{"distance": 10, "altitude": 6, "height": 5, "x": 2, "y": 1}]
+		}`, rec.Body.String())
+	})
+
+	t.Run("Svg", func(t *testing.T) {
+		svg := generated.GetEstateIdDronePlanProfileParamsFormatSvg
+		mockUsecase.EXPECT().GetDroneAltitudeProfile(gomock.Any(), estateID).Return(&profile, nil)
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(httptest.NewRequest(http.MethodGet, "/estate/"+estateID.String()+"/drone-plan/profile?format=svg", nil), rec)
+
+		assert.NoError(t, server.GetEstateIdDronePlanProfile(ctx, estateID, generated.GetEstateIdDronePlanProfileParams{Format: &svg}))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "image/svg+xml", rec.Header().Get(echo.HeaderContentType))
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		mockUsecase.EXPECT().GetDroneAltitudeProfile(gomock.Any(), estateID).Return(nil, domain.ErrorEstatesNotFound)
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(httptest.NewRequest(http.MethodGet, "/estate/"+estateID.String()+"/drone-plan/profile", nil), rec)
+
+		assert.NoError(t, server.GetEstateIdDronePlanProfile(ctx, estateID, generated.GetEstateIdDronePlanProfileParams{}))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestServer_GetEstateIdDronePlanRender(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	server := &Server{estateUsecase: mockUsecase}
+	e := echo.New()
+
+	estateID := uuid.New()
+	plan := &domain.DronePlan{
+		Estate: domain.Estate{ID: estateID, Width: 1, Length: 2},
+		Routes: domain.DroneZigzagTraverse(1, 2),
+	}
+	png := generated.GetEstateIdDronePlanRenderParamsFormatPng
+	tests := []struct {
+		name              string
+		params            generated.GetEstateIdDronePlanRenderParams
+		mockFunc          func()
+		expectStatus      int
+		expectContentType string
+	}{
+		{
+			name: "Svg by default",
+			mockFunc: func() {
+				mockUsecase.EXPECT().GetDronePlan(gomock.Any(), estateID, nil, nil).Return(plan, nil)
+			},
+			expectStatus:      http.StatusOK,
+			expectContentType: "image/svg+xml",
+		},
+		{
+			name:   "Png",
+			params: generated.GetEstateIdDronePlanRenderParams{Format: &png},
+			mockFunc: func() {
+				mockUsecase.EXPECT().GetDronePlan(gomock.Any(), estateID, nil, nil).Return(plan, nil)
+			},
+			expectStatus:      http.StatusOK,
+			expectContentType: "image/png",
+		},
+		{
+			name: "Too large",
+			mockFunc: func() {
+				mockUsecase.EXPECT().GetDronePlan(gomock.Any(), estateID, nil, nil).Return(nil, domain.ErrorDronePlanTooLarge)
+			},
+			expectStatus:      http.StatusUnprocessableEntity,
+			expectContentType: "application/problem+json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/estate/"+estateID.String()+"/drone-plan/render", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			tt.mockFunc()
+			assert.NoError(t, server.GetEstateIdDronePlanRender(ctx, estateID, tt.params))
+			assert.Equal(t, tt.expectStatus, rec.Code)
+			assert.Equal(t, tt.expectContentType, rec.Header().Get(echo.HeaderContentType))
+		})
+	}
+}
+
+func TestServer_GetEstateIdEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	server := &Server{estateUsecase: mockUsecase}
+	e := echo.New()
+
+	estateID := uuid.New()
+	event := domain.NewEvent(domain.EventTreePlanted, uuid.New(), estateID, domain.TreeEventData{
+		TreeID: uuid.New(),
+		Plot:   domain.Plot{Row: 1, Col: 2},
+		Height: 10,
+	})
+	lastEventID := "last-id"
+
+	tests := []struct {
+		name         string
+		mockFunc     func()
+		expectStatus int
+		expectBody   []string
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				events := make(chan domain.Event, 1)
+				events <- event
+				close(events)
+				mockUsecase.EXPECT().SubscribeEstateEvents(gomock.Any(), estateID, lastEventID).Return((<-chan domain.Event)(events), nil)
+			},
+			expectStatus: http.StatusOK,
+			expectBody: []string{
+				"id: " + event.ID.String() + "\n",
+				"event: tree.planted\n",
+				`"x":2`,
+				`"y":1`,
+			},
+		},
+		{
+			name: "Estate not found",
+			mockFunc: func() {
+				mockUsecase.EXPECT().SubscribeEstateEvents(gomock.Any(), estateID, lastEventID).Return(nil, domain.ErrorEstatesNotFound)
+			},
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name: "Usecase error",
+			mockFunc: func() {
+				mockUsecase.EXPECT().SubscribeEstateEvents(gomock.Any(), estateID, lastEventID).Return(nil, errors.New("usecase error"))
+			},
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/estate/:id/events", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			ctx.SetPath("/estate/:id/events")
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(estateID.String())
+
+			tt.mockFunc()
+			assert.NoError(t, server.GetEstateIdEvents(ctx, estateID, generated.GetEstateIdEventsParams{LastEventID: &lastEventID}))
+			assert.Equal(t, tt.expectStatus, rec.Code)
+			for _, body := range tt.expectBody {
+				assert.Contains(t, rec.Body.String(), body)
+			}
+		})
+	}
+}
+
+func TestServer_GetEstateId(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	srv := &Server{
+		estateUsecase: mockUsecase,
+	}
+	e := echo.New()
+	estateID := uuid.New()
+
+	tests := []struct {
+		name         string
+		prepareMock  func()
+		expectStatus int
+		expectETag   string
+	}{
+		{
+			name: "Success",
+			prepareMock: func() {
+				mockUsecase.EXPECT().GetEstate(gomock.Any(), estateID).Return(&domain.Estate{ID: estateID, Width: 10, Length: 20, Version: 3}, nil)
+			},
+			expectStatus: http.StatusOK,
+			expectETag:   `"3"`,
+		},
+		{
+			name: "Estate not found",
+			prepareMock: func() {
+				mockUsecase.EXPECT().GetEstate(gomock.Any(), estateID).Return(nil, domain.ErrorEstatesNotFound)
+			},
+			expectStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/estate/"+estateID.String(), nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			tt.prepareMock()
+			err := srv.GetEstateId(ctx, estateID)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectStatus, rec.Code)
+			assert.Equal(t, tt.expectETag, rec.Header().Get(HeaderETag))
+		})
+	}
+}
+
+func TestServer_PatchEstateId(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	srv := &Server{
+		estateUsecase: mockUsecase,
+	}
+	e := echo.New()
+	estateID := uuid.New()
+	ifMatch := func(s string) *string { return &s }
+
+	tests := []struct {
+		name         string
+		ifMatch      *string
+		prepareMock  func()
+		expectStatus int
+		expectETag   string
+	}{
+		{
+			name:    "Success",
+			ifMatch: ifMatch(`"2"`),
+			prepareMock: func() {
+				mockUsecase.EXPECT().ResizeEstate(gomock.Any(), estateID, 5, 10, 2).Return(&domain.Estate{ID: estateID, Width: 5, Length: 10, Version: 3}, nil)
+			},
+			expectStatus: http.StatusOK,
+			expectETag:   `"3"`,
+		},
+		{
+			name:         "Missing If-Match",
+			ifMatch:      nil,
+			prepareMock:  func() {},
+			expectStatus: http.StatusPreconditionRequired,
+		},
+		{
+			name:         "Weak If-Match",
+			ifMatch:      ifMatch(`W/"2"`),
+			prepareMock:  func() {},
+			expectStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name:    "Version mismatch",
+			ifMatch: ifMatch(`"1"`),
+			prepareMock: func() {
+				mockUsecase.EXPECT().ResizeEstate(gomock.Any(), estateID, 5, 10, 1).Return(nil, domain.ErrorVersionMismatch)
+			},
+			expectStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name:    "Tree outside of new size",
+			ifMatch: ifMatch(`"2"`),
+			prepareMock: func() {
+				mockUsecase.EXPECT().ResizeEstate(gomock.Any(), estateID, 5, 10, 2).Return(nil, domain.ErrorTreePlotOutOfBound)
+			},
+			expectStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPatch, "/estate/"+estateID.String(), bytes.NewBufferString(`{"width": 5, "length": 10}`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			tt.prepareMock()
+			err := srv.PatchEstateId(ctx, estateID, generated.PatchEstateIdParams{IfMatch: tt.ifMatch})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectStatus, rec.Code)
+			assert.Equal(t, tt.expectETag, rec.Header().Get(HeaderETag))
+		})
+	}
+}
+
+func TestServer_GetEstateIdTreeTreeId(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	srv := &Server{
+		estateUsecase: mockUsecase,
+	}
+	e := echo.New()
+	estateID := uuid.New()
+	treeID := uuid.New()
+
+	tests := []struct {
+		name         string
+		prepareMock  func()
+		expectStatus int
+		expectETag   string
+	}{
+		{
+			name: "Success",
+			prepareMock: func() {
+				mockUsecase.EXPECT().GetTree(gomock.Any(), estateID, treeID).Return(&domain.Tree{ID: treeID, Plot: domain.Plot{Row: 1, Col: 2}, Height: 10, Version: 1}, nil)
+			},
+			expectStatus: http.StatusOK,
+			expectETag:   `"1"`,
+		},
+		{
+			name: "Tree not found",
+			prepareMock: func() {
+				mockUsecase.EXPECT().GetTree(gomock.Any(), estateID, treeID).Return(nil, domain.ErrorTreeNotFound)
+			},
+			expectStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/estate/"+estateID.String()+"/tree/"+treeID.String(), nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			tt.prepareMock()
+			err := srv.GetEstateIdTreeTreeId(ctx, estateID, treeID)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectStatus, rec.Code)
+			assert.Equal(t, tt.expectETag, rec.Header().Get(HeaderETag))
+		})
+	}
+}
+
+func TestServer_PatchEstateIdTreeTreeId(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	srv := &Server{
+		estateUsecase: mockUsecase,
+	}
+	e := echo.New()
+	estateID := uuid.New()
+	treeID := uuid.New()
+	ifMatch := func(s string) *string { return &s }
+
+	tests := []struct {
+		name         string
+		ifMatch      *string
+		prepareMock  func()
+		expectStatus int
+		expectETag   string
+	}{
+		{
+			name:    "Success",
+			ifMatch: ifMatch(`"1"`),
+			prepareMock: func() {
+				mockUsecase.EXPECT().UpdateTreeHeight(gomock.Any(), estateID, treeID, 20, 1).Return(&domain.Tree{ID: treeID, Plot: domain.Plot{Row: 1, Col: 2}, Height: 20, Version: 2}, nil)
+			},
+			expectStatus: http.StatusOK,
+			expectETag:   `"2"`,
+		},
+		{
+			name:         "Missing If-Match",
+			ifMatch:      nil,
+			prepareMock:  func() {},
+			expectStatus: http.StatusPreconditionRequired,
+		},
+		{
+			name:    "Version mismatch",
+			ifMatch: ifMatch(`"1"`),
+			prepareMock: func() {
+				mockUsecase.EXPECT().UpdateTreeHeight(gomock.Any(), estateID, treeID, 20, 1).Return(nil, domain.ErrorVersionMismatch)
+			},
+			expectStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name:    "Internal server error",
+			ifMatch: ifMatch(`"1"`),
+			prepareMock: func() {
+				mockUsecase.EXPECT().UpdateTreeHeight(gomock.Any(), estateID, treeID, 20, 1).Return(nil, errors.New("unexpected error"))
+			},
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPatch, "/estate/"+estateID.String()+"/tree/"+treeID.String(), bytes.NewBufferString(`{"height": 20}`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			tt.prepareMock()
+			err := srv.PatchEstateIdTreeTreeId(ctx, estateID, treeID, generated.PatchEstateIdTreeTreeIdParams{IfMatch: tt.ifMatch})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectStatus, rec.Code)
+			assert.Equal(t, tt.expectETag, rec.Header().Get(HeaderETag))
+		})
+	}
+}
+
+func TestServer_GetEstate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	srv := &Server{
+		estateUsecase: mockUsecase,
+	}
+	e := echo.New()
+	estateID := uuid.New()
+	sandboxID := uuid.New()
+	limit := 5
+	offset := 10
+
+	tests := []struct {
+		name         string
+		params       generated.GetEstateParams
+		prepareMock  func()
+		expectStatus int
+		expectBody   string
+	}{
+		{
+			name: "Default page",
+			prepareMock: func() {
+				mockUsecase.EXPECT().ListEstates(gomock.Any(), 100, 0).Return([]domain.Estate{
+					{ID: estateID, Width: 1, Length: 2},
+					{ID: sandboxID, Width: 1, Length: 2, Sandbox: &domain.Sandbox{SourceEstateID: &estateID}},
+				}, nil)
+			},
+			expectStatus: http.StatusOK,
+			expectBody: `{"estates":[{"id":"` + estateID.String() + `","length":2,"width":1,"draft":false},` +
+				`{"id":"` + sandboxID.String() + `","length":2,"width":1,"draft":true,"source_estate_id":"` + estateID.String() + `"}]}`,
+		},
+		{
+			name:   "Empty page",
+			params: generated.GetEstateParams{Limit: &limit, Offset: &offset},
+			prepareMock: func() {
+				mockUsecase.EXPECT().ListEstates(gomock.Any(), 5, 10).Return([]domain.Estate{}, nil)
+			},
+			expectStatus: http.StatusOK,
+			expectBody:   `{"estates":[]}`,
+		},
+		{
+			name: "Forbidden",
+			prepareMock: func() {
+				mockUsecase.EXPECT().ListEstates(gomock.Any(), 100, 0).Return(nil, domain.ErrorForbidden)
+			},
+			expectStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/estate", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			tt.prepareMock()
+			err := srv.GetEstate(ctx, tt.params)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectStatus, rec.Code)
+			if tt.expectBody != "" {
+				assert.JSONEq(t, tt.expectBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestServer_DeleteEstateId(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	srv := &Server{
+		estateUsecase: mockUsecase,
+	}
+	e := echo.New()
+	estateID := uuid.New()
+
+	tests := []struct {
+		name         string
+		prepareMock  func()
+		expectStatus int
+	}{
+		{
+			name: "Success",
+			prepareMock: func() {
+				mockUsecase.EXPECT().DeleteEstate(gomock.Any(), estateID).Return(nil)
+			},
+			expectStatus: http.StatusNoContent,
+		},
+		{
+			name: "Estate not found",
+			prepareMock: func() {
+				mockUsecase.EXPECT().DeleteEstate(gomock.Any(), estateID).Return(domain.ErrorEstatesNotFound)
+			},
+			expectStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodDelete, "/estate/"+estateID.String(), nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			tt.prepareMock()
+			err := srv.DeleteEstateId(ctx, estateID)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectStatus, rec.Code)
+		})
+	}
+}
+
+func TestServer_DeleteEstateIdTreeTreeId(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	srv := &Server{
+		estateUsecase: mockUsecase,
+	}
+	e := echo.New()
+	estateID := uuid.New()
+	treeID := uuid.New()
+
+	tests := []struct {
+		name         string
+		prepareMock  func()
+		expectStatus int
+	}{
+		{
+			name: "Success",
+			prepareMock: func() {
+				mockUsecase.EXPECT().DeleteTree(gomock.Any(), estateID, treeID).Return(nil)
+			},
+			expectStatus: http.StatusNoContent,
+		},
+		{
+			name: "Tree not found",
+			prepareMock: func() {
+				mockUsecase.EXPECT().DeleteTree(gomock.Any(), estateID, treeID).Return(domain.ErrorTreeNotFound)
+			},
+			expectStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodDelete, "/estate/"+estateID.String()+"/tree/"+treeID.String(), nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			tt.prepareMock()
+			err := srv.DeleteEstateIdTreeTreeId(ctx, estateID, treeID)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectStatus, rec.Code)
+		})
+	}
+}
+
+func TestServer_GetEstateIdTree(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	srv := &Server{
+		estateUsecase: mockUsecase,
+	}
+	e := echo.New()
+	estateID := uuid.New()
+	treeID := uuid.New()
+
+	tests := []struct {
+		name         string
+		prepareMock  func()
+		expectStatus int
+		expectBody   string
+	}{
+		{
+			name: "Success",
+			prepareMock: func() {
+				mockUsecase.EXPECT().ListTrees(gomock.Any(), estateID).Return([]domain.Tree{{ID: treeID, Plot: domain.Plot{Row: 1, Col: 2}, Height: 10, Version: 1}}, nil)
+			},
+			expectStatus: http.StatusOK,
+			expectBody:   `{"trees":[{"id":"` + treeID.String() + `","x":2,"y":1,"height":10}]}`,
+		},
+		{
+			name: "Estate not found",
+			prepareMock: func() {
+				mockUsecase.EXPECT().ListTrees(gomock.Any(), estateID).Return(nil, domain.ErrorEstatesNotFound)
+			},
+			expectStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/estate/"+estateID.String()+"/tree", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			tt.prepareMock()
+			err := srv.GetEstateIdTree(ctx, estateID)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectStatus, rec.Code)
+			if tt.expectBody != "" {
+				assert.JSONEq(t, tt.expectBody, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const HeaderETag = "ETag"
+
+// formatETag return strong ETag of a resource version
+func formatETag(version int) string {
+	return fmt.Sprintf(`"%d"`, version)
+}
+
+// parseIfMatch return the version of a strong ETag sent as If-Match. Weak, wildcard and
+// malformed ETags never match since updates must be based on one known version
+func parseIfMatch(ifMatch string) (int, bool) {
+	tag := strings.TrimSpace(ifMatch)
+	if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+		return 0, false
+	}
+
+	version, err := strconv.Atoi(tag[1 : len(tag)-1])
+	if err != nil || version < 1 {
+		return 0, false
+	}
+	return version, true
+}
@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseIfMatch(t *testing.T) {
+	tests := []struct {
+		ifMatch string
+		version int
+		ok      bool
+	}{
+		{ifMatch: `"3"`, version: 3, ok: true},
+		{ifMatch: ` "12" `, version: 12, ok: true},
+		{ifMatch: `W/"3"`},
+		{ifMatch: `*`},
+		{ifMatch: `3`},
+		{ifMatch: `"0"`},
+		{ifMatch: `"abc"`},
+		{ifMatch: `"1", "2"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ifMatch, func(t *testing.T) {
+			version, ok := parseIfMatch(tt.ifMatch)
+			assert.Equal(t, tt.version, version)
+			assert.Equal(t, tt.ok, ok)
+		})
+	}
+
+	version, ok := parseIfMatch(formatETag(7))
+	assert.True(t, ok)
+	assert.Equal(t, 7, version)
+}
@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	PathLiveness  = "/healthz"
+	PathReadiness = "/readyz"
+)
+
+type healthResponse struct {
+	Status string `json:"status"`
+}
+
+// Health answer liveness and readiness probes. It must be registered with echo Pre so probes are answered before routing,
+// skipping authentication, validation, logging and metrics of the api.
+// Readiness fails when readinessChecker does not answer within timeout
+func Health(readinessChecker interfaces.ReadinessChecker, timeout time.Duration) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			switch ctx.Request().URL.Path {
+			case PathLiveness:
+				return ctx.JSON(http.StatusOK, healthResponse{Status: "ok"})
+			case PathReadiness:
+				checkCtx, cancel := context.WithTimeout(ctx.Request().Context(), timeout)
+				defer cancel()
+
+				err := readinessChecker.CheckReadiness(checkCtx)
+				if err != nil {
+					slog.Warn("not ready", "message", err.Error())
+					return ctx.JSON(http.StatusServiceUnavailable, healthResponse{Status: "unavailable"})
+				}
+				return ctx.JSON(http.StatusOK, healthResponse{Status: "ok"})
+			}
+			return next(ctx)
+		}
+	}
+}
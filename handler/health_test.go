@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHealth(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChecker := interfaces.NewMockReadinessChecker(ctrl)
+	e := echo.New()
+	e.Pre(Health(mockChecker, 50*time.Millisecond))
+	// probes must not reach middlewares of the api such as authentication
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			return respondProblem(ctx, problem{http.StatusUnauthorized, "unauthenticated", "Missing or invalid API key"}, "", nil)
+		}
+	})
+	e.GET("/estate", func(ctx echo.Context) error { return ctx.NoContent(http.StatusOK) })
+
+	tests := []struct {
+		name         string
+		path         string
+		mockFunc     func()
+		expectStatus int
+		expectBody   string
+	}{
+		{
+			name:         "Liveness",
+			path:         PathLiveness,
+			mockFunc:     func() {},
+			expectStatus: http.StatusOK,
+			expectBody:   `{"status":"ok"}`,
+		},
+		{
+			name: "Ready",
+			path: PathReadiness,
+			mockFunc: func() {
+				mockChecker.EXPECT().CheckReadiness(gomock.Any()).Return(nil)
+			},
+			expectStatus: http.StatusOK,
+			expectBody:   `{"status":"ok"}`,
+		},
+		{
+			name: "Not ready",
+			path: PathReadiness,
+			mockFunc: func() {
+				mockChecker.EXPECT().CheckReadiness(gomock.Any()).Return(errors.New("ping database: connection refused"))
+			},
+			expectStatus: http.StatusServiceUnavailable,
+			expectBody:   `{"status":"unavailable"}`,
+		},
+		{
+			name: "Readiness timeout",
+			path: PathReadiness,
+			mockFunc: func() {
+				mockChecker.EXPECT().CheckReadiness(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
+					<-ctx.Done()
+					return ctx.Err()
+				})
+			},
+			expectStatus: http.StatusServiceUnavailable,
+			expectBody:   `{"status":"unavailable"}`,
+		},
+		{
+			name:         "Api request",
+			path:         "/estate",
+			mockFunc:     func() {},
+			expectStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			assert.Equal(t, tt.expectStatus, rec.Code)
+			if tt.expectBody != "" {
+				assert.JSONEq(t, tt.expectBody, rec.Body.String())
+			}
+		})
+	}
+}
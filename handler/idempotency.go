@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/labstack/echo/v4"
+)
+
+const HeaderIdempotencyKey = "Idempotency-Key"
+const HeaderIdempotentReplayed = "Idempotent-Replayed"
+
+// idempotentRoutes are the routes whose retries would otherwise create duplicates or fail
+var idempotentRoutes = map[string]bool{
+	http.MethodPost + " /estate":             true,
+	http.MethodPost + " /estate/:id/tree":    true,
+	http.MethodPost + " /estate/:id/clone":   true,
+	http.MethodPost + " /estate/:id/mission": true,
+	http.MethodPost + " /estate/import":      true,
+}
+
+// Idempotency replay the original response for requests retried with the same Idempotency-Key.
+// It must run after APIKeyAuth since keys are scoped to the caller tenant, and after request validation
+// so invalid requests do not hold the key
+func Idempotency(idempotencyUsecase interfaces.IdempotencyUsecase) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			req := ctx.Request()
+			key := req.Header.Get(HeaderIdempotencyKey)
+			if key == "" || !idempotentRoutes[req.Method+" "+ctx.Path()] {
+				return next(ctx)
+			}
+
+			body, err := io.ReadAll(req.Body)
+			if isRequestTooLarge(err) {
+				return respondProblem(ctx, problemRequestTooLarge, "", nil)
+			}
+			if err != nil {
+				return respondProblem(ctx, problemInvalidRequest, "", nil)
+			}
+			req.Body = io.NopCloser(bytes.NewReader(body))
+
+			record, err := idempotencyUsecase.Begin(req.Context(), key, domain.HashIdempotencyRequest(req.Method, req.URL.Path, req.URL.RawQuery, body))
+			if err != nil {
+				return respondError(ctx, err)
+			}
+
+			if record != nil {
+				ctx.Response().Header().Set(HeaderIdempotentReplayed, "true")
+				// only successful responses and problems are recorded, server errors release the key
+				contentType := echo.MIMEApplicationJSON
+				if record.StatusCode >= http.StatusBadRequest {
+					contentType = MIMEApplicationProblemJSON
+				}
+				return ctx.Blob(record.StatusCode, contentType, record.ResponseBody)
+			}
+
+			recorder := &responseRecorder{ResponseWriter: ctx.Response().Writer}
+			ctx.Response().Writer = recorder
+
+			err = next(ctx)
+
+			// errors returned to echo are rendered later, release the key by recording them as server error
+			statusCode := ctx.Response().Status
+			if err != nil {
+				statusCode = http.StatusInternalServerError
+			}
+
+			// the write is already done when the client disconnects, so the key must still be completed for its retry
+			completeErr := idempotencyUsecase.Complete(context.WithoutCancel(req.Context()), key, statusCode, recorder.body.Bytes())
+			if completeErr != nil {
+				slog.Warn("failed to complete idempotency key", "message", completeErr.Error())
+			}
+
+			return err
+		}
+	}
+}
+
+// responseRecorder copy the response body while it is written to the client
+type responseRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
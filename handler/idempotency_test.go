@@ -0,0 +1,171 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestIdempotency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockIdempotencyUsecase(ctrl)
+	e := echo.New()
+	body := []byte(`{"width": 10, "length": 20}`)
+	hash := domain.HashIdempotencyRequest(http.MethodPost, "/estate", "", body)
+
+	tests := []struct {
+		name          string
+		path          string
+		query         string
+		key           string
+		mockFunc      func()
+		expectStatus  int
+		expectBody    string
+		expectHandled bool
+		expectReplay  bool
+		disconnect    bool
+	}{
+		{
+			name: "First request is recorded",
+			path: "/estate",
+			key:  "key",
+			mockFunc: func() {
+				mockUsecase.EXPECT().Begin(gomock.Any(), "key", hash).Return(nil, nil)
+				mockUsecase.EXPECT().Complete(gomock.Any(), "key", http.StatusCreated, []byte(`{"id":"estate"}`+"\n")).Return(nil)
+			},
+			expectStatus:  http.StatusCreated,
+			expectBody:    `{"id":"estate"}`,
+			expectHandled: true,
+		},
+		{
+			name: "Client disconnected before completed",
+			path: "/estate",
+			key:  "key",
+			mockFunc: func() {
+				mockUsecase.EXPECT().Begin(gomock.Any(), "key", hash).Return(nil, nil)
+				mockUsecase.EXPECT().Complete(gomock.Any(), "key", http.StatusCreated, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, _ string, _ int, _ []byte) error {
+						assert.NoError(t, ctx.Err())
+						return nil
+					})
+			},
+			expectStatus:  http.StatusCreated,
+			expectHandled: true,
+			disconnect:    true,
+		},
+		{
+			name: "Replay get original response",
+			path: "/estate",
+			key:  "key",
+			mockFunc: func() {
+				mockUsecase.EXPECT().Begin(gomock.Any(), "key", hash).Return(&domain.IdempotencyRecord{StatusCode: http.StatusCreated, ResponseBody: []byte(`{"id":"original"}`)}, nil)
+			},
+			expectStatus: http.StatusCreated,
+			expectBody:   `{"id":"original"}`,
+			expectReplay: true,
+		},
+		{
+			name: "Key reused with different body",
+			path: "/estate",
+			key:  "key",
+			mockFunc: func() {
+				mockUsecase.EXPECT().Begin(gomock.Any(), "key", hash).Return(nil, domain.ErrorIdempotencyKeyReused)
+			},
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Original request in progress",
+			path: "/estate",
+			key:  "key",
+			mockFunc: func() {
+				mockUsecase.EXPECT().Begin(gomock.Any(), "key", hash).Return(nil, domain.ErrorIdempotencyRequestInProgress)
+			},
+			expectStatus: http.StatusConflict,
+		},
+		{
+			name: "Internal server error",
+			path: "/estate",
+			key:  "key",
+			mockFunc: func() {
+				mockUsecase.EXPECT().Begin(gomock.Any(), "key", hash).Return(nil, errors.New("unexpected error"))
+			},
+			expectStatus: http.StatusInternalServerError,
+		},
+		{
+			name:  "Query is part of the request",
+			path:  "/estate/import",
+			query: "keep_ids=true",
+			key:   "key",
+			mockFunc: func() {
+				importHash := domain.HashIdempotencyRequest(http.MethodPost, "/estate/import", "keep_ids=true", body)
+				assert.NotEqual(t, domain.HashIdempotencyRequest(http.MethodPost, "/estate/import", "keep_ids=false", body), importHash)
+				mockUsecase.EXPECT().Begin(gomock.Any(), "key", importHash).Return(nil, domain.ErrorIdempotencyKeyReused)
+			},
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:          "Without key",
+			path:          "/estate",
+			key:           "",
+			mockFunc:      func() {},
+			expectStatus:  http.StatusCreated,
+			expectHandled: true,
+		},
+		{
+			name:          "Route without idempotency",
+			path:          "/webhooks",
+			key:           "key",
+			mockFunc:      func() {},
+			expectStatus:  http.StatusCreated,
+			expectHandled: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reqCtx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			target := tt.path
+			if tt.query != "" {
+				target += "?" + tt.query
+			}
+			req := httptest.NewRequest(http.MethodPost, target, bytes.NewReader(body)).WithContext(reqCtx)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.Header.Set(HeaderIdempotencyKey, tt.key)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetPath(tt.path)
+
+			tt.mockFunc()
+			handled := false
+			next := func(ctx echo.Context) error {
+				handled = true
+				if tt.disconnect {
+					cancel()
+				}
+				return ctx.JSON(http.StatusCreated, map[string]string{"id": "estate"})
+			}
+
+			assert.NoError(t, Idempotency(mockUsecase)(next)(ctx))
+			assert.Equal(t, tt.expectStatus, rec.Code)
+			assert.Equal(t, tt.expectHandled, handled)
+			if tt.expectBody != "" {
+				assert.JSONEq(t, tt.expectBody, rec.Body.String())
+			}
+			if tt.expectReplay {
+				assert.Equal(t, "true", rec.Header().Get(HeaderIdempotentReplayed))
+			}
+		})
+	}
+}
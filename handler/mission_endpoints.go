@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/generated"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// Create a drone mission from the current drone plan
+// (POST /estate/{id}/mission)
+// Idempotency-Key is handled by the Idempotency middleware
+func (s *Server) PostEstateIdMission(ctx echo.Context, id uuid.UUID, _ generated.PostEstateIdMissionParams) error {
+	var req generated.CreateMissionRequest
+
+	err := ctx.Bind(&req)
+	if err != nil {
+		return respondProblem(ctx, problemInvalidRequest, "", nil)
+	}
+
+	var drone, pilot string
+	if req.Drone != nil {
+		drone = *req.Drone
+	}
+	if req.Pilot != nil {
+		pilot = *req.Pilot
+	}
+
+	mission, err := s.estateUsecase.CreateMission(ctx.Request().Context(), id, req.MaxDistance, req.DroneId, drone, pilot)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusCreated, toMission(mission))
+}
+
+// List drone missions of an estate
+// (GET /estate/{id}/mission)
+func (s *Server) GetEstateIdMission(ctx echo.Context, id uuid.UUID, params generated.GetEstateIdMissionParams) error {
+	limit := defaultListEstatesLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	offset := 0
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+	var status *domain.MissionStatus
+	if params.Status != nil {
+		value := domain.MissionStatus(*params.Status)
+		status = &value
+	}
+
+	missions, err := s.estateUsecase.ListMissions(ctx.Request().Context(), id, status, limit, offset)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	res := generated.ListMissionsResponse{Missions: make([]generated.Mission, 0, len(missions))}
+	for i := range missions {
+		res.Missions = append(res.Missions, toMission(&missions[i]))
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// Get a drone mission with its waypoints
+// (GET /estate/{id}/mission/{mission_id})
+func (s *Server) GetEstateIdMissionMissionId(ctx echo.Context, id uuid.UUID, missionId uuid.UUID) error {
+	mission, err := s.estateUsecase.GetMission(ctx.Request().Context(), id, missionId)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, toMission(mission))
+}
+
+// Assign a drone mission or change its status
+// (PATCH /estate/{id}/mission/{mission_id})
+func (s *Server) PatchEstateIdMissionMissionId(ctx echo.Context, id uuid.UUID, missionId uuid.UUID) error {
+	var req generated.UpdateMissionRequest
+
+	err := ctx.Bind(&req)
+	if err != nil {
+		return respondProblem(ctx, problemInvalidRequest, "", nil)
+	}
+
+	update := domain.MissionUpdate{Drone: req.Drone, Pilot: req.Pilot}
+	if req.Status != nil {
+		status := domain.MissionStatus(*req.Status)
+		update.Status = &status
+	}
+
+	mission, err := s.estateUsecase.UpdateMission(ctx.Request().Context(), id, missionId, update)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, toMission(mission))
+}
+
+// toMission convert mission into its response, waypoints are left out when they were not loaded
+func toMission(mission *domain.Mission) generated.Mission {
+	res := generated.Mission{
+		Id:          mission.ID,
+		EstateId:    mission.EstateID,
+		Status:      generated.MissionStatus(mission.Status),
+		MaxDistance: mission.MaxDistance,
+		Distance:    mission.Distance,
+		DroneId:     mission.DroneID,
+		Drone:       mission.Drone,
+		Pilot:       mission.Pilot,
+		CreatedAt:   mission.CreatedAt,
+		StartedAt:   mission.StartedAt,
+		FinishedAt:  mission.FinishedAt,
+	}
+
+	if mission.DroneProfile != nil {
+		res.DroneProfile = &generated.MissionDroneProfile{
+			MaxRange:    mission.DroneProfile.MaxRange,
+			MaxAltitude: mission.DroneProfile.MaxAltitude,
+			CruiseSpeed: mission.DroneProfile.Speed.Cruise,
+			ClimbSpeed:  mission.DroneProfile.Speed.Climb,
+		}
+	}
+
+	if mission.Waypoints != nil {
+		waypoints := make([]generated.MissionWaypoint, 0, len(mission.Waypoints))
+		for _, waypoint := range mission.Waypoints {
+			waypoints = append(waypoints, generated.MissionWaypoint{
+				Sequence: waypoint.Sequence,
+				X:        waypoint.Plot.Col,
+				Y:        waypoint.Plot.Row,
+				Altitude: waypoint.Altitude,
+				Distance: waypoint.Distance,
+			})
+		}
+		res.Waypoints = &waypoints
+	}
+	return res
+}
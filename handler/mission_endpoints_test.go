@@ -0,0 +1,336 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/SawitProRecruitment/EstateService/generated"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestServer_PostEstateIdMission(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	srv := &Server{estateUsecase: mockUsecase}
+	e := echo.New()
+	estateID := uuid.New()
+	maxDistance := 20
+	mission := &domain.Mission{
+		ID:          uuid.New(),
+		EstateID:    estateID,
+		Status:      domain.MissionPlanned,
+		MaxDistance: &maxDistance,
+		Distance:    12,
+		Waypoints: []domain.DroneWaypoint{
+			{Sequence: 1, Plot: domain.Plot{Row: 1, Col: 1}, Altitude: 1, Distance: 1},
+			{Sequence: 2, Plot: domain.Plot{Row: 1, Col: 2}, Altitude: 1, Distance: 11},
+		},
+		Drone:     "drone-7",
+		CreatedAt: time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name         string
+		requestBody  []byte
+		mockFunc     func()
+		expectStatus int
+		expectCode   string
+		expectBody   string
+	}{
+		{
+			name:        "Success",
+			requestBody: []byte(`{"max_distance":20,"drone":"drone-7"}`),
+			mockFunc: func() {
+				mockUsecase.EXPECT().CreateMission(gomock.Any(), estateID, &maxDistance, nil, "drone-7", "").Return(mission, nil)
+			},
+			expectStatus: http.StatusCreated,
+			expectBody: `{
+				"id":"` + mission.ID.String() + `","estate_id":"` + estateID.String() + `","status":"planned",
+				"max_distance":20,"distance":12,"drone":"drone-7","pilot":"","created_at":"2024-03-01T08:00:00Z",
+				"waypoints":[
+					{"sequence":1,"x":1,"y":1,"altitude":1,"distance":1},
+					{"sequence":2,"x":2,"y":1,"altitude":1,"distance":11}
+				]
+			}`,
+		},
+		{
+			name:        "Estate not found",
+			requestBody: []byte(`{}`),
+			mockFunc: func() {
+				mockUsecase.EXPECT().CreateMission(gomock.Any(), estateID, nil, nil, "", "").Return(nil, domain.ErrorEstatesNotFound)
+			},
+			expectStatus: http.StatusNotFound,
+			expectCode:   "estate_not_found",
+		},
+		{
+			name:         "Invalid body",
+			requestBody:  []byte(`{"max_distance":"far"}`),
+			mockFunc:     func() {},
+			expectStatus: http.StatusBadRequest,
+			expectCode:   "invalid_request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/estate/"+estateID.String()+"/mission", bytes.NewReader(tt.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			tt.mockFunc()
+			assert.NoError(t, srv.PostEstateIdMission(ctx, estateID, generated.PostEstateIdMissionParams{}))
+			assert.Equal(t, tt.expectStatus, rec.Code)
+			if tt.expectCode != "" {
+				var p generated.Problem
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+				assert.Equal(t, tt.expectCode, p.Code)
+			}
+			if tt.expectBody != "" {
+				assert.JSONEq(t, tt.expectBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestServer_GetEstateIdMission(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	srv := &Server{estateUsecase: mockUsecase}
+	e := echo.New()
+	estateID := uuid.New()
+	startedAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	droneID := uuid.New()
+	mission := domain.Mission{
+		ID:           uuid.New(),
+		EstateID:     estateID,
+		Status:       domain.MissionInProgress,
+		Distance:     40,
+		DroneID:      &droneID,
+		DroneProfile: &domain.DroneProfile{MaxRange: 2000, MaxAltitude: 30, Speed: domain.DroneSpeed{Cruise: 10, Climb: 4}},
+		Drone:        "drone-7",
+		Pilot:        "Budi",
+		CreatedAt:    time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC),
+		StartedAt:    &startedAt,
+	}
+	inProgress := generated.InProgress
+	status := domain.MissionInProgress
+	limit := 10
+
+	tests := []struct {
+		name         string
+		params       generated.GetEstateIdMissionParams
+		mockFunc     func()
+		expectStatus int
+		expectCode   string
+		expectBody   string
+	}{
+		{
+			name:   "Success",
+			params: generated.GetEstateIdMissionParams{Status: &inProgress, Limit: &limit},
+			mockFunc: func() {
+				mockUsecase.EXPECT().ListMissions(gomock.Any(), estateID, &status, 10, 0).Return([]domain.Mission{mission}, nil)
+			},
+			expectStatus: http.StatusOK,
+			expectBody: `{"missions":[{
+				"id":"` + mission.ID.String() + `","estate_id":"` + estateID.String() + `","status":"in_progress",
+				"distance":40,"drone_id":"` + droneID.String() + `","drone":"drone-7","pilot":"Budi",
+				"drone_profile":{"max_range":2000,"max_altitude":30,"cruise_speed":10,"climb_speed":4},
+				"created_at":"2024-03-01T08:00:00Z","started_at":"2024-03-01T09:00:00Z"
+			}]}`,
+		},
+		{
+			name: "Estate not found",
+			mockFunc: func() {
+				mockUsecase.EXPECT().ListMissions(gomock.Any(), estateID, nil, defaultListEstatesLimit, 0).Return(nil, domain.ErrorEstatesNotFound)
+			},
+			expectStatus: http.StatusNotFound,
+			expectCode:   "estate_not_found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/estate/"+estateID.String()+"/mission", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			tt.mockFunc()
+			assert.NoError(t, srv.GetEstateIdMission(ctx, estateID, tt.params))
+			assert.Equal(t, tt.expectStatus, rec.Code)
+			if tt.expectCode != "" {
+				var p generated.Problem
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+				assert.Equal(t, tt.expectCode, p.Code)
+			}
+			if tt.expectBody != "" {
+				assert.JSONEq(t, tt.expectBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestServer_GetEstateIdMissionMissionId(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	srv := &Server{estateUsecase: mockUsecase}
+	e := echo.New()
+	estateID := uuid.New()
+	missionID := uuid.New()
+
+	tests := []struct {
+		name         string
+		mockFunc     func()
+		expectStatus int
+		expectCode   string
+		expectBody   string
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				mockUsecase.EXPECT().GetMission(gomock.Any(), estateID, missionID).Return(&domain.Mission{
+					ID:        missionID,
+					EstateID:  estateID,
+					Status:    domain.MissionPlanned,
+					Distance:  2,
+					Waypoints: []domain.DroneWaypoint{{Sequence: 1, Plot: domain.Plot{Row: 1, Col: 1}, Altitude: 1, Distance: 1}},
+					CreatedAt: time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC),
+				}, nil)
+			},
+			expectStatus: http.StatusOK,
+			expectBody: `{
+				"id":"` + missionID.String() + `","estate_id":"` + estateID.String() + `","status":"planned",
+				"distance":2,"drone":"","pilot":"","created_at":"2024-03-01T08:00:00Z",
+				"waypoints":[{"sequence":1,"x":1,"y":1,"altitude":1,"distance":1}]
+			}`,
+		},
+		{
+			name: "Not found",
+			mockFunc: func() {
+				mockUsecase.EXPECT().GetMission(gomock.Any(), estateID, missionID).Return(nil, domain.ErrorMissionNotFound)
+			},
+			expectStatus: http.StatusNotFound,
+			expectCode:   "mission_not_found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/estate/"+estateID.String()+"/mission/"+missionID.String(), nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			tt.mockFunc()
+			assert.NoError(t, srv.GetEstateIdMissionMissionId(ctx, estateID, missionID))
+			assert.Equal(t, tt.expectStatus, rec.Code)
+			if tt.expectCode != "" {
+				var p generated.Problem
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+				assert.Equal(t, tt.expectCode, p.Code)
+			}
+			if tt.expectBody != "" {
+				assert.JSONEq(t, tt.expectBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestServer_PatchEstateIdMissionMissionId(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	srv := &Server{estateUsecase: mockUsecase}
+	e := echo.New()
+	estateID := uuid.New()
+	missionID := uuid.New()
+	completed := domain.MissionCompleted
+	pilot := "Budi"
+	finishedAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		requestBody  []byte
+		mockFunc     func()
+		expectStatus int
+		expectCode   string
+		expectBody   string
+	}{
+		{
+			name:        "Complete",
+			requestBody: []byte(`{"status":"completed"}`),
+			mockFunc: func() {
+				mockUsecase.EXPECT().UpdateMission(gomock.Any(), estateID, missionID, domain.MissionUpdate{Status: &completed}).
+					Return(&domain.Mission{ID: missionID, EstateID: estateID, Status: domain.MissionCompleted, FinishedAt: &finishedAt}, nil)
+			},
+			expectStatus: http.StatusOK,
+			expectBody: `{
+				"id":"` + missionID.String() + `","estate_id":"` + estateID.String() + `","status":"completed",
+				"distance":0,"drone":"","pilot":"","created_at":"0001-01-01T00:00:00Z","finished_at":"2024-03-01T10:00:00Z"
+			}`,
+		},
+		{
+			name:        "Reassign started mission",
+			requestBody: []byte(`{"pilot":"Budi"}`),
+			mockFunc: func() {
+				mockUsecase.EXPECT().UpdateMission(gomock.Any(), estateID, missionID, domain.MissionUpdate{Pilot: &pilot}).
+					Return(nil, domain.ErrorMissionNotPlanned)
+			},
+			expectStatus: http.StatusConflict,
+			expectCode:   "mission_not_planned",
+		},
+		{
+			name:        "Invalid transition",
+			requestBody: []byte(`{"status":"completed"}`),
+			mockFunc: func() {
+				mockUsecase.EXPECT().UpdateMission(gomock.Any(), estateID, missionID, gomock.Any()).
+					Return(nil, domain.ErrorMissionInvalidTransition)
+			},
+			expectStatus: http.StatusConflict,
+			expectCode:   "mission_invalid_transition",
+		},
+		{
+			name:         "Invalid body",
+			requestBody:  []byte(`{"status":1}`),
+			mockFunc:     func() {},
+			expectStatus: http.StatusBadRequest,
+			expectCode:   "invalid_request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPatch, "/estate/"+estateID.String()+"/mission/"+missionID.String(), bytes.NewReader(tt.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			tt.mockFunc()
+			assert.NoError(t, srv.PatchEstateIdMissionMissionId(ctx, estateID, missionID))
+			assert.Equal(t, tt.expectStatus, rec.Code)
+			if tt.expectCode != "" {
+				var p generated.Problem
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+				assert.Equal(t, tt.expectCode, p.Code)
+			}
+			if tt.expectBody != "" {
+				assert.JSONEq(t, tt.expectBody, rec.Body.String())
+			}
+		})
+	}
+}
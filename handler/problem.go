@@ -0,0 +1,243 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/generated"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/labstack/echo/v4"
+)
+
+const MIMEApplicationProblemJSON = "application/problem+json"
+
+// problemTypePrefix prefix the code into the problem type uri
+const problemTypePrefix = "urn:estate-service:problem:"
+
+// problem is one kind of error of the api, Code is part of the api contract and must never change once released
+type problem struct {
+	Status int
+	Code   string
+	Title  string
+}
+
+var (
+	problemInvalidRequest       = problem{http.StatusBadRequest, "invalid_request", "Invalid request"}
+	problemValidationFailed     = problem{http.StatusBadRequest, "validation_failed", "Request validation failed"}
+	problemRouteNotFound        = problem{http.StatusNotFound, "route_not_found", "Route not found"}
+	problemMethodNotAllowed     = problem{http.StatusMethodNotAllowed, "method_not_allowed", "Method not allowed"}
+	problemRequestTooLarge      = problem{http.StatusRequestEntityTooLarge, "request_too_large", "Request body too large"}
+	problemPreconditionRequired = problem{http.StatusPreconditionRequired, "precondition_required", "If-Match header is required"}
+	problemFeatureDisabled      = problem{http.StatusNotFound, "feature_disabled", "Feature is disabled"}
+	problemInternal             = problem{http.StatusInternalServerError, "internal_error", "Internal server error"}
+)
+
+// domainProblems map domain errors into problems, the first matching error wins
+var domainProblems = []struct {
+	err     error
+	problem problem
+}{
+	{domain.ErrorUnauthenticated, problem{http.StatusUnauthorized, "unauthenticated", "Missing or invalid API key"}},
+	{domain.ErrorForbidden, problem{http.StatusForbidden, "forbidden", "API key is not allowed to perform this action"}},
+	{domain.ErrorInvalidRole, problem{http.StatusBadRequest, "invalid_role", "Invalid role"}},
+	{domain.ErrorAPIKeyNotFound, problem{http.StatusNotFound, "api_key_not_found", "API key not found"}},
+	{domain.ErrorEstatesNotFound, problem{http.StatusNotFound, "estate_not_found", "Estate not found"}},
+	{domain.ErrorTreeNotFound, problem{http.StatusNotFound, "tree_not_found", "Tree not found"}},
+	{domain.ErrorTreeAlreadyExists, problem{http.StatusBadRequest, "tree_already_exists", "Tree already exists"}},
+	{domain.ErrorTreePlotOutOfBound, problem{http.StatusBadRequest, "tree_plot_out_of_bound", "Tree plot out of bound"}},
+	{domain.ErrorSnapshotVersionUnsupported, problem{http.StatusBadRequest, "snapshot_version_unsupported", "Unsupported snapshot version"}},
+	{domain.ErrorSnapshotInvalid, problem{http.StatusBadRequest, "snapshot_invalid", "Invalid snapshot"}},
+	{domain.ErrorSnapshotConflict, problem{http.StatusConflict, "snapshot_conflict", "Snapshot estate or tree already exists"}},
+	{domain.ErrorEstateNotSandbox, problem{http.StatusBadRequest, "estate_not_sandbox", "Estate is not a sandbox"}},
+	{domain.ErrorSandboxStale, problem{http.StatusConflict, "sandbox_stale", "Source estate changed since the sandbox was cloned"}},
+	{domain.ErrorMissionNotFound, problem{http.StatusNotFound, "mission_not_found", "Mission not found"}},
+	{domain.ErrorMissionInvalidStatus, problem{http.StatusBadRequest, "mission_invalid_status", "Invalid mission status"}},
+	{domain.ErrorMissionInvalidTransition, problem{http.StatusConflict, "mission_invalid_transition", "Mission can not move to the requested status"}},
+	{domain.ErrorMissionNotPlanned, problem{http.StatusConflict, "mission_not_planned", "Mission is no longer planned"}},
+	{domain.ErrorMissionUnassigned, problem{http.StatusConflict, "mission_unassigned", "Mission has no drone or pilot"}},
+	{domain.ErrorMissionFleetDrone, problem{http.StatusConflict, "mission_fleet_drone", "Mission drone comes from the fleet"}},
+	{domain.ErrorMissionNotFlown, problem{http.StatusConflict, "mission_not_flown", "Mission has not started"}},
+	{domain.ErrorTelemetryInvalid, problem{http.StatusBadRequest, "telemetry_invalid", "Invalid telemetry"}},
+	{domain.ErrorTelemetryNotFound, problem{http.StatusNotFound, "telemetry_not_found", "No telemetry recorded for the mission"}},
+	{domain.ErrorDroneNotFound, problem{http.StatusNotFound, "drone_not_found", "Drone not found"}},
+	{domain.ErrorDroneInvalid, problem{http.StatusBadRequest, "drone_invalid", "Invalid drone"}},
+	{domain.ErrorDroneInvalidStatus, problem{http.StatusBadRequest, "drone_invalid_status", "Invalid drone status"}},
+	{domain.ErrorDroneRetired, problem{http.StatusConflict, "drone_retired", "Drone is retired"}},
+	{domain.ErrorDroneUnavailable, problem{http.StatusConflict, "drone_unavailable", "Drone is not available"}},
+	{domain.ErrorDroneAltitudeExceeded, problem{http.StatusUnprocessableEntity, "drone_altitude_exceeded", "Drone can not fly over the estate trees"}},
+	{domain.ErrorMaxDistanceWithDrone, problem{http.StatusBadRequest, "max_distance_with_drone", "Max distance can not be given with a drone"}},
+	{domain.ErrorChargingPadInvalid, problem{http.StatusBadRequest, "charging_pad_invalid", "Invalid charging pads"}},
+	{domain.ErrorNoChargingPads, problem{http.StatusUnprocessableEntity, "no_charging_pads", "Estate has no charging pad"}},
+	{domain.ErrorPlotOutOfPadRange, problem{http.StatusUnprocessableEntity, "plot_out_of_pad_range", "Plot out of the drone range from every charging pad"}},
+	{domain.ErrorSortiePlanTooLarge, problem{http.StatusUnprocessableEntity, "sortie_plan_too_large", "Estate too large to list its sorties"}},
+	{domain.ErrorDronePlanTooLarge, problem{http.StatusUnprocessableEntity, "drone_plan_too_large", "Estate too large to render"}},
+	{domain.ErrorVersionMismatch, problem{http.StatusPreconditionFailed, "version_mismatch", "Resource was modified"}},
+	{domain.ErrorEventStreamUnavailable, problem{http.StatusServiceUnavailable, "event_stream_unavailable", "Event stream unavailable"}},
+	{domain.ErrorWebhookNotFound, problem{http.StatusNotFound, "webhook_not_found", "Webhook not found"}},
+	{domain.ErrorWebhookInvalidURL, problem{http.StatusBadRequest, "webhook_invalid_url", "Invalid webhook url"}},
+	{domain.ErrorWebhookPrivateHost, problem{http.StatusBadRequest, "webhook_private_host", "Webhook url points to a private host"}},
+	{domain.ErrorWebhookInvalidEventType, problem{http.StatusBadRequest, "webhook_invalid_event_type", "Invalid webhook event type"}},
+	{domain.ErrorWebhookDeliveryNotFound, problem{http.StatusNotFound, "webhook_delivery_not_found", "Webhook delivery not found"}},
+	{domain.ErrorIdempotencyKeyInvalid, problem{http.StatusBadRequest, "idempotency_key_invalid", "Invalid idempotency key"}},
+	{domain.ErrorIdempotencyRequestInProgress, problem{http.StatusConflict, "idempotency_request_in_progress", "Request is still in progress"}},
+	{domain.ErrorIdempotencyKeyReused, problem{http.StatusUnprocessableEntity, "idempotency_key_reused", "Idempotency key reused"}},
+}
+
+// problemOf return the problem of a domain error, unknown errors are internal errors
+func problemOf(err error) problem {
+	for _, dp := range domainProblems {
+		if errors.Is(err, dp.err) {
+			return dp.problem
+		}
+	}
+	return problemInternal
+}
+
+// respondError log err and write it as problem, internal errors never expose their message
+func respondError(ctx echo.Context, err error) error {
+	slog.Error("error", "message", err.Error(), "request_id", requestID(ctx))
+
+	p := problemOf(err)
+	if p == problemInternal {
+		return respondProblem(ctx, p, "", nil)
+	}
+	return respondProblem(ctx, p, err.Error(), nil)
+}
+
+// respondProblem write p as application/problem+json, empty detail and fieldErrors are omitted
+func respondProblem(ctx echo.Context, p problem, detail string, fieldErrors []generated.ProblemFieldError) error {
+	body := generated.Problem{
+		Type:   problemTypePrefix + p.Code,
+		Title:  p.Title,
+		Status: p.Status,
+		Code:   p.Code,
+	}
+	if detail != "" {
+		body.Detail = &detail
+	}
+	if id := requestID(ctx); id != "" {
+		body.RequestId = &id
+	}
+	if len(fieldErrors) > 0 {
+		body.Errors = &fieldErrors
+	}
+
+	ctx.Response().Header().Set(echo.HeaderContentType, MIMEApplicationProblemJSON)
+	return ctx.JSON(p.Status, body)
+}
+
+// ProblemErrorHandler is the echo HTTPErrorHandler, it writes errors returned by handlers and middlewares
+// such as routing and request validation failures as problem
+func ProblemErrorHandler(err error, ctx echo.Context) {
+	if ctx.Response().Committed {
+		return
+	}
+
+	var httpErr *echo.HTTPError
+	if !errors.As(err, &httpErr) {
+		err = respondError(ctx, err)
+	} else {
+		err = respondHTTPError(ctx, httpErr)
+	}
+
+	if err != nil {
+		slog.Error("failed to write error response", "message", err.Error())
+	}
+}
+
+func respondHTTPError(ctx echo.Context, httpErr *echo.HTTPError) error {
+	if isRequestTooLarge(httpErr) {
+		return respondProblem(ctx, problemRequestTooLarge, "", nil)
+	}
+
+	if fieldErrors, detail, ok := validationErrors(httpErr.Internal); ok {
+		return respondProblem(ctx, problemValidationFailed, detail, fieldErrors)
+	}
+
+	detail := ""
+	if message, ok := httpErr.Message.(string); ok {
+		detail = message
+	}
+
+	switch httpErr.Code {
+	case http.StatusNotFound:
+		return respondProblem(ctx, problemRouteNotFound, detail, nil)
+	case http.StatusMethodNotAllowed:
+		return respondProblem(ctx, problemMethodNotAllowed, detail, nil)
+	case http.StatusRequestEntityTooLarge:
+		return respondProblem(ctx, problemRequestTooLarge, detail, nil)
+	}
+
+	if httpErr.Code >= http.StatusInternalServerError {
+		slog.Error("error", "message", httpErr.Error(), "request_id", requestID(ctx))
+		return respondProblem(ctx, problemInternal, "", nil)
+	}
+	return respondProblem(ctx, problem{httpErr.Code, problemInvalidRequest.Code, http.StatusText(httpErr.Code)}, detail, nil)
+}
+
+// validationErrors flatten request validation errors into field errors, ok is false when err is not a validation error.
+// Errors are matched on their type since a RequestError unwraps into the MultiError of its schema errors
+func validationErrors(err error) (fieldErrors []generated.ProblemFieldError, detail string, ok bool) {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		for _, inner := range e {
+			fields, d, _ := validationErrors(inner)
+			fieldErrors = append(fieldErrors, fields...)
+			if detail == "" {
+				detail = d
+			}
+		}
+		return fieldErrors, detail, true
+	case *openapi3filter.RequestError:
+		if e.Parameter != nil {
+			return []generated.ProblemFieldError{{Field: e.Parameter.Name, Message: requestErrMessage(e)}}, "", true
+		}
+		fieldErrors = schemaFieldErrors(e.Err)
+		if len(fieldErrors) == 0 {
+			return nil, requestErrMessage(e), true
+		}
+		return fieldErrors, "", true
+	default:
+		return nil, "", false
+	}
+}
+
+// schemaFieldErrors return field errors of request body schema errors
+func schemaFieldErrors(err error) []generated.ProblemFieldError {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		var fieldErrors []generated.ProblemFieldError
+		for _, inner := range e {
+			fieldErrors = append(fieldErrors, schemaFieldErrors(inner)...)
+		}
+		return fieldErrors
+	case *openapi3.SchemaError:
+		field := strings.Join(e.JSONPointer(), ".")
+		if field == "" {
+			field = "body"
+		}
+		return []generated.ProblemFieldError{{Field: field, Message: e.Reason}}
+	default:
+		return nil
+	}
+}
+
+func requestErrMessage(requestErr *openapi3filter.RequestError) string {
+	var schemaErr *openapi3.SchemaError
+	if errors.As(requestErr.Err, &schemaErr) {
+		return schemaErr.Reason
+	}
+	if requestErr.Err != nil {
+		return requestErr.Err.Error()
+	}
+	return requestErr.Reason
+}
+
+// requestID return the id set by the RequestID middleware
+func requestID(ctx echo.Context) string {
+	return ctx.Response().Header().Get(echo.HeaderXRequestID)
+}
@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/generated"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	echoMiddleware "github.com/oapi-codegen/echo-middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRespondError(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name         string
+		err          error
+		expectStatus int
+		expectCode   string
+		expectDetail *string
+	}{
+		{
+			name:         "Domain error",
+			err:          domain.ErrorEstatesNotFound,
+			expectStatus: http.StatusNotFound,
+			expectCode:   "estate_not_found",
+			expectDetail: &[]string{"estates not found"}[0],
+		},
+		{
+			name:         "Wrapped domain error",
+			err:          fmt.Errorf("create tree: %w", domain.ErrorTreePlotOutOfBound),
+			expectStatus: http.StatusBadRequest,
+			expectCode:   "tree_plot_out_of_bound",
+			expectDetail: &[]string{"create tree: tree plot out of bound"}[0],
+		},
+		{
+			name:         "Internal error hide its message",
+			err:          errors.New("pq: connection refused"),
+			expectStatus: http.StatusInternalServerError,
+			expectCode:   "internal_error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+			ctx.Response().Header().Set(echo.HeaderXRequestID, "request-1")
+
+			assert.NoError(t, respondError(ctx, tt.err))
+			assert.Equal(t, tt.expectStatus, rec.Code)
+			assert.Equal(t, MIMEApplicationProblemJSON, rec.Header().Get(echo.HeaderContentType))
+
+			var body generated.Problem
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			assert.Equal(t, tt.expectCode, body.Code)
+			assert.Equal(t, "urn:estate-service:problem:"+tt.expectCode, body.Type)
+			assert.Equal(t, tt.expectStatus, body.Status)
+			assert.Equal(t, tt.expectDetail, body.Detail)
+			assert.Equal(t, "request-1", *body.RequestId)
+		})
+	}
+}
+
+func TestProblemErrorHandler(t *testing.T) {
+	swagger, err := generated.GetSwagger()
+	require.NoError(t, err)
+	swagger.Servers = nil
+
+	e := echo.New()
+	e.HTTPErrorHandler = ProblemErrorHandler
+	e.Use(middleware.RequestID())
+	e.Use(echoMiddleware.OapiRequestValidatorWithOptions(swagger, &echoMiddleware.Options{
+		Options: openapi3filter.Options{AuthenticationFunc: openapi3filter.NoopAuthenticationFunc, MultiError: true},
+	}))
+	e.POST("/estate", func(ctx echo.Context) error {
+		return ctx.NoContent(http.StatusCreated)
+	})
+
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		body         string
+		header       map[string]string
+		expectStatus int
+		expectCode   string
+		expectErrors *[]generated.ProblemFieldError
+	}{
+		{
+			name:         "Invalid fields",
+			method:       http.MethodPost,
+			path:         "/estate",
+			body:         `{"width": 0, "length": 50001}`,
+			expectStatus: http.StatusBadRequest,
+			expectCode:   "validation_failed",
+			expectErrors: &[]generated.ProblemFieldError{
+				{Field: "length", Message: "number must be at most 50000"},
+				{Field: "width", Message: "number must be at least 1"},
+			},
+		},
+		{
+			name:         "Missing field",
+			method:       http.MethodPost,
+			path:         "/estate",
+			body:         `{"width": 1}`,
+			expectStatus: http.StatusBadRequest,
+			expectCode:   "validation_failed",
+			expectErrors: &[]generated.ProblemFieldError{
+				{Field: "length", Message: `property "length" is missing`},
+			},
+		},
+		{
+			name:         "Invalid header",
+			method:       http.MethodPost,
+			path:         "/estate",
+			body:         `{"width": 1, "length": 1}`,
+			header:       map[string]string{HeaderIdempotencyKey: string(bytes.Repeat([]byte("k"), 256))},
+			expectStatus: http.StatusBadRequest,
+			expectCode:   "validation_failed",
+			expectErrors: &[]generated.ProblemFieldError{
+				{Field: HeaderIdempotencyKey, Message: "maximum string length is 255"},
+			},
+		},
+		{
+			name:         "Unknown route",
+			method:       http.MethodGet,
+			path:         "/unknown",
+			expectStatus: http.StatusNotFound,
+			expectCode:   "route_not_found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, bytes.NewBufferString(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			for k, v := range tt.header {
+				req.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+			assert.Equal(t, tt.expectStatus, rec.Code)
+			assert.Equal(t, MIMEApplicationProblemJSON, rec.Header().Get(echo.HeaderContentType))
+
+			var body generated.Problem
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			assert.Equal(t, tt.expectCode, body.Code)
+			assert.NotEmpty(t, *body.RequestId)
+			if tt.expectErrors != nil {
+				assert.ElementsMatch(t, *tt.expectErrors, *body.Errors)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/generated"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// Clone an estate as a planning sandbox
+// (POST /estate/{id}/clone)
+// Idempotency-Key is handled by the Idempotency middleware
+func (s *Server) PostEstateIdClone(ctx echo.Context, id uuid.UUID, _ generated.PostEstateIdCloneParams) error {
+	sandbox, err := s.estateUsecase.CloneEstate(ctx.Request().Context(), id)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusCreated, toEstate(sandbox))
+}
+
+// Compare a sandbox with its source estate
+// (GET /estate/{id}/diff)
+func (s *Server) GetEstateIdDiff(ctx echo.Context, id uuid.UUID) error {
+	diff, err := s.estateUsecase.DiffSandbox(ctx.Request().Context(), id)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, generated.SandboxDiffResponse{
+		SourceEstateId: diff.SourceEstateID,
+		Source:         toEstatePlan(diff.Source),
+		Sandbox:        toEstatePlan(diff.Sandbox),
+		Changes: generated.TreeChanges{
+			Planted: toTrees(diff.Changes.Planted),
+			Updated: toTrees(diff.Changes.Updated),
+			Removed: toTrees(diff.Changes.Removed),
+		},
+	})
+}
+
+// Promote a sandbox to its source estate
+// (POST /estate/{id}/promote)
+func (s *Server) PostEstateIdPromote(ctx echo.Context, id uuid.UUID) error {
+	estate, err := s.estateUsecase.PromoteSandbox(ctx.Request().Context(), id)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, toEstate(estate))
+}
+
+func toEstatePlan(plan domain.EstatePlan) generated.EstatePlan {
+	return generated.EstatePlan{
+		Count:    plan.Stats.Count,
+		Max:      plan.Stats.Max,
+		Min:      plan.Stats.Min,
+		Median:   plan.Stats.Median,
+		Distance: plan.DroneDistance,
+	}
+}
+
+func toTrees(trees []domain.Tree) []generated.Tree {
+	res := make([]generated.Tree, 0, len(trees))
+	for i := range trees {
+		res = append(res, toTree(&trees[i]))
+	}
+	return res
+}
@@ -0,0 +1,155 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/SawitProRecruitment/EstateService/generated"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestServer_PostEstateIdClone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	srv := &Server{estateUsecase: mockUsecase}
+	e := echo.New()
+	sourceID := uuid.New()
+	sandbox := &domain.Estate{ID: uuid.New(), Width: 1, Length: 2, Sandbox: &domain.Sandbox{SourceEstateID: &sourceID}}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().CloneEstate(gomock.Any(), sourceID).Return(sandbox, nil)
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(httptest.NewRequest(http.MethodPost, "/estate/"+sourceID.String()+"/clone", nil), rec)
+
+		assert.NoError(t, srv.PostEstateIdClone(ctx, sourceID, generated.PostEstateIdCloneParams{}))
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.JSONEq(t, `{"id":"`+sandbox.ID.String()+`","width":1,"length":2,"draft":true,"source_estate_id":"`+sourceID.String()+`"}`, rec.Body.String())
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		mockUsecase.EXPECT().CloneEstate(gomock.Any(), sourceID).Return(nil, domain.ErrorEstatesNotFound)
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(httptest.NewRequest(http.MethodPost, "/estate/"+sourceID.String()+"/clone", nil), rec)
+
+		assert.NoError(t, srv.PostEstateIdClone(ctx, sourceID, generated.PostEstateIdCloneParams{}))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestServer_GetEstateIdDiff(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	srv := &Server{estateUsecase: mockUsecase}
+	e := echo.New()
+	sourceID := uuid.New()
+	sandboxID := uuid.New()
+	planted := domain.Tree{ID: uuid.New(), Plot: domain.Plot{Row: 1, Col: 2}, Height: 10}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().DiffSandbox(gomock.Any(), sandboxID).Return(&domain.SandboxDiff{
+			SourceEstateID: sourceID,
+			Source:         domain.EstatePlan{DroneDistance: 20},
+			Sandbox:        domain.EstatePlan{Stats: domain.EstateStats{Count: 1, Max: 10, Min: 10, Median: 10}, DroneDistance: 40},
+			Changes:        domain.TreeChanges{Planted: []domain.Tree{planted}},
+		}, nil)
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(httptest.NewRequest(http.MethodGet, "/estate/"+sandboxID.String()+"/diff", nil), rec)
+
+		assert.NoError(t, srv.GetEstateIdDiff(ctx, sandboxID))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var got generated.SandboxDiffResponse
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+		assert.Equal(t, generated.SandboxDiffResponse{
+			SourceEstateId: sourceID,
+			Source:         generated.EstatePlan{Distance: 20},
+			Sandbox:        generated.EstatePlan{Count: 1, Max: 10, Min: 10, Median: 10, Distance: 40},
+			Changes: generated.TreeChanges{
+				Planted: []generated.Tree{{Id: planted.ID, X: 2, Y: 1, Height: 10}},
+				Updated: []generated.Tree{},
+				Removed: []generated.Tree{},
+			},
+		}, got)
+	})
+
+	t.Run("Not a sandbox", func(t *testing.T) {
+		mockUsecase.EXPECT().DiffSandbox(gomock.Any(), sourceID).Return(nil, domain.ErrorEstateNotSandbox)
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(httptest.NewRequest(http.MethodGet, "/estate/"+sourceID.String()+"/diff", nil), rec)
+
+		assert.NoError(t, srv.GetEstateIdDiff(ctx, sourceID))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		var p generated.Problem
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+		assert.Equal(t, "estate_not_sandbox", p.Code)
+	})
+}
+
+func TestServer_PostEstateIdPromote(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	srv := &Server{estateUsecase: mockUsecase}
+	e := echo.New()
+	source := &domain.Estate{ID: uuid.New(), Width: 1, Length: 2}
+	sandboxID := uuid.New()
+
+	tests := []struct {
+		name         string
+		mockFunc     func()
+		expectStatus int
+		expectCode   string
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				mockUsecase.EXPECT().PromoteSandbox(gomock.Any(), sandboxID).Return(source, nil)
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Stale",
+			mockFunc: func() {
+				mockUsecase.EXPECT().PromoteSandbox(gomock.Any(), sandboxID).Return(nil, domain.ErrorSandboxStale)
+			},
+			expectStatus: http.StatusConflict,
+			expectCode:   "sandbox_stale",
+		},
+		{
+			name: "Planted out of the source bounds",
+			mockFunc: func() {
+				mockUsecase.EXPECT().PromoteSandbox(gomock.Any(), sandboxID).Return(nil, domain.ErrorTreePlotOutOfBound)
+			},
+			expectStatus: http.StatusBadRequest,
+			expectCode:   "tree_plot_out_of_bound",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(httptest.NewRequest(http.MethodPost, "/estate/"+sandboxID.String()+"/promote", nil), rec)
+
+			tt.mockFunc()
+			assert.NoError(t, srv.PostEstateIdPromote(ctx, sandboxID))
+			assert.Equal(t, tt.expectStatus, rec.Code)
+			if tt.expectCode != "" {
+				var p generated.Problem
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+				assert.Equal(t, tt.expectCode, p.Code)
+			}
+		})
+	}
+}
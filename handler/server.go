@@ -5,11 +5,38 @@ import (
 )
 
 type Server struct {
-	estateUsecase interfaces.EstateUsecase
+	estateUsecase  interfaces.EstateUsecase
+	webhookUsecase interfaces.WebhookUsecase
+	authUsecase    interfaces.AuthUsecase
+	droneUsecase   interfaces.DroneUsecase
 }
 
-func NewServer(estateUsecase interfaces.EstateUsecase) *Server {
-	return &Server{
+type ServerOptions func(*Server)
+
+func WithWebhookUsecase(webhookUsecase interfaces.WebhookUsecase) ServerOptions {
+	return func(s *Server) {
+		s.webhookUsecase = webhookUsecase
+	}
+}
+
+func WithAuthUsecase(authUsecase interfaces.AuthUsecase) ServerOptions {
+	return func(s *Server) {
+		s.authUsecase = authUsecase
+	}
+}
+
+func WithDroneUsecase(droneUsecase interfaces.DroneUsecase) ServerOptions {
+	return func(s *Server) {
+		s.droneUsecase = droneUsecase
+	}
+}
+
+func NewServer(estateUsecase interfaces.EstateUsecase, opts ...ServerOptions) *Server {
+	server := &Server{
 		estateUsecase: estateUsecase,
 	}
+	for _, opt := range opts {
+		opt(server)
+	}
+	return server
 }
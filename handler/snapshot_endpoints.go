@@ -0,0 +1,321 @@
+package handler
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/generated"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+const MIMEApplicationGzip = "application/gzip"
+
+// ndjson record types, the header comes first and carries the snapshot version
+const (
+	snapshotRecordHeader     = "header"
+	snapshotRecordEstate     = "estate"
+	snapshotRecordTree       = "tree"
+	snapshotRecordMeasure    = "measurement"
+	snapshotRecordDroneRoute = "drone_route"
+)
+
+// maxSnapshotRecordSize bound a single ndjson line, records are a few hundred bytes at most
+const maxSnapshotRecordSize = 64 * 1024
+
+// maxSnapshotSize bound the decompressed size of a gzipped import so a small body can not expand without limit
+var maxSnapshotSize int64 = 256 * 1024 * 1024
+
+// snapshotRecord is one line of the ndjson format
+type snapshotRecord struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+type snapshotHeader struct {
+	Version    int        `json:"version"`
+	ExportedAt *time.Time `json:"exported_at,omitempty"`
+}
+
+// Export an estate snapshot
+// (GET /estate/{id}/export)
+func (s *Server) GetEstateIdExport(ctx echo.Context, id uuid.UUID, params generated.GetEstateIdExportParams) error {
+	snapshot, err := s.estateUsecase.ExportEstate(ctx.Request().Context(), id)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	if params.Format == nil || *params.Format == generated.Json {
+		ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=\"estate-%s.json\"", id))
+		return ctx.JSON(http.StatusOK, toEstateSnapshot(snapshot))
+	}
+
+	res := ctx.Response()
+	res.Header().Set(echo.HeaderContentType, MIMEApplicationGzip)
+	res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=\"estate-%s.ndjson.gz\"", id))
+	res.WriteHeader(http.StatusOK)
+
+	// the status is already sent, a failure can only cut the stream which gzip readers report as unexpected EOF
+	gz := gzip.NewWriter(res)
+	err = writeSnapshotRecords(gz, toEstateSnapshot(snapshot))
+	if err == nil {
+		err = gz.Close()
+	}
+	if err != nil {
+		slog.Error("error", "message", err.Error(), "request_id", requestID(ctx))
+	}
+	return nil
+}
+
+// Import an estate snapshot
+// (POST /estate/import)
+// Idempotency-Key is handled by the Idempotency middleware
+func (s *Server) PostEstateImport(ctx echo.Context, params generated.PostEstateImportParams) error {
+	var req generated.EstateSnapshot
+
+	mediaType, _, _ := mime.ParseMediaType(ctx.Request().Header.Get(echo.HeaderContentType))
+	if mediaType == MIMEApplicationGzip {
+		snapshot, err := readSnapshotRecords(ctx.Request().Body)
+		if errors.Is(err, errSnapshotTooLarge) {
+			return respondProblem(ctx, problemRequestTooLarge, err.Error(), nil)
+		}
+		if isRequestTooLarge(err) {
+			return respondProblem(ctx, problemRequestTooLarge, "", nil)
+		}
+		if err != nil {
+			return respondProblem(ctx, problemInvalidRequest, err.Error(), nil)
+		}
+		req = *snapshot
+	} else if err := ctx.Bind(&req); isRequestTooLarge(err) {
+		return respondProblem(ctx, problemRequestTooLarge, "", nil)
+	} else if err != nil {
+		return respondProblem(ctx, problemInvalidRequest, "", nil)
+	}
+
+	keepIDs := params.KeepIds != nil && *params.KeepIds
+	estate, err := s.estateUsecase.ImportEstate(ctx.Request().Context(), fromEstateSnapshot(req), keepIDs)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusCreated, toEstate(estate))
+}
+
+var errSnapshotTooLarge = errors.New("snapshot is too large once decompressed")
+
+func writeSnapshotRecords(w io.Writer, snapshot generated.EstateSnapshot) error {
+	encoder := json.NewEncoder(w)
+	write := func(recordType string, data any) error {
+		return encoder.Encode(struct {
+			Type string `json:"type"`
+			Data any    `json:"data"`
+		}{recordType, data})
+	}
+
+	err := write(snapshotRecordHeader, snapshotHeader{Version: snapshot.Version, ExportedAt: snapshot.ExportedAt})
+	if err != nil {
+		return err
+	}
+	if err := write(snapshotRecordEstate, snapshot.Estate); err != nil {
+		return err
+	}
+	for _, tree := range snapshot.Trees {
+		if err := write(snapshotRecordTree, tree); err != nil {
+			return err
+		}
+	}
+	for _, measurement := range deref(snapshot.Measurements) {
+		if err := write(snapshotRecordMeasure, measurement); err != nil {
+			return err
+		}
+	}
+	for _, route := range deref(snapshot.DroneRoutes) {
+		if err := write(snapshotRecordDroneRoute, route); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readSnapshotRecords decode a gzipped ndjson snapshot, the header and estate records must come first
+func readSnapshotRecords(body io.Reader) (*generated.EstateSnapshot, error) {
+	gz, err := gzip.NewReader(body)
+	if err != nil {
+		return nil, fmt.Errorf("body is not gzipped: %w", err)
+	}
+	defer gz.Close()
+
+	limited := &io.LimitedReader{R: gz, N: maxSnapshotSize + 1}
+	scanner := bufio.NewScanner(limited)
+	scanner.Buffer(make([]byte, 0, 4096), maxSnapshotRecordSize)
+
+	var snapshot generated.EstateSnapshot
+	var header snapshotHeader
+	var measurements []generated.SnapshotMeasurement
+	var routes []generated.SnapshotDroneRoute
+
+	line, records := 0, 0
+	for scanner.Scan() {
+		// the last line is cut by the limit
+		if limited.N <= 0 {
+			return nil, errSnapshotTooLarge
+		}
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		records++
+
+		var record snapshotRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		switch {
+		case records == 1 && record.Type != snapshotRecordHeader:
+			return nil, fmt.Errorf("line %d: first record must be the %s", line, snapshotRecordHeader)
+		case records == 2 && record.Type != snapshotRecordEstate:
+			return nil, fmt.Errorf("line %d: second record must be the %s", line, snapshotRecordEstate)
+		case records > 2 && (record.Type == snapshotRecordHeader || record.Type == snapshotRecordEstate):
+			return nil, fmt.Errorf("line %d: %s record is duplicated", line, record.Type)
+		}
+
+		var target any
+		switch record.Type {
+		case snapshotRecordHeader:
+			target = &header
+		case snapshotRecordEstate:
+			target = &snapshot.Estate
+		case snapshotRecordTree:
+			snapshot.Trees = append(snapshot.Trees, generated.SnapshotTree{})
+			target = &snapshot.Trees[len(snapshot.Trees)-1]
+		case snapshotRecordMeasure:
+			measurements = append(measurements, generated.SnapshotMeasurement{})
+			target = &measurements[len(measurements)-1]
+		case snapshotRecordDroneRoute:
+			routes = append(routes, generated.SnapshotDroneRoute{})
+			target = &routes[len(routes)-1]
+		default:
+			return nil, fmt.Errorf("line %d: unknown record type %q", line, record.Type)
+		}
+
+		if err := json.Unmarshal(record.Data, target); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+	if limited.N <= 0 {
+		return nil, errSnapshotTooLarge
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if records < 2 {
+		return nil, fmt.Errorf("snapshot has no %s record", snapshotRecordEstate)
+	}
+
+	snapshot.Version = header.Version
+	snapshot.ExportedAt = header.ExportedAt
+	snapshot.Measurements = &measurements
+	snapshot.DroneRoutes = &routes
+	return &snapshot, nil
+}
+
+func toEstateSnapshot(snapshot *domain.EstateSnapshot) generated.EstateSnapshot {
+	res := generated.EstateSnapshot{
+		Version:    snapshot.Version,
+		ExportedAt: &snapshot.ExportedAt,
+		Estate: generated.SnapshotEstate{
+			Id:      snapshot.Estate.ID,
+			Width:   snapshot.Estate.Width,
+			Length:  snapshot.Estate.Length,
+			Version: snapshot.Estate.Version,
+		},
+		Trees: make([]generated.SnapshotTree, 0, len(snapshot.Trees)),
+	}
+	for _, tree := range snapshot.Trees {
+		res.Trees = append(res.Trees, generated.SnapshotTree{
+			Id:      tree.ID,
+			X:       tree.Plot.Col,
+			Y:       tree.Plot.Row,
+			Height:  tree.Height,
+			Version: tree.Version,
+		})
+	}
+
+	measurements := make([]generated.SnapshotMeasurement, 0, len(snapshot.Measurements))
+	for _, measurement := range snapshot.Measurements {
+		measurements = append(measurements, generated.SnapshotMeasurement{
+			TreeId:     measurement.TreeID,
+			Height:     measurement.Height,
+			MeasuredAt: measurement.MeasuredAt,
+		})
+	}
+	res.Measurements = &measurements
+
+	routes := make([]generated.SnapshotDroneRoute, 0, len(snapshot.DroneRoutes))
+	for _, route := range snapshot.DroneRoutes {
+		routes = append(routes, generated.SnapshotDroneRoute{
+			Route:    route.Route,
+			X:        route.Plot.Col,
+			Y:        route.Plot.Row,
+			Altitude: route.Altitude,
+		})
+	}
+	res.DroneRoutes = &routes
+	return res
+}
+
+func fromEstateSnapshot(req generated.EstateSnapshot) *domain.EstateSnapshot {
+	snapshot := &domain.EstateSnapshot{
+		Version: req.Version,
+		Estate: domain.Estate{
+			ID:      req.Estate.Id,
+			Width:   req.Estate.Width,
+			Length:  req.Estate.Length,
+			Version: req.Estate.Version,
+		},
+		Trees: make([]domain.Tree, 0, len(req.Trees)),
+	}
+	if req.ExportedAt != nil {
+		snapshot.ExportedAt = *req.ExportedAt
+	}
+	for _, tree := range req.Trees {
+		snapshot.Trees = append(snapshot.Trees, domain.Tree{
+			ID:      tree.Id,
+			Plot:    domain.Plot{Row: tree.Y, Col: tree.X},
+			Height:  tree.Height,
+			Version: tree.Version,
+		})
+	}
+	for _, measurement := range deref(req.Measurements) {
+		snapshot.Measurements = append(snapshot.Measurements, domain.TreeMeasurement{
+			TreeID:     measurement.TreeId,
+			Height:     measurement.Height,
+			MeasuredAt: measurement.MeasuredAt,
+		})
+	}
+	for _, route := range deref(req.DroneRoutes) {
+		snapshot.DroneRoutes = append(snapshot.DroneRoutes, domain.DroneRoute{
+			Route:    route.Route,
+			Plot:     domain.Plot{Row: route.Y, Col: route.X},
+			Altitude: route.Altitude,
+		})
+	}
+	return snapshot
+}
+
+func deref[T any](items *[]T) []T {
+	if items == nil {
+		return nil
+	}
+	return *items
+}
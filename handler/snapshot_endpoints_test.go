@@ -0,0 +1,225 @@
+package handler
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/SawitProRecruitment/EstateService/generated"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func testSnapshot() *domain.EstateSnapshot {
+	treeID := uuid.New()
+	return &domain.EstateSnapshot{
+		Version:    domain.SnapshotVersion,
+		ExportedAt: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		Estate:     domain.Estate{ID: uuid.New(), Width: 1, Length: 2, Version: 3},
+		Trees:      []domain.Tree{{ID: treeID, Plot: domain.Plot{Row: 1, Col: 2}, Height: 7, Version: 2}},
+		Measurements: []domain.TreeMeasurement{
+			{TreeID: treeID, Height: 5, MeasuredAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		DroneRoutes: []domain.DroneRoute{
+			{Route: 1, Plot: domain.Plot{Row: 1, Col: 1}, Altitude: 1},
+			{Route: 2, Plot: domain.Plot{Row: 1, Col: 2}, Altitude: 8},
+		},
+	}
+}
+
+// gzipLines gzip lines joined as ndjson
+func gzipLines(t *testing.T, lines ...string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	for _, line := range lines {
+		_, err := gz.Write([]byte(line + "\n"))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestServer_GetEstateIdExport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	srv := &Server{estateUsecase: mockUsecase}
+	e := echo.New()
+	snapshot := testSnapshot()
+	id := snapshot.Estate.ID
+	ndjson := generated.Ndjson
+
+	t.Run("Json", func(t *testing.T) {
+		mockUsecase.EXPECT().ExportEstate(gomock.Any(), id).Return(snapshot, nil)
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(httptest.NewRequest(http.MethodGet, "/estate/"+id.String()+"/export", nil), rec)
+
+		assert.NoError(t, srv.GetEstateIdExport(ctx, id, generated.GetEstateIdExportParams{}))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `attachment; filename="estate-`+id.String()+`.json"`, rec.Header().Get(echo.HeaderContentDisposition))
+
+		var got generated.EstateSnapshot
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+		assert.Equal(t, toEstateSnapshot(snapshot), got)
+	})
+
+	t.Run("Ndjson", func(t *testing.T) {
+		mockUsecase.EXPECT().ExportEstate(gomock.Any(), id).Return(snapshot, nil)
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(httptest.NewRequest(http.MethodGet, "/estate/"+id.String()+"/export?format=ndjson", nil), rec)
+
+		assert.NoError(t, srv.GetEstateIdExport(ctx, id, generated.GetEstateIdExportParams{Format: &ndjson}))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, MIMEApplicationGzip, rec.Header().Get(echo.HeaderContentType))
+
+		gz, err := gzip.NewReader(rec.Body)
+		assert.NoError(t, err)
+		var types []string
+		decoder := json.NewDecoder(gz)
+		for decoder.More() {
+			var record snapshotRecord
+			assert.NoError(t, decoder.Decode(&record))
+			types = append(types, record.Type)
+		}
+		assert.Equal(t, []string{"header", "estate", "tree", "measurement", "drone_route", "drone_route"}, types)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		mockUsecase.EXPECT().ExportEstate(gomock.Any(), id).Return(nil, domain.ErrorEstatesNotFound)
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(httptest.NewRequest(http.MethodGet, "/estate/"+id.String()+"/export", nil), rec)
+
+		assert.NoError(t, srv.GetEstateIdExport(ctx, id, generated.GetEstateIdExportParams{Format: &ndjson}))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestServer_PostEstateImport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	srv := &Server{estateUsecase: mockUsecase}
+	e := echo.New()
+	snapshot := testSnapshot()
+	imported := &domain.Estate{ID: uuid.New(), Width: 1, Length: 2, Version: 1}
+
+	jsonBody, err := json.Marshal(toEstateSnapshot(snapshot))
+	assert.NoError(t, err)
+	var exported bytes.Buffer
+	gz := gzip.NewWriter(&exported)
+	assert.NoError(t, writeSnapshotRecords(gz, toEstateSnapshot(snapshot)))
+	assert.NoError(t, gz.Close())
+
+	keepIDs := true
+	tests := []struct {
+		name         string
+		contentType  string
+		body         []byte
+		keepIDs      *bool
+		mockFunc     func()
+		expectStatus int
+		expectCode   string
+	}{
+		{
+			name:        "Json",
+			contentType: echo.MIMEApplicationJSON,
+			body:        jsonBody,
+			mockFunc: func() {
+				mockUsecase.EXPECT().ImportEstate(gomock.Any(), snapshot, false).Return(imported, nil)
+			},
+			expectStatus: http.StatusCreated,
+		},
+		{
+			name:        "Gzipped ndjson keeping ids",
+			contentType: MIMEApplicationGzip,
+			body:        exported.Bytes(),
+			keepIDs:     &keepIDs,
+			mockFunc: func() {
+				mockUsecase.EXPECT().ImportEstate(gomock.Any(), snapshot, true).Return(imported, nil)
+			},
+			expectStatus: http.StatusCreated,
+		},
+		{
+			name:         "Not gzipped",
+			contentType:  MIMEApplicationGzip,
+			body:         jsonBody,
+			mockFunc:     func() {},
+			expectStatus: http.StatusBadRequest,
+			expectCode:   "invalid_request",
+		},
+		{
+			name:         "Missing header",
+			contentType:  MIMEApplicationGzip,
+			body:         gzipLines(t, `{"type":"estate","data":{}}`),
+			mockFunc:     func() {},
+			expectStatus: http.StatusBadRequest,
+			expectCode:   "invalid_request",
+		},
+		{
+			name:         "Unknown record",
+			contentType:  MIMEApplicationGzip,
+			body:         gzipLines(t, `{"type":"header","data":{"version":1}}`, `{"type":"estate","data":{}}`, `{"type":"obstacle","data":{}}`),
+			mockFunc:     func() {},
+			expectStatus: http.StatusBadRequest,
+			expectCode:   "invalid_request",
+		},
+		{
+			name:        "Invalid snapshot",
+			contentType: echo.MIMEApplicationJSON,
+			body:        jsonBody,
+			mockFunc: func() {
+				mockUsecase.EXPECT().ImportEstate(gomock.Any(), snapshot, false).Return(nil, domain.ErrorSnapshotInvalid)
+			},
+			expectStatus: http.StatusBadRequest,
+			expectCode:   "snapshot_invalid",
+		},
+		{
+			name:        "Conflict",
+			contentType: echo.MIMEApplicationJSON,
+			body:        jsonBody,
+			keepIDs:     &keepIDs,
+			mockFunc: func() {
+				mockUsecase.EXPECT().ImportEstate(gomock.Any(), snapshot, true).Return(nil, domain.ErrorSnapshotConflict)
+			},
+			expectStatus: http.StatusConflict,
+			expectCode:   "snapshot_conflict",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/estate/import", bytes.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, tt.contentType)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			tt.mockFunc()
+			assert.NoError(t, srv.PostEstateImport(ctx, generated.PostEstateImportParams{KeepIds: tt.keepIDs}))
+			assert.Equal(t, tt.expectStatus, rec.Code)
+			if tt.expectCode != "" {
+				var p generated.Problem
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+				assert.Equal(t, tt.expectCode, p.Code)
+			}
+		})
+	}
+}
+
+func Test_readSnapshotRecords_TooLarge(t *testing.T) {
+	defer func(size int64) { maxSnapshotSize = size }(maxSnapshotSize)
+	maxSnapshotSize = 32
+
+	body := gzipLines(t, `{"type":"header","data":{"version":1}}`, `{"type":"estate","data":{}}`)
+	_, err := readSnapshotRecords(bytes.NewReader(body))
+	assert.Equal(t, errSnapshotTooLarge, err)
+}
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/generated"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// Replace the charging pads of an estate
+// (PUT /estate/{id}/charging-pads)
+func (s *Server) PutEstateIdChargingPads(ctx echo.Context, id uuid.UUID) error {
+	var req generated.ChargingPads
+
+	err := ctx.Bind(&req)
+	if err != nil {
+		return respondProblem(ctx, problemInvalidRequest, "", nil)
+	}
+
+	pads := make([]domain.Plot, 0, len(req.Pads))
+	for _, pad := range req.Pads {
+		pads = append(pads, domain.Plot{Row: pad.Y, Col: pad.X})
+	}
+
+	pads, err = s.estateUsecase.ReplaceChargingPads(ctx.Request().Context(), id, pads)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, toChargingPads(pads))
+}
+
+// List the charging pads of an estate
+// (GET /estate/{id}/charging-pads)
+func (s *Server) GetEstateIdChargingPads(ctx echo.Context, id uuid.UUID) error {
+	pads, err := s.estateUsecase.ListChargingPads(ctx.Request().Context(), id)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, toChargingPads(pads))
+}
+
+// Plan the sorties covering the estate between its charging pads
+// (GET /estate/{id}/drone-plan/sorties)
+func (s *Server) GetEstateIdDronePlanSorties(ctx echo.Context, id uuid.UUID, params generated.GetEstateIdDronePlanSortiesParams) error {
+	plan, err := s.estateUsecase.GetSortiePlan(ctx.Request().Context(), id, params.DroneId)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	res := generated.SortiePlan{
+		Distance:      plan.Distance,
+		FerryDistance: plan.FerryDistance,
+		BatterySwaps:  plan.BatterySwaps(),
+		FlightTime:    int(plan.FlightTime.Seconds()),
+		Sorties:       make([]generated.Sortie, 0, len(plan.Sorties)),
+	}
+	for _, sortie := range plan.Sorties {
+		waypoints := make([]generated.MissionWaypoint, 0, len(sortie.Waypoints))
+		for _, waypoint := range sortie.Waypoints {
+			waypoints = append(waypoints, generated.MissionWaypoint{
+				Sequence: waypoint.Sequence,
+				X:        waypoint.Plot.Col,
+				Y:        waypoint.Plot.Row,
+				Altitude: waypoint.Altitude,
+				Distance: waypoint.Distance,
+			})
+		}
+
+		res.Sorties = append(res.Sorties, generated.Sortie{
+			Sequence:      sortie.Sequence,
+			Pad:           toChargingPad(sortie.Pad),
+			LandingPad:    toChargingPad(sortie.LandingPad),
+			Distance:      sortie.Distance,
+			FerryDistance: sortie.FerryDistance,
+			FlightTime:    int(sortie.FlightTime.Seconds()),
+			Waypoints:     waypoints,
+		})
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+func toChargingPads(pads []domain.Plot) generated.ChargingPads {
+	res := generated.ChargingPads{Pads: make([]generated.ChargingPad, 0, len(pads))}
+	for _, pad := range pads {
+		res.Pads = append(res.Pads, toChargingPad(pad))
+	}
+	return res
+}
+
+func toChargingPad(pad domain.Plot) generated.ChargingPad {
+	return generated.ChargingPad{X: pad.Col, Y: pad.Row}
+}
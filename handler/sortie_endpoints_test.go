@@ -0,0 +1,174 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/SawitProRecruitment/EstateService/generated"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestServer_PutEstateIdChargingPads(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	srv := &Server{estateUsecase: mockUsecase}
+	e := echo.New()
+	estateID := uuid.New()
+
+	tests := []struct {
+		name         string
+		requestBody  []byte
+		mockFunc     func()
+		expectStatus int
+		expectCode   string
+		expectBody   string
+	}{
+		{
+			name:        "Success",
+			requestBody: []byte(`{"pads":[{"x":5,"y":2},{"x":1,"y":1}]}`),
+			mockFunc: func() {
+				mockUsecase.EXPECT().ReplaceChargingPads(gomock.Any(), estateID, []domain.Plot{{Row: 2, Col: 5}, {Row: 1, Col: 1}}).
+					Return([]domain.Plot{{Row: 1, Col: 1}, {Row: 2, Col: 5}}, nil)
+			},
+			expectStatus: http.StatusOK,
+			expectBody:   `{"pads":[{"x":1,"y":1},{"x":5,"y":2}]}`,
+		},
+		{
+			name:        "Pad outside of the estate",
+			requestBody: []byte(`{"pads":[{"x":50,"y":1}]}`),
+			mockFunc: func() {
+				mockUsecase.EXPECT().ReplaceChargingPads(gomock.Any(), estateID, gomock.Any()).Return(nil, domain.ErrorChargingPadInvalid)
+			},
+			expectStatus: http.StatusBadRequest,
+			expectCode:   "charging_pad_invalid",
+		},
+		{
+			name:         "Invalid body",
+			requestBody:  []byte(`{"pads":"everywhere"}`),
+			mockFunc:     func() {},
+			expectStatus: http.StatusBadRequest,
+			expectCode:   "invalid_request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, "/estate/"+estateID.String()+"/charging-pads", bytes.NewReader(tt.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			tt.mockFunc()
+			assert.NoError(t, srv.PutEstateIdChargingPads(ctx, estateID))
+			assert.Equal(t, tt.expectStatus, rec.Code)
+			if tt.expectCode != "" {
+				var p generated.Problem
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+				assert.Equal(t, tt.expectCode, p.Code)
+			}
+			if tt.expectBody != "" {
+				assert.JSONEq(t, tt.expectBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestServer_GetEstateIdDronePlanSorties(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	srv := &Server{estateUsecase: mockUsecase}
+	e := echo.New()
+	estateID := uuid.New()
+	droneID := uuid.New()
+	params := generated.GetEstateIdDronePlanSortiesParams{DroneId: droneID}
+
+	tests := []struct {
+		name         string
+		mockFunc     func()
+		expectStatus int
+		expectCode   string
+		expectBody   string
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				mockUsecase.EXPECT().GetSortiePlan(gomock.Any(), estateID, droneID).Return(&domain.SortiePlan{
+					Sorties: []domain.Sortie{
+						{
+							Sequence:      1,
+							Pad:           domain.Plot{Row: 1, Col: 1},
+							LandingPad:    domain.Plot{Row: 1, Col: 3},
+							Waypoints:     []domain.DroneWaypoint{{Sequence: 1, Plot: domain.Plot{Row: 1, Col: 1}, Altitude: 1, Distance: 1}},
+							Distance:      22,
+							FerryDistance: 2,
+							FlightTime:    4 * time.Second,
+						},
+						{
+							Sequence:      2,
+							Pad:           domain.Plot{Row: 1, Col: 3},
+							LandingPad:    domain.Plot{Row: 1, Col: 5},
+							Waypoints:     []domain.DroneWaypoint{{Sequence: 2, Plot: domain.Plot{Row: 1, Col: 2}, Altitude: 1, Distance: 11}},
+							Distance:      22,
+							FerryDistance: 12,
+							FlightTime:    4 * time.Second,
+						},
+					},
+					Distance:      44,
+					FerryDistance: 14,
+					FlightTime:    8 * time.Second,
+				}, nil)
+			},
+			expectStatus: http.StatusOK,
+			expectBody: `{
+				"distance":44,"ferry_distance":14,"battery_swaps":1,"flight_time":8,
+				"sorties":[
+					{"sequence":1,"pad":{"x":1,"y":1},"landing_pad":{"x":3,"y":1},"distance":22,"ferry_distance":2,"flight_time":4,
+					 "waypoints":[{"sequence":1,"x":1,"y":1,"altitude":1,"distance":1}]},
+					{"sequence":2,"pad":{"x":3,"y":1},"landing_pad":{"x":5,"y":1},"distance":22,"ferry_distance":12,"flight_time":4,
+					 "waypoints":[{"sequence":2,"x":2,"y":1,"altitude":1,"distance":11}]}
+				]
+			}`,
+		},
+		{
+			name: "No charging pads",
+			mockFunc: func() {
+				mockUsecase.EXPECT().GetSortiePlan(gomock.Any(), estateID, droneID).Return(nil, domain.ErrorNoChargingPads)
+			},
+			expectStatus: http.StatusUnprocessableEntity,
+			expectCode:   "no_charging_pads",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/estate/"+estateID.String()+"/drone-plan/sorties?drone_id="+droneID.String(), nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			tt.mockFunc()
+			assert.NoError(t, srv.GetEstateIdDronePlanSorties(ctx, estateID, params))
+			assert.Equal(t, tt.expectStatus, rec.Code)
+			if tt.expectCode != "" {
+				var p generated.Problem
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+				assert.Equal(t, tt.expectCode, p.Code)
+			}
+			if tt.expectBody != "" {
+				assert.JSONEq(t, tt.expectBody, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/generated"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+const MIMETextCSV = "text/csv"
+
+// telemetryColumns are the columns a CSV telemetry upload must name in its header, in any order
+var telemetryColumns = []string{"recorded_at", "x", "y", "altitude"}
+
+// Record the telemetry of a drone mission
+// (PUT /estate/{id}/mission/{mission_id}/telemetry)
+func (s *Server) PutEstateIdMissionMissionIdTelemetry(ctx echo.Context, id uuid.UUID, missionId uuid.UUID) error {
+	var samples []domain.TelemetrySample
+
+	mediaType, _, _ := mime.ParseMediaType(ctx.Request().Header.Get(echo.HeaderContentType))
+	if mediaType == MIMETextCSV {
+		var err error
+		samples, err = readTelemetryCSV(ctx.Request().Body)
+		if err != nil {
+			return respondError(ctx, err)
+		}
+	} else {
+		var req generated.RecordTelemetryRequest
+		if err := ctx.Bind(&req); err != nil {
+			return respondProblem(ctx, problemInvalidRequest, "", nil)
+		}
+		samples = make([]domain.TelemetrySample, 0, len(req.Samples))
+		for _, sample := range req.Samples {
+			samples = append(samples, domain.TelemetrySample{RecordedAt: sample.RecordedAt, X: sample.X, Y: sample.Y, Altitude: sample.Altitude})
+		}
+	}
+
+	report, err := s.estateUsecase.RecordTelemetry(ctx.Request().Context(), id, missionId, samples)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, toTelemetryReport(report))
+}
+
+// Compare the recorded telemetry of a drone mission with its plan
+// (GET /estate/{id}/mission/{mission_id}/telemetry)
+func (s *Server) GetEstateIdMissionMissionIdTelemetry(ctx echo.Context, id uuid.UUID, missionId uuid.UUID) error {
+	report, err := s.estateUsecase.GetTelemetryReport(ctx.Request().Context(), id, missionId)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, toTelemetryReport(report))
+}
+
+// readTelemetryCSV read samples from a CSV body whose header names the telemetryColumns in any order,
+// reading stops once there are more samples than a mission accepts
+func readTelemetryCSV(body io.Reader) ([]domain.TelemetrySample, error) {
+	reader := csv.NewReader(body)
+	reader.TrimLeadingSpace = true
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: read header: %s", domain.ErrorTelemetryInvalid, err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range telemetryColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("%w: missing %q column, the header must name %s", domain.ErrorTelemetryInvalid, name, strings.Join(telemetryColumns, ", "))
+		}
+	}
+
+	samples := []domain.TelemetrySample{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", domain.ErrorTelemetryInvalid, err)
+		}
+		if len(samples) == domain.MaxTelemetrySamples {
+			return nil, fmt.Errorf("%w: more than %d samples", domain.ErrorTelemetryInvalid, domain.MaxTelemetrySamples)
+		}
+
+		line, _ := reader.FieldPos(0)
+		sample, err := parseTelemetryRecord(record, columns)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %s", domain.ErrorTelemetryInvalid, line, err)
+		}
+		samples = append(samples, sample)
+	}
+	return samples, nil
+}
+
+func parseTelemetryRecord(record []string, columns map[string]int) (domain.TelemetrySample, error) {
+	var sample domain.TelemetrySample
+
+	value := strings.TrimSpace(record[columns["recorded_at"]])
+	recordedAt, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return sample, fmt.Errorf("invalid recorded_at %q", value)
+	}
+	sample.RecordedAt = recordedAt
+
+	for _, field := range []struct {
+		name  string
+		value *float64
+	}{{"x", &sample.X}, {"y", &sample.Y}, {"altitude", &sample.Altitude}} {
+		value := strings.TrimSpace(record[columns[field.name]])
+		*field.value, err = strconv.ParseFloat(value, 64)
+		if err != nil {
+			return sample, fmt.Errorf("invalid %s %q", field.name, value)
+		}
+	}
+	return sample, nil
+}
+
+func toTelemetryReport(report *domain.TelemetryReport) generated.TelemetryReport {
+	res := generated.TelemetryReport{
+		Samples:         report.Samples,
+		OutsideSamples:  report.OutsideSamples,
+		PlannedPlots:    report.PlannedPlots,
+		VisitedPlots:    report.VisitedPlots,
+		Coverage:        report.Coverage(),
+		MissedPlots:     make([]generated.TelemetryPlot, 0, len(report.MissedPlots)),
+		Deviations:      make([]generated.ClearanceDeviation, 0, len(report.Deviations)),
+		DeviationCount:  report.DeviationCount,
+		PlannedDistance: report.PlannedDistance,
+		ActualDistance:  report.ActualDistance,
+	}
+	for _, plot := range report.MissedPlots {
+		res.MissedPlots = append(res.MissedPlots, generated.TelemetryPlot{X: plot.Col, Y: plot.Row})
+	}
+	for _, deviation := range report.Deviations {
+		res.Deviations = append(res.Deviations, generated.ClearanceDeviation{
+			X:               deviation.Plot.Col,
+			Y:               deviation.Plot.Row,
+			TreeHeight:      deviation.TreeHeight,
+			PlannedAltitude: deviation.PlannedAltitude,
+			Altitude:        deviation.Altitude,
+			Clearance:       deviation.Clearance(),
+		})
+	}
+	return res
+}
@@ -0,0 +1,206 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/SawitProRecruitment/EstateService/generated"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestServer_PutEstateIdMissionMissionIdTelemetry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	srv := &Server{estateUsecase: mockUsecase}
+	e := echo.New()
+	estateID := uuid.New()
+	missionID := uuid.New()
+	at := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	samples := []domain.TelemetrySample{
+		{RecordedAt: at, X: 0, Y: 0, Altitude: 1},
+		{RecordedAt: at.Add(time.Second), X: 10.5, Y: 0.2, Altitude: 4.5},
+	}
+	report := &domain.TelemetryReport{
+		Samples:      2,
+		PlannedPlots: 3,
+		VisitedPlots: 2,
+		MissedPlots:  []domain.Plot{{Row: 1, Col: 3}},
+		Deviations: []domain.ClearanceDeviation{
+			{Plot: domain.Plot{Row: 1, Col: 2}, TreeHeight: 5, PlannedAltitude: 6, Altitude: 4.5},
+		},
+		DeviationCount:  1,
+		PlannedDistance: 32,
+		ActualDistance:  20,
+	}
+	reportBody := `{
+		"samples":2,"outside_samples":0,"planned_plots":3,"visited_plots":2,"coverage":0.6666666666666666,
+		"missed_plots":[{"x":3,"y":1}],
+		"deviations":[{"x":2,"y":1,"tree_height":5,"planned_altitude":6,"altitude":4.5,"clearance":-0.5}],
+		"deviation_count":1,"planned_distance":32,"actual_distance":20
+	}`
+
+	tests := []struct {
+		name         string
+		contentType  string
+		requestBody  []byte
+		mockFunc     func()
+		expectStatus int
+		expectCode   string
+		expectDetail string
+		expectBody   string
+	}{
+		{
+			name:        "Json",
+			contentType: echo.MIMEApplicationJSON,
+			requestBody: []byte(`{"samples":[
+				{"recorded_at":"2024-03-01T09:00:00Z","x":0,"y":0,"altitude":1},
+				{"recorded_at":"2024-03-01T09:00:01Z","x":10.5,"y":0.2,"altitude":4.5}
+			]}`),
+			mockFunc: func() {
+				mockUsecase.EXPECT().RecordTelemetry(gomock.Any(), estateID, missionID, samples).Return(report, nil)
+			},
+			expectStatus: http.StatusOK,
+			expectBody:   reportBody,
+		},
+		{
+			name:        "Csv with columns in any order",
+			contentType: "text/csv; charset=utf-8",
+			requestBody: []byte("x,y,altitude,recorded_at\n0,0,1,2024-03-01T09:00:00Z\n10.5, 0.2, 4.5, 2024-03-01T09:00:01Z\n"),
+			mockFunc: func() {
+				mockUsecase.EXPECT().RecordTelemetry(gomock.Any(), estateID, missionID, samples).Return(report, nil)
+			},
+			expectStatus: http.StatusOK,
+			expectBody:   reportBody,
+		},
+		{
+			name:         "Csv missing column",
+			contentType:  MIMETextCSV,
+			requestBody:  []byte("recorded_at,x,y\n2024-03-01T09:00:00Z,0,0\n"),
+			mockFunc:     func() {},
+			expectStatus: http.StatusBadRequest,
+			expectCode:   "telemetry_invalid",
+		},
+		{
+			name:         "Csv invalid value",
+			contentType:  MIMETextCSV,
+			requestBody:  []byte("recorded_at,x,y,altitude\n2024-03-01T09:00:00Z,0,0,1\n2024-03-01T09:00:01Z,east,0,1\n"),
+			mockFunc:     func() {},
+			expectStatus: http.StatusBadRequest,
+			expectCode:   "telemetry_invalid",
+			expectDetail: `line 3: invalid x "east"`,
+		},
+		{
+			name:         "Invalid json",
+			contentType:  echo.MIMEApplicationJSON,
+			requestBody:  []byte(`{"samples":"all"}`),
+			mockFunc:     func() {},
+			expectStatus: http.StatusBadRequest,
+			expectCode:   "invalid_request",
+		},
+		{
+			name:        "Mission still planned",
+			contentType: echo.MIMEApplicationJSON,
+			requestBody: []byte(`{"samples":[{"recorded_at":"2024-03-01T09:00:00Z","x":0,"y":0,"altitude":1}]}`),
+			mockFunc: func() {
+				mockUsecase.EXPECT().RecordTelemetry(gomock.Any(), estateID, missionID, gomock.Any()).Return(nil, domain.ErrorMissionNotFlown)
+			},
+			expectStatus: http.StatusConflict,
+			expectCode:   "mission_not_flown",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, "/estate/"+estateID.String()+"/mission/"+missionID.String()+"/telemetry", bytes.NewReader(tt.requestBody))
+			req.Header.Set(echo.HeaderContentType, tt.contentType)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			tt.mockFunc()
+			assert.NoError(t, srv.PutEstateIdMissionMissionIdTelemetry(ctx, estateID, missionID))
+			assert.Equal(t, tt.expectStatus, rec.Code)
+			if tt.expectCode != "" {
+				var p generated.Problem
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+				assert.Equal(t, tt.expectCode, p.Code)
+				if tt.expectDetail != "" && assert.NotNil(t, p.Detail) {
+					assert.Contains(t, *p.Detail, tt.expectDetail)
+				}
+			}
+			if tt.expectBody != "" {
+				assert.JSONEq(t, tt.expectBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestServer_GetEstateIdMissionMissionIdTelemetry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	srv := &Server{estateUsecase: mockUsecase}
+	e := echo.New()
+	estateID := uuid.New()
+	missionID := uuid.New()
+
+	tests := []struct {
+		name         string
+		mockFunc     func()
+		expectStatus int
+		expectCode   string
+		expectBody   string
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				mockUsecase.EXPECT().GetTelemetryReport(gomock.Any(), estateID, missionID).
+					Return(&domain.TelemetryReport{Samples: 1, PlannedPlots: 1, VisitedPlots: 1, PlannedDistance: 2, ActualDistance: 2}, nil)
+			},
+			expectStatus: http.StatusOK,
+			expectBody: `{
+				"samples":1,"outside_samples":0,"planned_plots":1,"visited_plots":1,"coverage":1,
+				"missed_plots":[],"deviations":[],"deviation_count":0,"planned_distance":2,"actual_distance":2
+			}`,
+		},
+		{
+			name: "No telemetry",
+			mockFunc: func() {
+				mockUsecase.EXPECT().GetTelemetryReport(gomock.Any(), estateID, missionID).Return(nil, domain.ErrorTelemetryNotFound)
+			},
+			expectStatus: http.StatusNotFound,
+			expectCode:   "telemetry_not_found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/estate/"+estateID.String()+"/mission/"+missionID.String()+"/telemetry", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			tt.mockFunc()
+			assert.NoError(t, srv.GetEstateIdMissionMissionIdTelemetry(ctx, estateID, missionID))
+			assert.Equal(t, tt.expectStatus, rec.Code)
+			if tt.expectCode != "" {
+				var p generated.Problem
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+				assert.Equal(t, tt.expectCode, p.Code)
+			}
+			if tt.expectBody != "" {
+				assert.JSONEq(t, tt.expectBody, rec.Body.String())
+			}
+		})
+	}
+}
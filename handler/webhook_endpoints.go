@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/generated"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// Subscribe a webhook to estate events
+// (POST /webhooks)
+func (s *Server) PostWebhooks(ctx echo.Context) error {
+	if s.webhookUsecase == nil {
+		return respondWebhooksDisabled(ctx)
+	}
+	var req generated.CreateWebhookRequest
+
+	err := ctx.Bind(&req)
+	if err != nil {
+		return respondProblem(ctx, problemInvalidRequest, "", nil)
+	}
+
+	subscription := &domain.WebhookSubscription{
+		EstateID: req.EstateId,
+		URL:      req.Url,
+	}
+	if req.Secret != nil {
+		subscription.Secret = *req.Secret
+	}
+	if req.EventTypes != nil {
+		for _, eventType := range *req.EventTypes {
+			subscription.EventTypes = append(subscription.EventTypes, domain.EventType(eventType))
+		}
+	}
+
+	subscription, err = s.webhookUsecase.CreateWebhook(ctx.Request().Context(), subscription)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	webhook := toWebhook(*subscription)
+	return ctx.JSON(http.StatusCreated, generated.CreateWebhookResponse{
+		Id:         webhook.Id,
+		Url:        webhook.Url,
+		EstateId:   webhook.EstateId,
+		EventTypes: webhook.EventTypes,
+		CreatedAt:  webhook.CreatedAt,
+		Secret:     subscription.Secret,
+	})
+}
+
+// List webhook subscriptions
+// (GET /webhooks)
+func (s *Server) GetWebhooks(ctx echo.Context) error {
+	if s.webhookUsecase == nil {
+		return respondWebhooksDisabled(ctx)
+	}
+	subscriptions, err := s.webhookUsecase.ListWebhooks(ctx.Request().Context())
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	webhooks := make([]generated.Webhook, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		webhooks = append(webhooks, toWebhook(subscription))
+	}
+
+	return ctx.JSON(http.StatusOK, generated.ListWebhooksResponse{Webhooks: webhooks})
+}
+
+// Delete a webhook subscription
+// (DELETE /webhooks/{id})
+func (s *Server) DeleteWebhooksId(ctx echo.Context, id uuid.UUID) error {
+	if s.webhookUsecase == nil {
+		return respondWebhooksDisabled(ctx)
+	}
+	err := s.webhookUsecase.DeleteWebhook(ctx.Request().Context(), id)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// List webhook deliveries that failed after all retries
+// (GET /webhooks/dead-letters)
+func (s *Server) GetWebhooksDeadLetters(ctx echo.Context) error {
+	if s.webhookUsecase == nil {
+		return respondWebhooksDisabled(ctx)
+	}
+	deliveries, err := s.webhookUsecase.ListDeadLetters(ctx.Request().Context())
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	deadLetters := make([]generated.WebhookDeadLetter, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		deadLetter := generated.WebhookDeadLetter{
+			Id:        delivery.ID,
+			WebhookId: delivery.Subscription.ID,
+			Event:     toEstateEvent(delivery.Event),
+			Attempts:  delivery.Attempts,
+		}
+		if delivery.LastError != "" {
+			deadLetter.LastError = &delivery.LastError
+		}
+		deadLetters = append(deadLetters, deadLetter)
+	}
+
+	return ctx.JSON(http.StatusOK, generated.ListWebhookDeadLettersResponse{DeadLetters: deadLetters})
+}
+
+// Retry a dead webhook delivery
+// (POST /webhooks/dead-letters/{id}/retry)
+func (s *Server) PostWebhooksDeadLettersIdRetry(ctx echo.Context, id uuid.UUID) error {
+	if s.webhookUsecase == nil {
+		return respondWebhooksDisabled(ctx)
+	}
+	err := s.webhookUsecase.RetryDeadLetter(ctx.Request().Context(), id)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	return ctx.NoContent(http.StatusAccepted)
+}
+
+func toWebhook(subscription domain.WebhookSubscription) generated.Webhook {
+	eventTypes := make([]generated.WebhookEventType, 0, len(subscription.EventTypes))
+	for _, eventType := range subscription.EventTypes {
+		eventTypes = append(eventTypes, generated.WebhookEventType(eventType))
+	}
+
+	return generated.Webhook{
+		Id:         subscription.ID,
+		Url:        subscription.URL,
+		EstateId:   subscription.EstateID,
+		EventTypes: eventTypes,
+		CreatedAt:  subscription.CreatedAt,
+	}
+}
+
+// respondWebhooksDisabled answer webhook endpoints when the server has no webhook usecase
+func respondWebhooksDisabled(ctx echo.Context) error {
+	return respondProblem(ctx, problemFeatureDisabled, "webhooks are disabled", nil)
+}
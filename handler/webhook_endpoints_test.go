@@ -0,0 +1,194 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestServer_PostWebhooks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockWebhookUsecase(ctrl)
+	srv := NewServer(interfaces.NewMockEstateUsecase(ctrl), WithWebhookUsecase(mockUsecase))
+	e := echo.New()
+
+	tests := []struct {
+		name         string
+		requestBody  []byte
+		mockFunc     func()
+		expectStatus int
+	}{
+		{
+			name:        "Success",
+			requestBody: []byte(`{"url": "https://erp.example.com/hooks", "event_types": ["tree.planted"]}`),
+			mockFunc: func() {
+				mockUsecase.EXPECT().CreateWebhook(gomock.Any(), &domain.WebhookSubscription{
+					URL:        "https://erp.example.com/hooks",
+					EventTypes: []domain.EventType{domain.EventTreePlanted},
+				}).DoAndReturn(func(_ any, subscription *domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+					subscription.ID = uuid.New()
+					subscription.Secret = "secret"
+					return subscription, nil
+				})
+			},
+			expectStatus: http.StatusCreated,
+		},
+		{
+			name:         "Invalid request body",
+			requestBody:  []byte(`{invalid-json}`),
+			mockFunc:     func() {},
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "Invalid url",
+			requestBody: []byte(`{"url": "erp"}`),
+			mockFunc: func() {
+				mockUsecase.EXPECT().CreateWebhook(gomock.Any(), gomock.Any()).Return(nil, domain.ErrorWebhookInvalidURL)
+			},
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "Internal server error",
+			requestBody: []byte(`{"url": "https://erp.example.com/hooks"}`),
+			mockFunc: func() {
+				mockUsecase.EXPECT().CreateWebhook(gomock.Any(), gomock.Any()).Return(nil, errors.New("unexpected error"))
+			},
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/webhooks", io.NopCloser(bytes.NewReader(tt.requestBody)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			tt.mockFunc()
+			assert.NoError(t, srv.PostWebhooks(ctx))
+			assert.Equal(t, tt.expectStatus, rec.Code)
+		})
+	}
+}
+
+func TestServer_GetWebhooks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockWebhookUsecase(ctrl)
+	srv := &Server{webhookUsecase: mockUsecase}
+	e := echo.New()
+
+	mockUsecase.EXPECT().ListWebhooks(gomock.Any()).Return([]domain.WebhookSubscription{{ID: uuid.New(), URL: "https://erp.example.com/hooks", Secret: "secret"}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/webhooks", nil)
+	rec := httptest.NewRecorder()
+	assert.NoError(t, srv.GetWebhooks(e.NewContext(req, rec)))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "https://erp.example.com/hooks")
+	assert.NotContains(t, rec.Body.String(), "secret")
+}
+
+func TestServer_DeleteWebhooksId(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockWebhookUsecase(ctrl)
+	srv := &Server{webhookUsecase: mockUsecase}
+	e := echo.New()
+	id := uuid.New()
+
+	tests := []struct {
+		name         string
+		mockFunc     func()
+		expectStatus int
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				mockUsecase.EXPECT().DeleteWebhook(gomock.Any(), id).Return(nil)
+			},
+			expectStatus: http.StatusNoContent,
+		},
+		{
+			name: "Not found",
+			mockFunc: func() {
+				mockUsecase.EXPECT().DeleteWebhook(gomock.Any(), id).Return(domain.ErrorWebhookNotFound)
+			},
+			expectStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodDelete, "/webhooks/:id", nil)
+			rec := httptest.NewRecorder()
+
+			tt.mockFunc()
+			assert.NoError(t, srv.DeleteWebhooksId(e.NewContext(req, rec), id))
+			assert.Equal(t, tt.expectStatus, rec.Code)
+		})
+	}
+}
+
+func TestServer_WebhookDeadLetters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockWebhookUsecase(ctrl)
+	srv := &Server{webhookUsecase: mockUsecase}
+	e := echo.New()
+	id := uuid.New()
+
+	mockUsecase.EXPECT().ListDeadLetters(gomock.Any()).Return([]domain.WebhookDelivery{{
+		ID:        id,
+		Event:     domain.NewEvent(domain.EventTreePlanted, uuid.New(), uuid.New(), map[string]any{"height": 10}),
+		Attempts:  domain.MaxWebhookAttempts,
+		LastError: "unexpected webhook response status 500",
+	}}, nil)
+
+	rec := httptest.NewRecorder()
+	assert.NoError(t, srv.GetWebhooksDeadLetters(e.NewContext(httptest.NewRequest(http.MethodGet, "/webhooks/dead-letters", nil), rec)))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), id.String())
+
+	mockUsecase.EXPECT().RetryDeadLetter(gomock.Any(), id).Return(domain.ErrorWebhookDeliveryNotFound)
+	rec = httptest.NewRecorder()
+	assert.NoError(t, srv.PostWebhooksDeadLettersIdRetry(e.NewContext(httptest.NewRequest(http.MethodPost, "/webhooks/dead-letters/:id/retry", nil), rec), id))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestServer_WebhooksDisabled(t *testing.T) {
+	srv := &Server{}
+	e := echo.New()
+
+	handlers := map[string]func(ctx echo.Context) error{
+		"PostWebhooks":                   srv.PostWebhooks,
+		"GetWebhooks":                    srv.GetWebhooks,
+		"DeleteWebhooksId":               func(ctx echo.Context) error { return srv.DeleteWebhooksId(ctx, uuid.New()) },
+		"GetWebhooksDeadLetters":         srv.GetWebhooksDeadLetters,
+		"PostWebhooksDeadLettersIdRetry": func(ctx echo.Context) error { return srv.PostWebhooksDeadLettersIdRetry(ctx, uuid.New()) },
+	}
+	for name, handle := range handlers {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/webhooks", nil)
+			rec := httptest.NewRecorder()
+			assert.NoError(t, handle(e.NewContext(req, rec)))
+			assert.Equal(t, http.StatusNotFound, rec.Code)
+			assert.Equal(t, MIMEApplicationProblemJSON, rec.Header().Get(echo.HeaderContentType))
+			assert.Contains(t, rec.Body.String(), `"code":"feature_disabled"`)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/google/uuid"
+)
+
+// droneRepository is an instrumenting decorator of DroneRepository, it measures every call
+// in the same histogram as estateRepository
+type droneRepository struct {
+	next    interfaces.DroneRepository
+	metrics *metrics
+}
+
+func NewDroneRepository(next interfaces.DroneRepository, m *metrics) *droneRepository {
+	return &droneRepository{
+		next:    next,
+		metrics: m,
+	}
+}
+
+func (r *droneRepository) CreateDrone(ctx context.Context, drone *domain.Drone) (err error) {
+	defer r.metrics.observeRepository("CreateDrone", time.Now(), &err)
+	return r.next.CreateDrone(ctx, drone)
+}
+
+func (r *droneRepository) ListDrones(ctx context.Context, tenantID uuid.UUID, status *domain.DroneStatus, limit int, offset int) (drones []domain.Drone, err error) {
+	defer r.metrics.observeRepository("ListDrones", time.Now(), &err)
+	return r.next.ListDrones(ctx, tenantID, status, limit, offset)
+}
+
+func (r *droneRepository) GetDrone(ctx context.Context, tenantID uuid.UUID, droneID uuid.UUID) (drone *domain.Drone, err error) {
+	defer r.metrics.observeRepository("GetDrone", time.Now(), &err)
+	return r.next.GetDrone(ctx, tenantID, droneID)
+}
+
+func (r *droneRepository) UpdateDrone(ctx context.Context, drone *domain.Drone) (err error) {
+	defer r.metrics.observeRepository("UpdateDrone", time.Now(), &err)
+	return r.next.UpdateDrone(ctx, drone)
+}
@@ -0,0 +1,45 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_droneRepository(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockDroneRepository(ctrl)
+	m := New()
+	repo := NewDroneRepository(mockRepo, m)
+	ctx := context.Background()
+	tenantID := uuid.New()
+	drone := &domain.Drone{ID: uuid.New(), TenantID: tenantID, Name: "drone-7"}
+
+	mockRepo.EXPECT().CreateDrone(ctx, drone).Return(nil)
+	assert.NoError(t, repo.CreateDrone(ctx, drone))
+
+	mockRepo.EXPECT().ListDrones(ctx, tenantID, nil, 10, 0).Return(nil, errors.New("query error"))
+	_, err := repo.ListDrones(ctx, tenantID, nil, 10, 0)
+	assert.Error(t, err)
+
+	mockRepo.EXPECT().GetDrone(ctx, tenantID, drone.ID).Return(drone, nil)
+	got, err := repo.GetDrone(ctx, tenantID, drone.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, drone, got)
+
+	mockRepo.EXPECT().UpdateDrone(ctx, drone).Return(domain.ErrorDroneNotFound)
+	assert.Equal(t, domain.ErrorDroneNotFound, repo.UpdateDrone(ctx, drone))
+
+	assert.Equal(t, uint64(1), histogramCount(t, m, "CreateDrone", "success"))
+	assert.Equal(t, uint64(1), histogramCount(t, m, "ListDrones", "error"))
+	assert.Equal(t, uint64(1), histogramCount(t, m, "GetDrone", "success"))
+	assert.Equal(t, uint64(1), histogramCount(t, m, "UpdateDrone", "error"))
+}
@@ -0,0 +1,188 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/google/uuid"
+)
+
+// estateRepository is an instrumenting decorator of EstateRepository, it measures every call
+// and counts created estates and trees without touching the underlying repository
+type estateRepository struct {
+	next    interfaces.EstateRepository
+	metrics *metrics
+}
+
+func NewEstateRepository(next interfaces.EstateRepository, m *metrics) *estateRepository {
+	return &estateRepository{
+		next:    next,
+		metrics: m,
+	}
+}
+
+func (r *estateRepository) observe(method string, start time.Time, err *error) {
+	r.metrics.observeRepository(method, start, err)
+}
+
+func (r *estateRepository) CreateEstateAndDroneRoute(ctx context.Context, estate *domain.Estate, droneRoutes []domain.DroneRoute, outbox []domain.Event) (err error) {
+	defer r.observe("CreateEstateAndDroneRoute", time.Now(), &err)
+	err = r.next.CreateEstateAndDroneRoute(ctx, estate, droneRoutes, outbox)
+	if err == nil {
+		r.metrics.estatesCreated.Inc()
+		r.metrics.droneRouteDistance.Observe(float64(domain.DroneTotalDistance(nil, droneRoutes)))
+	}
+	return err
+}
+
+func (r *estateRepository) CreateTreeAndUpdateDroneRoute(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, droneRouteAltitude int, tree *domain.Tree, outbox []domain.Event) (change domain.DroneDistanceChange, err error) {
+	defer r.observe("CreateTreeAndUpdateDroneRoute", time.Now(), &err)
+	change, err = r.next.CreateTreeAndUpdateDroneRoute(ctx, tenantID, estateID, droneRouteAltitude, tree, outbox)
+	if err == nil {
+		r.metrics.treesCreated.Inc()
+	}
+	return change, err
+}
+
+func (r *estateRepository) GetEstateAndStats(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID) (estate *domain.Estate, stats *domain.EstateStats, err error) {
+	defer r.observe("GetEstateAndStats", time.Now(), &err)
+	return r.next.GetEstateAndStats(ctx, tenantID, estateID)
+}
+
+func (r *estateRepository) GetDroneRoutes(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID) (routes []domain.DroneRoute, err error) {
+	defer r.observe("GetDroneRoutes", time.Now(), &err)
+	return r.next.GetDroneRoutes(ctx, tenantID, estateID)
+}
+
+func (r *estateRepository) GetEstateAndTree(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, plot domain.Plot) (estate *domain.Estate, tree *domain.Tree, err error) {
+	defer r.observe("GetEstateAndTree", time.Now(), &err)
+	return r.next.GetEstateAndTree(ctx, tenantID, estateID, plot)
+}
+
+func (r *estateRepository) GetTree(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, treeID uuid.UUID) (tree *domain.Tree, err error) {
+	defer r.observe("GetTree", time.Now(), &err)
+	return r.next.GetTree(ctx, tenantID, estateID, treeID)
+}
+
+func (r *estateRepository) ListEstates(ctx context.Context, tenantID uuid.UUID, limit int, offset int) (estates []domain.Estate, err error) {
+	defer r.observe("ListEstates", time.Now(), &err)
+	return r.next.ListEstates(ctx, tenantID, limit, offset)
+}
+
+func (r *estateRepository) ListTrees(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID) (trees []domain.Tree, err error) {
+	defer r.observe("ListTrees", time.Now(), &err)
+	return r.next.ListTrees(ctx, tenantID, estateID)
+}
+
+func (r *estateRepository) DeleteEstate(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, outbox []domain.Event) (err error) {
+	defer r.observe("DeleteEstate", time.Now(), &err)
+	return r.next.DeleteEstate(ctx, tenantID, estateID, outbox)
+}
+
+func (r *estateRepository) ResizeEstateAndDroneRoute(ctx context.Context, tenantID uuid.UUID, estate *domain.Estate, expectedVersion int, droneRoutes []domain.DroneRoute, outbox []domain.Event) (change domain.DroneDistanceChange, err error) {
+	defer r.observe("ResizeEstateAndDroneRoute", time.Now(), &err)
+	change, err = r.next.ResizeEstateAndDroneRoute(ctx, tenantID, estate, expectedVersion, droneRoutes, outbox)
+	if err == nil {
+		r.metrics.droneRouteDistance.Observe(float64(domain.DroneTotalDistance(nil, droneRoutes)))
+	}
+	return change, err
+}
+
+func (r *estateRepository) UpdateTreeAndDroneRoute(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, tree *domain.Tree, expectedVersion int, outbox []domain.Event) (change domain.DroneDistanceChange, err error) {
+	defer r.observe("UpdateTreeAndDroneRoute", time.Now(), &err)
+	return r.next.UpdateTreeAndDroneRoute(ctx, tenantID, estateID, tree, expectedVersion, outbox)
+}
+
+func (r *estateRepository) ListTreesByEstates(ctx context.Context, tenantID uuid.UUID, estateIDs []uuid.UUID) (trees map[uuid.UUID][]domain.Tree, err error) {
+	defer r.observe("ListTreesByEstates", time.Now(), &err)
+	return r.next.ListTreesByEstates(ctx, tenantID, estateIDs)
+}
+
+func (r *estateRepository) GetEstatesStats(ctx context.Context, tenantID uuid.UUID, estateIDs []uuid.UUID) (stats map[uuid.UUID]domain.EstateStats, err error) {
+	defer r.observe("GetEstatesStats", time.Now(), &err)
+	return r.next.GetEstatesStats(ctx, tenantID, estateIDs)
+}
+
+func (r *estateRepository) GetDroneRoutesByEstates(ctx context.Context, tenantID uuid.UUID, estateIDs []uuid.UUID) (routes map[uuid.UUID][]domain.DroneRoute, err error) {
+	defer r.observe("GetDroneRoutesByEstates", time.Now(), &err)
+	return r.next.GetDroneRoutesByEstates(ctx, tenantID, estateIDs)
+}
+
+func (r *estateRepository) ListTreeMeasurements(ctx context.Context, tenantID uuid.UUID, estateIDs []uuid.UUID, treeIDs []uuid.UUID) (measurements map[uuid.UUID][]domain.TreeMeasurement, err error) {
+	defer r.observe("ListTreeMeasurements", time.Now(), &err)
+	return r.next.ListTreeMeasurements(ctx, tenantID, estateIDs, treeIDs)
+}
+
+func (r *estateRepository) GetEstateSnapshot(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID) (snapshot *domain.EstateSnapshot, err error) {
+	defer r.observe("GetEstateSnapshot", time.Now(), &err)
+	return r.next.GetEstateSnapshot(ctx, tenantID, estateID)
+}
+
+// ImportEstate count the imported estate and trees as created
+func (r *estateRepository) ImportEstate(ctx context.Context, snapshot *domain.EstateSnapshot, droneRoutes []domain.DroneRoute, outbox []domain.Event) (err error) {
+	defer r.observe("ImportEstate", time.Now(), &err)
+	err = r.next.ImportEstate(ctx, snapshot, droneRoutes, outbox)
+	if err == nil {
+		r.metrics.estatesCreated.Inc()
+		r.metrics.treesCreated.Add(float64(len(snapshot.Trees)))
+		r.metrics.droneRouteDistance.Observe(float64(domain.DroneTotalDistance(nil, droneRoutes)))
+	}
+	return err
+}
+
+func (r *estateRepository) DeleteTreeAndDroneRoute(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, tree *domain.Tree, outbox []domain.Event) (change domain.DroneDistanceChange, err error) {
+	defer r.observe("DeleteTreeAndDroneRoute", time.Now(), &err)
+	return r.next.DeleteTreeAndDroneRoute(ctx, tenantID, estateID, tree, outbox)
+}
+
+// PromoteSandbox count the trees planted on the source estate as created
+func (r *estateRepository) PromoteSandbox(ctx context.Context, tenantID uuid.UUID, sandbox *domain.Estate, changes domain.TreeChanges, outbox []domain.Event) (change domain.DroneDistanceChange, err error) {
+	defer r.observe("PromoteSandbox", time.Now(), &err)
+	change, err = r.next.PromoteSandbox(ctx, tenantID, sandbox, changes, outbox)
+	if err == nil {
+		r.metrics.treesCreated.Add(float64(len(changes.Planted)))
+	}
+	return change, err
+}
+
+func (r *estateRepository) CreateMission(ctx context.Context, tenantID uuid.UUID, mission *domain.Mission) (err error) {
+	defer r.observe("CreateMission", time.Now(), &err)
+	return r.next.CreateMission(ctx, tenantID, mission)
+}
+
+func (r *estateRepository) ListMissions(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, status *domain.MissionStatus, limit int, offset int) (missions []domain.Mission, err error) {
+	defer r.observe("ListMissions", time.Now(), &err)
+	return r.next.ListMissions(ctx, tenantID, estateID, status, limit, offset)
+}
+
+func (r *estateRepository) GetMission(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, missionID uuid.UUID) (mission *domain.Mission, err error) {
+	defer r.observe("GetMission", time.Now(), &err)
+	return r.next.GetMission(ctx, tenantID, estateID, missionID)
+}
+
+func (r *estateRepository) UpdateMission(ctx context.Context, tenantID uuid.UUID, mission *domain.Mission, expectedStatus domain.MissionStatus) (err error) {
+	defer r.observe("UpdateMission", time.Now(), &err)
+	return r.next.UpdateMission(ctx, tenantID, mission, expectedStatus)
+}
+
+func (r *estateRepository) ReplaceMissionTelemetry(ctx context.Context, tenantID uuid.UUID, mission *domain.Mission, samples []domain.TelemetrySample) (err error) {
+	defer r.observe("ReplaceMissionTelemetry", time.Now(), &err)
+	return r.next.ReplaceMissionTelemetry(ctx, tenantID, mission, samples)
+}
+
+func (r *estateRepository) GetMissionTelemetry(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, missionID uuid.UUID) (samples []domain.TelemetrySample, err error) {
+	defer r.observe("GetMissionTelemetry", time.Now(), &err)
+	return r.next.GetMissionTelemetry(ctx, tenantID, estateID, missionID)
+}
+
+func (r *estateRepository) ReplaceChargingPads(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, pads []domain.Plot) (err error) {
+	defer r.observe("ReplaceChargingPads", time.Now(), &err)
+	return r.next.ReplaceChargingPads(ctx, tenantID, estateID, pads)
+}
+
+func (r *estateRepository) ListChargingPads(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID) (pads []domain.Plot, err error) {
+	defer r.observe("ListChargingPads", time.Now(), &err)
+	return r.next.ListChargingPads(ctx, tenantID, estateID)
+}
@@ -0,0 +1,204 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_estateRepository_CreateEstateAndDroneRoute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	m := New()
+	repo := NewEstateRepository(mockRepo, m)
+	ctx := context.Background()
+	estate := &domain.Estate{ID: uuid.New(), Width: 1, Length: 2}
+	droneRoutes := domain.DroneZigzagTraverse(1, 2)
+
+	mockRepo.EXPECT().CreateEstateAndDroneRoute(ctx, estate, droneRoutes, nil).Return(nil)
+	assert.NoError(t, repo.CreateEstateAndDroneRoute(ctx, estate, droneRoutes, nil))
+
+	mockRepo.EXPECT().CreateEstateAndDroneRoute(ctx, estate, droneRoutes, nil).Return(errors.New("insert error"))
+	assert.Error(t, repo.CreateEstateAndDroneRoute(ctx, estate, droneRoutes, nil))
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.estatesCreated))
+	assert.Equal(t, 1, testutil.CollectAndCount(m.droneRouteDistance))
+	assert.Equal(t, 2, testutil.CollectAndCount(m.repositoryDuration, "estate_service_repository_query_duration_seconds"))
+	assert.Equal(t, uint64(1), histogramCount(t, m, "CreateEstateAndDroneRoute", "success"))
+	assert.Equal(t, uint64(1), histogramCount(t, m, "CreateEstateAndDroneRoute", "error"))
+}
+
+func Test_estateRepository_CreateTreeAndUpdateDroneRoute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	m := New()
+	repo := NewEstateRepository(mockRepo, m)
+	ctx := context.Background()
+	tenantID := uuid.New()
+	estateID := uuid.New()
+	tree := &domain.Tree{ID: uuid.New(), Plot: domain.Plot{Row: 1, Col: 1}, Height: 10}
+
+	change := domain.DroneDistanceChange{Previous: 20, Current: 40}
+	mockRepo.EXPECT().CreateTreeAndUpdateDroneRoute(ctx, tenantID, estateID, 11, tree, nil).Return(change, nil)
+	got, err := repo.CreateTreeAndUpdateDroneRoute(ctx, tenantID, estateID, 11, tree, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, change, got)
+
+	mockRepo.EXPECT().CreateTreeAndUpdateDroneRoute(ctx, tenantID, estateID, 11, tree, nil).Return(domain.DroneDistanceChange{}, domain.ErrorTreeAlreadyExists)
+	_, err = repo.CreateTreeAndUpdateDroneRoute(ctx, tenantID, estateID, 11, tree, nil)
+	assert.Equal(t, domain.ErrorTreeAlreadyExists, err)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.treesCreated))
+	assert.Equal(t, uint64(1), histogramCount(t, m, "CreateTreeAndUpdateDroneRoute", "error"))
+}
+
+func Test_estateRepository_ImportEstate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	m := New()
+	repo := NewEstateRepository(mockRepo, m)
+	ctx := context.Background()
+	snapshot := &domain.EstateSnapshot{
+		Estate: domain.Estate{ID: uuid.New(), Width: 1, Length: 2},
+		Trees:  []domain.Tree{{ID: uuid.New(), Plot: domain.Plot{Row: 1, Col: 1}, Height: 10}, {ID: uuid.New(), Plot: domain.Plot{Row: 1, Col: 2}, Height: 5}},
+	}
+	droneRoutes := domain.DroneRoutesOverTrees(1, 2, snapshot.Trees)
+
+	mockRepo.EXPECT().ImportEstate(ctx, snapshot, droneRoutes, nil).Return(nil)
+	assert.NoError(t, repo.ImportEstate(ctx, snapshot, droneRoutes, nil))
+
+	mockRepo.EXPECT().ImportEstate(ctx, snapshot, droneRoutes, nil).Return(domain.ErrorSnapshotConflict)
+	assert.Equal(t, domain.ErrorSnapshotConflict, repo.ImportEstate(ctx, snapshot, droneRoutes, nil))
+
+	// an imported estate counts as created along with its trees
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.estatesCreated))
+	assert.Equal(t, float64(2), testutil.ToFloat64(m.treesCreated))
+	assert.Equal(t, 1, testutil.CollectAndCount(m.droneRouteDistance))
+	assert.Equal(t, uint64(1), histogramCount(t, m, "ImportEstate", "success"))
+	assert.Equal(t, uint64(1), histogramCount(t, m, "ImportEstate", "error"))
+}
+
+func Test_estateRepository_PromoteSandbox(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	m := New()
+	repo := NewEstateRepository(mockRepo, m)
+	ctx := context.Background()
+	tenantID := uuid.New()
+	sandbox := &domain.Estate{ID: uuid.New()}
+	changes := domain.TreeChanges{
+		Planted: []domain.Tree{{ID: uuid.New()}, {ID: uuid.New()}},
+		Removed: []domain.Tree{{ID: uuid.New()}},
+	}
+
+	mockRepo.EXPECT().PromoteSandbox(ctx, tenantID, sandbox, changes, nil).Return(domain.DroneDistanceChange{}, nil)
+	_, err := repo.PromoteSandbox(ctx, tenantID, sandbox, changes, nil)
+	assert.NoError(t, err)
+
+	mockRepo.EXPECT().PromoteSandbox(ctx, tenantID, sandbox, changes, nil).Return(domain.DroneDistanceChange{}, domain.ErrorSandboxStale)
+	_, err = repo.PromoteSandbox(ctx, tenantID, sandbox, changes, nil)
+	assert.Equal(t, domain.ErrorSandboxStale, err)
+
+	// trees planted on the source count as created, the sandbox ones already were
+	assert.Equal(t, float64(2), testutil.ToFloat64(m.treesCreated))
+	assert.Equal(t, uint64(1), histogramCount(t, m, "PromoteSandbox", "success"))
+	assert.Equal(t, uint64(1), histogramCount(t, m, "PromoteSandbox", "error"))
+}
+
+func Test_estateRepository_Queries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	m := New()
+	repo := NewEstateRepository(mockRepo, m)
+	ctx := context.Background()
+	tenantID := uuid.New()
+	estateID := uuid.New()
+	routes := []domain.DroneRoute{{Route: 1, Plot: domain.Plot{Row: 1, Col: 1}, Altitude: 1}}
+
+	mockRepo.EXPECT().GetDroneRoutes(ctx, tenantID, estateID).Return(routes, nil)
+	got, err := repo.GetDroneRoutes(ctx, tenantID, estateID)
+	assert.NoError(t, err)
+	assert.Equal(t, routes, got)
+
+	mockRepo.EXPECT().GetEstateAndStats(ctx, tenantID, estateID).Return(nil, nil, errors.New("query error"))
+	_, _, err = repo.GetEstateAndStats(ctx, tenantID, estateID)
+	assert.Error(t, err)
+
+	mockRepo.EXPECT().ListEstates(ctx, tenantID, 10, 0).Return([]domain.Estate{}, nil)
+	_, err = repo.ListEstates(ctx, tenantID, 10, 0)
+	assert.NoError(t, err)
+
+	mockRepo.EXPECT().ListTrees(ctx, tenantID, estateID).Return([]domain.Tree{}, nil)
+	_, err = repo.ListTrees(ctx, tenantID, estateID)
+	assert.NoError(t, err)
+
+	mockRepo.EXPECT().DeleteEstate(ctx, tenantID, estateID, nil).Return(domain.ErrorEstatesNotFound)
+	assert.Equal(t, domain.ErrorEstatesNotFound, repo.DeleteEstate(ctx, tenantID, estateID, nil))
+
+	estateIDs := []uuid.UUID{estateID}
+	mockRepo.EXPECT().ListTreesByEstates(ctx, tenantID, estateIDs).Return(map[uuid.UUID][]domain.Tree{}, nil)
+	_, err = repo.ListTreesByEstates(ctx, tenantID, estateIDs)
+	assert.NoError(t, err)
+
+	mockRepo.EXPECT().ListTreeMeasurements(ctx, tenantID, estateIDs, nil).Return(nil, errors.New("query error"))
+	_, err = repo.ListTreeMeasurements(ctx, tenantID, estateIDs, nil)
+	assert.Error(t, err)
+
+	mockRepo.EXPECT().GetEstateSnapshot(ctx, tenantID, estateID).Return(nil, nil)
+	_, err = repo.GetEstateSnapshot(ctx, tenantID, estateID)
+	assert.NoError(t, err)
+
+	assert.Equal(t, uint64(1), histogramCount(t, m, "GetDroneRoutes", "success"))
+	assert.Equal(t, uint64(1), histogramCount(t, m, "GetEstateAndStats", "error"))
+	assert.Equal(t, uint64(1), histogramCount(t, m, "ListEstates", "success"))
+	assert.Equal(t, uint64(1), histogramCount(t, m, "ListTrees", "success"))
+	assert.Equal(t, uint64(1), histogramCount(t, m, "DeleteEstate", "error"))
+	assert.Equal(t, uint64(1), histogramCount(t, m, "ListTreesByEstates", "success"))
+	assert.Equal(t, uint64(1), histogramCount(t, m, "ListTreeMeasurements", "error"))
+	assert.Equal(t, uint64(1), histogramCount(t, m, "GetEstateSnapshot", "success"))
+}
+
+// histogramCount return the number of observed repository calls of method with outcome
+func histogramCount(t *testing.T, m *metrics, method string, outcome string) uint64 {
+	return sampleCount(t, m, "estate_service_repository_query_duration_seconds", map[string]string{"method": method, "outcome": outcome})
+}
+
+// sampleCount return the sample count of the histogram name whose labels are exactly labels
+func sampleCount(t *testing.T, m *metrics, name string, labels map[string]string) uint64 {
+	families, err := m.registry.Gather()
+	assert.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			got := map[string]string{}
+			for _, label := range metric.GetLabel() {
+				got[label.GetName()] = label.GetValue()
+			}
+			if reflect.DeepEqual(labels, got) {
+				return metric.GetHistogram().GetSampleCount()
+			}
+		}
+	}
+	return 0
+}
@@ -0,0 +1,36 @@
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// unmatchedRoute label requests without route, so unknown paths do not create new series
+const unmatchedRoute = "unmatched"
+
+// HTTPMiddleware observe request duration per route pattern. It must be the first middleware,
+// errors are rendered here through the echo error handler so their status code is known
+func (m *metrics) HTTPMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			start := time.Now()
+
+			err := next(ctx)
+			if err != nil {
+				ctx.Error(err)
+			}
+
+			route := ctx.Path()
+			if route == "" || route == "/*" {
+				route = unmatchedRoute
+			}
+
+			m.httpDuration.
+				WithLabelValues(ctx.Request().Method, route, strconv.Itoa(ctx.Response().Status)).
+				Observe(time.Since(start).Seconds())
+			return nil
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPMiddleware(t *testing.T) {
+	m := New()
+	e := echo.New()
+	e.Use(m.HTTPMiddleware())
+	e.GET("/estate/:id", func(ctx echo.Context) error {
+		if ctx.Param("id") == "missing" {
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+		return ctx.NoContent(http.StatusOK)
+	})
+
+	for _, path := range []string{"/estate/1", "/estate/2", "/estate/missing", "/unknown"} {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	name := "estate_service_http_request_duration_seconds"
+	assert.Equal(t, 3, testutil.CollectAndCount(m.httpDuration))
+	assert.Equal(t, uint64(2), sampleCount(t, m, name, map[string]string{"method": "GET", "route": "/estate/:id", "status": "200"}))
+	assert.Equal(t, uint64(1), sampleCount(t, m, name, map[string]string{"method": "GET", "route": "/estate/:id", "status": "404"}))
+	assert.Equal(t, uint64(1), sampleCount(t, m, name, map[string]string{"method": "GET", "route": unmatchedRoute, "status": "404"}))
+}
+
+func TestHandler(t *testing.T) {
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	m := New(WithDBStats(db, "estates"))
+	m.estatesCreated.Inc()
+
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "estate_service_estates_created_total 1")
+	assert.Contains(t, rec.Body.String(), "go_goroutines")
+	assert.Contains(t, rec.Body.String(), `go_sql_open_connections{db_name="estates"}`)
+}
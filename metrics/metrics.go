@@ -0,0 +1,96 @@
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "estate_service"
+
+// metrics hold every collector of the service in its own registry, so tests can create them independently
+type metrics struct {
+	registry           *prometheus.Registry
+	httpDuration       *prometheus.HistogramVec
+	repositoryDuration *prometheus.HistogramVec
+	estatesCreated     prometheus.Counter
+	treesCreated       prometheus.Counter
+	droneRouteDistance prometheus.Histogram
+}
+
+type MetricsOptions func(*metrics)
+
+// WithDBStats export connection pool stats of db from sql.DB.Stats()
+func WithDBStats(db *sql.DB, dbName string) MetricsOptions {
+	return func(m *metrics) {
+		m.registry.MustRegister(collectors.NewDBStatsCollector(db, dbName))
+	}
+}
+
+func New(opts ...MetricsOptions) *metrics {
+	m := &metrics{
+		registry: prometheus.NewRegistry(),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "Duration of HTTP requests by method, route and status code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		repositoryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "repository_query_duration_seconds",
+			Help:      "Duration of repository calls by method and outcome.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "outcome"}),
+		estatesCreated: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "estates_created_total",
+			Help:      "Number of estates created.",
+		}),
+		treesCreated: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "trees_created_total",
+			Help:      "Number of trees planted.",
+		}),
+		droneRouteDistance: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "drone_route_distance_meters",
+			Help:      "Total drone route distance of estates when they are created or resized.",
+			Buckets:   prometheus.ExponentialBuckets(100, 4, 10),
+		}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpDuration,
+		m.repositoryDuration,
+		m.estatesCreated,
+		m.treesCreated,
+		m.droneRouteDistance,
+	)
+
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// Handler serve the registry in prometheus text format
+func (m *metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// observeRepository record duration of a repository call since start, labeled by its outcome.
+// err is a pointer to the named result since it is only known once the deferred call runs
+func (m *metrics) observeRepository(method string, start time.Time, err *error) {
+	outcome := "success"
+	if *err != nil {
+		outcome = "error"
+	}
+	m.repositoryDuration.WithLabelValues(method, outcome).Observe(time.Since(start).Seconds())
+}
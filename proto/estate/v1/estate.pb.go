@@ -0,0 +1,946 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v4.25.0
+// source: estate/v1/estate.proto
+
+package estatev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateEstateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// width is the number of rows, from 1 to 50000
+	Width int32 `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
+	// length is the number of columns, from 1 to 50000
+	Length int32 `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+}
+
+func (x *CreateEstateRequest) Reset() {
+	*x = CreateEstateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_estate_v1_estate_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateEstateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEstateRequest) ProtoMessage() {}
+
+func (x *CreateEstateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_estate_v1_estate_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEstateRequest.ProtoReflect.Descriptor instead.
+func (*CreateEstateRequest) Descriptor() ([]byte, []int) {
+	return file_estate_v1_estate_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateEstateRequest) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *CreateEstateRequest) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+type Estate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Width   int32  `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
+	Length  int32  `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+	Version int32  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *Estate) Reset() {
+	*x = Estate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_estate_v1_estate_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Estate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Estate) ProtoMessage() {}
+
+func (x *Estate) ProtoReflect() protoreflect.Message {
+	mi := &file_estate_v1_estate_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Estate.ProtoReflect.Descriptor instead.
+func (*Estate) Descriptor() ([]byte, []int) {
+	return file_estate_v1_estate_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Estate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Estate) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Estate) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *Estate) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type CreateTreeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EstateId string `protobuf:"bytes,1,opt,name=estate_id,json=estateId,proto3" json:"estate_id,omitempty"`
+	// x is the column of the plot, from 1 to the estate length
+	X int32 `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	// y is the row of the plot, from 1 to the estate width
+	Y int32 `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+	// height is from 1 to 30
+	Height int32 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *CreateTreeRequest) Reset() {
+	*x = CreateTreeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_estate_v1_estate_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTreeRequest) ProtoMessage() {}
+
+func (x *CreateTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_estate_v1_estate_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTreeRequest.ProtoReflect.Descriptor instead.
+func (*CreateTreeRequest) Descriptor() ([]byte, []int) {
+	return file_estate_v1_estate_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateTreeRequest) GetEstateId() string {
+	if x != nil {
+		return x.EstateId
+	}
+	return ""
+}
+
+func (x *CreateTreeRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *CreateTreeRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *CreateTreeRequest) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type Tree struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EstateId string `protobuf:"bytes,2,opt,name=estate_id,json=estateId,proto3" json:"estate_id,omitempty"`
+	X        int32  `protobuf:"varint,3,opt,name=x,proto3" json:"x,omitempty"`
+	Y        int32  `protobuf:"varint,4,opt,name=y,proto3" json:"y,omitempty"`
+	Height   int32  `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Version  int32  `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *Tree) Reset() {
+	*x = Tree{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_estate_v1_estate_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tree) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tree) ProtoMessage() {}
+
+func (x *Tree) ProtoReflect() protoreflect.Message {
+	mi := &file_estate_v1_estate_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tree.ProtoReflect.Descriptor instead.
+func (*Tree) Descriptor() ([]byte, []int) {
+	return file_estate_v1_estate_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Tree) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Tree) GetEstateId() string {
+	if x != nil {
+		return x.EstateId
+	}
+	return ""
+}
+
+func (x *Tree) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Tree) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *Tree) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Tree) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type GetEstateStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EstateId string `protobuf:"bytes,1,opt,name=estate_id,json=estateId,proto3" json:"estate_id,omitempty"`
+}
+
+func (x *GetEstateStatsRequest) Reset() {
+	*x = GetEstateStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_estate_v1_estate_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEstateStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEstateStatsRequest) ProtoMessage() {}
+
+func (x *GetEstateStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_estate_v1_estate_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEstateStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetEstateStatsRequest) Descriptor() ([]byte, []int) {
+	return file_estate_v1_estate_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetEstateStatsRequest) GetEstateId() string {
+	if x != nil {
+		return x.EstateId
+	}
+	return ""
+}
+
+// EstateStats are all zero when the estate has no tree
+type EstateStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count  int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Max    int32 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+	Min    int32 `protobuf:"varint,3,opt,name=min,proto3" json:"min,omitempty"`
+	Median int32 `protobuf:"varint,4,opt,name=median,proto3" json:"median,omitempty"`
+}
+
+func (x *EstateStats) Reset() {
+	*x = EstateStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_estate_v1_estate_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstateStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstateStats) ProtoMessage() {}
+
+func (x *EstateStats) ProtoReflect() protoreflect.Message {
+	mi := &file_estate_v1_estate_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstateStats.ProtoReflect.Descriptor instead.
+func (*EstateStats) Descriptor() ([]byte, []int) {
+	return file_estate_v1_estate_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EstateStats) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *EstateStats) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *EstateStats) GetMin() int32 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *EstateStats) GetMedian() int32 {
+	if x != nil {
+		return x.Median
+	}
+	return 0
+}
+
+type GetDroneDistanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EstateId string `protobuf:"bytes,1,opt,name=estate_id,json=estateId,proto3" json:"estate_id,omitempty"`
+	// max_distance is the distance the drone can fly, unlimited when unset
+	MaxDistance *int32 `protobuf:"varint,2,opt,name=max_distance,json=maxDistance,proto3,oneof" json:"max_distance,omitempty"`
+}
+
+func (x *GetDroneDistanceRequest) Reset() {
+	*x = GetDroneDistanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_estate_v1_estate_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDroneDistanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDroneDistanceRequest) ProtoMessage() {}
+
+func (x *GetDroneDistanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_estate_v1_estate_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDroneDistanceRequest.ProtoReflect.Descriptor instead.
+func (*GetDroneDistanceRequest) Descriptor() ([]byte, []int) {
+	return file_estate_v1_estate_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetDroneDistanceRequest) GetEstateId() string {
+	if x != nil {
+		return x.EstateId
+	}
+	return ""
+}
+
+func (x *GetDroneDistanceRequest) GetMaxDistance() int32 {
+	if x != nil && x.MaxDistance != nil {
+		return *x.MaxDistance
+	}
+	return 0
+}
+
+type DroneDistance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Distance int32 `protobuf:"varint,1,opt,name=distance,proto3" json:"distance,omitempty"`
+}
+
+func (x *DroneDistance) Reset() {
+	*x = DroneDistance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_estate_v1_estate_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DroneDistance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DroneDistance) ProtoMessage() {}
+
+func (x *DroneDistance) ProtoReflect() protoreflect.Message {
+	mi := &file_estate_v1_estate_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DroneDistance.ProtoReflect.Descriptor instead.
+func (*DroneDistance) Descriptor() ([]byte, []int) {
+	return file_estate_v1_estate_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DroneDistance) GetDistance() int32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+type StreamDroneWaypointsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EstateId string `protobuf:"bytes,1,opt,name=estate_id,json=estateId,proto3" json:"estate_id,omitempty"`
+}
+
+func (x *StreamDroneWaypointsRequest) Reset() {
+	*x = StreamDroneWaypointsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_estate_v1_estate_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamDroneWaypointsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamDroneWaypointsRequest) ProtoMessage() {}
+
+func (x *StreamDroneWaypointsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_estate_v1_estate_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamDroneWaypointsRequest.ProtoReflect.Descriptor instead.
+func (*StreamDroneWaypointsRequest) Descriptor() ([]byte, []int) {
+	return file_estate_v1_estate_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StreamDroneWaypointsRequest) GetEstateId() string {
+	if x != nil {
+		return x.EstateId
+	}
+	return ""
+}
+
+type DroneWaypoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sequence is the position of the waypoint in the route, starting at 1
+	Sequence int32 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	X        int32 `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y        int32 `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+	// altitude the drone flies at over the plot, one above its tree
+	Altitude int32 `protobuf:"varint,4,opt,name=altitude,proto3" json:"altitude,omitempty"`
+	// distance flown from takeoff until reaching the waypoint at its altitude
+	Distance int32 `protobuf:"varint,5,opt,name=distance,proto3" json:"distance,omitempty"`
+}
+
+func (x *DroneWaypoint) Reset() {
+	*x = DroneWaypoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_estate_v1_estate_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DroneWaypoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DroneWaypoint) ProtoMessage() {}
+
+func (x *DroneWaypoint) ProtoReflect() protoreflect.Message {
+	mi := &file_estate_v1_estate_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DroneWaypoint.ProtoReflect.Descriptor instead.
+func (*DroneWaypoint) Descriptor() ([]byte, []int) {
+	return file_estate_v1_estate_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DroneWaypoint) GetSequence() int32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *DroneWaypoint) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *DroneWaypoint) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *DroneWaypoint) GetAltitude() int32 {
+	if x != nil {
+		return x.Altitude
+	}
+	return 0
+}
+
+func (x *DroneWaypoint) GetDistance() int32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+var File_estate_v1_estate_proto protoreflect.FileDescriptor
+
+var file_estate_v1_estate_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x65, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x65, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x2e, 0x76, 0x31, 0x22, 0x43, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69,
+	0x64, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x60, 0x0a, 0x06, 0x45, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x64, 0x0a, 0x11, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x65, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x65, 0x73, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x0c, 0x0a, 0x01,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0x81, 0x01, 0x0a, 0x04, 0x54, 0x72, 0x65, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x01, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x34, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x45, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x65, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x65, 0x73, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x0b, 0x45, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x61,
+	0x78, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x6d, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x22, 0x6f, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x44, 0x72, 0x6f, 0x6e, 0x65, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0b, 0x6d, 0x61, 0x78,
+	0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x6d, 0x61, 0x78, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x2b, 0x0a, 0x0d,
+	0x44, 0x72, 0x6f, 0x6e, 0x65, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x3a, 0x0a, 0x1b, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x44, 0x72, 0x6f, 0x6e, 0x65, 0x57, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0x7f, 0x0a, 0x0d, 0x44, 0x72, 0x6f, 0x6e, 0x65, 0x57, 0x61,
+	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78,
+	0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x12, 0x1a,
+	0x0a, 0x08, 0x61, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x61, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x32, 0x89, 0x03, 0x0a, 0x0d, 0x45, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x45, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x65, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x65, 0x65, 0x12, 0x1c, 0x2e, 0x65, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x65, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x65, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x12, 0x4a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x65, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x65,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x74, 0x61, 0x74, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x50, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x44, 0x72, 0x6f, 0x6e, 0x65,
+	0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x2e, 0x65, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x72, 0x6f, 0x6e, 0x65, 0x44, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x65,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x6f, 0x6e, 0x65, 0x44, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x5a, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x44, 0x72, 0x6f, 0x6e, 0x65, 0x57, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x26,
+	0x2e, 0x65, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x44, 0x72, 0x6f, 0x6e, 0x65, 0x57, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x65, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x72, 0x6f, 0x6e, 0x65, 0x57, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x30, 0x01, 0x42, 0x47, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x53, 0x61, 0x77, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x52, 0x65, 0x63, 0x72, 0x75, 0x69, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x45, 0x73, 0x74, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2f,
+	0x76, 0x31, 0x3b, 0x65, 0x73, 0x74, 0x61, 0x74, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_estate_v1_estate_proto_rawDescOnce sync.Once
+	file_estate_v1_estate_proto_rawDescData = file_estate_v1_estate_proto_rawDesc
+)
+
+func file_estate_v1_estate_proto_rawDescGZIP() []byte {
+	file_estate_v1_estate_proto_rawDescOnce.Do(func() {
+		file_estate_v1_estate_proto_rawDescData = protoimpl.X.CompressGZIP(file_estate_v1_estate_proto_rawDescData)
+	})
+	return file_estate_v1_estate_proto_rawDescData
+}
+
+var file_estate_v1_estate_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_estate_v1_estate_proto_goTypes = []interface{}{
+	(*CreateEstateRequest)(nil),         // 0: estate.v1.CreateEstateRequest
+	(*Estate)(nil),                      // 1: estate.v1.Estate
+	(*CreateTreeRequest)(nil),           // 2: estate.v1.CreateTreeRequest
+	(*Tree)(nil),                        // 3: estate.v1.Tree
+	(*GetEstateStatsRequest)(nil),       // 4: estate.v1.GetEstateStatsRequest
+	(*EstateStats)(nil),                 // 5: estate.v1.EstateStats
+	(*GetDroneDistanceRequest)(nil),     // 6: estate.v1.GetDroneDistanceRequest
+	(*DroneDistance)(nil),               // 7: estate.v1.DroneDistance
+	(*StreamDroneWaypointsRequest)(nil), // 8: estate.v1.StreamDroneWaypointsRequest
+	(*DroneWaypoint)(nil),               // 9: estate.v1.DroneWaypoint
+}
+var file_estate_v1_estate_proto_depIdxs = []int32{
+	0, // 0: estate.v1.EstateService.CreateEstate:input_type -> estate.v1.CreateEstateRequest
+	2, // 1: estate.v1.EstateService.CreateTree:input_type -> estate.v1.CreateTreeRequest
+	4, // 2: estate.v1.EstateService.GetEstateStats:input_type -> estate.v1.GetEstateStatsRequest
+	6, // 3: estate.v1.EstateService.GetDroneDistance:input_type -> estate.v1.GetDroneDistanceRequest
+	8, // 4: estate.v1.EstateService.StreamDroneWaypoints:input_type -> estate.v1.StreamDroneWaypointsRequest
+	1, // 5: estate.v1.EstateService.CreateEstate:output_type -> estate.v1.Estate
+	3, // 6: estate.v1.EstateService.CreateTree:output_type -> estate.v1.Tree
+	5, // 7: estate.v1.EstateService.GetEstateStats:output_type -> estate.v1.EstateStats
+	7, // 8: estate.v1.EstateService.GetDroneDistance:output_type -> estate.v1.DroneDistance
+	9, // 9: estate.v1.EstateService.StreamDroneWaypoints:output_type -> estate.v1.DroneWaypoint
+	5, // [5:10] is the sub-list for method output_type
+	0, // [0:5] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_estate_v1_estate_proto_init() }
+func file_estate_v1_estate_proto_init() {
+	if File_estate_v1_estate_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_estate_v1_estate_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateEstateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_estate_v1_estate_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Estate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_estate_v1_estate_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTreeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_estate_v1_estate_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tree); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_estate_v1_estate_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEstateStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_estate_v1_estate_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstateStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_estate_v1_estate_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDroneDistanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_estate_v1_estate_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DroneDistance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_estate_v1_estate_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamDroneWaypointsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_estate_v1_estate_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DroneWaypoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_estate_v1_estate_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_estate_v1_estate_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_estate_v1_estate_proto_goTypes,
+		DependencyIndexes: file_estate_v1_estate_proto_depIdxs,
+		MessageInfos:      file_estate_v1_estate_proto_msgTypes,
+	}.Build()
+	File_estate_v1_estate_proto = out.File
+	file_estate_v1_estate_proto_rawDesc = nil
+	file_estate_v1_estate_proto_goTypes = nil
+	file_estate_v1_estate_proto_depIdxs = nil
+}
@@ -0,0 +1,295 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.0
+// source: estate/v1/estate.proto
+
+package estatev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	EstateService_CreateEstate_FullMethodName         = "/estate.v1.EstateService/CreateEstate"
+	EstateService_CreateTree_FullMethodName           = "/estate.v1.EstateService/CreateTree"
+	EstateService_GetEstateStats_FullMethodName       = "/estate.v1.EstateService/GetEstateStats"
+	EstateService_GetDroneDistance_FullMethodName     = "/estate.v1.EstateService/GetDroneDistance"
+	EstateService_StreamDroneWaypoints_FullMethodName = "/estate.v1.EstateService/StreamDroneWaypoints"
+)
+
+// EstateServiceClient is the client API for EstateService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EstateServiceClient interface {
+	// CreateEstate create an estate of width by length plots, requires an admin key
+	CreateEstate(ctx context.Context, in *CreateEstateRequest, opts ...grpc.CallOption) (*Estate, error)
+	// CreateTree plant a tree on a free plot of the estate, requires a planter key
+	CreateTree(ctx context.Context, in *CreateTreeRequest, opts ...grpc.CallOption) (*Tree, error)
+	// GetEstateStats return count, max, min and median of the tree heights of the estate
+	GetEstateStats(ctx context.Context, in *GetEstateStatsRequest, opts ...grpc.CallOption) (*EstateStats, error)
+	// GetDroneDistance return the distance the drone flies to monitor every plot of the estate
+	GetDroneDistance(ctx context.Context, in *GetDroneDistanceRequest, opts ...grpc.CallOption) (*DroneDistance, error)
+	// StreamDroneWaypoints stream the waypoints of the drone route over the estate in flight order
+	StreamDroneWaypoints(ctx context.Context, in *StreamDroneWaypointsRequest, opts ...grpc.CallOption) (EstateService_StreamDroneWaypointsClient, error)
+}
+
+type estateServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEstateServiceClient(cc grpc.ClientConnInterface) EstateServiceClient {
+	return &estateServiceClient{cc}
+}
+
+func (c *estateServiceClient) CreateEstate(ctx context.Context, in *CreateEstateRequest, opts ...grpc.CallOption) (*Estate, error) {
+	out := new(Estate)
+	err := c.cc.Invoke(ctx, EstateService_CreateEstate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *estateServiceClient) CreateTree(ctx context.Context, in *CreateTreeRequest, opts ...grpc.CallOption) (*Tree, error) {
+	out := new(Tree)
+	err := c.cc.Invoke(ctx, EstateService_CreateTree_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *estateServiceClient) GetEstateStats(ctx context.Context, in *GetEstateStatsRequest, opts ...grpc.CallOption) (*EstateStats, error) {
+	out := new(EstateStats)
+	err := c.cc.Invoke(ctx, EstateService_GetEstateStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *estateServiceClient) GetDroneDistance(ctx context.Context, in *GetDroneDistanceRequest, opts ...grpc.CallOption) (*DroneDistance, error) {
+	out := new(DroneDistance)
+	err := c.cc.Invoke(ctx, EstateService_GetDroneDistance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *estateServiceClient) StreamDroneWaypoints(ctx context.Context, in *StreamDroneWaypointsRequest, opts ...grpc.CallOption) (EstateService_StreamDroneWaypointsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &EstateService_ServiceDesc.Streams[0], EstateService_StreamDroneWaypoints_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &estateServiceStreamDroneWaypointsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EstateService_StreamDroneWaypointsClient interface {
+	Recv() (*DroneWaypoint, error)
+	grpc.ClientStream
+}
+
+type estateServiceStreamDroneWaypointsClient struct {
+	grpc.ClientStream
+}
+
+func (x *estateServiceStreamDroneWaypointsClient) Recv() (*DroneWaypoint, error) {
+	m := new(DroneWaypoint)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// EstateServiceServer is the server API for EstateService service.
+// All implementations must embed UnimplementedEstateServiceServer
+// for forward compatibility
+type EstateServiceServer interface {
+	// CreateEstate create an estate of width by length plots, requires an admin key
+	CreateEstate(context.Context, *CreateEstateRequest) (*Estate, error)
+	// CreateTree plant a tree on a free plot of the estate, requires a planter key
+	CreateTree(context.Context, *CreateTreeRequest) (*Tree, error)
+	// GetEstateStats return count, max, min and median of the tree heights of the estate
+	GetEstateStats(context.Context, *GetEstateStatsRequest) (*EstateStats, error)
+	// GetDroneDistance return the distance the drone flies to monitor every plot of the estate
+	GetDroneDistance(context.Context, *GetDroneDistanceRequest) (*DroneDistance, error)
+	// StreamDroneWaypoints stream the waypoints of the drone route over the estate in flight order
+	StreamDroneWaypoints(*StreamDroneWaypointsRequest, EstateService_StreamDroneWaypointsServer) error
+	mustEmbedUnimplementedEstateServiceServer()
+}
+
+// UnimplementedEstateServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedEstateServiceServer struct {
+}
+
+func (UnimplementedEstateServiceServer) CreateEstate(context.Context, *CreateEstateRequest) (*Estate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEstate not implemented")
+}
+func (UnimplementedEstateServiceServer) CreateTree(context.Context, *CreateTreeRequest) (*Tree, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTree not implemented")
+}
+func (UnimplementedEstateServiceServer) GetEstateStats(context.Context, *GetEstateStatsRequest) (*EstateStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEstateStats not implemented")
+}
+func (UnimplementedEstateServiceServer) GetDroneDistance(context.Context, *GetDroneDistanceRequest) (*DroneDistance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDroneDistance not implemented")
+}
+func (UnimplementedEstateServiceServer) StreamDroneWaypoints(*StreamDroneWaypointsRequest, EstateService_StreamDroneWaypointsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamDroneWaypoints not implemented")
+}
+func (UnimplementedEstateServiceServer) mustEmbedUnimplementedEstateServiceServer() {}
+
+// UnsafeEstateServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EstateServiceServer will
+// result in compilation errors.
+type UnsafeEstateServiceServer interface {
+	mustEmbedUnimplementedEstateServiceServer()
+}
+
+func RegisterEstateServiceServer(s grpc.ServiceRegistrar, srv EstateServiceServer) {
+	s.RegisterService(&EstateService_ServiceDesc, srv)
+}
+
+func _EstateService_CreateEstate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEstateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EstateServiceServer).CreateEstate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EstateService_CreateEstate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstateServiceServer).CreateEstate(ctx, req.(*CreateEstateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EstateService_CreateTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EstateServiceServer).CreateTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EstateService_CreateTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstateServiceServer).CreateTree(ctx, req.(*CreateTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EstateService_GetEstateStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEstateStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EstateServiceServer).GetEstateStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EstateService_GetEstateStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstateServiceServer).GetEstateStats(ctx, req.(*GetEstateStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EstateService_GetDroneDistance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDroneDistanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EstateServiceServer).GetDroneDistance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EstateService_GetDroneDistance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstateServiceServer).GetDroneDistance(ctx, req.(*GetDroneDistanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EstateService_StreamDroneWaypoints_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamDroneWaypointsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EstateServiceServer).StreamDroneWaypoints(m, &estateServiceStreamDroneWaypointsServer{stream})
+}
+
+type EstateService_StreamDroneWaypointsServer interface {
+	Send(*DroneWaypoint) error
+	grpc.ServerStream
+}
+
+type estateServiceStreamDroneWaypointsServer struct {
+	grpc.ServerStream
+}
+
+func (x *estateServiceStreamDroneWaypointsServer) Send(m *DroneWaypoint) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// EstateService_ServiceDesc is the grpc.ServiceDesc for EstateService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EstateService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "estate.v1.EstateService",
+	HandlerType: (*EstateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateEstate",
+			Handler:    _EstateService_CreateEstate_Handler,
+		},
+		{
+			MethodName: "CreateTree",
+			Handler:    _EstateService_CreateTree_Handler,
+		},
+		{
+			MethodName: "GetEstateStats",
+			Handler:    _EstateService_GetEstateStats_Handler,
+		},
+		{
+			MethodName: "GetDroneDistance",
+			Handler:    _EstateService_GetDroneDistance_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamDroneWaypoints",
+			Handler:       _EstateService_StreamDroneWaypoints_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "estate/v1/estate.proto",
+}
@@ -0,0 +1,91 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+)
+
+const MIMEImagePNG = "image/png"
+
+// PNG write the drone plan as a PNG image
+func PNG(w io.Writer, plan *domain.DronePlan) error {
+	l := newLayout(plan.Estate)
+	img := image.NewRGBA(l.bounds())
+	fill(img, img.Bounds(), colorGround)
+
+	for _, tree := range plan.Trees {
+		fill(img, l.rect(tree.Plot), treeColor(tree.Height))
+	}
+
+	if l.grid() {
+		for row := 1; row < l.rows; row++ {
+			fill(img, image.Rect(0, row*l.cell, img.Bounds().Dx(), row*l.cell+1), colorGrid)
+		}
+		for col := 1; col < l.cols; col++ {
+			fill(img, image.Rect(col*l.cell, 0, col*l.cell+1, img.Bounds().Dy()), colorGrid)
+		}
+	}
+
+	// consecutive plots of the zigzag are neighbours, so every segment is a horizontal or vertical bar
+	path := l.path(plan.Routes)
+	half := l.pathWidth() / 2
+	for i := 0; i+1 < len(path); i++ {
+		segment := image.Rectangle{Min: path[i], Max: path[i+1]}.Canon()
+		segment.Min = segment.Min.Sub(image.Pt(half, half))
+		segment.Max = segment.Max.Add(image.Pt(l.pathWidth()-half, l.pathWidth()-half))
+		fill(img, segment, colorPath)
+	}
+
+	for _, arrow := range l.arrows(path) {
+		fillTriangle(img, arrow, colorPath)
+	}
+
+	if plan.Rest != nil {
+		strokeCircle(img, l.center(*plan.Rest), l.restRadius(), max(l.pathWidth(), 2), colorRest)
+	}
+
+	return png.Encode(w, img)
+}
+
+func fill(img *image.RGBA, r image.Rectangle, c color.RGBA) {
+	draw.Draw(img, r, image.NewUniform(c), image.Point{}, draw.Src)
+}
+
+// fillTriangle fill the pixels whose center is on the inner side of every edge of the triangle
+func fillTriangle(img *image.RGBA, t [3]image.Point, c color.RGBA) {
+	bounds := image.Rectangle{Min: t[0], Max: t[0]}.
+		Union(image.Rectangle{Min: t[1], Max: t[1].Add(image.Pt(1, 1))}).
+		Union(image.Rectangle{Min: t[2], Max: t[2].Add(image.Pt(1, 1))}).
+		Intersect(img.Bounds())
+
+	edge := func(a, b image.Point, x, y int) int {
+		return (b.X-a.X)*(y-a.Y) - (b.Y-a.Y)*(x-a.X)
+	}
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			e0, e1, e2 := edge(t[0], t[1], x, y), edge(t[1], t[2], x, y), edge(t[2], t[0], x, y)
+			if (e0 >= 0 && e1 >= 0 && e2 >= 0) || (e0 <= 0 && e1 <= 0 && e2 <= 0) {
+				img.SetRGBA(x, y, c)
+			}
+		}
+	}
+}
+
+// strokeCircle draw a ring of the given width inside radius around center
+func strokeCircle(img *image.RGBA, center image.Point, radius int, width int, c color.RGBA) {
+	inner := max(radius-width, 0)
+	bounds := image.Rect(center.X-radius, center.Y-radius, center.X+radius+1, center.Y+radius+1).Intersect(img.Bounds())
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			dx, dy := x-center.X, y-center.Y
+			if d := dx*dx + dy*dy; d <= radius*radius && d > inner*inner {
+				img.SetRGBA(x, y, c)
+			}
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package render
+
+import (
+	"bufio"
+	"fmt"
+	"image"
+	"io"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+)
+
+const (
+	profileWidth  = 800
+	profileHeight = 300
+	profileMargin = 40
+)
+
+var colorAxis = colorGrid
+
+// ProfileSVG write the altitude profile as a side view chart, horizontal distance along x and altitude along y.
+// The largest climbs are marked with the height gained and the title gives the vertical and horizontal share of the distance
+func ProfileSVG(w io.Writer, profile *domain.AltitudeProfile) error {
+	maxAltitude := 1
+	for _, point := range profile.Points {
+		maxAltitude = max(maxAltitude, point.Altitude)
+	}
+	plotWidth, plotHeight := profileWidth-2*profileMargin, profileHeight-2*profileMargin
+	at := func(distance int, altitude int) image.Point {
+		return image.Pt(
+			profileMargin+distance*plotWidth/max(profile.Horizontal, 1),
+			profileHeight-profileMargin-altitude*plotHeight/maxAltitude,
+		)
+	}
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="12">`+"\n",
+		profileWidth, profileHeight, profileWidth, profileHeight)
+	fmt.Fprintf(bw, `<rect width="%d" height="%d" fill="#ffffff"/>`+"\n", profileWidth, profileHeight)
+
+	total := profile.Horizontal + profile.Vertical
+	fmt.Fprintf(bw, `<text x="%d" y="%d">%d m flown, vertical %.0f%%, horizontal %.0f%%</text>`+"\n",
+		profileMargin, profileMargin/2, total, profile.VerticalShare()*100, profile.HorizontalShare()*100)
+
+	origin, right, top := at(0, 0), at(profile.Horizontal, 0), at(0, maxAltitude)
+	fmt.Fprintf(bw, `<path d="M%d %dV%dH%d" stroke="%s" fill="none"/>`+"\n", top.X, top.Y, origin.Y, right.X, hex(colorAxis))
+	fmt.Fprintf(bw, `<text x="%d" y="%d" text-anchor="end">%d m</text>`+"\n", top.X-4, top.Y+4, maxAltitude)
+	fmt.Fprintf(bw, `<text x="%d" y="%d" text-anchor="end">0</text>`+"\n", origin.X-4, origin.Y+4)
+	fmt.Fprintf(bw, `<text x="%d" y="%d" text-anchor="end">%d m</text>`+"\n", right.X, right.Y+16, profile.Horizontal)
+
+	if len(profile.Points) > 0 {
+		bw.WriteString(`<polyline points="`)
+		for i, point := range profile.Points {
+			if i > 0 {
+				bw.WriteByte(' ')
+			}
+			p := at(point.Distance, point.Altitude)
+			fmt.Fprintf(bw, "%d,%d", p.X, p.Y)
+		}
+		fmt.Fprintf(bw, `" fill="none" stroke="%s" stroke-width="2" stroke-linejoin="round"/>`+"\n", hex(colorPath))
+	}
+
+	for _, climb := range profile.Climbs {
+		p := at(climb.Distance, climb.Altitude)
+		fmt.Fprintf(bw, `<circle cx="%d" cy="%d" r="4" fill="%s"><title>climb of %d m over x=%d y=%d</title></circle>`+"\n",
+			p.X, p.Y, hex(colorRest), climb.Height, climb.Plot.Col, climb.Plot.Row)
+		fmt.Fprintf(bw, `<text x="%d" y="%d" text-anchor="middle" fill="%s">+%d</text>`+"\n", p.X, p.Y-8, hex(colorRest), climb.Height)
+	}
+
+	bw.WriteString("</svg>\n")
+	return bw.Flush()
+}
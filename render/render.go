@@ -0,0 +1,131 @@
+// Package render draws the drone plan of an estate as SVG or PNG and its altitude profile as SVG,
+// in pure Go so it works without any external service. Plots are laid out with columns along x and rows along y, row 1 on top, and colored by the height of their tree
+package render
+
+import (
+	"image"
+	"image/color"
+	"slices"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+)
+
+const (
+	// maxImageSide is the side the longest estate side is scaled to, within the cell size bounds
+	maxImageSide = 1024
+	minCellSize  = 2
+	maxCellSize  = 32
+
+	// minGridCellSize is the smallest cell drawn with grid lines, below it they would hide the plots
+	minGridCellSize = 6
+	// minArrowSpacing is the minimum number of pixels between two direction arrows
+	minArrowSpacing = 8
+)
+
+var (
+	colorGround   = color.RGBA{0xf3, 0xed, 0xdc, 0xff}
+	colorGrid     = color.RGBA{0xd6, 0xcd, 0xb4, 0xff}
+	colorPath     = color.RGBA{0x1f, 0x4e, 0x9c, 0xff}
+	colorRest     = color.RGBA{0xd6, 0x28, 0x28, 0xff}
+	colorTreeLow  = color.RGBA{0xc7, 0xe9, 0xb4, 0xff}
+	colorTreeHigh = color.RGBA{0x0b, 0x4f, 0x1c, 0xff}
+)
+
+// layout place plots of an estate on the image, every plot is a square cell
+type layout struct {
+	cell int
+	rows int
+	cols int
+}
+
+func newLayout(estate domain.Estate) layout {
+	rows, cols := estate.Width, estate.Length
+	cell := maxImageSide / max(rows, cols, 1)
+	return layout{
+		cell: min(max(cell, minCellSize), maxCellSize),
+		rows: rows,
+		cols: cols,
+	}
+}
+
+func (l layout) bounds() image.Rectangle {
+	return image.Rect(0, 0, l.cols*l.cell, l.rows*l.cell)
+}
+
+func (l layout) rect(plot domain.Plot) image.Rectangle {
+	return image.Rect((plot.Col-1)*l.cell, (plot.Row-1)*l.cell, plot.Col*l.cell, plot.Row*l.cell)
+}
+
+func (l layout) center(plot domain.Plot) image.Point {
+	return image.Pt((plot.Col-1)*l.cell+l.cell/2, (plot.Row-1)*l.cell+l.cell/2)
+}
+
+func (l layout) grid() bool {
+	return l.cell >= minGridCellSize
+}
+
+func (l layout) pathWidth() int {
+	return max(l.cell/8, 1)
+}
+
+func (l layout) restRadius() int {
+	return max(l.cell*2/5, 3)
+}
+
+// path is the center of every plot in flight order
+func (l layout) path(routes []domain.DroneRoute) []image.Point {
+	ordered := slices.Clone(routes)
+	slices.SortFunc(ordered, func(a, b domain.DroneRoute) int {
+		return a.Route - b.Route
+	})
+
+	points := make([]image.Point, 0, len(ordered))
+	for _, route := range ordered {
+		points = append(points, l.center(route.Plot))
+	}
+	return points
+}
+
+// arrows are triangles pointing the flight direction at the middle of path segments,
+// spaced so they do not overlap on small cells
+func (l layout) arrows(path []image.Point) [][3]image.Point {
+	size := max(l.cell/4, 2)
+	every := max(minArrowSpacing/l.cell, 1)
+
+	var arrows [][3]image.Point
+	for i := 0; i+1 < len(path); i += every {
+		from, to := path[i], path[i+1]
+		direction := image.Pt(sign(to.X-from.X), sign(to.Y-from.Y))
+		if direction == (image.Point{}) {
+			continue
+		}
+		normal := image.Pt(-direction.Y, direction.X)
+		middle := from.Add(to).Div(2)
+		base := middle.Sub(direction.Mul(size))
+		arrows = append(arrows, [3]image.Point{
+			middle.Add(direction.Mul(size)),
+			base.Add(normal.Mul(size)),
+			base.Sub(normal.Mul(size)),
+		})
+	}
+	return arrows
+}
+
+// treeColor shade trees from light to dark green as they grow
+func treeColor(height int) color.RGBA {
+	height = min(max(height, domain.MinTreeHeight), domain.MaxTreeHeight)
+	mix := func(low uint8, high uint8) uint8 {
+		return uint8(int(low) + (int(high)-int(low))*(height-domain.MinTreeHeight)/(domain.MaxTreeHeight-domain.MinTreeHeight))
+	}
+	return color.RGBA{mix(colorTreeLow.R, colorTreeHigh.R), mix(colorTreeLow.G, colorTreeHigh.G), mix(colorTreeLow.B, colorTreeHigh.B), 0xff}
+}
+
+func sign(v int) int {
+	switch {
+	case v > 0:
+		return 1
+	case v < 0:
+		return -1
+	}
+	return 0
+}
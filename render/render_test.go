@@ -0,0 +1,131 @@
+package render
+
+import (
+	"bytes"
+	"encoding/xml"
+	"image"
+	"image/png"
+	"io"
+	"testing"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// testPlan is a 2 rows by 3 columns estate with a tree at x=2 y=1
+func testPlan(rest *domain.Plot) *domain.DronePlan {
+	trees := []domain.Tree{{ID: uuid.New(), Plot: domain.Plot{Row: 1, Col: 2}, Height: domain.MaxTreeHeight}}
+	return &domain.DronePlan{
+		Estate: domain.Estate{Width: 2, Length: 3},
+		Trees:  trees,
+		Routes: domain.DroneRoutesOverTrees(2, 3, trees),
+		Rest:   rest,
+	}
+}
+
+// countElements count the elements of an svg document by name
+func countElements(t *testing.T, r io.Reader) map[string]int {
+	counts := map[string]int{}
+	decoder := xml.NewDecoder(r)
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return counts
+		}
+		if !assert.NoError(t, err) {
+			return counts
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			counts[start.Name.Local]++
+		}
+	}
+}
+
+func TestSVG(t *testing.T) {
+	rest := domain.Plot{Row: 2, Col: 3}
+
+	tests := []struct {
+		name          string
+		plan          *domain.DronePlan
+		expectCircles int
+	}{
+		{"Without rest", testPlan(nil), 0},
+		{"With rest", testPlan(&rest), 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			assert.NoError(t, SVG(&buf, tt.plan))
+
+			counts := countElements(t, &buf)
+			assert.Equal(t, 1, counts["svg"])
+			// the ground and the tree
+			assert.Equal(t, 2, counts["rect"])
+			assert.Equal(t, 1, counts["polyline"])
+			// one arrow between each of the 6 plots
+			assert.Equal(t, 5, counts["polygon"])
+			assert.Equal(t, tt.expectCircles, counts["circle"])
+		})
+	}
+}
+
+func TestPNG(t *testing.T) {
+	rest := domain.Plot{Row: 2, Col: 3}
+	var buf bytes.Buffer
+	assert.NoError(t, PNG(&buf, testPlan(&rest)))
+
+	img, err := png.Decode(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 3*maxCellSize, 2*maxCellSize), img.Bounds())
+
+	// corners of plots are away from the path
+	assert.Equal(t, colorGround, img.At(2, 2))
+	assert.Equal(t, treeColor(domain.MaxTreeHeight), img.At(maxCellSize+2, 2))
+	assert.Equal(t, colorGrid, img.At(maxCellSize, 2))
+
+	center := image.Pt(2*maxCellSize+maxCellSize/2, maxCellSize+maxCellSize/2)
+	assert.Equal(t, colorPath, img.At(center.X, center.Y))
+	assert.Equal(t, colorRest, img.At(center.X+maxCellSize*2/5, center.Y))
+}
+
+func Test_newLayout(t *testing.T) {
+	tests := []struct {
+		name   string
+		estate domain.Estate
+		cell   int
+	}{
+		{"small estate uses the largest cells", domain.Estate{Width: 2, Length: 3}, maxCellSize},
+		{"scaled to the image side", domain.Estate{Width: 100, Length: 256}, 4},
+		{"long estate keeps the smallest cells", domain.Estate{Width: 1, Length: domain.MaxRenderPlots}, minCellSize},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.cell, newLayout(tt.estate).cell)
+		})
+	}
+}
+
+func Test_treeColor(t *testing.T) {
+	assert.Equal(t, colorTreeLow, treeColor(domain.MinTreeHeight))
+	assert.Equal(t, colorTreeHigh, treeColor(domain.MaxTreeHeight))
+	assert.Equal(t, colorTreeHigh, treeColor(domain.MaxTreeHeight+1))
+}
+
+func TestProfileSVG(t *testing.T) {
+	profile := domain.DroneAltitudeProfile(testPlan(nil).Routes)
+	var buf bytes.Buffer
+	assert.NoError(t, ProfileSVG(&buf, &profile))
+	assert.Contains(t, buf.String(), "112 m flown, vertical 55%, horizontal 45%")
+
+	counts := countElements(t, bytes.NewReader(buf.Bytes()))
+	assert.Equal(t, 1, counts["polyline"])
+	// the climb over the tree is the only one
+	assert.Equal(t, 1, counts["circle"])
+
+	buf.Reset()
+	assert.NoError(t, ProfileSVG(&buf, &domain.AltitudeProfile{}))
+	assert.Equal(t, 0, countElements(t, &buf)["polyline"])
+}
@@ -0,0 +1,71 @@
+package render
+
+import (
+	"bufio"
+	"fmt"
+	"image/color"
+	"io"
+	"strings"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+)
+
+const MIMEImageSVG = "image/svg+xml"
+
+// SVG write the drone plan as an SVG document, trees carry their plot and height as tooltip
+func SVG(w io.Writer, plan *domain.DronePlan) error {
+	l := newLayout(plan.Estate)
+	size := l.bounds().Size()
+	// the buffered writer keeps the first write error, it is returned by Flush
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`+"\n", size.X, size.Y, size.X, size.Y)
+	fmt.Fprintf(bw, `<rect width="%d" height="%d" fill="%s"/>`+"\n", size.X, size.Y, hex(colorGround))
+
+	for _, tree := range plan.Trees {
+		r := l.rect(tree.Plot)
+		fmt.Fprintf(bw, `<rect x="%d" y="%d" width="%d" height="%d" fill="%s"><title>x=%d y=%d height=%d</title></rect>`+"\n",
+			r.Min.X, r.Min.Y, l.cell, l.cell, hex(treeColor(tree.Height)), tree.Plot.Col, tree.Plot.Row, tree.Height)
+	}
+
+	if l.grid() {
+		var d strings.Builder
+		for row := 1; row < l.rows; row++ {
+			fmt.Fprintf(&d, "M0 %dH%d", row*l.cell, size.X)
+		}
+		for col := 1; col < l.cols; col++ {
+			fmt.Fprintf(&d, "M%d 0V%d", col*l.cell, size.Y)
+		}
+		fmt.Fprintf(bw, `<path d="%s" stroke="%s" stroke-width="1" fill="none"/>`+"\n", d.String(), hex(colorGrid))
+	}
+
+	path := l.path(plan.Routes)
+	if len(path) > 1 {
+		bw.WriteString(`<polyline points="`)
+		for i, point := range path {
+			if i > 0 {
+				bw.WriteByte(' ')
+			}
+			fmt.Fprintf(bw, "%d,%d", point.X, point.Y)
+		}
+		fmt.Fprintf(bw, `" fill="none" stroke="%s" stroke-width="%d" stroke-linejoin="round"/>`+"\n", hex(colorPath), l.pathWidth())
+	}
+
+	for _, arrow := range l.arrows(path) {
+		fmt.Fprintf(bw, `<polygon points="%d,%d %d,%d %d,%d" fill="%s"/>`+"\n",
+			arrow[0].X, arrow[0].Y, arrow[1].X, arrow[1].Y, arrow[2].X, arrow[2].Y, hex(colorPath))
+	}
+
+	if plan.Rest != nil {
+		center := l.center(*plan.Rest)
+		fmt.Fprintf(bw, `<circle cx="%d" cy="%d" r="%d" fill="none" stroke="%s" stroke-width="%d"><title>rest x=%d y=%d</title></circle>`+"\n",
+			center.X, center.Y, l.restRadius(), hex(colorRest), max(l.pathWidth(), 2), plan.Rest.Col, plan.Rest.Row)
+	}
+
+	bw.WriteString("</svg>\n")
+	return bw.Flush()
+}
+
+func hex(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
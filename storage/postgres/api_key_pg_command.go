@@ -0,0 +1,35 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+)
+
+// CreateAPIKey store hashed api key, storing the same key again is ignored so bootstrap keys can be ensured on every start
+func (p *postgres) CreateAPIKey(ctx context.Context, key *domain.APIKey) error {
+	query := `
+        INSERT INTO api_keys (id, tenant_id, name, key_prefix, key_hash, role, estate_id, created_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+        ON CONFLICT (key_hash) DO NOTHING
+    `
+	_, err := p.DB.ExecContext(ctx, query, key.ID, key.TenantID, key.Name, key.Prefix, key.KeyHash, string(key.Role), key.EstateID, key.CreatedAt)
+	return err
+}
+
+// RevokeAPIKey mark api key revoked, return false when it does not exist in the tenant or already revoked
+func (p *postgres) RevokeAPIKey(ctx context.Context, tenantID uuid.UUID, keyID uuid.UUID, revokedAt time.Time) (bool, error) {
+	query := `UPDATE api_keys SET revoked_at = $2, updated_at = NOW() WHERE id = $1 AND tenant_id = $3 AND revoked_at IS NULL`
+	result, err := p.DB.ExecContext(ctx, query, keyID, revokedAt, tenantID)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+)
+
+const apiKeyColumns = `id, tenant_id, name, key_prefix, key_hash, role, estate_id, created_at, revoked_at`
+
+// GetAPIKey retrieves api key of the tenant by id, return nil when it does not exist
+func (p *postgres) GetAPIKey(ctx context.Context, tenantID uuid.UUID, keyID uuid.UUID) (*domain.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE id = $1 AND tenant_id = $2`
+	return scanAPIKey(p.DB.QueryRowContext(ctx, query, keyID, tenantID))
+}
+
+// GetAPIKeyByHash retrieves api key by its hash for authentication, return nil when it does not exist
+func (p *postgres) GetAPIKeyByHash(ctx context.Context, keyHash string) (*domain.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE key_hash = $1`
+	return scanAPIKey(p.DB.QueryRowContext(ctx, query, keyHash))
+}
+
+// GetAPIKeys retrieves all api keys of the tenant including revoked ones, newest first
+func (p *postgres) GetAPIKeys(ctx context.Context, tenantID uuid.UUID) ([]domain.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE tenant_id = $1 ORDER BY created_at DESC`
+
+	rows, err := p.DB.QueryContext(ctx, query, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := []domain.APIKey{}
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, *key)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAPIKey(row rowScanner) (*domain.APIKey, error) {
+	var key domain.APIKey
+	var role string
+	err := row.Scan(&key.ID, &key.TenantID, &key.Name, &key.Prefix, &key.KeyHash, &role, &key.EstateID, &key.CreatedAt, &key.RevokedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	key.Role = domain.Role(role)
+	return &key, nil
+}
@@ -0,0 +1,133 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_postgres_CreateAPIKey(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	key := &domain.APIKey{ID: uuid.New(), TenantID: uuid.New(), Name: "tablet", Prefix: "est_abcd", KeyHash: "hash", Role: domain.RolePlanter, CreatedAt: time.Now()}
+
+	mock.ExpectExec("INSERT INTO api_keys .* ON CONFLICT \\(key_hash\\) DO NOTHING").
+		WithArgs(key.ID, key.TenantID, key.Name, key.Prefix, key.KeyHash, "planter", key.EstateID, key.CreatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	assert.NoError(t, pg.CreateAPIKey(ctx, key))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func Test_postgres_RevokeAPIKey(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	tenantID := uuid.New()
+	id := uuid.New()
+	now := time.Now()
+
+	mock.ExpectExec("UPDATE api_keys SET revoked_at .* WHERE id = \\$1 AND tenant_id = \\$3").WithArgs(id, now, tenantID).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	revoked, err := pg.RevokeAPIKey(ctx, tenantID, id, now)
+	assert.NoError(t, err)
+	assert.False(t, revoked)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func Test_postgres_GetAPIKeyByHash(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	id := uuid.New()
+	tenantID := uuid.New()
+	estateID := uuid.New()
+	createdAt := time.Now()
+	columns := []string{"id", "tenant_id", "name", "key_prefix", "key_hash", "role", "estate_id", "created_at", "revoked_at"}
+
+	tests := []struct {
+		name      string
+		mockFunc  func()
+		expected  *domain.APIKey
+		wantError bool
+	}{
+		{
+			name: "Found",
+			mockFunc: func() {
+				mock.ExpectQuery("SELECT .* FROM api_keys WHERE key_hash = \\$1").
+					WithArgs("hash").
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(id, tenantID, "tablet", "est_abcd", "hash", "viewer", estateID, createdAt, nil))
+			},
+			expected: &domain.APIKey{ID: id, TenantID: tenantID, Name: "tablet", Prefix: "est_abcd", KeyHash: "hash", Role: domain.RoleViewer, EstateID: &estateID, CreatedAt: createdAt},
+		},
+		{
+			name: "Not found",
+			mockFunc: func() {
+				mock.ExpectQuery("SELECT .* FROM api_keys WHERE key_hash = \\$1").WithArgs("hash").WillReturnError(sql.ErrNoRows)
+			},
+			expected: nil,
+		},
+		{
+			name: "Query error",
+			mockFunc: func() {
+				mock.ExpectQuery("SELECT .* FROM api_keys WHERE key_hash = \\$1").WithArgs("hash").WillReturnError(errors.New("query error"))
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			key, err := pg.GetAPIKeyByHash(ctx, "hash")
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, key)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_postgres_GetAPIKeys(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	id := uuid.New()
+	tenantID := uuid.New()
+	createdAt := time.Now()
+
+	mock.ExpectQuery("SELECT .* FROM api_keys WHERE tenant_id = \\$1 ORDER BY created_at DESC").
+		WithArgs(tenantID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "name", "key_prefix", "key_hash", "role", "estate_id", "created_at", "revoked_at"}).
+			AddRow(id, tenantID, "bootstrap", "est_abcd", "hash", "admin", nil, createdAt, createdAt))
+
+	keys, err := pg.GetAPIKeys(ctx, tenantID)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.APIKey{{ID: id, TenantID: tenantID, Name: "bootstrap", Prefix: "est_abcd", KeyHash: "hash", Role: domain.RoleAdmin, CreatedAt: createdAt, RevokedAt: &createdAt}}, keys)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+)
+
+// ReplaceChargingPads replace the charging pads of an estate of the tenant.
+// The estate row is locked so concurrent replacements do not interleave,
+// ErrorEstatesNotFound is returned when the estate does not belong to the tenant
+func (p *postgres) ReplaceChargingPads(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, pads []domain.Plot) error {
+	return p.inTx(ctx, "ReplaceChargingPads", func(tx *sql.Tx) error {
+		var id uuid.UUID
+		query := `SELECT id FROM estates WHERE id = $1 AND tenant_id = $2 FOR UPDATE`
+		err := tx.QueryRowContext(ctx, query, estateID, tenantID).Scan(&id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return domain.ErrorEstatesNotFound
+			}
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `DELETE FROM charging_pads WHERE estate_id = $1`, estateID)
+		if err != nil {
+			return err
+		}
+
+		if len(pads) == 0 {
+			return nil
+		}
+
+		// pads are bounded by MaxChargingPads, one insert stays below the placeholder limit of postgres
+		query = `INSERT INTO charging_pads (estate_id, row, col) VALUES `
+		args := []interface{}{}
+		argPos := 1
+		for _, pad := range pads {
+			// constructed with placeholder, still safe from sql injections
+			query += fmt.Sprintf("($%d, $%d, $%d),", argPos, argPos+1, argPos+2)
+			args = append(args, estateID, pad.Row, pad.Col)
+			argPos += 3
+		}
+
+		// Trim the trailing comma
+		query = query[:len(query)-1]
+
+		_, err = tx.ExecContext(ctx, query, args...)
+		return err
+	})
+}
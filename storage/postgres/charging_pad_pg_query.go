@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+)
+
+// ListChargingPads retrieves the charging pads of an estate of the tenant ordered by row then col,
+// nil is returned when the estate does not belong to the tenant and an empty list when it has no pads
+func (p *postgres) ListChargingPads(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID) ([]domain.Plot, error) {
+	query := `
+        SELECT c.row, c.col
+        FROM estates e LEFT JOIN charging_pads c ON c.estate_id = e.id
+        WHERE e.id = $1 AND e.tenant_id = $2
+        ORDER BY c.row, c.col
+    `
+
+	rows, err := p.DB.QueryContext(ctx, query, estateID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var pads []domain.Plot
+	for rows.Next() {
+		var row, col sql.NullInt64
+		err := rows.Scan(&row, &col)
+		if err != nil {
+			return nil, err
+		}
+
+		if pads == nil {
+			pads = []domain.Plot{}
+		}
+		// the estate without pads is one row of nulls
+		if row.Valid && col.Valid {
+			pads = append(pads, domain.Plot{Row: int(row.Int64), Col: int(col.Int64)})
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return pads, nil
+}
@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_postgres_ReplaceChargingPads(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+
+	tenantID := uuid.New()
+	estateID := uuid.New()
+	pads := []domain.Plot{{Row: 1, Col: 1}, {Row: 2, Col: 5}}
+
+	tests := []struct {
+		name        string
+		pads        []domain.Plot
+		mockFunc    func()
+		expectError error
+	}{
+		{
+			name: "Success",
+			pads: pads,
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT id FROM estates WHERE id = \\$1 AND tenant_id = \\$2 FOR UPDATE").
+					WithArgs(estateID, tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(estateID))
+				mock.ExpectExec("DELETE FROM charging_pads WHERE estate_id = \\$1").
+					WithArgs(estateID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("INSERT INTO charging_pads").
+					WithArgs(estateID, 1, 1, estateID, 2, 5).
+					WillReturnResult(sqlmock.NewResult(2, 2))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Remove every pad",
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT id FROM estates").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(estateID))
+				mock.ExpectExec("DELETE FROM charging_pads").WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Estate not found",
+			pads: pads,
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT id FROM estates").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectRollback()
+			},
+			expectError: domain.ErrorEstatesNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := pg.ReplaceChargingPads(ctx, tenantID, estateID, tt.pads)
+			assert.ErrorIs(t, err, tt.expectError)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_postgres_ListChargingPads(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+
+	tenantID := uuid.New()
+	estateID := uuid.New()
+
+	tests := []struct {
+		name string
+		rows *sqlmock.Rows
+		want []domain.Plot
+	}{
+		{
+			name: "Success",
+			rows: sqlmock.NewRows([]string{"row", "col"}).AddRow(1, 1).AddRow(2, 5),
+			want: []domain.Plot{{Row: 1, Col: 1}, {Row: 2, Col: 5}},
+		},
+		{
+			name: "No pads",
+			rows: sqlmock.NewRows([]string{"row", "col"}).AddRow(nil, nil),
+			want: []domain.Plot{},
+		},
+		{
+			name: "Estate not found",
+			rows: sqlmock.NewRows([]string{"row", "col"}),
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.ExpectQuery("FROM estates e LEFT JOIN charging_pads c ON c.estate_id = e.id").
+				WithArgs(estateID, tenantID).
+				WillReturnRows(tt.rows)
+
+			got, err := pg.ListChargingPads(ctx, tenantID, estateID)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+)
+
+// CreateDrone create drone in the fleet of its tenant
+func (p *postgres) CreateDrone(ctx context.Context, drone *domain.Drone) error {
+	query := `
+        INSERT INTO drones (id, tenant_id, name, model, max_range, max_altitude, cruise_speed, climb_speed, status, created_at, updated_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+    `
+	_, err := p.DB.ExecContext(ctx, query, drone.ID, drone.TenantID, drone.Name, drone.Model, drone.MaxRange, drone.MaxAltitude,
+		drone.Speed.Cruise, drone.Speed.Climb, string(drone.Status), drone.CreatedAt, drone.UpdatedAt)
+	return err
+}
+
+// UpdateDrone save the specification and status of a drone of its tenant.
+// ErrorDroneNotFound is returned when the drone does not belong to the tenant
+func (p *postgres) UpdateDrone(ctx context.Context, drone *domain.Drone) error {
+	query := `
+        UPDATE drones SET name = $1, model = $2, max_range = $3, max_altitude = $4, cruise_speed = $5, climb_speed = $6,
+            status = $7, updated_at = $8
+        WHERE id = $9 AND tenant_id = $10
+    `
+	result, err := p.DB.ExecContext(ctx, query, drone.Name, drone.Model, drone.MaxRange, drone.MaxAltitude, drone.Speed.Cruise, drone.Speed.Climb,
+		string(drone.Status), drone.UpdatedAt, drone.ID, drone.TenantID)
+	if err != nil {
+		return err
+	}
+
+	return requireAffected(result, domain.ErrorDroneNotFound)
+}
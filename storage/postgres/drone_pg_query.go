@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+)
+
+const droneColumns = `id, tenant_id, name, model, max_range, max_altitude, cruise_speed, climb_speed, status, created_at, updated_at`
+
+// ListDrones retrieves a page of drones of the tenant fleet, oldest first.
+// Drones of every status are listed when status is nil
+func (p *postgres) ListDrones(ctx context.Context, tenantID uuid.UUID, status *domain.DroneStatus, limit int, offset int) ([]domain.Drone, error) {
+	query := `
+        SELECT ` + droneColumns + `
+        FROM drones
+        WHERE tenant_id = $1 AND ($2::VARCHAR IS NULL OR status = $2)
+        ORDER BY created_at, id
+        LIMIT $3 OFFSET $4
+    `
+
+	var statusArg *string
+	if status != nil {
+		value := string(*status)
+		statusArg = &value
+	}
+
+	rows, err := p.DB.QueryContext(ctx, query, tenantID, statusArg, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	drones := []domain.Drone{}
+	for rows.Next() {
+		drone, err := scanDrone(rows)
+		if err != nil {
+			return nil, err
+		}
+		drones = append(drones, *drone)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return drones, nil
+}
+
+// GetDrone retrieves a drone of the tenant fleet, nil when it does not belong to the tenant
+func (p *postgres) GetDrone(ctx context.Context, tenantID uuid.UUID, droneID uuid.UUID) (*domain.Drone, error) {
+	query := `
+        SELECT ` + droneColumns + `
+        FROM drones
+        WHERE id = $1 AND tenant_id = $2
+    `
+
+	drone, err := scanDrone(p.DB.QueryRowContext(ctx, query, droneID, tenantID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return drone, nil
+}
+
+func scanDrone(row rowScanner) (*domain.Drone, error) {
+	var drone domain.Drone
+	var status string
+	err := row.Scan(&drone.ID, &drone.TenantID, &drone.Name, &drone.Model, &drone.MaxRange, &drone.MaxAltitude,
+		&drone.Speed.Cruise, &drone.Speed.Climb, &status, &drone.CreatedAt, &drone.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	drone.Status = domain.DroneStatus(status)
+	return &drone, nil
+}
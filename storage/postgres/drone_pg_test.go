@@ -0,0 +1,160 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var droneColumnNames = []string{"id", "tenant_id", "name", "model", "max_range", "max_altitude", "cruise_speed", "climb_speed", "status", "created_at", "updated_at"}
+
+func testDrone() *domain.Drone {
+	createdAt := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+	return &domain.Drone{
+		ID:          uuid.New(),
+		TenantID:    uuid.New(),
+		Name:        "drone-7",
+		Model:       "DJI Agras T40",
+		MaxRange:    2000,
+		MaxAltitude: 30,
+		Speed:       domain.DroneSpeed{Cruise: 10, Climb: 4},
+		Status:      domain.DroneAvailable,
+		CreatedAt:   createdAt,
+		UpdatedAt:   createdAt,
+	}
+}
+
+func Test_postgres_CreateDrone(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	drone := testDrone()
+
+	mock.ExpectExec("INSERT INTO drones").
+		WithArgs(drone.ID, drone.TenantID, "drone-7", "DJI Agras T40", 2000, 30, 10.0, 4.0, "available", drone.CreatedAt, drone.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	assert.NoError(t, pg.CreateDrone(ctx, drone))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func Test_postgres_UpdateDrone(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	drone := testDrone()
+	drone.Status = domain.DroneMaintenance
+
+	tests := []struct {
+		name      string
+		mockFunc  func()
+		expectErr error
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				mock.ExpectExec("UPDATE drones SET name = \\$1").
+					WithArgs("drone-7", "DJI Agras T40", 2000, 30, 10.0, 4.0, "maintenance", drone.UpdatedAt, drone.ID, drone.TenantID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "Not found",
+			mockFunc: func() {
+				mock.ExpectExec("UPDATE drones SET name = \\$1").WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectErr: domain.ErrorDroneNotFound,
+			wantErr:   true,
+		},
+		{
+			name: "Exec error",
+			mockFunc: func() {
+				mock.ExpectExec("UPDATE drones SET name = \\$1").WillReturnError(errors.New("exec error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := pg.UpdateDrone(ctx, drone)
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.expectErr != nil {
+					assert.ErrorIs(t, err, tt.expectErr)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_postgres_ListDrones(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	drone := testDrone()
+	available := domain.DroneAvailable
+	status := "available"
+
+	mock.ExpectQuery("FROM drones").
+		WithArgs(drone.TenantID, &status, 10, 0).
+		WillReturnRows(sqlmock.NewRows(droneColumnNames).
+			AddRow(drone.ID, drone.TenantID, "drone-7", "DJI Agras T40", 2000, 30, 10.0, 4.0, "available", drone.CreatedAt, drone.UpdatedAt))
+
+	drones, err := pg.ListDrones(ctx, drone.TenantID, &available, 10, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Drone{*drone}, drones)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func Test_postgres_GetDrone(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	drone := testDrone()
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectQuery("FROM drones").
+			WithArgs(drone.ID, drone.TenantID).
+			WillReturnRows(sqlmock.NewRows(droneColumnNames).
+				AddRow(drone.ID, drone.TenantID, "drone-7", "DJI Agras T40", 2000, 30, 10.0, 4.0, "available", drone.CreatedAt, drone.UpdatedAt))
+
+		got, err := pg.GetDrone(ctx, drone.TenantID, drone.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, drone, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		mock.ExpectQuery("FROM drones").WithArgs(drone.ID, drone.TenantID).WillReturnRows(sqlmock.NewRows(droneColumnNames))
+
+		got, err := pg.GetDrone(ctx, drone.TenantID, drone.ID)
+		assert.NoError(t, err)
+		assert.Nil(t, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
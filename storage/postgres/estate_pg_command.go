@@ -2,77 +2,663 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/SawitProRecruitment/EstateService/core/domain"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
-// CreateTreeAndUpdateDroneRoute Create estate and initialize drone route with empty tree, altitude is 1
-func (p *postgres) CreateEstateAndDroneRoute(ctx context.Context, estate *domain.Estate, droneRoutes []domain.DroneRoute) error {
-	tx, err := p.DB.BeginTx(ctx, nil)
+// CreateEstateAndDroneRoute Create estate in its tenant and initialize drone route with empty tree, altitude is 1
+func (p *postgres) CreateEstateAndDroneRoute(ctx context.Context, estate *domain.Estate, droneRoutes []domain.DroneRoute, outbox []domain.Event) error {
+	return p.inTx(ctx, "CreateEstateAndDroneRoute", func(tx *sql.Tx) error {
+		query := `INSERT INTO estates (id, tenant_id, width, length) VALUES ($1, $2, $3, $4)`
+		_, err := tx.ExecContext(ctx, query, estate.ID, estate.TenantID, estate.Width, estate.Length)
+		if err != nil {
+			return err
+		}
+
+		err = insertDroneRoutes(ctx, tx, estate.ID, droneRoutes)
+		if err != nil {
+			return err
+		}
+
+		return insertOutboxEvents(ctx, tx, outbox)
+	})
+}
+
+// CreateTreeAndUpdateDroneRoute Create tree and update drone route altitude because that plot will be planted by tree.
+// The tree is only inserted when the estate belongs to the tenant, otherwise ErrorEstatesNotFound is returned.
+// The plot uniqueness is enforced by the database, so a concurrent planting on the same plot return ErrorTreeAlreadyExists.
+// The drone total distance before and after the route update is returned
+func (p *postgres) CreateTreeAndUpdateDroneRoute(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, droneRouteAltitude int, tree *domain.Tree, outbox []domain.Event) (domain.DroneDistanceChange, error) {
+	var change domain.DroneDistanceChange
+	err := p.inTx(ctx, "CreateTreeAndUpdateDroneRoute", func(tx *sql.Tx) error {
+		query := `
+            INSERT INTO trees (id, estate_id, row, col, height)
+            SELECT $1, e.id, $3, $4, $5 FROM estates e WHERE e.id = $2 AND e.tenant_id = $6
+        `
+		result, err := tx.ExecContext(ctx, query, tree.ID, estateID, tree.Plot.Row, tree.Plot.Col, tree.Height, tenantID)
+		if err != nil {
+			return mapConstraintError(err, domain.ErrorTreeAlreadyExists, domain.ErrorEstatesNotFound)
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		if affected == 0 {
+			return domain.ErrorEstatesNotFound
+		}
+
+		err = bumpTreesVersion(ctx, tx, tenantID, estateID)
+		if err != nil {
+			return err
+		}
+
+		change.Previous, err = droneTotalDistance(ctx, tx, estateID)
+		if err != nil {
+			return err
+		}
+
+		// the route is missing when the estate was resized concurrently and the plot is no longer part of it
+		query = `UPDATE drone_routes SET altitude = $1 WHERE estate_id = $2 AND row = $3 AND col = $4`
+		result, err = tx.ExecContext(ctx, query, droneRouteAltitude, estateID, tree.Plot.Row, tree.Plot.Col)
+		if err != nil {
+			return err
+		}
+
+		err = requireAffected(result, domain.ErrorTreePlotOutOfBound)
+		if err != nil {
+			return err
+		}
+
+		change.Current, err = droneTotalDistance(ctx, tx, estateID)
+		if err != nil {
+			return err
+		}
+
+		err = insertTreeMeasurements(ctx, tx, estateID, measurementsOf([]domain.Tree{*tree}, time.Now().UTC()))
+		if err != nil {
+			return err
+		}
+
+		return insertOutboxEvents(ctx, tx, outbox)
+	})
 	if err != nil {
-		return err
+		return domain.DroneDistanceChange{}, err
 	}
+	return change, nil
+}
+
+// ResizeEstateAndDroneRoute change estate size when it is still at expectedVersion and rebuild its drone routes,
+// keeping the altitude over existing trees. ErrorTreePlotOutOfBound is returned when a tree is outside of the new size.
+// The drone total distance before and after the rebuild is returned
+func (p *postgres) ResizeEstateAndDroneRoute(ctx context.Context, tenantID uuid.UUID, estate *domain.Estate, expectedVersion int, droneRoutes []domain.DroneRoute, outbox []domain.Event) (domain.DroneDistanceChange, error) {
+	var change domain.DroneDistanceChange
+	err := p.inTx(ctx, "ResizeEstateAndDroneRoute", func(tx *sql.Tx) error {
+		query := `
+            UPDATE estates SET width = $1, length = $2, version = version + 1, updated_at = NOW()
+            WHERE id = $3 AND tenant_id = $4 AND version = $5
+        `
+		result, err := tx.ExecContext(ctx, query, estate.Width, estate.Length, estate.ID, tenantID, expectedVersion)
+		if err != nil {
+			return err
+		}
+
+		err = requireAffected(result, domain.ErrorVersionMismatch)
+		if err != nil {
+			return err
+		}
+
+		change.Previous, err = droneTotalDistance(ctx, tx, estate.ID)
+		if err != nil {
+			return err
+		}
+
+		// routes are deleted before checking trees, it waits for trees being planted concurrently to commit,
+		// and trees planted afterwards can not find their route
+		_, err = tx.ExecContext(ctx, `DELETE FROM drone_routes WHERE estate_id = $1`, estate.ID)
+		if err != nil {
+			return err
+		}
+
+		var outOfBound bool
+		query = `SELECT EXISTS (SELECT 1 FROM trees WHERE estate_id = $1 AND (row > $2 OR col > $3))`
+		err = tx.QueryRowContext(ctx, query, estate.ID, estate.Width, estate.Length).Scan(&outOfBound)
+		if err != nil {
+			return err
+		}
+
+		if outOfBound {
+			return domain.ErrorTreePlotOutOfBound
+		}
 
-	defer func() {
+		err = insertDroneRoutes(ctx, tx, estate.ID, droneRoutes)
 		if err != nil {
-			tx.Rollback()
+			return err
 		}
-	}()
 
-	query := `INSERT INTO estates (id, width, length) VALUES ($1, $2, $3)`
-	_, err = tx.ExecContext(ctx, query, estate.ID, estate.Width, estate.Length)
+		query = `
+            UPDATE drone_routes r SET altitude = t.height + 1
+            FROM trees t WHERE t.estate_id = r.estate_id AND t.row = r.row AND t.col = r.col AND r.estate_id = $1
+        `
+		_, err = tx.ExecContext(ctx, query, estate.ID)
+		if err != nil {
+			return err
+		}
+
+		change.Current, err = droneTotalDistance(ctx, tx, estate.ID)
+		if err != nil {
+			return err
+		}
+
+		return insertOutboxEvents(ctx, tx, outbox)
+	})
 	if err != nil {
-		return err
+		return domain.DroneDistanceChange{}, err
+	}
+	return change, nil
+}
+
+// UpdateTreeAndDroneRoute change tree height when it is still at expectedVersion and adjust drone route altitude over it.
+// The drone total distance before and after the route update is returned
+func (p *postgres) UpdateTreeAndDroneRoute(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, tree *domain.Tree, expectedVersion int, outbox []domain.Event) (domain.DroneDistanceChange, error) {
+	var change domain.DroneDistanceChange
+	err := p.inTx(ctx, "UpdateTreeAndDroneRoute", func(tx *sql.Tx) error {
+		query := `
+            UPDATE trees t SET height = $1, version = t.version + 1, updated_at = NOW()
+            FROM estates e
+            WHERE e.id = t.estate_id AND t.id = $2 AND t.estate_id = $3 AND e.tenant_id = $4 AND t.version = $5
+        `
+		result, err := tx.ExecContext(ctx, query, tree.Height, tree.ID, estateID, tenantID, expectedVersion)
+		if err != nil {
+			return err
+		}
+
+		err = requireAffected(result, domain.ErrorVersionMismatch)
+		if err != nil {
+			return err
+		}
+
+		err = bumpTreesVersion(ctx, tx, tenantID, estateID)
+		if err != nil {
+			return err
+		}
+
+		change.Previous, err = droneTotalDistance(ctx, tx, estateID)
+		if err != nil {
+			return err
+		}
+
+		query = `UPDATE drone_routes SET altitude = $1 WHERE estate_id = $2 AND row = $3 AND col = $4`
+		_, err = tx.ExecContext(ctx, query, tree.DroneAltitude(), estateID, tree.Plot.Row, tree.Plot.Col)
+		if err != nil {
+			return err
+		}
+
+		change.Current, err = droneTotalDistance(ctx, tx, estateID)
+		if err != nil {
+			return err
+		}
+
+		err = insertTreeMeasurements(ctx, tx, estateID, measurementsOf([]domain.Tree{*tree}, time.Now().UTC()))
+		if err != nil {
+			return err
+		}
+
+		return insertOutboxEvents(ctx, tx, outbox)
+	})
+	if err != nil {
+		return domain.DroneDistanceChange{}, err
 	}
+	return change, nil
+}
+
+// DeleteEstate delete estate of the tenant with its trees and drone routes, webhook subscriptions and api keys
+// scoped to it are deleted by the database. ErrorEstatesNotFound is returned when the estate does not belong to the tenant
+func (p *postgres) DeleteEstate(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, outbox []domain.Event) error {
+	return p.inTx(ctx, "DeleteEstate", func(tx *sql.Tx) error {
+		// the estate row is locked first so trees can not be planted while its children are deleted
+		var id uuid.UUID
+		query := `SELECT id FROM estates WHERE id = $1 AND tenant_id = $2 FOR UPDATE`
+		err := tx.QueryRowContext(ctx, query, estateID, tenantID).Scan(&id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return domain.ErrorEstatesNotFound
+			}
+			return err
+		}
+
+		err = deleteEstateRows(ctx, tx, estateID)
+		if err != nil {
+			return err
+		}
 
-	// Bulk insert for drone_routes
-	query = `INSERT INTO drone_routes (estate_id, route, row, col, altitude) VALUES `
-	args := []interface{}{}
-	argPos := 1
-	for _, route := range droneRoutes {
-		// constructed with placeholder, still safe from sql injections
-		query += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d),", argPos, argPos+1, argPos+2, argPos+3, argPos+4)
-		args = append(args, estate.ID, route.Route, route.Plot.Row, route.Plot.Col, route.Altitude)
-		argPos += 5
+		return insertOutboxEvents(ctx, tx, outbox)
+	})
+}
+
+// deleteEstateRows delete estate with its trees and drone routes using the caller transaction
+func deleteEstateRows(ctx context.Context, tx *sql.Tx, estateID uuid.UUID) error {
+	for _, query := range []string{
+		`DELETE FROM drone_routes WHERE estate_id = $1`,
+		`DELETE FROM trees WHERE estate_id = $1`,
+		`DELETE FROM estates WHERE id = $1`,
+	} {
+		_, err := tx.ExecContext(ctx, query, estateID)
+		if err != nil {
+			return err
+		}
 	}
+	return nil
+}
+
+// DeleteTreeAndDroneRoute delete tree of an estate of the tenant and lower the drone route over its plot back to the ground.
+// ErrorTreeNotFound is returned when the tree is not part of the estate. The drone total distance before and after is returned
+func (p *postgres) DeleteTreeAndDroneRoute(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, tree *domain.Tree, outbox []domain.Event) (domain.DroneDistanceChange, error) {
+	var change domain.DroneDistanceChange
+	err := p.inTx(ctx, "DeleteTreeAndDroneRoute", func(tx *sql.Tx) error {
+		query := `
+            DELETE FROM trees t USING estates e
+            WHERE e.id = t.estate_id AND t.id = $1 AND t.estate_id = $2 AND e.tenant_id = $3
+        `
+		result, err := tx.ExecContext(ctx, query, tree.ID, estateID, tenantID)
+		if err != nil {
+			return err
+		}
+
+		err = requireAffected(result, domain.ErrorTreeNotFound)
+		if err != nil {
+			return err
+		}
+
+		err = bumpTreesVersion(ctx, tx, tenantID, estateID)
+		if err != nil {
+			return err
+		}
 
-	// Trim the trailing comma
-	query = query[:len(query)-1]
+		change.Previous, err = droneTotalDistance(ctx, tx, estateID)
+		if err != nil {
+			return err
+		}
 
-	_, err = tx.ExecContext(ctx, query, args...)
+		query = `UPDATE drone_routes SET altitude = $1 WHERE estate_id = $2 AND row = $3 AND col = $4`
+		_, err = tx.ExecContext(ctx, query, domain.GroundAltitude, estateID, tree.Plot.Row, tree.Plot.Col)
+		if err != nil {
+			return err
+		}
+
+		change.Current, err = droneTotalDistance(ctx, tx, estateID)
+		if err != nil {
+			return err
+		}
+
+		return insertOutboxEvents(ctx, tx, outbox)
+	})
+	if err != nil {
+		return domain.DroneDistanceChange{}, err
+	}
+	return change, nil
+}
+
+// PromoteSandbox apply tree changes of a sandbox to its source estate and delete the sandbox, in one transaction.
+// ErrorSandboxStale is returned when the source version or trees version moved past the ones recorded on the sandbox
+// when it was cloned, or when one of the changed trees is no longer at the version it was read.
+// The drone total distance of the source before and after the promotion is returned
+func (p *postgres) PromoteSandbox(ctx context.Context, tenantID uuid.UUID, sandbox *domain.Estate, changes domain.TreeChanges, outbox []domain.Event) (domain.DroneDistanceChange, error) {
+	var change domain.DroneDistanceChange
+	err := p.inTx(ctx, "PromoteSandbox", func(tx *sql.Tx) error {
+		if sandbox.Sandbox == nil || sandbox.Sandbox.SourceEstateID == nil {
+			return domain.ErrorEstateNotSandbox
+		}
+		sourceID := *sandbox.Sandbox.SourceEstateID
+
+		// the source row is locked so its versions can not move until the promotion commits
+		var version, treesVersion int
+		query := `SELECT version, trees_version FROM estates WHERE id = $1 AND tenant_id = $2 FOR UPDATE`
+		err := tx.QueryRowContext(ctx, query, sourceID, tenantID).Scan(&version, &treesVersion)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return domain.ErrorEstatesNotFound
+			}
+			return err
+		}
+
+		// sandboxes cloned before the source versions were recorded are always stale
+		var sourceVersion, sourceTreesVersion int
+		query = `
+            SELECT COALESCE(source_version, 0), COALESCE(source_trees_version, 0)
+            FROM estates WHERE id = $1 AND tenant_id = $2 AND source_estate_id = $3 FOR UPDATE
+        `
+		err = tx.QueryRowContext(ctx, query, sandbox.ID, tenantID, sourceID).Scan(&sourceVersion, &sourceTreesVersion)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return domain.ErrorEstatesNotFound
+			}
+			return err
+		}
+
+		if sourceVersion != version || sourceTreesVersion != treesVersion {
+			return domain.ErrorSandboxStale
+		}
+
+		change.Previous, err = droneTotalDistance(ctx, tx, sourceID)
+		if err != nil {
+			return err
+		}
+
+		// the sandbox goes first, its planted trees keep their id in the source
+		err = deleteEstateRows(ctx, tx, sandbox.ID)
+		if err != nil {
+			return err
+		}
+
+		err = deleteTreeVersions(ctx, tx, sourceID, changes.Removed)
+		if err != nil {
+			return err
+		}
+
+		err = updateTreeVersions(ctx, tx, sourceID, changes.Updated)
+		if err != nil {
+			return err
+		}
+
+		err = insertTrees(ctx, tx, sourceID, changes.Planted)
+		if err != nil {
+			return mapConstraintError(err, domain.ErrorSandboxStale, nil)
+		}
+
+		measuredAt := time.Now().UTC()
+		measurements := append(measurementsOf(changes.Updated, measuredAt), measurementsOf(changes.Planted, measuredAt)...)
+		err = insertTreeMeasurements(ctx, tx, sourceID, measurements)
+		if err != nil {
+			return err
+		}
+
+		err = bumpTreesVersion(ctx, tx, tenantID, sourceID)
+		if err != nil {
+			return err
+		}
+
+		query = `
+            UPDATE drone_routes r SET altitude = COALESCE(
+                (SELECT t.height + 1 FROM trees t WHERE t.estate_id = r.estate_id AND t.row = r.row AND t.col = r.col), $2
+            )
+            WHERE r.estate_id = $1
+        `
+		_, err = tx.ExecContext(ctx, query, sourceID, domain.GroundAltitude)
+		if err != nil {
+			return err
+		}
+
+		change.Current, err = droneTotalDistance(ctx, tx, sourceID)
+		if err != nil {
+			return err
+		}
+
+		return insertOutboxEvents(ctx, tx, outbox)
+	})
+	if err != nil {
+		return domain.DroneDistanceChange{}, err
+	}
+	return change, nil
+}
+
+// bumpTreesVersion increment the trees version of an estate of the tenant using the caller transaction,
+// so sandboxes cloned before the tree change become stale. The estate version, its ETag, is left as is.
+// ErrorEstatesNotFound is returned when the estate does not belong to the tenant
+func bumpTreesVersion(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, estateID uuid.UUID) error {
+	query := `UPDATE estates SET trees_version = trees_version + 1 WHERE id = $1 AND tenant_id = $2`
+	result, err := tx.ExecContext(ctx, query, estateID, tenantID)
 	if err != nil {
 		return err
 	}
+	return requireAffected(result, domain.ErrorEstatesNotFound)
+}
+
+// droneTotalDistance sum the drone total distance of an estate the way domain.DroneTotalDistance does, using the caller
+// transaction so it sees its own route changes. The sum is done by the database so the routes are not read back.
+// Callers hold the estate row lock, so the distance can not move under concurrent writes to the same estate
+func droneTotalDistance(ctx context.Context, tx *sql.Tx, estateID uuid.UUID) (int, error) {
+	// vertical movement climbs from the ground to every route in flight order, then lands after the last one
+	query := `
+        SELECT COUNT(*), COALESCE(SUM(ABS(altitude - previous) + CASE WHEN last THEN altitude ELSE 0 END), 0)
+        FROM (
+            SELECT altitude, LAG(altitude, 1, 0) OVER w AS previous, LEAD(route) OVER w IS NULL AS last
+            FROM drone_routes WHERE estate_id = $1
+            WINDOW w AS (ORDER BY route)
+        ) r
+    `
+	var routes, vertical int
+	err := tx.QueryRowContext(ctx, query, estateID).Scan(&routes, &vertical)
+	if err != nil {
+		return 0, err
+	}
+
+	if routes == 0 {
+		return 0, nil
+	}
+	return (routes-1)*domain.DistanceBetweenPlot + vertical, nil
+}
 
-	return tx.Commit()
+// deleteTreeVersions delete trees of an estate that are still at their version,
+// ErrorSandboxStale is returned when one of them changed
+func deleteTreeVersions(ctx context.Context, tx *sql.Tx, estateID uuid.UUID, trees []domain.Tree) error {
+	if len(trees) == 0 {
+		return nil
+	}
+
+	ids, versions, _ := treeColumns(trees)
+	query := `
+        DELETE FROM trees t USING unnest($2::UUID[], $3::INTEGER[]) AS r(id, version)
+        WHERE t.estate_id = $1 AND t.id = r.id AND t.version = r.version
+    `
+	result, err := tx.ExecContext(ctx, query, estateID, ids, versions)
+	if err != nil {
+		return err
+	}
+	return requireAllAffected(result, len(trees), domain.ErrorSandboxStale)
 }
 
-// CreateTreeAndUpdateDroneRoute Create tree and update drone route altitude because that plot will be planted by tree
-func (p *postgres) CreateTreeAndUpdateDroneRoute(ctx context.Context, estateID uuid.UUID, droneRouteAltitude int, tree *domain.Tree) error {
-	tx, err := p.DB.BeginTx(ctx, nil)
+// updateTreeVersions set the height of trees of an estate that are still at their version,
+// ErrorSandboxStale is returned when one of them changed
+func updateTreeVersions(ctx context.Context, tx *sql.Tx, estateID uuid.UUID, trees []domain.Tree) error {
+	if len(trees) == 0 {
+		return nil
+	}
+
+	ids, versions, heights := treeColumns(trees)
+	query := `
+        UPDATE trees t SET height = r.height, version = t.version + 1, updated_at = NOW()
+        FROM unnest($2::UUID[], $3::INTEGER[], $4::INTEGER[]) AS r(id, version, height)
+        WHERE t.estate_id = $1 AND t.id = r.id AND t.version = r.version
+    `
+	result, err := tx.ExecContext(ctx, query, estateID, ids, versions, heights)
 	if err != nil {
 		return err
 	}
+	return requireAllAffected(result, len(trees), domain.ErrorSandboxStale)
+}
+
+// treeColumns return the id, version and height columns of trees as postgres arrays
+func treeColumns(trees []domain.Tree) (ids interface{}, versions interface{}, heights interface{}) {
+	idValues := make([]string, len(trees))
+	versionValues := make([]int64, len(trees))
+	heightValues := make([]int64, len(trees))
+	for i, tree := range trees {
+		idValues[i] = tree.ID.String()
+		versionValues[i] = int64(tree.Version)
+		heightValues[i] = int64(tree.Height)
+	}
+	return pq.Array(idValues), pq.Array(versionValues), pq.Array(heightValues)
+}
+
+// ImportEstate create estate of a snapshot in its tenant with its trees, measurements and drone routes,
+// keeping the snapshot ids and versions. The estate is a sandbox when the snapshot estate has one.
+// ErrorSnapshotConflict is returned when the estate or one of its trees already exists
+func (p *postgres) ImportEstate(ctx context.Context, snapshot *domain.EstateSnapshot, droneRoutes []domain.DroneRoute, outbox []domain.Event) error {
+	return p.inTx(ctx, "ImportEstate", func(tx *sql.Tx) error {
+		estate := snapshot.Estate
+		var clonedAt *time.Time
+		var sourceEstateID *uuid.UUID
+		var sourceVersion, sourceTreesVersion *int
+		if estate.Sandbox != nil {
+			clonedAt, sourceEstateID = &estate.Sandbox.ClonedAt, estate.Sandbox.SourceEstateID
+			sourceVersion, sourceTreesVersion = &estate.Sandbox.SourceVersion, &estate.Sandbox.SourceTreesVersion
+		}
+
+		query := `
+            INSERT INTO estates (id, tenant_id, width, length, version, cloned_at, source_estate_id, source_version, source_trees_version)
+            VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+        `
+		_, err := tx.ExecContext(ctx, query, estate.ID, estate.TenantID, estate.Width, estate.Length, estate.Version, clonedAt, sourceEstateID,
+			sourceVersion, sourceTreesVersion)
+		if err != nil {
+			return mapConstraintError(err, domain.ErrorSnapshotConflict, nil)
+		}
+
+		err = insertTrees(ctx, tx, estate.ID, snapshot.Trees)
+		if err != nil {
+			return mapConstraintError(err, domain.ErrorSnapshotConflict, nil)
+		}
+
+		err = insertDroneRoutes(ctx, tx, estate.ID, droneRoutes)
+		if err != nil {
+			return err
+		}
+
+		err = insertTreeMeasurements(ctx, tx, estate.ID, snapshot.Measurements)
+		if err != nil {
+			return err
+		}
+
+		return insertOutboxEvents(ctx, tx, outbox)
+	})
+}
+
+// insertChunkSize is the number of rows inserted per statement, postgres accepts at most 65535 placeholders
+const insertChunkSize = 1000
+
+// insertTrees bulk insert trees of an estate using the caller transaction,
+// in chunks that stay below the placeholder limit of postgres
+func insertTrees(ctx context.Context, tx *sql.Tx, estateID uuid.UUID, trees []domain.Tree) error {
+	for len(trees) > 0 {
+		chunk := trees[:min(len(trees), insertChunkSize)]
+		trees = trees[len(chunk):]
+
+		query := `INSERT INTO trees (id, estate_id, row, col, height, version) VALUES `
+		args := []interface{}{}
+		argPos := 1
+		for _, tree := range chunk {
+			// constructed with placeholder, still safe from sql injections
+			query += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d),", argPos, argPos+1, argPos+2, argPos+3, argPos+4, argPos+5)
+			args = append(args, tree.ID, estateID, tree.Plot.Row, tree.Plot.Col, tree.Height, tree.Version)
+			argPos += 6
+		}
+
+		// Trim the trailing comma
+		query = query[:len(query)-1]
+
+		_, err := tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insertTreeMeasurements bulk insert height readings of trees of an estate using the caller transaction,
+// in chunks that stay below the placeholder limit of postgres
+func insertTreeMeasurements(ctx context.Context, tx *sql.Tx, estateID uuid.UUID, measurements []domain.TreeMeasurement) error {
+	for len(measurements) > 0 {
+		chunk := measurements[:min(len(measurements), insertChunkSize)]
+		measurements = measurements[len(chunk):]
+
+		query := `INSERT INTO tree_measurements (tree_id, estate_id, height, measured_at) VALUES `
+		args := []interface{}{}
+		argPos := 1
+		for _, measurement := range chunk {
+			// constructed with placeholder, still safe from sql injections
+			query += fmt.Sprintf("($%d, $%d, $%d, $%d),", argPos, argPos+1, argPos+2, argPos+3)
+			args = append(args, measurement.TreeID, estateID, measurement.Height, measurement.MeasuredAt)
+			argPos += 4
+		}
+
+		// Trim the trailing comma
+		query = query[:len(query)-1]
+
+		_, err := tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// measurementsOf return the current height of trees as readings taken at measuredAt
+func measurementsOf(trees []domain.Tree, measuredAt time.Time) []domain.TreeMeasurement {
+	measurements := make([]domain.TreeMeasurement, 0, len(trees))
+	for _, tree := range trees {
+		measurements = append(measurements, domain.TreeMeasurement{TreeID: tree.ID, Height: tree.Height, MeasuredAt: measuredAt})
+	}
+	return measurements
+}
+
+// insertDroneRoutes bulk insert drone routes of an estate using the caller transaction,
+// in chunks that stay below the placeholder limit of postgres
+func insertDroneRoutes(ctx context.Context, tx *sql.Tx, estateID uuid.UUID, droneRoutes []domain.DroneRoute) error {
+	for len(droneRoutes) > 0 {
+		chunk := droneRoutes[:min(len(droneRoutes), insertChunkSize)]
+		droneRoutes = droneRoutes[len(chunk):]
+
+		query := `INSERT INTO drone_routes (estate_id, route, row, col, altitude) VALUES `
+		args := []interface{}{}
+		argPos := 1
+		for _, route := range chunk {
+			// constructed with placeholder, still safe from sql injections
+			query += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d),", argPos, argPos+1, argPos+2, argPos+3, argPos+4)
+			args = append(args, estateID, route.Route, route.Plot.Row, route.Plot.Col, route.Altitude)
+			argPos += 5
+		}
 
-	defer func() {
+		// Trim the trailing comma
+		query = query[:len(query)-1]
+
+		_, err := tx.ExecContext(ctx, query, args...)
 		if err != nil {
-			tx.Rollback()
+			return err
 		}
-	}()
+	}
+	return nil
+}
 
-	query := `INSERT INTO trees (id, estate_id, row, col, height) VALUES ($1, $2, $3, $4, $5)`
-	_, err = tx.ExecContext(ctx, query, tree.ID, estateID, tree.Plot.Row, tree.Plot.Col, tree.Height)
+// requireAllAffected return errMissing when the statement changed fewer than expected rows
+func requireAllAffected(result sql.Result, expected int, errMissing error) error {
+	affected, err := result.RowsAffected()
 	if err != nil {
 		return err
 	}
 
-	query = `UPDATE drone_routes SET altitude = $1 WHERE estate_id = $2 AND row = $3 AND col = $4`
-	_, err = tx.ExecContext(ctx, query, droneRouteAltitude, estateID, tree.Plot.Row, tree.Plot.Col)
+	if affected != int64(expected) {
+		return errMissing
+	}
+	return nil
+}
+
+// requireAffected return errNone when the statement did not change any row
+func requireAffected(result sql.Result, errNone error) error {
+	affected, err := result.RowsAffected()
 	if err != nil {
 		return err
 	}
 
-	return tx.Commit()
+	if affected == 0 {
+		return errNone
+	}
+	return nil
 }
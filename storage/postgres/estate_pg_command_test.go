@@ -2,12 +2,15 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/SawitProRecruitment/EstateService/core/domain"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -20,13 +23,15 @@ func Test_postgres_CreateEstateAndDroneRoute(t *testing.T) {
 	pg := &postgres{DB: mockDB}
 
 	estate := &domain.Estate{
-		ID:     uuid.New(),
-		Width:  100,
-		Length: 200,
+		ID:       uuid.New(),
+		TenantID: uuid.New(),
+		Width:    100,
+		Length:   200,
 	}
 	droneRoutes := []domain.DroneRoute{
 		{Route: 1, Plot: domain.Plot{Row: 1, Col: 1}, Altitude: 1},
 	}
+	event := domain.NewEvent(domain.EventEstateCreated, estate.TenantID, estate.ID, domain.EstateEventData{Width: 100, Length: 200})
 
 	tests := []struct {
 		name      string
@@ -37,8 +42,9 @@ func Test_postgres_CreateEstateAndDroneRoute(t *testing.T) {
 			name: "Success",
 			mockFunc: func() {
 				mock.ExpectBegin()
-				mock.ExpectExec("INSERT INTO estates").WithArgs(estate.ID, estate.Width, estate.Length).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("INSERT INTO estates").WithArgs(estate.ID, estate.TenantID, estate.Width, estate.Length).WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectExec("INSERT INTO drone_routes").WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("INSERT INTO event_outbox").WithArgs(event.ID, estate.TenantID, estate.ID, "estate.created", sqlmock.AnyArg(), event.OccurredAt).WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectCommit()
 			},
 			wantError: false,
@@ -54,7 +60,7 @@ func Test_postgres_CreateEstateAndDroneRoute(t *testing.T) {
 			name: "ExecContext error",
 			mockFunc: func() {
 				mock.ExpectBegin()
-				mock.ExpectExec("INSERT INTO estates").WithArgs(estate.ID, estate.Width, estate.Length).WillReturnError(errors.New("failed to execute query"))
+				mock.ExpectExec("INSERT INTO estates").WithArgs(estate.ID, estate.TenantID, estate.Width, estate.Length).WillReturnError(errors.New("failed to execute query"))
 			},
 			wantError: true,
 		},
@@ -64,7 +70,7 @@ func Test_postgres_CreateEstateAndDroneRoute(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockFunc()
 
-			err := pg.CreateEstateAndDroneRoute(ctx, estate, droneRoutes)
+			err := pg.CreateEstateAndDroneRoute(ctx, estate, droneRoutes, []domain.Event{event})
 			if tt.wantError {
 				assert.Error(t, err)
 			} else {
@@ -76,6 +82,12 @@ func Test_postgres_CreateEstateAndDroneRoute(t *testing.T) {
 	}
 }
 
+// expectDroneTotalDistance expect the drone total distance of an estate to be summed from its routes in the transaction
+func expectDroneTotalDistance(mock sqlmock.Sqlmock, estateID uuid.UUID, routes int, vertical int) {
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\), COALESCE\\(SUM\\(ABS\\(altitude - previous\\).*LAG\\(altitude, 1, 0\\) OVER w.*WINDOW w AS \\(ORDER BY route\\)").
+		WithArgs(estateID).WillReturnRows(sqlmock.NewRows([]string{"count", "vertical"}).AddRow(routes, vertical))
+}
+
 func Test_postgres_CreateTreeAndUpdateDroneRoute(t *testing.T) {
 	ctx := context.Background()
 	mockDB, mock, err := sqlmock.New()
@@ -84,27 +96,95 @@ func Test_postgres_CreateTreeAndUpdateDroneRoute(t *testing.T) {
 
 	pg := &postgres{DB: mockDB}
 
+	tenantID := uuid.New()
 	estateID := uuid.New()
 	tree := &domain.Tree{
 		ID:     uuid.New(),
 		Plot:   domain.Plot{Row: 1, Col: 1},
 		Height: 10,
 	}
+	event := domain.NewEvent(domain.EventTreePlanted, tenantID, estateID, domain.TreeEventData{TreeID: tree.ID, Plot: tree.Plot, Height: tree.Height})
 
 	tests := []struct {
-		name      string
-		mockFunc  func()
-		wantError bool
+		name         string
+		mockFunc     func()
+		wantError    bool
+		expectError  error
+		expectChange domain.DroneDistanceChange
 	}{
 		{
 			name: "Success",
 			mockFunc: func() {
 				mock.ExpectBegin()
-				mock.ExpectExec("INSERT INTO trees").WithArgs(tree.ID, estateID, tree.Plot.Row, tree.Plot.Col, tree.Height).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("INSERT INTO trees").WithArgs(tree.ID, estateID, tree.Plot.Row, tree.Plot.Col, tree.Height, tenantID).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("UPDATE estates SET trees_version = trees_version \\+ 1").WithArgs(estateID, tenantID).WillReturnResult(sqlmock.NewResult(0, 1))
+				expectDroneTotalDistance(mock, estateID, 2, 2)
 				mock.ExpectExec("UPDATE drone_routes").WithArgs(sqlmock.AnyArg(), estateID, tree.Plot.Row, tree.Plot.Col).WillReturnResult(sqlmock.NewResult(1, 1))
+				expectDroneTotalDistance(mock, estateID, 2, 22)
+				mock.ExpectExec("INSERT INTO tree_measurements").WithArgs(tree.ID, estateID, tree.Height, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("INSERT INTO event_outbox").WithArgs(event.ID, tenantID, estateID, "tree.planted", sqlmock.AnyArg(), event.OccurredAt).WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectCommit()
 			},
-			wantError: false,
+			wantError:    false,
+			expectChange: domain.DroneDistanceChange{Previous: 12, Current: 32},
+		},
+		{
+			name: "Estate of other tenant",
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO trees .* WHERE e.id = \\$2 AND e.tenant_id = \\$6").WithArgs(tree.ID, estateID, tree.Plot.Row, tree.Plot.Col, tree.Height, tenantID).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			wantError:   true,
+			expectError: domain.ErrorEstatesNotFound,
+		},
+		{
+			name: "Plot removed by concurrent resize",
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO trees").WithArgs(tree.ID, estateID, tree.Plot.Row, tree.Plot.Col, tree.Height, tenantID).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("UPDATE estates SET trees_version = trees_version \\+ 1").WithArgs(estateID, tenantID).WillReturnResult(sqlmock.NewResult(0, 1))
+				expectDroneTotalDistance(mock, estateID, 2, 2)
+				mock.ExpectExec("UPDATE drone_routes").WithArgs(sqlmock.AnyArg(), estateID, tree.Plot.Row, tree.Plot.Col).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			wantError:   true,
+			expectError: domain.ErrorTreePlotOutOfBound,
+		},
+		{
+			name: "Plot planted concurrently",
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO trees").WithArgs(tree.ID, estateID, tree.Plot.Row, tree.Plot.Col, tree.Height, tenantID).WillReturnError(&pq.Error{Code: "23505", Constraint: "trees_estate_id_row_col_key"})
+				mock.ExpectRollback()
+			},
+			wantError:   true,
+			expectError: domain.ErrorTreeAlreadyExists,
+		},
+		{
+			name: "Estate deleted concurrently",
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO trees").WithArgs(tree.ID, estateID, tree.Plot.Row, tree.Plot.Col, tree.Height, tenantID).WillReturnError(&pq.Error{Code: "23503", Constraint: "trees_estate_id_fkey"})
+				mock.ExpectRollback()
+			},
+			wantError:   true,
+			expectError: domain.ErrorEstatesNotFound,
+		},
+		{
+			name: "Outbox error rollback",
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO trees").WithArgs(tree.ID, estateID, tree.Plot.Row, tree.Plot.Col, tree.Height, tenantID).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("UPDATE estates SET trees_version = trees_version \\+ 1").WithArgs(estateID, tenantID).WillReturnResult(sqlmock.NewResult(0, 1))
+				expectDroneTotalDistance(mock, estateID, 2, 2)
+				mock.ExpectExec("UPDATE drone_routes").WithArgs(sqlmock.AnyArg(), estateID, tree.Plot.Row, tree.Plot.Col).WillReturnResult(sqlmock.NewResult(1, 1))
+				expectDroneTotalDistance(mock, estateID, 2, 22)
+				mock.ExpectExec("INSERT INTO tree_measurements").WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("INSERT INTO event_outbox").WillReturnError(errors.New("failed to insert outbox"))
+				mock.ExpectRollback()
+			},
+			wantError: true,
 		},
 		{
 			name: "BeginTx error",
@@ -117,7 +197,7 @@ func Test_postgres_CreateTreeAndUpdateDroneRoute(t *testing.T) {
 			name: "ExecContext error",
 			mockFunc: func() {
 				mock.ExpectBegin()
-				mock.ExpectExec("INSERT INTO trees").WithArgs(tree.ID, estateID, tree.Plot.Row, tree.Plot.Col, tree.Height).WillReturnError(errors.New("failed to execute query"))
+				mock.ExpectExec("INSERT INTO trees").WithArgs(tree.ID, estateID, tree.Plot.Row, tree.Plot.Col, tree.Height, tenantID).WillReturnError(errors.New("failed to execute query"))
 			},
 			wantError: true,
 		},
@@ -127,14 +207,513 @@ func Test_postgres_CreateTreeAndUpdateDroneRoute(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockFunc()
 
-			err := pg.CreateTreeAndUpdateDroneRoute(ctx, estateID, 1, tree)
+			change, err := pg.CreateTreeAndUpdateDroneRoute(ctx, tenantID, estateID, 1, tree, []domain.Event{event})
 			if tt.wantError {
 				assert.Error(t, err)
+				if tt.expectError != nil {
+					assert.Equal(t, tt.expectError, err)
+				}
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, tt.expectChange, change)
 			}
 
 			assert.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
+
+func Test_postgres_ResizeEstateAndDroneRoute(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+
+	tenantID := uuid.New()
+	estate := &domain.Estate{ID: uuid.New(), TenantID: tenantID, Width: 1, Length: 2, Version: 3}
+	droneRoutes := domain.DroneZigzagTraverse(estate.Width, estate.Length)
+	event := domain.NewEvent(domain.EventEstateResized, tenantID, estate.ID, domain.EstateEventData{Width: 1, Length: 2})
+
+	tests := []struct {
+		name         string
+		mockFunc     func()
+		expectError  error
+		expectChange domain.DroneDistanceChange
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE estates SET width = \\$1, length = \\$2, version = version \\+ 1").WithArgs(1, 2, estate.ID, tenantID, 3).WillReturnResult(sqlmock.NewResult(0, 1))
+				expectDroneTotalDistance(mock, estate.ID, 1, 2)
+				mock.ExpectExec("DELETE FROM drone_routes").WithArgs(estate.ID).WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectQuery("SELECT EXISTS").WithArgs(estate.ID, 1, 2).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+				mock.ExpectExec("INSERT INTO drone_routes").WithArgs(estate.ID, 1, 1, 1, 1, estate.ID, 2, 1, 2, 1).WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectExec("UPDATE drone_routes r SET altitude = t.height \\+ 1").WithArgs(estate.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+				expectDroneTotalDistance(mock, estate.ID, 2, 2)
+				mock.ExpectExec("INSERT INTO event_outbox").WithArgs(event.ID, tenantID, estate.ID, "estate.resized", sqlmock.AnyArg(), event.OccurredAt).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			expectChange: domain.DroneDistanceChange{Previous: 2, Current: 12},
+		},
+		{
+			name: "Version mismatch",
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE estates").WithArgs(1, 2, estate.ID, tenantID, 3).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectError: domain.ErrorVersionMismatch,
+		},
+		{
+			name: "Tree outside of new size",
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE estates").WithArgs(1, 2, estate.ID, tenantID, 3).WillReturnResult(sqlmock.NewResult(0, 1))
+				expectDroneTotalDistance(mock, estate.ID, 1, 2)
+				mock.ExpectExec("DELETE FROM drone_routes").WithArgs(estate.ID).WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectQuery("SELECT EXISTS").WithArgs(estate.ID, 1, 2).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+				mock.ExpectRollback()
+			},
+			expectError: domain.ErrorTreePlotOutOfBound,
+		},
+		{
+			name: "BeginTx error",
+			mockFunc: func() {
+				mock.ExpectBegin().WillReturnError(errors.New("failed to begin transaction"))
+			},
+			expectError: errors.New("failed to begin transaction"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			change, err := pg.ResizeEstateAndDroneRoute(ctx, tenantID, estate, 3, droneRoutes, []domain.Event{event})
+			assert.Equal(t, tt.expectError, err)
+			assert.Equal(t, tt.expectChange, change)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_postgres_UpdateTreeAndDroneRoute(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+
+	tenantID := uuid.New()
+	estateID := uuid.New()
+	tree := &domain.Tree{ID: uuid.New(), Plot: domain.Plot{Row: 2, Col: 3}, Height: 20, Version: 1}
+	event := domain.NewEvent(domain.EventTreeUpdated, tenantID, estateID, domain.TreeEventData{TreeID: tree.ID, Plot: tree.Plot, Height: tree.Height})
+
+	tests := []struct {
+		name         string
+		mockFunc     func()
+		expectError  error
+		expectChange domain.DroneDistanceChange
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE trees t SET height = \\$1, version = t.version \\+ 1").WithArgs(20, tree.ID, estateID, tenantID, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("UPDATE estates SET trees_version = trees_version \\+ 1").WithArgs(estateID, tenantID).WillReturnResult(sqlmock.NewResult(0, 1))
+				expectDroneTotalDistance(mock, estateID, 6, 30)
+				mock.ExpectExec("UPDATE drone_routes").WithArgs(21, estateID, 2, 3).WillReturnResult(sqlmock.NewResult(0, 1))
+				expectDroneTotalDistance(mock, estateID, 6, 42)
+				mock.ExpectExec("INSERT INTO tree_measurements").WithArgs(tree.ID, estateID, 20, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("INSERT INTO event_outbox").WithArgs(event.ID, tenantID, estateID, "tree.updated", sqlmock.AnyArg(), event.OccurredAt).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			expectChange: domain.DroneDistanceChange{Previous: 80, Current: 92},
+		},
+		{
+			name: "Version mismatch",
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE trees").WithArgs(20, tree.ID, estateID, tenantID, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectError: domain.ErrorVersionMismatch,
+		},
+		{
+			name: "Drone route error rollback",
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE trees").WithArgs(20, tree.ID, estateID, tenantID, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("UPDATE estates SET trees_version = trees_version \\+ 1").WithArgs(estateID, tenantID).WillReturnResult(sqlmock.NewResult(0, 1))
+				expectDroneTotalDistance(mock, estateID, 6, 30)
+				mock.ExpectExec("UPDATE drone_routes").WillReturnError(errors.New("failed to update drone route"))
+				mock.ExpectRollback()
+			},
+			expectError: errors.New("failed to update drone route"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			change, err := pg.UpdateTreeAndDroneRoute(ctx, tenantID, estateID, tree, 1, []domain.Event{event})
+			assert.Equal(t, tt.expectError, err)
+			assert.Equal(t, tt.expectChange, change)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_postgres_DeleteEstate(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+
+	tenantID := uuid.New()
+	estateID := uuid.New()
+	event := domain.NewEvent(domain.EventEstateDeleted, tenantID, estateID, nil)
+
+	tests := []struct {
+		name        string
+		mockFunc    func()
+		expectError error
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT id FROM estates WHERE id = \\$1 AND tenant_id = \\$2 FOR UPDATE").WithArgs(estateID, tenantID).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(estateID))
+				mock.ExpectExec("DELETE FROM drone_routes").WithArgs(estateID).WillReturnResult(sqlmock.NewResult(0, 4))
+				mock.ExpectExec("DELETE FROM trees").WithArgs(estateID).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("DELETE FROM estates").WithArgs(estateID).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("INSERT INTO event_outbox").WithArgs(event.ID, tenantID, estateID, "estate.deleted", sqlmock.AnyArg(), event.OccurredAt).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Estate of another tenant",
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT id FROM estates").WithArgs(estateID, tenantID).WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			expectError: domain.ErrorEstatesNotFound,
+		},
+		{
+			name: "Delete error rollback",
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT id FROM estates").WithArgs(estateID, tenantID).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(estateID))
+				mock.ExpectExec("DELETE FROM drone_routes").WithArgs(estateID).WillReturnError(errors.New("failed to delete drone routes"))
+				mock.ExpectRollback()
+			},
+			expectError: errors.New("failed to delete drone routes"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := pg.DeleteEstate(ctx, tenantID, estateID, []domain.Event{event})
+			assert.Equal(t, tt.expectError, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_postgres_DeleteTreeAndDroneRoute(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+
+	tenantID := uuid.New()
+	estateID := uuid.New()
+	tree := &domain.Tree{ID: uuid.New(), Plot: domain.Plot{Row: 1, Col: 2}, Height: 5}
+	event := domain.NewEvent(domain.EventTreeRemoved, tenantID, estateID, domain.TreeEventData{TreeID: tree.ID, Plot: tree.Plot, Height: 5})
+
+	tests := []struct {
+		name         string
+		mockFunc     func()
+		expectError  error
+		expectChange domain.DroneDistanceChange
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("DELETE FROM trees t USING estates e").WithArgs(tree.ID, estateID, tenantID).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("UPDATE estates SET trees_version = trees_version \\+ 1").WithArgs(estateID, tenantID).WillReturnResult(sqlmock.NewResult(0, 1))
+				expectDroneTotalDistance(mock, estateID, 2, 12)
+				mock.ExpectExec("UPDATE drone_routes SET altitude = \\$1").WithArgs(domain.GroundAltitude, estateID, 1, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+				expectDroneTotalDistance(mock, estateID, 2, 2)
+				mock.ExpectExec("INSERT INTO event_outbox").WithArgs(event.ID, tenantID, estateID, "tree.removed", sqlmock.AnyArg(), event.OccurredAt).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			expectChange: domain.DroneDistanceChange{Previous: 22, Current: 12},
+		},
+		{
+			name: "Tree not found",
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("DELETE FROM trees t USING estates e").WithArgs(tree.ID, estateID, tenantID).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectError: domain.ErrorTreeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			change, err := pg.DeleteTreeAndDroneRoute(ctx, tenantID, estateID, tree, []domain.Event{event})
+			assert.Equal(t, tt.expectError, err)
+			assert.Equal(t, tt.expectChange, change)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_postgres_PromoteSandbox(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+
+	tenantID := uuid.New()
+	sourceID := uuid.New()
+	clonedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sandbox := &domain.Estate{ID: uuid.New(), Width: 2, Length: 2, Sandbox: &domain.Sandbox{SourceEstateID: &sourceID, SourceVersion: 3, SourceTreesVersion: 5, ClonedAt: clonedAt}}
+	removed := domain.Tree{ID: uuid.New(), Plot: domain.Plot{Row: 1, Col: 1}, Height: 3, Version: 1}
+	updated := domain.Tree{ID: uuid.New(), Plot: domain.Plot{Row: 1, Col: 2}, Height: 9, Version: 2}
+	planted := domain.Tree{ID: uuid.New(), Plot: domain.Plot{Row: 2, Col: 1}, Height: 4, Version: domain.InitialVersion}
+	changes := domain.TreeChanges{Planted: []domain.Tree{planted}, Updated: []domain.Tree{updated}, Removed: []domain.Tree{removed}}
+	event := domain.NewEvent(domain.EventEstateDeleted, tenantID, sandbox.ID, nil)
+
+	lockEstates := func(version int, treesVersion int) {
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT version, trees_version FROM estates WHERE id = \\$1 AND tenant_id = \\$2 FOR UPDATE").WithArgs(sourceID, tenantID).
+			WillReturnRows(sqlmock.NewRows([]string{"version", "trees_version"}).AddRow(version, treesVersion))
+		mock.ExpectQuery("SELECT COALESCE\\(source_version, 0\\), COALESCE\\(source_trees_version, 0\\)\\s+FROM estates WHERE id = \\$1 AND tenant_id = \\$2 AND source_estate_id = \\$3 FOR UPDATE").
+			WithArgs(sandbox.ID, tenantID, sourceID).WillReturnRows(sqlmock.NewRows([]string{"source_version", "source_trees_version"}).AddRow(3, 5))
+	}
+	deleteSandbox := func() {
+		expectDroneTotalDistance(mock, sourceID, 4, 8)
+		mock.ExpectExec("DELETE FROM drone_routes").WithArgs(sandbox.ID).WillReturnResult(sqlmock.NewResult(0, 4))
+		mock.ExpectExec("DELETE FROM trees").WithArgs(sandbox.ID).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec("DELETE FROM estates").WithArgs(sandbox.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+
+	tests := []struct {
+		name         string
+		sandbox      *domain.Estate
+		mockFunc     func()
+		expectError  error
+		expectChange domain.DroneDistanceChange
+	}{
+		{
+			name:    "Success",
+			sandbox: sandbox,
+			mockFunc: func() {
+				lockEstates(3, 5)
+				deleteSandbox()
+				mock.ExpectExec("DELETE FROM trees t USING unnest").
+					WithArgs(sourceID, pq.Array([]string{removed.ID.String()}), pq.Array([]int64{1})).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("UPDATE trees t SET height").
+					WithArgs(sourceID, pq.Array([]string{updated.ID.String()}), pq.Array([]int64{2}), pq.Array([]int64{9})).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("INSERT INTO trees").WithArgs(planted.ID, sourceID, 2, 1, 4, domain.InitialVersion).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("INSERT INTO tree_measurements").
+					WithArgs(updated.ID, sourceID, 9, sqlmock.AnyArg(), planted.ID, sourceID, 4, sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(2, 2))
+				mock.ExpectExec("UPDATE estates SET trees_version = trees_version \\+ 1").WithArgs(sourceID, tenantID).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("UPDATE drone_routes r SET altitude").WithArgs(sourceID, domain.GroundAltitude).WillReturnResult(sqlmock.NewResult(0, 4))
+				expectDroneTotalDistance(mock, sourceID, 4, 20)
+				mock.ExpectExec("INSERT INTO event_outbox").WithArgs(event.ID, tenantID, sandbox.ID, "estate.deleted", sqlmock.AnyArg(), event.OccurredAt).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			expectChange: domain.DroneDistanceChange{Previous: 38, Current: 50},
+		},
+		{
+			name:    "Not a sandbox",
+			sandbox: &domain.Estate{ID: sandbox.ID},
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectRollback()
+			},
+			expectError: domain.ErrorEstateNotSandbox,
+		},
+		{
+			name:    "Source not found",
+			sandbox: sandbox,
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT version, trees_version FROM estates").WithArgs(sourceID, tenantID).WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			expectError: domain.ErrorEstatesNotFound,
+		},
+		{
+			name:    "Source changed since the clone",
+			sandbox: sandbox,
+			mockFunc: func() {
+				lockEstates(4, 5)
+				mock.ExpectRollback()
+			},
+			expectError: domain.ErrorSandboxStale,
+		},
+		{
+			name:    "Source trees changed since the clone",
+			sandbox: sandbox,
+			mockFunc: func() {
+				lockEstates(3, 6)
+				mock.ExpectRollback()
+			},
+			expectError: domain.ErrorSandboxStale,
+		},
+		{
+			name:    "Removed tree changed",
+			sandbox: sandbox,
+			mockFunc: func() {
+				lockEstates(3, 5)
+				deleteSandbox()
+				mock.ExpectExec("DELETE FROM trees t USING unnest").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectError: domain.ErrorSandboxStale,
+		},
+		{
+			name:    "Plot planted in the meantime",
+			sandbox: sandbox,
+			mockFunc: func() {
+				lockEstates(3, 5)
+				deleteSandbox()
+				mock.ExpectExec("DELETE FROM trees t USING unnest").WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("UPDATE trees t SET height").WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("INSERT INTO trees").WillReturnError(&pq.Error{Code: "23505"})
+				mock.ExpectRollback()
+			},
+			expectError: domain.ErrorSandboxStale,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			change, err := pg.PromoteSandbox(ctx, tenantID, tt.sandbox, changes, []domain.Event{event})
+			assert.Equal(t, tt.expectError, err)
+			assert.Equal(t, tt.expectChange, change)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_postgres_ImportEstate(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+
+	tree := domain.Tree{ID: uuid.New(), Plot: domain.Plot{Row: 1, Col: 1}, Height: 5, Version: 2}
+	snapshot := &domain.EstateSnapshot{
+		Estate: domain.Estate{ID: uuid.New(), TenantID: uuid.New(), Width: 1, Length: 1, Version: 3},
+		Trees:  []domain.Tree{tree},
+		Measurements: []domain.TreeMeasurement{
+			{TreeID: tree.ID, Height: 4, MeasuredAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+			{TreeID: tree.ID, Height: 5, MeasuredAt: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+	droneRoutes := []domain.DroneRoute{{Route: 1, Plot: domain.Plot{Row: 1, Col: 1}, Altitude: 6}}
+	estate := snapshot.Estate
+	created := domain.NewEvent(domain.EventEstateCreated, estate.TenantID, estate.ID, domain.EstateEventData{Width: 1, Length: 1})
+
+	tests := []struct {
+		name      string
+		mockFunc  func()
+		wantError error
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO estates").WithArgs(estate.ID, estate.TenantID, 1, 1, 3, nil, nil, nil, nil).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("INSERT INTO trees").WithArgs(tree.ID, estate.ID, 1, 1, 5, 2).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("INSERT INTO drone_routes").WithArgs(estate.ID, 1, 1, 1, 6).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(`INSERT INTO tree_measurements \(tree_id, estate_id, height, measured_at\) VALUES \(\$1, \$2, \$3, \$4\),\(\$5, \$6, \$7, \$8\)`).
+					WithArgs(tree.ID, estate.ID, 4, snapshot.Measurements[0].MeasuredAt, tree.ID, estate.ID, 5, snapshot.Measurements[1].MeasuredAt).WillReturnResult(sqlmock.NewResult(2, 2))
+				mock.ExpectExec("INSERT INTO event_outbox").
+					WithArgs(created.ID, estate.TenantID, estate.ID, "estate.created", sqlmock.AnyArg(), created.OccurredAt).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Estate already exists",
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO estates").WillReturnError(&pq.Error{Code: pqUniqueViolation})
+				mock.ExpectRollback()
+			},
+			wantError: domain.ErrorSnapshotConflict,
+		},
+		{
+			name: "Tree already exists",
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO estates").WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("INSERT INTO trees").WillReturnError(&pq.Error{Code: pqUniqueViolation})
+				mock.ExpectRollback()
+			},
+			wantError: domain.ErrorSnapshotConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := pg.ImportEstate(ctx, snapshot, droneRoutes, []domain.Event{created})
+			assert.Equal(t, tt.wantError, err)
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_insertDroneRoutes(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	estateID := uuid.New()
+	droneRoutes := domain.DroneRoutesOverTrees(1, insertChunkSize+1, nil)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO drone_routes").WillReturnResult(sqlmock.NewResult(0, insertChunkSize))
+	mock.ExpectExec(`INSERT INTO drone_routes \(estate_id, route, row, col, altitude\) VALUES \(\$1, \$2, \$3, \$4, \$5\)$`).
+		WithArgs(estateID, insertChunkSize+1, 1, insertChunkSize+1, domain.GroundAltitude).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	tx, err := mockDB.Begin()
+	assert.NoError(t, err)
+	assert.NoError(t, insertDroneRoutes(ctx, tx, estateID, droneRoutes))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
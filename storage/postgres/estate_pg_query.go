@@ -4,22 +4,27 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 
 	"github.com/SawitProRecruitment/EstateService/core/domain"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
-// GetEstateAndStats retrieves estate and estate statistics for all trees, using a LEFT JOIN on the estate table,
+// GetEstateAndStats retrieves estate of the tenant and estate statistics for all trees, using a LEFT JOIN on the estate table,
 // allowing estates to be returned even if they have no associated tree stats.
 // this is for minimizing query to db when doing validations both on estate and tree stats existense
-func (p *postgres) GetEstateAndStats(ctx context.Context, estateID uuid.UUID) (*domain.Estate, *domain.EstateStats, error) {
+func (p *postgres) GetEstateAndStats(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID) (*domain.Estate, *domain.EstateStats, error) {
 	query := `
-        SELECT e.id, e.width, e.length, m.tree_count, m.max_height, m.min_height, m.median_height
+        SELECT e.id, e.tenant_id, e.width, e.length, e.version, e.cloned_at, e.source_estate_id, e.source_version, e.source_trees_version, m.tree_count, m.max_height, m.min_height, m.median_height
         FROM estates e LEFT JOIN estate_stats_mv m ON m.estate_id = e.id 
-        WHERE e.id = $1
+        WHERE e.id = $1 AND e.tenant_id = $2
     `
 
 	var estate domain.Estate
+	var clonedAt *time.Time
+	var sourceEstateID *uuid.UUID
+	var sourceVersion, sourceTreesVersion *int
 
 	//stats can be empty
 	var statsCount *int
@@ -27,8 +32,8 @@ func (p *postgres) GetEstateAndStats(ctx context.Context, estateID uuid.UUID) (*
 	var statsMin *int
 	var statsMedian *int
 
-	err := p.DB.QueryRowContext(ctx, query, estateID).Scan(
-		&estate.ID, &estate.Width, &estate.Length, &statsCount, &statsMax, &statsMin, &statsMedian,
+	err := p.DB.QueryRowContext(ctx, query, estateID, tenantID).Scan(
+		&estate.ID, &estate.TenantID, &estate.Width, &estate.Length, &estate.Version, &clonedAt, &sourceEstateID, &sourceVersion, &sourceTreesVersion, &statsCount, &statsMax, &statsMin, &statsMedian,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -36,6 +41,7 @@ func (p *postgres) GetEstateAndStats(ctx context.Context, estateID uuid.UUID) (*
 		}
 		return nil, nil, err
 	}
+	estate.Sandbox = sandboxOf(clonedAt, sourceEstateID, sourceVersion, sourceTreesVersion)
 
 	if statsCount == nil || statsMax == nil || statsMin == nil || statsMedian == nil {
 		return &estate, nil, nil
@@ -50,19 +56,19 @@ func (p *postgres) GetEstateAndStats(ctx context.Context, estateID uuid.UUID) (*
 	return &estate, &stats, nil
 }
 
-// GetDroneRoutes retrieves a list of drone routes within estates.
+// GetDroneRoutes retrieves a list of drone routes within estates of the tenant.
 // The routes and altitude is precomputed when creating estates and trees.
 // While this doesn't necessarily improve read performance (as we still need to sum the total distances),
 // it makes the data structure cleaner and more intuitive.
-func (p *postgres) GetDroneRoutes(ctx context.Context, estateID uuid.UUID) ([]domain.DroneRoute, error) {
+func (p *postgres) GetDroneRoutes(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID) ([]domain.DroneRoute, error) {
 	query := `
-        SELECT route, row, col, altitude 
-        FROM drone_routes 
-        WHERE estate_id = $1
-        ORDER BY route
+        SELECT r.route, r.row, r.col, r.altitude 
+        FROM drone_routes r JOIN estates e ON e.id = r.estate_id
+        WHERE r.estate_id = $1 AND e.tenant_id = $2
+        ORDER BY r.route
     `
 
-	rows, err := p.DB.QueryContext(ctx, query, estateID)
+	rows, err := p.DB.QueryContext(ctx, query, estateID, tenantID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -88,14 +94,14 @@ func (p *postgres) GetDroneRoutes(ctx context.Context, estateID uuid.UUID) ([]do
 	return routes, nil
 }
 
-// GetEstateAndTree retrieves tree along with its estate, using a LEFT JOIN on the estate table,
+// GetEstateAndTree retrieves tree along with its estate of the tenant, using a LEFT JOIN on the estate table,
 // allowing estates to be returned even if they have no associated trees.
 // this is for minimizing query to db when doing validations both on estate and tree existense
-func (p *postgres) GetEstateAndTree(ctx context.Context, estateID uuid.UUID, plot domain.Plot) (*domain.Estate, *domain.Tree, error) {
+func (p *postgres) GetEstateAndTree(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, plot domain.Plot) (*domain.Estate, *domain.Tree, error) {
 	query := `
-        SELECT e.id as estate_id, e.width, e.length, t.id as tree_id, t.row, t.col, t.height 
+        SELECT e.id as estate_id, e.tenant_id, e.width, e.length, e.version, t.id as tree_id, t.row, t.col, t.height, t.version
         FROM estates e LEFT JOIN trees t ON t.estate_id = e.id AND t.row = $2 AND t.col = $3 
-        WHERE e.id = $1
+        WHERE e.id = $1 AND e.tenant_id = $4
     `
 
 	var estate domain.Estate
@@ -105,9 +111,10 @@ func (p *postgres) GetEstateAndTree(ctx context.Context, estateID uuid.UUID, plo
 	var treeRow *int
 	var treeCol *int
 	var treeHeight *int
+	var treeVersion *int
 
-	err := p.DB.QueryRowContext(ctx, query, estateID, plot.Row, plot.Col).Scan(
-		&estate.ID, &estate.Width, &estate.Length, &treeID, &treeRow, &treeCol, &treeHeight,
+	err := p.DB.QueryRowContext(ctx, query, estateID, plot.Row, plot.Col, tenantID).Scan(
+		&estate.ID, &estate.TenantID, &estate.Width, &estate.Length, &estate.Version, &treeID, &treeRow, &treeCol, &treeHeight, &treeVersion,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -116,14 +123,330 @@ func (p *postgres) GetEstateAndTree(ctx context.Context, estateID uuid.UUID, plo
 		return nil, nil, err
 	}
 
-	if treeID == uuid.Nil || treeRow == nil || treeCol == nil || treeHeight == nil {
+	if treeID == uuid.Nil || treeRow == nil || treeCol == nil || treeHeight == nil || treeVersion == nil {
 		return &estate, nil, nil
 	}
 
 	tree := domain.Tree{
-		ID:     treeID,
-		Plot:   domain.Plot{Row: *treeRow, Col: *treeCol},
-		Height: *treeHeight,
+		ID:      treeID,
+		Plot:    domain.Plot{Row: *treeRow, Col: *treeCol},
+		Height:  *treeHeight,
+		Version: *treeVersion,
 	}
 	return &estate, &tree, nil
 }
+
+// GetTree retrieves tree of an estate of the tenant
+func (p *postgres) GetTree(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, treeID uuid.UUID) (*domain.Tree, error) {
+	query := `
+        SELECT t.id, t.row, t.col, t.height, t.version
+        FROM trees t JOIN estates e ON e.id = t.estate_id
+        WHERE t.id = $1 AND t.estate_id = $2 AND e.tenant_id = $3
+    `
+
+	var tree domain.Tree
+	err := p.DB.QueryRowContext(ctx, query, treeID, estateID, tenantID).Scan(
+		&tree.ID, &tree.Plot.Row, &tree.Plot.Col, &tree.Height, &tree.Version,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &tree, nil
+}
+
+// ListEstates retrieves a page of estates of the tenant, oldest first
+func (p *postgres) ListEstates(ctx context.Context, tenantID uuid.UUID, limit int, offset int) ([]domain.Estate, error) {
+	query := `
+        SELECT id, tenant_id, width, length, version, cloned_at, source_estate_id, source_version, source_trees_version
+        FROM estates
+        WHERE tenant_id = $1
+        ORDER BY created_at, id
+        LIMIT $2 OFFSET $3
+    `
+
+	rows, err := p.DB.QueryContext(ctx, query, tenantID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	estates := []domain.Estate{}
+	for rows.Next() {
+		var estate domain.Estate
+		var clonedAt *time.Time
+		var sourceEstateID *uuid.UUID
+		var sourceVersion, sourceTreesVersion *int
+		err := rows.Scan(&estate.ID, &estate.TenantID, &estate.Width, &estate.Length, &estate.Version, &clonedAt, &sourceEstateID, &sourceVersion, &sourceTreesVersion)
+		if err != nil {
+			return nil, err
+		}
+		estate.Sandbox = sandboxOf(clonedAt, sourceEstateID, sourceVersion, sourceTreesVersion)
+		estates = append(estates, estate)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return estates, nil
+}
+
+// ListTrees retrieves trees of an estate of the tenant ordered by plot,
+// an estate without trees and an unknown estate both return an empty list
+func (p *postgres) ListTrees(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID) ([]domain.Tree, error) {
+	query := `
+        SELECT t.id, t.row, t.col, t.height, t.version
+        FROM trees t JOIN estates e ON e.id = t.estate_id
+        WHERE t.estate_id = $1 AND e.tenant_id = $2
+        ORDER BY t.row, t.col
+    `
+
+	rows, err := p.DB.QueryContext(ctx, query, estateID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	trees := []domain.Tree{}
+	for rows.Next() {
+		var tree domain.Tree
+		err := rows.Scan(&tree.ID, &tree.Plot.Row, &tree.Plot.Col, &tree.Height, &tree.Version)
+		if err != nil {
+			return nil, err
+		}
+		trees = append(trees, tree)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return trees, nil
+}
+
+// ListTreesByEstates retrieves trees of many estates of the tenant in one query ordered by plot, grouped by estate.
+// Estates without trees and unknown estates are absent from the result
+func (p *postgres) ListTreesByEstates(ctx context.Context, tenantID uuid.UUID, estateIDs []uuid.UUID) (map[uuid.UUID][]domain.Tree, error) {
+	query := `
+        SELECT t.estate_id, t.id, t.row, t.col, t.height, t.version
+        FROM trees t JOIN estates e ON e.id = t.estate_id
+        WHERE t.estate_id = ANY($1) AND e.tenant_id = $2
+        ORDER BY t.estate_id, t.row, t.col
+    `
+
+	rows, err := p.DB.QueryContext(ctx, query, pq.Array(uuidsToStrings(estateIDs)), tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	trees := map[uuid.UUID][]domain.Tree{}
+	for rows.Next() {
+		var estateID uuid.UUID
+		var tree domain.Tree
+		err := rows.Scan(&estateID, &tree.ID, &tree.Plot.Row, &tree.Plot.Col, &tree.Height, &tree.Version)
+		if err != nil {
+			return nil, err
+		}
+		trees[estateID] = append(trees[estateID], tree)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return trees, nil
+}
+
+// GetEstatesStats retrieves statistics of many estates of the tenant in one query,
+// estates without trees have zero stats and unknown estates are absent from the result
+func (p *postgres) GetEstatesStats(ctx context.Context, tenantID uuid.UUID, estateIDs []uuid.UUID) (map[uuid.UUID]domain.EstateStats, error) {
+	query := `
+        SELECT e.id, COALESCE(m.tree_count, 0), COALESCE(m.max_height, 0), COALESCE(m.min_height, 0), COALESCE(m.median_height, 0)
+        FROM estates e LEFT JOIN estate_stats_mv m ON m.estate_id = e.id
+        WHERE e.id = ANY($1) AND e.tenant_id = $2
+    `
+
+	rows, err := p.DB.QueryContext(ctx, query, pq.Array(uuidsToStrings(estateIDs)), tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats := map[uuid.UUID]domain.EstateStats{}
+	for rows.Next() {
+		var estateID uuid.UUID
+		var s domain.EstateStats
+		err := rows.Scan(&estateID, &s.Count, &s.Max, &s.Min, &s.Median)
+		if err != nil {
+			return nil, err
+		}
+		stats[estateID] = s
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// GetDroneRoutesByEstates retrieves drone routes of many estates of the tenant in one query, grouped by estate.
+// Every estate has drone routes, so unknown estates are the ones absent from the result
+func (p *postgres) GetDroneRoutesByEstates(ctx context.Context, tenantID uuid.UUID, estateIDs []uuid.UUID) (map[uuid.UUID][]domain.DroneRoute, error) {
+	query := `
+        SELECT r.estate_id, r.route, r.row, r.col, r.altitude
+        FROM drone_routes r JOIN estates e ON e.id = r.estate_id
+        WHERE r.estate_id = ANY($1) AND e.tenant_id = $2
+        ORDER BY r.estate_id, r.route
+    `
+
+	rows, err := p.DB.QueryContext(ctx, query, pq.Array(uuidsToStrings(estateIDs)), tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	routes := map[uuid.UUID][]domain.DroneRoute{}
+	for rows.Next() {
+		var estateID uuid.UUID
+		var route domain.DroneRoute
+		err := rows.Scan(&estateID, &route.Route, &route.Plot.Row, &route.Plot.Col, &route.Altitude)
+		if err != nil {
+			return nil, err
+		}
+		routes[estateID] = append(routes[estateID], route)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return routes, nil
+}
+
+// ListTreeMeasurements retrieves height readings of trees within estates of the tenant, oldest first and grouped by tree
+func (p *postgres) ListTreeMeasurements(ctx context.Context, tenantID uuid.UUID, estateIDs []uuid.UUID, treeIDs []uuid.UUID) (map[uuid.UUID][]domain.TreeMeasurement, error) {
+	query := `
+        SELECT m.tree_id, m.height, m.measured_at
+        FROM tree_measurements m JOIN estates e ON e.id = m.estate_id
+        WHERE e.tenant_id = $1 AND m.estate_id = ANY($2) AND m.tree_id = ANY($3)
+        ORDER BY m.measured_at, m.id
+    `
+
+	rows, err := p.DB.QueryContext(ctx, query, tenantID, pq.Array(uuidsToStrings(estateIDs)), pq.Array(uuidsToStrings(treeIDs)))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	measurements := map[uuid.UUID][]domain.TreeMeasurement{}
+	for rows.Next() {
+		var measurement domain.TreeMeasurement
+		err := rows.Scan(&measurement.TreeID, &measurement.Height, &measurement.MeasuredAt)
+		if err != nil {
+			return nil, err
+		}
+		measurements[measurement.TreeID] = append(measurements[measurement.TreeID], measurement)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return measurements, nil
+}
+
+// GetEstateSnapshot retrieves estate of the tenant with its trees, measurements and drone routes.
+// Everything is read in one repeatable read transaction, so the snapshot is consistent even while the estate changes
+func (p *postgres) GetEstateSnapshot(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID) (*domain.EstateSnapshot, error) {
+	tx, err := p.DB.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	// read only, nothing to commit
+	defer tx.Rollback()
+
+	snapshot := &domain.EstateSnapshot{}
+	estate := &snapshot.Estate
+	query := `SELECT id, tenant_id, width, length, version, trees_version FROM estates WHERE id = $1 AND tenant_id = $2`
+	err = tx.QueryRowContext(ctx, query, estateID, tenantID).Scan(&estate.ID, &estate.TenantID, &estate.Width, &estate.Length, &estate.Version, &estate.TreesVersion)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	query = `SELECT id, row, col, height, version FROM trees WHERE estate_id = $1 ORDER BY row, col`
+	err = queryRows(ctx, tx, query, []any{estateID}, func(rows *sql.Rows) error {
+		var tree domain.Tree
+		err := rows.Scan(&tree.ID, &tree.Plot.Row, &tree.Plot.Col, &tree.Height, &tree.Version)
+		snapshot.Trees = append(snapshot.Trees, tree)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	query = `SELECT tree_id, height, measured_at FROM tree_measurements WHERE estate_id = $1 ORDER BY measured_at, id`
+	err = queryRows(ctx, tx, query, []any{estateID}, func(rows *sql.Rows) error {
+		var measurement domain.TreeMeasurement
+		err := rows.Scan(&measurement.TreeID, &measurement.Height, &measurement.MeasuredAt)
+		snapshot.Measurements = append(snapshot.Measurements, measurement)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	query = `SELECT route, row, col, altitude FROM drone_routes WHERE estate_id = $1 ORDER BY route`
+	err = queryRows(ctx, tx, query, []any{estateID}, func(rows *sql.Rows) error {
+		var route domain.DroneRoute
+		err := rows.Scan(&route.Route, &route.Plot.Row, &route.Plot.Col, &route.Altitude)
+		snapshot.DroneRoutes = append(snapshot.DroneRoutes, route)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// queryRows run query in tx and call scan for every row
+func queryRows(ctx context.Context, tx *sql.Tx, query string, args []any, scan func(rows *sql.Rows) error) error {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err = scan(rows)
+		if err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// sandboxOf return the sandbox of an estate from its nullable columns, only sandboxes have a clone time
+func sandboxOf(clonedAt *time.Time, sourceEstateID *uuid.UUID, sourceVersion *int, sourceTreesVersion *int) *domain.Sandbox {
+	if clonedAt == nil {
+		return nil
+	}
+
+	sandbox := &domain.Sandbox{SourceEstateID: sourceEstateID, ClonedAt: *clonedAt}
+	if sourceVersion != nil {
+		sandbox.SourceVersion = *sourceVersion
+	}
+	if sourceTreesVersion != nil {
+		sandbox.SourceTreesVersion = *sourceTreesVersion
+	}
+	return sandbox
+}
@@ -3,12 +3,15 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/SawitProRecruitment/EstateService/core/domain"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -19,6 +22,7 @@ func Test_postgres_GetEstateStats(t *testing.T) {
 	defer mockDB.Close()
 
 	pg := &postgres{DB: mockDB}
+	tenantID := uuid.New()
 	estateID := uuid.New()
 
 	tests := []struct {
@@ -32,27 +36,27 @@ func Test_postgres_GetEstateStats(t *testing.T) {
 			name: "Success",
 			mockFunc: func() {
 				mock.ExpectQuery(`
-                        SELECT e.id, e.width, e.length, m.tree_count, m.max_height, m.min_height, m.median_height
+                        SELECT e.id, e.tenant_id, e.width, e.length, e.version, e.cloned_at, e.source_estate_id, e.source_version, e.source_trees_version, m.tree_count, m.max_height, m.min_height, m.median_height
                         FROM estates e LEFT JOIN estate_stats_mv m ON m.estate_id = e.id 
-                        WHERE e.id = \$1
+                        WHERE e.id = \$1 AND e.tenant_id = \$2
                     `).
-					WithArgs(estateID).
-					WillReturnRows(sqlmock.NewRows([]string{"id", "width", "length", "tree_count", "max_height", "min_height", "median_height"}).
-						AddRow(estateID, 10, 10, 10, 100, 1, 50))
+					WithArgs(estateID, tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "width", "length", "version", "cloned_at", "source_estate_id", "source_version", "source_trees_version", "tree_count", "max_height", "min_height", "median_height"}).
+						AddRow(estateID, tenantID, 10, 10, 3, nil, nil, nil, nil, 10, 100, 1, 50))
 			},
 			wantError: false,
-			estate:    &domain.Estate{ID: estateID, Width: 10, Length: 10},
+			estate:    &domain.Estate{ID: estateID, TenantID: tenantID, Width: 10, Length: 10, Version: 3},
 			stats:     &domain.EstateStats{Count: 10, Max: 100, Min: 1, Median: 50},
 		},
 		{
 			name: "Query error",
 			mockFunc: func() {
 				mock.ExpectQuery(`
-                        SELECT e.id, e.width, e.length, m.tree_count, m.max_height, m.min_height, m.median_height
+                        SELECT e.id, e.tenant_id, e.width, e.length, e.version, e.cloned_at, e.source_estate_id, e.source_version, e.source_trees_version, m.tree_count, m.max_height, m.min_height, m.median_height
                         FROM estates e LEFT JOIN estate_stats_mv m ON m.estate_id = e.id 
-                        WHERE e.id = \$1
+                        WHERE e.id = \$1 AND e.tenant_id = \$2
                     `).
-					WithArgs(estateID).
+					WithArgs(estateID, tenantID).
 					WillReturnError(errors.New("query error"))
 			},
 			wantError: true,
@@ -63,7 +67,7 @@ func Test_postgres_GetEstateStats(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockFunc()
 
-			estate, stats, err := pg.GetEstateAndStats(ctx, estateID)
+			estate, stats, err := pg.GetEstateAndStats(ctx, tenantID, estateID)
 			if tt.wantError {
 				assert.Error(t, err)
 			} else {
@@ -84,6 +88,7 @@ func Test_postgres_GetDroneRoutes(t *testing.T) {
 	defer mockDB.Close()
 
 	pg := &postgres{DB: mockDB}
+	tenantID := uuid.New()
 	estateID := uuid.New()
 
 	tests := []struct {
@@ -95,8 +100,8 @@ func Test_postgres_GetDroneRoutes(t *testing.T) {
 		{
 			name: "Success",
 			mockFunc: func() {
-				mock.ExpectQuery("SELECT route, row, col, altitude FROM drone_routes").
-					WithArgs(estateID).
+				mock.ExpectQuery("SELECT r.route, r.row, r.col, r.altitude FROM drone_routes r JOIN estates e ON e.id = r.estate_id WHERE r.estate_id = \\$1 AND e.tenant_id = \\$2").
+					WithArgs(estateID, tenantID).
 					WillReturnRows(sqlmock.NewRows([]string{"route", "row", "col", "altitude"}).
 						AddRow(1, 1, 1, 10))
 			},
@@ -106,8 +111,8 @@ func Test_postgres_GetDroneRoutes(t *testing.T) {
 		{
 			name: "Query error",
 			mockFunc: func() {
-				mock.ExpectQuery("SELECT route, row, col, altitude FROM drone_routes").
-					WithArgs(estateID).
+				mock.ExpectQuery("SELECT r.route, r.row, r.col, r.altitude FROM drone_routes r JOIN estates e ON e.id = r.estate_id WHERE r.estate_id = \\$1 AND e.tenant_id = \\$2").
+					WithArgs(estateID, tenantID).
 					WillReturnError(errors.New("query error"))
 			},
 			wantError: true,
@@ -115,8 +120,8 @@ func Test_postgres_GetDroneRoutes(t *testing.T) {
 		{
 			name: "Row scan error",
 			mockFunc: func() {
-				mock.ExpectQuery("SELECT route, row, col, altitude FROM drone_routes").
-					WithArgs(estateID).
+				mock.ExpectQuery("SELECT r.route, r.row, r.col, r.altitude FROM drone_routes r JOIN estates e ON e.id = r.estate_id WHERE r.estate_id = \\$1 AND e.tenant_id = \\$2").
+					WithArgs(estateID, tenantID).
 					WillReturnRows(sqlmock.NewRows([]string{"route", "row", "col", "altitude"}).
 						AddRow(1, "invalid", 1, 10))
 			},
@@ -128,7 +133,7 @@ func Test_postgres_GetDroneRoutes(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockFunc()
 
-			routes, err := pg.GetDroneRoutes(ctx, estateID)
+			routes, err := pg.GetDroneRoutes(ctx, tenantID, estateID)
 			if tt.wantError {
 				assert.Error(t, err)
 			} else {
@@ -141,23 +146,27 @@ func Test_postgres_GetDroneRoutes(t *testing.T) {
 	}
 }
 
-func Test_postgres_GetTree(t *testing.T) {
+func Test_postgres_GetEstateAndTree(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	assert.NoError(t, err)
 	defer db.Close()
 
+	tenantID := uuid.New()
 	estateID := uuid.New()
 	plot := domain.Plot{Row: 1, Col: 1}
 
 	tree := domain.Tree{
-		ID:     uuid.New(),
-		Plot:   plot,
-		Height: 10,
+		ID:      uuid.New(),
+		Plot:    plot,
+		Height:  10,
+		Version: 2,
 	}
 	estate := domain.Estate{
-		ID:     estateID,
-		Width:  100,
-		Length: 200,
+		ID:       estateID,
+		TenantID: tenantID,
+		Width:    100,
+		Length:   200,
+		Version:  1,
 	}
 
 	repo := &postgres{DB: db}
@@ -173,13 +182,13 @@ func Test_postgres_GetTree(t *testing.T) {
 			name: "Success",
 			mockSetup: func() {
 				mock.ExpectQuery(`
-                        SELECT e.id as estate_id, e.width, e.length, t.id as tree_id, t.row, t.col, t.height 
+                        SELECT e.id as estate_id, e.tenant_id, e.width, e.length, e.version, t.id as tree_id, t.row, t.col, t.height, t.version
                         FROM estates e LEFT JOIN trees t ON t.estate_id = e.id AND t.row = \$2 AND t.col = \$3 
-                        WHERE e.id = \$1 
+                        WHERE e.id = \$1 AND e.tenant_id = \$4
                     `).
-					WithArgs(estateID, plot.Row, plot.Col).
-					WillReturnRows(sqlmock.NewRows([]string{"eid", "width", "length", "tid", "row", "col", "height"}).
-						AddRow(estate.ID, estate.Width, estate.Length, tree.ID, tree.Plot.Row, tree.Plot.Col, tree.Height))
+					WithArgs(estateID, plot.Row, plot.Col, tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"eid", "tenant_id", "width", "length", "version", "tid", "row", "col", "height", "version"}).
+						AddRow(estate.ID, estate.TenantID, estate.Width, estate.Length, estate.Version, tree.ID, tree.Plot.Row, tree.Plot.Col, tree.Height, tree.Version))
 			},
 			expectEstate: &estate,
 			expectTree:   &tree,
@@ -189,11 +198,11 @@ func Test_postgres_GetTree(t *testing.T) {
 			name: "Query Error",
 			mockSetup: func() {
 				mock.ExpectQuery(`
-                        SELECT e.id as estate_id, e.width, e.length, t.id as tree_id, t.row, t.col, t.height 
+                        SELECT e.id as estate_id, e.tenant_id, e.width, e.length, e.version, t.id as tree_id, t.row, t.col, t.height, t.version
                         FROM estates e LEFT JOIN trees t ON t.estate_id = e.id AND t.row = \$2 AND t.col = \$3 
-                        WHERE e.id = \$1 
+                        WHERE e.id = \$1 AND e.tenant_id = \$4
                     `).
-					WithArgs(estateID, plot.Row, plot.Col).
+					WithArgs(estateID, plot.Row, plot.Col, tenantID).
 					WillReturnError(errors.New("database error"))
 			},
 			expectEstate: nil,
@@ -204,11 +213,11 @@ func Test_postgres_GetTree(t *testing.T) {
 			name: "Not Found",
 			mockSetup: func() {
 				mock.ExpectQuery(`
-                        SELECT e.id as estate_id, e.width, e.length, t.id as tree_id, t.row, t.col, t.height 
+                        SELECT e.id as estate_id, e.tenant_id, e.width, e.length, e.version, t.id as tree_id, t.row, t.col, t.height, t.version
                         FROM estates e LEFT JOIN trees t ON t.estate_id = e.id AND t.row = \$2 AND t.col = \$3
-                        WHERE e.id = \$1 
+                        WHERE e.id = \$1 AND e.tenant_id = \$4
                     `).
-					WithArgs(estateID, plot.Row, plot.Col).
+					WithArgs(estateID, plot.Row, plot.Col, tenantID).
 					WillReturnError(sql.ErrNoRows)
 			},
 			expectEstate: nil,
@@ -221,7 +230,7 @@ func Test_postgres_GetTree(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockSetup()
 
-			estate, tree, err := repo.GetEstateAndTree(context.Background(), estateID, plot)
+			estate, tree, err := repo.GetEstateAndTree(context.Background(), tenantID, estateID, plot)
 			assert.Equal(t, tt.expectEstate, estate)
 			assert.Equal(t, tt.expectTree, tree)
 			assert.Equal(t, tt.expectErr, err)
@@ -229,3 +238,489 @@ func Test_postgres_GetTree(t *testing.T) {
 		})
 	}
 }
+
+func Test_postgres_GetTree(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	tenantID := uuid.New()
+	estateID := uuid.New()
+	treeID := uuid.New()
+	query := "SELECT t.id, t.row, t.col, t.height, t.version FROM trees t JOIN estates e ON e.id = t.estate_id WHERE t.id = \\$1 AND t.estate_id = \\$2 AND e.tenant_id = \\$3"
+
+	tests := []struct {
+		name      string
+		mockFunc  func()
+		wantError bool
+		tree      *domain.Tree
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				mock.ExpectQuery(query).
+					WithArgs(treeID, estateID, tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "row", "col", "height", "version"}).AddRow(treeID, 2, 3, 10, 4))
+			},
+			tree: &domain.Tree{ID: treeID, Plot: domain.Plot{Row: 2, Col: 3}, Height: 10, Version: 4},
+		},
+		{
+			name: "Not found",
+			mockFunc: func() {
+				mock.ExpectQuery(query).WithArgs(treeID, estateID, tenantID).WillReturnError(sql.ErrNoRows)
+			},
+			tree: nil,
+		},
+		{
+			name: "Query error",
+			mockFunc: func() {
+				mock.ExpectQuery(query).WithArgs(treeID, estateID, tenantID).WillReturnError(errors.New("query error"))
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			tree, err := pg.GetTree(ctx, tenantID, estateID, treeID)
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.tree, tree)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_postgres_ListEstates(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	tenantID := uuid.New()
+	estateID := uuid.New()
+	sandboxID := uuid.New()
+	clonedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	columns := []string{"id", "tenant_id", "width", "length", "version", "cloned_at", "source_estate_id", "source_version", "source_trees_version"}
+	query := "SELECT id, tenant_id, width, length, version, cloned_at, source_estate_id, source_version, source_trees_version FROM estates WHERE tenant_id = \\$1 ORDER BY created_at, id LIMIT \\$2 OFFSET \\$3"
+
+	tests := []struct {
+		name      string
+		mockFunc  func()
+		wantError bool
+		estates   []domain.Estate
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				mock.ExpectQuery(query).
+					WithArgs(tenantID, 10, 20).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(estateID, tenantID, 5, 6, 2, nil, nil, nil, nil).
+						AddRow(sandboxID, tenantID, 5, 6, 1, clonedAt, estateID, 2, 7))
+			},
+			estates: []domain.Estate{
+				{ID: estateID, TenantID: tenantID, Width: 5, Length: 6, Version: 2},
+				{ID: sandboxID, TenantID: tenantID, Width: 5, Length: 6, Version: 1, Sandbox: &domain.Sandbox{SourceEstateID: &estateID, SourceVersion: 2, SourceTreesVersion: 7, ClonedAt: clonedAt}},
+			},
+		},
+		{
+			name: "Empty page",
+			mockFunc: func() {
+				mock.ExpectQuery(query).WithArgs(tenantID, 10, 20).WillReturnRows(sqlmock.NewRows(columns))
+			},
+			estates: []domain.Estate{},
+		},
+		{
+			name: "Query error",
+			mockFunc: func() {
+				mock.ExpectQuery(query).WithArgs(tenantID, 10, 20).WillReturnError(errors.New("query error"))
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			estates, err := pg.ListEstates(ctx, tenantID, 10, 20)
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.estates, estates)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_postgres_ListTrees(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	tenantID := uuid.New()
+	estateID := uuid.New()
+	treeID := uuid.New()
+	query := "SELECT t.id, t.row, t.col, t.height, t.version FROM trees t JOIN estates e ON e.id = t.estate_id WHERE t.estate_id = \\$1 AND e.tenant_id = \\$2 ORDER BY t.row, t.col"
+
+	tests := []struct {
+		name      string
+		mockFunc  func()
+		wantError bool
+		trees     []domain.Tree
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				mock.ExpectQuery(query).
+					WithArgs(estateID, tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "row", "col", "height", "version"}).AddRow(treeID, 2, 3, 10, 1))
+			},
+			trees: []domain.Tree{{ID: treeID, Plot: domain.Plot{Row: 2, Col: 3}, Height: 10, Version: 1}},
+		},
+		{
+			name: "Scan error",
+			mockFunc: func() {
+				mock.ExpectQuery(query).WithArgs(estateID, tenantID).WillReturnRows(sqlmock.NewRows([]string{"id", "row", "col", "height", "version"}).AddRow(treeID, "x", 3, 10, 1))
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			trees, err := pg.ListTrees(ctx, tenantID, estateID)
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.trees, trees)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_postgres_ListTreesByEstates(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	tenantID := uuid.New()
+	estateIDs := []uuid.UUID{uuid.New(), uuid.New()}
+	treeID := uuid.New()
+	otherTreeID := uuid.New()
+	query := "SELECT t.estate_id, t.id, t.row, t.col, t.height, t.version FROM trees t JOIN estates e ON e.id = t.estate_id WHERE t.estate_id = ANY\\(\\$1\\) AND e.tenant_id = \\$2 ORDER BY t.estate_id, t.row, t.col"
+	columns := []string{"estate_id", "id", "row", "col", "height", "version"}
+
+	tests := []struct {
+		name      string
+		mockFunc  func()
+		wantError bool
+		trees     map[uuid.UUID][]domain.Tree
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				mock.ExpectQuery(query).
+					WithArgs(idsArg(estateIDs), tenantID).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(estateIDs[0], treeID, 1, 1, 10, 1).
+						AddRow(estateIDs[0], otherTreeID, 1, 2, 5, 2))
+			},
+			trees: map[uuid.UUID][]domain.Tree{
+				estateIDs[0]: {
+					{ID: treeID, Plot: domain.Plot{Row: 1, Col: 1}, Height: 10, Version: 1},
+					{ID: otherTreeID, Plot: domain.Plot{Row: 1, Col: 2}, Height: 5, Version: 2},
+				},
+			},
+		},
+		{
+			name: "Query error",
+			mockFunc: func() {
+				mock.ExpectQuery(query).WithArgs(idsArg(estateIDs), tenantID).WillReturnError(errors.New("query error"))
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			trees, err := pg.ListTreesByEstates(ctx, tenantID, estateIDs)
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.trees, trees)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_postgres_GetEstatesStats(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	tenantID := uuid.New()
+	estateIDs := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	query := "SELECT e.id, COALESCE\\(m.tree_count, 0\\), .* FROM estates e LEFT JOIN estate_stats_mv m ON m.estate_id = e.id WHERE e.id = ANY\\(\\$1\\) AND e.tenant_id = \\$2"
+	columns := []string{"id", "tree_count", "max_height", "min_height", "median_height"}
+
+	tests := []struct {
+		name      string
+		mockFunc  func()
+		wantError bool
+		stats     map[uuid.UUID]domain.EstateStats
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				mock.ExpectQuery(query).
+					WithArgs(idsArg(estateIDs), tenantID).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(estateIDs[0], 3, 10, 1, 5).
+						AddRow(estateIDs[1], 0, 0, 0, 0))
+			},
+			// the last estate is unknown
+			stats: map[uuid.UUID]domain.EstateStats{
+				estateIDs[0]: {Count: 3, Max: 10, Min: 1, Median: 5},
+				estateIDs[1]: {},
+			},
+		},
+		{
+			name: "Row scan error",
+			mockFunc: func() {
+				mock.ExpectQuery(query).
+					WithArgs(idsArg(estateIDs), tenantID).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(estateIDs[0], "invalid", 10, 1, 5))
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			stats, err := pg.GetEstatesStats(ctx, tenantID, estateIDs)
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.stats, stats)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_postgres_GetDroneRoutesByEstates(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	tenantID := uuid.New()
+	estateIDs := []uuid.UUID{uuid.New(), uuid.New()}
+	query := "SELECT r.estate_id, r.route, r.row, r.col, r.altitude FROM drone_routes r JOIN estates e ON e.id = r.estate_id WHERE r.estate_id = ANY\\(\\$1\\) AND e.tenant_id = \\$2 ORDER BY r.estate_id, r.route"
+
+	mock.ExpectQuery(query).
+		WithArgs(idsArg(estateIDs), tenantID).
+		WillReturnRows(sqlmock.NewRows([]string{"estate_id", "route", "row", "col", "altitude"}).
+			AddRow(estateIDs[0], 1, 1, 1, 1).
+			AddRow(estateIDs[0], 2, 1, 2, 6).
+			AddRow(estateIDs[1], 1, 1, 1, 1))
+
+	routes, err := pg.GetDroneRoutesByEstates(ctx, tenantID, estateIDs)
+	assert.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID][]domain.DroneRoute{
+		estateIDs[0]: {
+			{Route: 1, Plot: domain.Plot{Row: 1, Col: 1}, Altitude: 1},
+			{Route: 2, Plot: domain.Plot{Row: 1, Col: 2}, Altitude: 6},
+		},
+		estateIDs[1]: {{Route: 1, Plot: domain.Plot{Row: 1, Col: 1}, Altitude: 1}},
+	}, routes)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func Test_postgres_ListTreeMeasurements(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	tenantID := uuid.New()
+	estateIDs := []uuid.UUID{uuid.New()}
+	treeIDs := []uuid.UUID{uuid.New(), uuid.New()}
+	plantedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	query := "SELECT m.tree_id, m.height, m.measured_at FROM tree_measurements m JOIN estates e ON e.id = m.estate_id WHERE e.tenant_id = \\$1 AND m.estate_id = ANY\\(\\$2\\) AND m.tree_id = ANY\\(\\$3\\) ORDER BY m.measured_at, m.id"
+
+	tests := []struct {
+		name         string
+		mockFunc     func()
+		wantError    bool
+		measurements map[uuid.UUID][]domain.TreeMeasurement
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				mock.ExpectQuery(query).
+					WithArgs(tenantID, idsArg(estateIDs), idsArg(treeIDs)).
+					WillReturnRows(sqlmock.NewRows([]string{"tree_id", "height", "measured_at"}).
+						AddRow(treeIDs[0], 5, plantedAt).
+						AddRow(treeIDs[0], 7, plantedAt.Add(time.Hour)))
+			},
+			measurements: map[uuid.UUID][]domain.TreeMeasurement{
+				treeIDs[0]: {
+					{TreeID: treeIDs[0], Height: 5, MeasuredAt: plantedAt},
+					{TreeID: treeIDs[0], Height: 7, MeasuredAt: plantedAt.Add(time.Hour)},
+				},
+			},
+		},
+		{
+			name: "Query error",
+			mockFunc: func() {
+				mock.ExpectQuery(query).WillReturnError(errors.New("query error"))
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			measurements, err := pg.ListTreeMeasurements(ctx, tenantID, estateIDs, treeIDs)
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.measurements, measurements)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+// idsArg is the driver value of ids sent as a postgres array
+func idsArg(ids []uuid.UUID) driver.Value {
+	value, _ := pq.Array(uuidsToStrings(ids)).Value()
+	return value
+}
+
+func Test_postgres_GetEstateSnapshot(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	tenantID := uuid.New()
+	estateID := uuid.New()
+	treeID := uuid.New()
+	plantedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	estateQuery := `SELECT id, tenant_id, width, length, version, trees_version FROM estates WHERE id = \$1 AND tenant_id = \$2`
+
+	tests := []struct {
+		name      string
+		mockFunc  func()
+		wantError bool
+		snapshot  *domain.EstateSnapshot
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(estateQuery).WithArgs(estateID, tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "width", "length", "version", "trees_version"}).AddRow(estateID, tenantID, 1, 2, 4, 6))
+				mock.ExpectQuery(`SELECT id, row, col, height, version FROM trees WHERE estate_id = \$1 ORDER BY row, col`).WithArgs(estateID).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "row", "col", "height", "version"}).AddRow(treeID, 1, 2, 7, 2))
+				mock.ExpectQuery(`SELECT tree_id, height, measured_at FROM tree_measurements WHERE estate_id = \$1 ORDER BY measured_at, id`).WithArgs(estateID).
+					WillReturnRows(sqlmock.NewRows([]string{"tree_id", "height", "measured_at"}).
+						AddRow(treeID, 5, plantedAt).
+						AddRow(treeID, 7, plantedAt.Add(time.Hour)))
+				mock.ExpectQuery(`SELECT route, row, col, altitude FROM drone_routes WHERE estate_id = \$1 ORDER BY route`).WithArgs(estateID).
+					WillReturnRows(sqlmock.NewRows([]string{"route", "row", "col", "altitude"}).AddRow(1, 1, 1, 1).AddRow(2, 1, 2, 8))
+				mock.ExpectRollback()
+			},
+			snapshot: &domain.EstateSnapshot{
+				Estate: domain.Estate{ID: estateID, TenantID: tenantID, Width: 1, Length: 2, Version: 4, TreesVersion: 6},
+				Trees:  []domain.Tree{{ID: treeID, Plot: domain.Plot{Row: 1, Col: 2}, Height: 7, Version: 2}},
+				Measurements: []domain.TreeMeasurement{
+					{TreeID: treeID, Height: 5, MeasuredAt: plantedAt},
+					{TreeID: treeID, Height: 7, MeasuredAt: plantedAt.Add(time.Hour)},
+				},
+				DroneRoutes: []domain.DroneRoute{
+					{Route: 1, Plot: domain.Plot{Row: 1, Col: 1}, Altitude: 1},
+					{Route: 2, Plot: domain.Plot{Row: 1, Col: 2}, Altitude: 8},
+				},
+			},
+		},
+		{
+			name: "Not found",
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(estateQuery).WithArgs(estateID, tenantID).WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name: "Query error",
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(estateQuery).WithArgs(estateID, tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "width", "length", "version", "trees_version"}).AddRow(estateID, tenantID, 1, 2, 4, 6))
+				mock.ExpectQuery(`FROM trees`).WillReturnError(errors.New("query error"))
+				mock.ExpectRollback()
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			snapshot, err := pg.GetEstateSnapshot(ctx, tenantID, estateID)
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.snapshot, snapshot)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+)
+
+// SchemaVersion is the version of database.sql this code expects
+const SchemaVersion = 13
+
+// CheckReadiness ping the database and check the applied schema is at least SchemaVersion
+func (p *postgres) CheckReadiness(ctx context.Context) error {
+	err := p.DB.PingContext(ctx)
+	if err != nil {
+		return fmt.Errorf("ping database: %w", err)
+	}
+
+	var version int
+	err = p.DB.QueryRowContext(ctx, `SELECT COALESCE(MAX(version), 0) FROM schema_migrations`).Scan(&version)
+	if err != nil {
+		return fmt.Errorf("read schema version: %w", err)
+	}
+	if version < SchemaVersion {
+		return fmt.Errorf("schema version %d is older than %d, apply database.sql", version, SchemaVersion)
+	}
+	return nil
+}
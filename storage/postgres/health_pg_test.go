@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_postgres_CheckReadiness(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+
+	tests := []struct {
+		name     string
+		mockFunc func()
+		wantErr  string
+	}{
+		{
+			name: "Ready",
+			mockFunc: func() {
+				mock.ExpectPing()
+				mock.ExpectQuery("SELECT COALESCE\\(MAX\\(version\\), 0\\) FROM schema_migrations").
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(SchemaVersion))
+			},
+		},
+		{
+			name: "Newer schema",
+			mockFunc: func() {
+				mock.ExpectPing()
+				mock.ExpectQuery("FROM schema_migrations").WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(SchemaVersion + 1))
+			},
+		},
+		{
+			name: "Ping error",
+			mockFunc: func() {
+				mock.ExpectPing().WillReturnError(errors.New("connection refused"))
+			},
+			wantErr: "ping database: connection refused",
+		},
+		{
+			name: "Schema not applied",
+			mockFunc: func() {
+				mock.ExpectPing()
+				mock.ExpectQuery("FROM schema_migrations").WillReturnError(errors.New(`relation "schema_migrations" does not exist`))
+			},
+			wantErr: "read schema version",
+		},
+		{
+			name: "Outdated schema",
+			mockFunc: func() {
+				mock.ExpectPing()
+				mock.ExpectQuery("FROM schema_migrations").WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(1))
+			},
+			wantErr: "schema version 1 is older than 13",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := pg.CheckReadiness(ctx)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+)
+
+// ReserveIdempotencyKey insert in progress record for the key, an expired record is taken over.
+// Return false when the key is already held by another request, the primary key make it safe for concurrent retries
+func (p *postgres) ReserveIdempotencyKey(ctx context.Context, record *domain.IdempotencyRecord) (bool, error) {
+	query := `
+        INSERT INTO idempotency_keys (tenant_id, key, request_hash, created_at, expires_at)
+        VALUES ($1, $2, $3, $4, $5)
+        ON CONFLICT (tenant_id, key) DO UPDATE
+        SET request_hash = EXCLUDED.request_hash, status_code = NULL, response_body = NULL,
+            created_at = EXCLUDED.created_at, expires_at = EXCLUDED.expires_at
+        WHERE idempotency_keys.expires_at <= EXCLUDED.created_at
+    `
+	result, err := p.DB.ExecContext(ctx, query, record.TenantID, record.Key, record.RequestHash, record.CreatedAt, record.ExpiresAt)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// CompleteIdempotencyRecord store the response of the request holding the key
+func (p *postgres) CompleteIdempotencyRecord(ctx context.Context, tenantID uuid.UUID, key string, statusCode int, responseBody []byte) error {
+	query := `UPDATE idempotency_keys SET status_code = $3, response_body = $4 WHERE tenant_id = $1 AND key = $2`
+	_, err := p.DB.ExecContext(ctx, query, tenantID, key, statusCode, responseBody)
+	return err
+}
+
+// DeleteIdempotencyRecord release the key so it can be used again
+func (p *postgres) DeleteIdempotencyRecord(ctx context.Context, tenantID uuid.UUID, key string) error {
+	query := `DELETE FROM idempotency_keys WHERE tenant_id = $1 AND key = $2`
+	_, err := p.DB.ExecContext(ctx, query, tenantID, key)
+	return err
+}
+
+// DeleteExpiredIdempotencyRecords delete records of every tenant that expired before now
+func (p *postgres) DeleteExpiredIdempotencyRecords(ctx context.Context, now time.Time) error {
+	query := `DELETE FROM idempotency_keys WHERE expires_at <= $1`
+	_, err := p.DB.ExecContext(ctx, query, now)
+	return err
+}
@@ -0,0 +1,32 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+)
+
+// GetIdempotencyRecord retrieves record of the key in the tenant, return nil when it does not exist
+func (p *postgres) GetIdempotencyRecord(ctx context.Context, tenantID uuid.UUID, key string) (*domain.IdempotencyRecord, error) {
+	query := `
+        SELECT tenant_id, key, request_hash, COALESCE(status_code, 0), response_body, created_at, expires_at
+        FROM idempotency_keys
+        WHERE tenant_id = $1 AND key = $2
+    `
+
+	var record domain.IdempotencyRecord
+	err := p.DB.QueryRowContext(ctx, query, tenantID, key).Scan(
+		&record.TenantID, &record.Key, &record.RequestHash, &record.StatusCode, &record.ResponseBody, &record.CreatedAt, &record.ExpiresAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &record, nil
+}
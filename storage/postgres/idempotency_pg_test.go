@@ -0,0 +1,157 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_postgres_ReserveIdempotencyKey(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	now := time.Now()
+	record := &domain.IdempotencyRecord{TenantID: uuid.New(), Key: "key", RequestHash: "hash", CreatedAt: now, ExpiresAt: now.Add(time.Hour)}
+
+	tests := []struct {
+		name      string
+		mockFunc  func()
+		expected  bool
+		wantError bool
+	}{
+		{
+			name: "Reserved",
+			mockFunc: func() {
+				mock.ExpectExec("INSERT INTO idempotency_keys .* ON CONFLICT \\(tenant_id, key\\) DO UPDATE .* WHERE idempotency_keys.expires_at <= EXCLUDED.created_at").
+					WithArgs(record.TenantID, record.Key, record.RequestHash, record.CreatedAt, record.ExpiresAt).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			expected: true,
+		},
+		{
+			name: "Held by another request",
+			mockFunc: func() {
+				mock.ExpectExec("INSERT INTO idempotency_keys").WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expected: false,
+		},
+		{
+			name: "Exec error",
+			mockFunc: func() {
+				mock.ExpectExec("INSERT INTO idempotency_keys").WillReturnError(errors.New("exec error"))
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			reserved, err := pg.ReserveIdempotencyKey(ctx, record)
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, reserved)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_postgres_CompleteAndDeleteIdempotencyRecord(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	tenantID := uuid.New()
+	now := time.Now()
+
+	mock.ExpectExec("UPDATE idempotency_keys SET status_code = \\$3, response_body = \\$4 WHERE tenant_id = \\$1 AND key = \\$2").
+		WithArgs(tenantID, "key", 201, []byte(`{}`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM idempotency_keys WHERE tenant_id = \\$1 AND key = \\$2").
+		WithArgs(tenantID, "key").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM idempotency_keys WHERE expires_at <= \\$1").
+		WithArgs(now).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	assert.NoError(t, pg.CompleteIdempotencyRecord(ctx, tenantID, "key", 201, []byte(`{}`)))
+	assert.NoError(t, pg.DeleteIdempotencyRecord(ctx, tenantID, "key"))
+	assert.NoError(t, pg.DeleteExpiredIdempotencyRecords(ctx, now))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func Test_postgres_GetIdempotencyRecord(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	tenantID := uuid.New()
+	now := time.Now()
+	columns := []string{"tenant_id", "key", "request_hash", "status_code", "response_body", "created_at", "expires_at"}
+
+	tests := []struct {
+		name      string
+		mockFunc  func()
+		expected  *domain.IdempotencyRecord
+		wantError bool
+	}{
+		{
+			name: "Found",
+			mockFunc: func() {
+				mock.ExpectQuery("SELECT .* FROM idempotency_keys WHERE tenant_id = \\$1 AND key = \\$2").
+					WithArgs(tenantID, "key").
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(tenantID, "key", "hash", 201, []byte(`{}`), now, now.Add(time.Hour)))
+			},
+			expected: &domain.IdempotencyRecord{TenantID: tenantID, Key: "key", RequestHash: "hash", StatusCode: 201, ResponseBody: []byte(`{}`), CreatedAt: now, ExpiresAt: now.Add(time.Hour)},
+		},
+		{
+			name: "Not found",
+			mockFunc: func() {
+				mock.ExpectQuery("SELECT .* FROM idempotency_keys").WithArgs(tenantID, "key").WillReturnError(sql.ErrNoRows)
+			},
+			expected: nil,
+		},
+		{
+			name: "Query error",
+			mockFunc: func() {
+				mock.ExpectQuery("SELECT .* FROM idempotency_keys").WithArgs(tenantID, "key").WillReturnError(errors.New("query error"))
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			record, err := pg.GetIdempotencyRecord(ctx, tenantID, "key")
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, record)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+)
+
+// CreateMission create mission on an estate of the tenant together with its waypoints.
+// ErrorEstatesNotFound is returned when the estate does not belong to the tenant
+func (p *postgres) CreateMission(ctx context.Context, tenantID uuid.UUID, mission *domain.Mission) error {
+	return p.inTx(ctx, "CreateMission", func(tx *sql.Tx) error {
+		query := `
+            INSERT INTO missions (id, estate_id, status, max_distance, distance, drone_id, drone, pilot, created_at,
+                drone_max_range, drone_max_altitude, drone_cruise_speed, drone_climb_speed)
+            SELECT $1, e.id, $3, $4, $5, $10, $6, $7, $8, $11, $12, $13, $14
+            FROM estates e
+            WHERE e.id = $2 AND e.tenant_id = $9
+        `
+		var maxRange, maxAltitude *int
+		var cruiseSpeed, climbSpeed *float64
+		if mission.DroneProfile != nil {
+			maxRange, maxAltitude = &mission.DroneProfile.MaxRange, &mission.DroneProfile.MaxAltitude
+			cruiseSpeed, climbSpeed = &mission.DroneProfile.Speed.Cruise, &mission.DroneProfile.Speed.Climb
+		}
+		result, err := tx.ExecContext(ctx, query, mission.ID, mission.EstateID, string(mission.Status), mission.MaxDistance, mission.Distance,
+			mission.Drone, mission.Pilot, mission.CreatedAt, tenantID, mission.DroneID, maxRange, maxAltitude, cruiseSpeed, climbSpeed)
+		if err != nil {
+			return err
+		}
+
+		err = requireAffected(result, domain.ErrorEstatesNotFound)
+		if err != nil {
+			return err
+		}
+
+		return insertMissionWaypoints(ctx, tx, mission.ID, mission.Waypoints)
+	})
+}
+
+// insertMissionWaypoints bulk insert waypoints of a mission using the caller transaction,
+// in chunks that stay below the placeholder limit of postgres
+func insertMissionWaypoints(ctx context.Context, tx *sql.Tx, missionID uuid.UUID, waypoints []domain.DroneWaypoint) error {
+	for len(waypoints) > 0 {
+		chunk := waypoints[:min(len(waypoints), insertChunkSize)]
+		waypoints = waypoints[len(chunk):]
+
+		query := `INSERT INTO mission_waypoints (mission_id, sequence, row, col, altitude, distance) VALUES `
+		args := []interface{}{}
+		argPos := 1
+		for _, waypoint := range chunk {
+			// constructed with placeholder, still safe from sql injections
+			query += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d),", argPos, argPos+1, argPos+2, argPos+3, argPos+4, argPos+5)
+			args = append(args, missionID, waypoint.Sequence, waypoint.Plot.Row, waypoint.Plot.Col, waypoint.Altitude, waypoint.Distance)
+			argPos += 6
+		}
+
+		// Trim the trailing comma
+		query = query[:len(query)-1]
+
+		_, err := tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateMission save status, assignment and timestamps of a mission of the tenant when it is still at expectedStatus.
+// ErrorMissionInvalidTransition is returned when another request moved the mission in between
+func (p *postgres) UpdateMission(ctx context.Context, tenantID uuid.UUID, mission *domain.Mission, expectedStatus domain.MissionStatus) error {
+	query := `
+        UPDATE missions m SET status = $1, drone = $2, pilot = $3, started_at = $4, finished_at = $5, updated_at = NOW()
+        FROM estates e
+        WHERE e.id = m.estate_id AND m.id = $6 AND m.estate_id = $7 AND e.tenant_id = $8 AND m.status = $9
+    `
+	result, err := p.DB.ExecContext(ctx, query, string(mission.Status), mission.Drone, mission.Pilot, mission.StartedAt, mission.FinishedAt,
+		mission.ID, mission.EstateID, tenantID, string(expectedStatus))
+	if err != nil {
+		return err
+	}
+
+	return requireAffected(result, domain.ErrorMissionInvalidTransition)
+}
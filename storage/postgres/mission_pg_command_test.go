@@ -0,0 +1,149 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_postgres_CreateMission(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+
+	tenantID := uuid.New()
+	maxDistance := 30
+	droneID := uuid.New()
+	mission := &domain.Mission{
+		ID:          uuid.New(),
+		EstateID:    uuid.New(),
+		Status:      domain.MissionPlanned,
+		MaxDistance: &maxDistance,
+		Distance:    12,
+		Waypoints: []domain.DroneWaypoint{
+			{Sequence: 1, Plot: domain.Plot{Row: 1, Col: 1}, Altitude: 1, Distance: 1},
+			{Sequence: 2, Plot: domain.Plot{Row: 1, Col: 2}, Altitude: 1, Distance: 11},
+		},
+		DroneID:      &droneID,
+		DroneProfile: &domain.DroneProfile{MaxRange: 30, MaxAltitude: 25, Speed: domain.DroneSpeed{Cruise: 10, Climb: 4}},
+		Drone:        "drone-7",
+		CreatedAt:    time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name        string
+		mockFunc    func()
+		expectError error
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO missions").
+					WithArgs(mission.ID, mission.EstateID, "planned", mission.MaxDistance, 12, "drone-7", "", mission.CreatedAt, tenantID, mission.DroneID,
+						&mission.DroneProfile.MaxRange, &mission.DroneProfile.MaxAltitude, &mission.DroneProfile.Speed.Cruise, &mission.DroneProfile.Speed.Climb).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("INSERT INTO mission_waypoints").
+					WithArgs(mission.ID, 1, 1, 1, 1, 1, mission.ID, 2, 1, 2, 1, 11).
+					WillReturnResult(sqlmock.NewResult(2, 2))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Estate not found",
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO missions").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectError: domain.ErrorEstatesNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := pg.CreateMission(ctx, tenantID, mission)
+			assert.Equal(t, tt.expectError, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_postgres_UpdateMission(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+
+	tenantID := uuid.New()
+	startedAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	mission := &domain.Mission{
+		ID:        uuid.New(),
+		EstateID:  uuid.New(),
+		Status:    domain.MissionInProgress,
+		Drone:     "drone-7",
+		Pilot:     "Budi",
+		StartedAt: &startedAt,
+	}
+
+	tests := []struct {
+		name        string
+		mockFunc    func()
+		expectError error
+		wantError   bool
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				mock.ExpectExec("UPDATE missions m SET status = \\$1").
+					WithArgs("in_progress", "drone-7", "Budi", mission.StartedAt, mission.FinishedAt, mission.ID, mission.EstateID, tenantID, "planned").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "Moved by another request",
+			mockFunc: func() {
+				mock.ExpectExec("UPDATE missions m SET status = \\$1").WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectError: domain.ErrorMissionInvalidTransition,
+			wantError:   true,
+		},
+		{
+			name: "Exec error",
+			mockFunc: func() {
+				mock.ExpectExec("UPDATE missions m SET status = \\$1").WillReturnError(errors.New("exec error"))
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := pg.UpdateMission(ctx, tenantID, mission, domain.MissionPlanned)
+			if tt.wantError {
+				assert.Error(t, err)
+				if tt.expectError != nil {
+					assert.ErrorIs(t, err, tt.expectError)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
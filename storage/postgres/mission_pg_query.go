@@ -0,0 +1,120 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+)
+
+// ListMissions retrieves a page of missions of an estate of the tenant without their waypoints, newest first.
+// Missions of every status are listed when status is nil
+func (p *postgres) ListMissions(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, status *domain.MissionStatus, limit int, offset int) ([]domain.Mission, error) {
+	query := `
+        SELECT m.id, m.estate_id, m.status, m.max_distance, m.distance, m.drone_id, m.drone, m.pilot, m.created_at, m.started_at, m.finished_at,
+            m.drone_max_range, m.drone_max_altitude, m.drone_cruise_speed, m.drone_climb_speed
+        FROM missions m JOIN estates e ON e.id = m.estate_id
+        WHERE m.estate_id = $1 AND e.tenant_id = $2 AND ($3::VARCHAR IS NULL OR m.status = $3)
+        ORDER BY m.created_at DESC, m.id
+        LIMIT $4 OFFSET $5
+    `
+
+	var statusArg *string
+	if status != nil {
+		value := string(*status)
+		statusArg = &value
+	}
+
+	rows, err := p.DB.QueryContext(ctx, query, estateID, tenantID, statusArg, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	missions := []domain.Mission{}
+	for rows.Next() {
+		mission, err := scanMission(rows)
+		if err != nil {
+			return nil, err
+		}
+		missions = append(missions, *mission)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return missions, nil
+}
+
+// GetMission retrieves mission of an estate of the tenant with its waypoints in flight order.
+// Waypoints never change once the mission is created so they are read outside of a transaction
+func (p *postgres) GetMission(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, missionID uuid.UUID) (*domain.Mission, error) {
+	query := `
+        SELECT m.id, m.estate_id, m.status, m.max_distance, m.distance, m.drone_id, m.drone, m.pilot, m.created_at, m.started_at, m.finished_at,
+            m.drone_max_range, m.drone_max_altitude, m.drone_cruise_speed, m.drone_climb_speed
+        FROM missions m JOIN estates e ON e.id = m.estate_id
+        WHERE m.id = $1 AND m.estate_id = $2 AND e.tenant_id = $3
+    `
+
+	mission, err := scanMission(p.DB.QueryRowContext(ctx, query, missionID, estateID, tenantID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	query = `
+        SELECT sequence, row, col, altitude, distance
+        FROM mission_waypoints
+        WHERE mission_id = $1
+        ORDER BY sequence
+    `
+
+	rows, err := p.DB.QueryContext(ctx, query, missionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	mission.Waypoints = []domain.DroneWaypoint{}
+	for rows.Next() {
+		var waypoint domain.DroneWaypoint
+		err := rows.Scan(&waypoint.Sequence, &waypoint.Plot.Row, &waypoint.Plot.Col, &waypoint.Altitude, &waypoint.Distance)
+		if err != nil {
+			return nil, err
+		}
+		mission.Waypoints = append(mission.Waypoints, waypoint)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return mission, nil
+}
+
+// scanMission scan a mission row, the drone profile is left nil unless the mission was planned for a fleet drone
+func scanMission(row rowScanner) (*domain.Mission, error) {
+	var mission domain.Mission
+	var status string
+	var maxRange, maxAltitude *int
+	var cruiseSpeed, climbSpeed *float64
+	err := row.Scan(&mission.ID, &mission.EstateID, &status, &mission.MaxDistance, &mission.Distance, &mission.DroneID, &mission.Drone, &mission.Pilot,
+		&mission.CreatedAt, &mission.StartedAt, &mission.FinishedAt, &maxRange, &maxAltitude, &cruiseSpeed, &climbSpeed)
+	if err != nil {
+		return nil, err
+	}
+	mission.Status = domain.MissionStatus(status)
+	if maxRange != nil && maxAltitude != nil && cruiseSpeed != nil && climbSpeed != nil {
+		mission.DroneProfile = &domain.DroneProfile{
+			MaxRange:    *maxRange,
+			MaxAltitude: *maxAltitude,
+			Speed:       domain.DroneSpeed{Cruise: *cruiseSpeed, Climb: *climbSpeed},
+		}
+	}
+	return &mission, nil
+}
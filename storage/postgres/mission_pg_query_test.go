@@ -0,0 +1,175 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var missionColumns = []string{"id", "estate_id", "status", "max_distance", "distance", "drone_id", "drone", "pilot", "created_at", "started_at", "finished_at",
+	"drone_max_range", "drone_max_altitude", "drone_cruise_speed", "drone_climb_speed"}
+
+func Test_postgres_ListMissions(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+
+	tenantID := uuid.New()
+	estateID := uuid.New()
+	missionID := uuid.New()
+	createdAt := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+	finishedAt := createdAt.Add(time.Hour)
+	aborted := domain.MissionAborted
+	maxDistance := 30
+	droneID := uuid.New()
+
+	tests := []struct {
+		name      string
+		status    *domain.MissionStatus
+		mockFunc  func()
+		expected  []domain.Mission
+		wantError bool
+	}{
+		{
+			name:   "Every status",
+			status: nil,
+			mockFunc: func() {
+				mock.ExpectQuery("FROM missions m JOIN estates e ON e.id = m.estate_id").
+					WithArgs(estateID, tenantID, nil, 10, 0).
+					WillReturnRows(sqlmock.NewRows(missionColumns).
+						AddRow(missionID, estateID, "planned", nil, 40, nil, "", "", createdAt, nil, nil, nil, nil, nil, nil))
+			},
+			expected: []domain.Mission{
+				{ID: missionID, EstateID: estateID, Status: domain.MissionPlanned, Distance: 40, CreatedAt: createdAt},
+			},
+		},
+		{
+			name:   "Filtered by status",
+			status: &aborted,
+			mockFunc: func() {
+				value := "aborted"
+				mock.ExpectQuery("FROM missions m JOIN estates e ON e.id = m.estate_id").
+					WithArgs(estateID, tenantID, &value, 10, 0).
+					WillReturnRows(sqlmock.NewRows(missionColumns).
+						AddRow(missionID, estateID, "aborted", 30, 12, droneID, "drone-7", "", createdAt, nil, finishedAt, 30, 25, 10.0, 4.0))
+			},
+			expected: []domain.Mission{
+				{
+					ID: missionID, EstateID: estateID, Status: domain.MissionAborted, MaxDistance: &maxDistance, Distance: 12,
+					DroneID: &droneID, DroneProfile: &domain.DroneProfile{MaxRange: 30, MaxAltitude: 25, Speed: domain.DroneSpeed{Cruise: 10, Climb: 4}},
+					Drone: "drone-7", CreatedAt: createdAt, FinishedAt: &finishedAt,
+				},
+			},
+		},
+		{
+			name:   "Query error",
+			status: nil,
+			mockFunc: func() {
+				mock.ExpectQuery("FROM missions m JOIN estates e ON e.id = m.estate_id").WillReturnError(errors.New("query error"))
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			missions, err := pg.ListMissions(ctx, tenantID, estateID, tt.status, 10, 0)
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, missions)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_postgres_GetMission(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+
+	tenantID := uuid.New()
+	estateID := uuid.New()
+	missionID := uuid.New()
+	createdAt := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		mockFunc  func()
+		expected  *domain.Mission
+		wantError bool
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				mock.ExpectQuery("FROM missions m JOIN estates e ON e.id = m.estate_id").
+					WithArgs(missionID, estateID, tenantID).
+					WillReturnRows(sqlmock.NewRows(missionColumns).
+						AddRow(missionID, estateID, "planned", nil, 22, nil, "", "", createdAt, nil, nil, nil, nil, nil, nil))
+				mock.ExpectQuery("FROM mission_waypoints").
+					WithArgs(missionID).
+					WillReturnRows(sqlmock.NewRows([]string{"sequence", "row", "col", "altitude", "distance"}).
+						AddRow(1, 1, 1, 1, 1).
+						AddRow(2, 1, 2, 1, 11))
+			},
+			expected: &domain.Mission{
+				ID: missionID, EstateID: estateID, Status: domain.MissionPlanned, Distance: 22, CreatedAt: createdAt,
+				Waypoints: []domain.DroneWaypoint{
+					{Sequence: 1, Plot: domain.Plot{Row: 1, Col: 1}, Altitude: 1, Distance: 1},
+					{Sequence: 2, Plot: domain.Plot{Row: 1, Col: 2}, Altitude: 1, Distance: 11},
+				},
+			},
+		},
+		{
+			name: "Not found",
+			mockFunc: func() {
+				mock.ExpectQuery("FROM missions m JOIN estates e ON e.id = m.estate_id").
+					WithArgs(missionID, estateID, tenantID).
+					WillReturnRows(sqlmock.NewRows(missionColumns))
+			},
+			expected: nil,
+		},
+		{
+			name: "Waypoints query error",
+			mockFunc: func() {
+				mock.ExpectQuery("FROM missions m JOIN estates e ON e.id = m.estate_id").
+					WithArgs(missionID, estateID, tenantID).
+					WillReturnRows(sqlmock.NewRows(missionColumns).
+						AddRow(missionID, estateID, "planned", nil, 22, nil, "", "", createdAt, nil, nil, nil, nil, nil, nil))
+				mock.ExpectQuery("FROM mission_waypoints").WillReturnError(errors.New("query error"))
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			mission, err := pg.GetMission(ctx, tenantID, estateID, missionID)
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, mission)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package postgres
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
 	"time"
 
 	_ "github.com/lib/pq"
@@ -10,7 +12,9 @@ import (
 var sqlOpen = sql.Open
 
 type postgres struct {
-	DB *sql.DB
+	DB           *sql.DB
+	connectRetry retryPolicy
+	writeRetry   retryPolicy
 }
 
 type PostgresOptions func(*postgres)
@@ -23,16 +27,46 @@ func WithPostgresPool(maxOpenConns, maxIdleConns int, connMaxLifetimeSec int) Po
 	}
 }
 
-func NewRepository(dsn string, opts ...PostgresOptions) *postgres {
-	db, err := sqlOpen("postgres", dsn)
+// WithConnectRetry retry the startup ping up to maxAttempts times while the database is unreachable
+func WithConnectRetry(maxAttempts int, initialBackoff time.Duration, maxBackoff time.Duration) PostgresOptions {
+	return func(p *postgres) {
+		p.connectRetry = retryPolicy{maxAttempts: maxAttempts, initialBackoff: initialBackoff, maxBackoff: maxBackoff}
+	}
+}
+
+// WithWriteRetry retry write transactions up to maxAttempts times when they fail with a transient error
+func WithWriteRetry(maxAttempts int, initialBackoff time.Duration, maxBackoff time.Duration) PostgresOptions {
+	return func(p *postgres) {
+		p.writeRetry = retryPolicy{maxAttempts: maxAttempts, initialBackoff: initialBackoff, maxBackoff: maxBackoff}
+	}
+}
+
+func NewRepository(ctx context.Context, dsn string, opts ...PostgresOptions) (*postgres, error) {
+	return NewRepositoryWithDriver(ctx, "postgres", dsn, opts...)
+}
+
+// NewRepositoryWithDriver open dsn with driverName, a registered wrapper of the postgres driver such as the tracing one.
+// The database is pinged until it answers, transient errors are retried with backoff and others fail right away
+func NewRepositoryWithDriver(ctx context.Context, driverName string, dsn string, opts ...PostgresOptions) (*postgres, error) {
+	db, err := sqlOpen(driverName, dsn)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 	postgres := &postgres{
-		DB: db,
+		DB:           db,
+		connectRetry: defaultConnectRetry,
+		writeRetry:   defaultWriteRetry,
 	}
 	for _, opt := range opts {
 		opt(postgres)
 	}
-	return postgres
+
+	err = postgres.connectRetry.retry(ctx, "connect", isTransient, func() error {
+		return db.PingContext(ctx)
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("connect to database: %w", err)
+	}
+	return postgres, nil
 }
@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"database/sql/driver"
+	"errors"
+	"io"
+	"syscall"
+
+	"github.com/lib/pq"
+)
+
+const (
+	pqUniqueViolation      pq.ErrorCode = "23505"
+	pqForeignKeyViolation  pq.ErrorCode = "23503"
+	pqSerializationFailure pq.ErrorCode = "40001"
+	pqDeadlockDetected     pq.ErrorCode = "40P01"
+	pqAdminShutdown        pq.ErrorCode = "57P01"
+	pqCannotConnectNow     pq.ErrorCode = "57P03"
+
+	// pqConnectionExceptionClass is the class of errors establishing or losing the connection
+	pqConnectionExceptionClass pq.ErrorClass = "08"
+)
+
+// mapConstraintError map unique and foreign key violations to domain errors,
+// a nil domain error keep the original error. Other errors are returned as is
+func mapConstraintError(err error, uniqueErr error, foreignKeyErr error) error {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return err
+	}
+
+	switch {
+	case pqErr.Code == pqUniqueViolation && uniqueErr != nil:
+		return uniqueErr
+	case pqErr.Code == pqForeignKeyViolation && foreignKeyErr != nil:
+		return foreignKeyErr
+	default:
+		return err
+	}
+}
+
+// isTransient report errors likely to succeed when the operation is retried:
+// serialization failures, deadlocks, a server starting or shutting down and refused, reset or lost connections
+func isTransient(err error) bool {
+	if isAborted(err) {
+		return true
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return pqErr.Code.Class() == pqConnectionExceptionClass || pqErr.Code == pqAdminShutdown || pqErr.Code == pqCannotConnectNow
+	}
+
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// isAborted report transactions rolled back by the server to resolve a conflict, nothing of them was applied
+func isAborted(err error) bool {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return false
+	}
+	return pqErr.Code == pqSerializationFailure || pqErr.Code == pqDeadlockDetected
+}
@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_mapConstraintError(t *testing.T) {
+	otherErr := errors.New("connection reset")
+	uniqueErr := &pq.Error{Code: "23505"}
+	checkErr := &pq.Error{Code: "23514"}
+
+	tests := []struct {
+		name          string
+		err           error
+		foreignKeyErr error
+		expect        error
+	}{
+		{name: "Unique violation", err: uniqueErr, expect: domain.ErrorTreeAlreadyExists},
+		{name: "Wrapped unique violation", err: fmt.Errorf("insert tree: %w", uniqueErr), expect: domain.ErrorTreeAlreadyExists},
+		{name: "Foreign key violation", err: &pq.Error{Code: "23503"}, foreignKeyErr: domain.ErrorEstatesNotFound, expect: domain.ErrorEstatesNotFound},
+		{name: "Foreign key violation without domain error", err: &pq.Error{Code: "23503"}, expect: &pq.Error{Code: "23503"}},
+		{name: "Other constraint", err: checkErr, expect: checkErr},
+		{name: "Other error", err: otherErr, expect: otherErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expect, mapConstraintError(tt.err, domain.ErrorTreeAlreadyExists, tt.foreignKeyErr))
+		})
+	}
+}
+
+func Test_isTransient(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		want    bool
+		aborted bool
+	}{
+		{name: "Serialization failure", err: &pq.Error{Code: "40001"}, want: true, aborted: true},
+		{name: "Wrapped deadlock", err: fmt.Errorf("update tree: %w", &pq.Error{Code: "40P01"}), want: true, aborted: true},
+		{name: "Connection failure", err: &pq.Error{Code: "08006"}, want: true},
+		{name: "Server shutting down", err: &pq.Error{Code: "57P01"}, want: true},
+		{name: "Server starting up", err: &pq.Error{Code: "57P03"}, want: true},
+		{name: "Connection refused", err: &net.OpError{Op: "dial", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}, want: true},
+		{name: "Connection reset", err: syscall.ECONNRESET, want: true},
+		{name: "Bad connection", err: driver.ErrBadConn, want: true},
+		{name: "Unexpected EOF", err: io.ErrUnexpectedEOF, want: true},
+		{name: "Unique violation", err: &pq.Error{Code: "23505"}},
+		{name: "Authentication failure", err: &pq.Error{Code: "28P01"}},
+		{name: "Other error", err: errors.New("syntax error")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isTransient(tt.err))
+			assert.Equal(t, tt.aborted, isAborted(tt.err))
+		})
+	}
+}
@@ -1,10 +1,17 @@
 package postgres
 
 import (
+	"context"
 	"database/sql"
+	"errors"
+	"net"
+	"os"
+	"syscall"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -38,7 +45,8 @@ func TestNewRepository(t *testing.T) {
 	}
 	defer func() { sqlOpen = sql.Open }() // Restore original sql.Open after test
 
-	repo := NewRepository(dsn)
+	repo, err := NewRepository(context.Background(), dsn)
+	assert.NoError(t, err)
 	assert.NotNil(t, repo)
 	assert.Equal(t, db, repo.DB)
 
@@ -46,3 +54,92 @@ func TestNewRepository(t *testing.T) {
 	repo.DB.Close()
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestNewRepositoryWithDriver(t *testing.T) {
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlOpen = func(driverName, dataSourceName string) (*sql.DB, error) {
+		assert.Equal(t, "postgres-otelsql-0", driverName)
+		assert.Equal(t, "mock-dsn", dataSourceName)
+		return db, nil
+	}
+	defer func() { sqlOpen = sql.Open }()
+
+	repo, err := NewRepositoryWithDriver(context.Background(), "postgres-otelsql-0", "mock-dsn")
+	assert.NoError(t, err)
+	assert.Equal(t, db, repo.DB)
+}
+
+func TestNewRepository_Connect(t *testing.T) {
+	connRefused := &net.OpError{Op: "dial", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}
+
+	tests := []struct {
+		name     string
+		mockFunc func(mock sqlmock.Sqlmock)
+		wantErr  string
+	}{
+		{
+			name: "Retried until the database is up",
+			mockFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectPing().WillReturnError(connRefused)
+				mock.ExpectPing().WillReturnError(&pq.Error{Code: "57P03"})
+				mock.ExpectPing()
+			},
+		},
+		{
+			name: "Attempts exhausted",
+			mockFunc: func(mock sqlmock.Sqlmock) {
+				for i := 0; i < 3; i++ {
+					mock.ExpectPing().WillReturnError(connRefused)
+				}
+				mock.ExpectClose()
+			},
+			wantErr: "connect to database",
+		},
+		{
+			name: "Permanent error fails fast",
+			mockFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectPing().WillReturnError(&pq.Error{Code: "28P01", Message: "password authentication failed"})
+				mock.ExpectClose()
+			},
+			wantErr: "password authentication failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+			assert.NoError(t, err)
+			defer db.Close()
+
+			sqlOpen = func(driverName, dataSourceName string) (*sql.DB, error) {
+				return db, nil
+			}
+			defer func() { sqlOpen = sql.Open }()
+
+			tt.mockFunc(mock)
+			repo, err := NewRepository(context.Background(), "mock-dsn", WithConnectRetry(3, time.Millisecond, time.Millisecond))
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				assert.Nil(t, repo)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, repo)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestNewRepository_OpenError(t *testing.T) {
+	sqlOpen = func(driverName, dataSourceName string) (*sql.DB, error) {
+		return nil, errors.New("unknown driver")
+	}
+	defer func() { sqlOpen = sql.Open }()
+
+	repo, err := NewRepository(context.Background(), "mock-dsn")
+	assert.EqualError(t, err, "unknown driver")
+	assert.Nil(t, repo)
+}
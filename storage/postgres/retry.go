@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"log/slog"
+	"math/rand"
+	"time"
+)
+
+// retryPolicy retry an operation with exponential backoff and full jitter, maxAttempts below 2 disables retries
+type retryPolicy struct {
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+}
+
+var (
+	defaultConnectRetry = retryPolicy{maxAttempts: 10, initialBackoff: 100 * time.Millisecond, maxBackoff: 5 * time.Second}
+	defaultWriteRetry   = retryPolicy{maxAttempts: 3, initialBackoff: 10 * time.Millisecond, maxBackoff: 200 * time.Millisecond}
+)
+
+// backoff return a random delay before the attempt following attempt, up to initialBackoff doubled per attempt
+func (r retryPolicy) backoff(attempt int) time.Duration {
+	backoff := r.initialBackoff
+	for i := 1; i < attempt && backoff < r.maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > r.maxBackoff {
+		backoff = r.maxBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(backoff) + 1))
+}
+
+// retry call fn until it succeeds, fails with an error that is not retryable or attempts are exhausted.
+// The last error is returned, also when ctx is done while waiting
+func (r retryPolicy) retry(ctx context.Context, operation string, retryable func(error) bool, fn func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || attempt >= r.maxAttempts || !retryable(err) {
+			return err
+		}
+
+		delay := r.backoff(attempt)
+		slog.Warn("retrying transient database error", "operation", operation, "attempt", attempt, "delay", delay.String(), "message", err.Error())
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
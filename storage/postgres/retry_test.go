@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_retryPolicy_backoff(t *testing.T) {
+	policy := retryPolicy{maxAttempts: 5, initialBackoff: 10 * time.Millisecond, maxBackoff: 50 * time.Millisecond}
+
+	for attempt, limit := range map[int]time.Duration{1: 10 * time.Millisecond, 2: 20 * time.Millisecond, 3: 40 * time.Millisecond, 4: 50 * time.Millisecond, 10: 50 * time.Millisecond} {
+		for i := 0; i < 100; i++ {
+			delay := policy.backoff(attempt)
+			assert.GreaterOrEqual(t, delay, time.Duration(0))
+			assert.LessOrEqual(t, delay, limit)
+		}
+	}
+	assert.Equal(t, time.Duration(0), retryPolicy{}.backoff(1))
+}
+
+func Test_retryPolicy_retry(t *testing.T) {
+	errTransient := errors.New("transient")
+	errPermanent := errors.New("permanent")
+	retryable := func(err error) bool { return errors.Is(err, errTransient) }
+	policy := retryPolicy{maxAttempts: 3, initialBackoff: time.Millisecond, maxBackoff: time.Millisecond}
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		policy    retryPolicy
+		errs      []error
+		wantErr   error
+		wantCalls int
+	}{
+		{name: "Succeeds first", ctx: context.Background(), policy: policy, errs: []error{nil}, wantCalls: 1},
+		{name: "Succeeds after transient errors", ctx: context.Background(), policy: policy, errs: []error{errTransient, errTransient, nil}, wantCalls: 3},
+		{name: "Permanent error is not retried", ctx: context.Background(), policy: policy, errs: []error{errPermanent}, wantErr: errPermanent, wantCalls: 1},
+		{name: "Attempts exhausted", ctx: context.Background(), policy: policy, errs: []error{errTransient, errTransient, errTransient}, wantErr: errTransient, wantCalls: 3},
+		{name: "Retries disabled", ctx: context.Background(), policy: retryPolicy{}, errs: []error{errTransient}, wantErr: errTransient, wantCalls: 1},
+		{name: "Context done while waiting", ctx: cancelled, policy: retryPolicy{maxAttempts: 3, initialBackoff: time.Hour, maxBackoff: time.Hour}, errs: []error{errTransient}, wantErr: errTransient, wantCalls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			err := tt.policy.retry(tt.ctx, "test", retryable, func() error {
+				err := tt.errs[calls]
+				calls++
+				return err
+			})
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantCalls, calls)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+)
+
+// ReplaceMissionTelemetry replace the telemetry samples of a mission of the tenant, samples are stored in the given order.
+// The mission row is locked so concurrent uploads of the same mission do not interleave,
+// ErrorMissionNotFound is returned when the mission does not belong to the tenant
+func (p *postgres) ReplaceMissionTelemetry(ctx context.Context, tenantID uuid.UUID, mission *domain.Mission, samples []domain.TelemetrySample) error {
+	return p.inTx(ctx, "ReplaceMissionTelemetry", func(tx *sql.Tx) error {
+		query := `
+            SELECT m.id
+            FROM missions m JOIN estates e ON e.id = m.estate_id
+            WHERE m.id = $1 AND m.estate_id = $2 AND e.tenant_id = $3
+            FOR UPDATE OF m
+        `
+		var missionID uuid.UUID
+		err := tx.QueryRowContext(ctx, query, mission.ID, mission.EstateID, tenantID).Scan(&missionID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return domain.ErrorMissionNotFound
+			}
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `DELETE FROM mission_telemetry WHERE mission_id = $1`, mission.ID)
+		if err != nil {
+			return err
+		}
+
+		return insertMissionTelemetry(ctx, tx, mission.ID, samples)
+	})
+}
+
+// insertMissionTelemetry bulk insert samples of a mission using the caller transaction,
+// in chunks that stay below the placeholder limit of postgres
+func insertMissionTelemetry(ctx context.Context, tx *sql.Tx, missionID uuid.UUID, samples []domain.TelemetrySample) error {
+	sequence := 0
+	for len(samples) > 0 {
+		chunk := samples[:min(len(samples), insertChunkSize)]
+		samples = samples[len(chunk):]
+
+		query := `INSERT INTO mission_telemetry (mission_id, sequence, recorded_at, x, y, altitude) VALUES `
+		args := []interface{}{}
+		argPos := 1
+		for _, sample := range chunk {
+			sequence++
+			// constructed with placeholder, still safe from sql injections
+			query += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d),", argPos, argPos+1, argPos+2, argPos+3, argPos+4, argPos+5)
+			args = append(args, missionID, sequence, sample.RecordedAt, sample.X, sample.Y, sample.Altitude)
+			argPos += 6
+		}
+
+		// Trim the trailing comma
+		query = query[:len(query)-1]
+
+		_, err := tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+)
+
+// GetMissionTelemetry retrieves the telemetry samples of a mission of the tenant in the order they were stored,
+// an empty list is returned when no telemetry was recorded or the mission does not belong to the tenant
+func (p *postgres) GetMissionTelemetry(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, missionID uuid.UUID) ([]domain.TelemetrySample, error) {
+	query := `
+        SELECT t.recorded_at, t.x, t.y, t.altitude
+        FROM mission_telemetry t
+        JOIN missions m ON m.id = t.mission_id
+        JOIN estates e ON e.id = m.estate_id
+        WHERE t.mission_id = $1 AND m.estate_id = $2 AND e.tenant_id = $3
+        ORDER BY t.sequence
+    `
+
+	rows, err := p.DB.QueryContext(ctx, query, missionID, estateID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	samples := []domain.TelemetrySample{}
+	for rows.Next() {
+		var sample domain.TelemetrySample
+		err := rows.Scan(&sample.RecordedAt, &sample.X, &sample.Y, &sample.Altitude)
+		if err != nil {
+			return nil, err
+		}
+		samples = append(samples, sample)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return samples, nil
+}
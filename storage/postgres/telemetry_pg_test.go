@@ -0,0 +1,122 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_postgres_ReplaceMissionTelemetry(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+
+	tenantID := uuid.New()
+	mission := &domain.Mission{ID: uuid.New(), EstateID: uuid.New(), Status: domain.MissionInProgress}
+	recordedAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	samples := []domain.TelemetrySample{
+		{RecordedAt: recordedAt, X: 0, Y: 0, Altitude: 1},
+		{RecordedAt: recordedAt.Add(time.Second), X: 10.5, Y: 0.2, Altitude: 6.1},
+	}
+
+	tests := []struct {
+		name        string
+		mockFunc    func()
+		expectError error
+		wantError   bool
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FROM missions m JOIN estates e ON e.id = m.estate_id .* FOR UPDATE OF m").
+					WithArgs(mission.ID, mission.EstateID, tenantID).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(mission.ID))
+				mock.ExpectExec("DELETE FROM mission_telemetry WHERE mission_id = \\$1").
+					WithArgs(mission.ID).
+					WillReturnResult(sqlmock.NewResult(0, 3))
+				mock.ExpectExec("INSERT INTO mission_telemetry").
+					WithArgs(mission.ID, 1, recordedAt, 0.0, 0.0, 1.0, mission.ID, 2, recordedAt.Add(time.Second), 10.5, 0.2, 6.1).
+					WillReturnResult(sqlmock.NewResult(2, 2))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Mission not found",
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FROM missions m JOIN estates e ON e.id = m.estate_id").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectRollback()
+			},
+			expectError: domain.ErrorMissionNotFound,
+			wantError:   true,
+		},
+		{
+			name: "Insert error",
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FROM missions m JOIN estates e ON e.id = m.estate_id").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(mission.ID))
+				mock.ExpectExec("DELETE FROM mission_telemetry").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec("INSERT INTO mission_telemetry").WillReturnError(errors.New("insert error"))
+				mock.ExpectRollback()
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := pg.ReplaceMissionTelemetry(ctx, tenantID, mission, samples)
+			if tt.wantError {
+				assert.Error(t, err)
+				if tt.expectError != nil {
+					assert.ErrorIs(t, err, tt.expectError)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_postgres_GetMissionTelemetry(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+
+	tenantID := uuid.New()
+	estateID := uuid.New()
+	missionID := uuid.New()
+	recordedAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("FROM mission_telemetry t").
+		WithArgs(missionID, estateID, tenantID).
+		WillReturnRows(sqlmock.NewRows([]string{"recorded_at", "x", "y", "altitude"}).
+			AddRow(recordedAt, 0.0, 0.0, 1.0).
+			AddRow(recordedAt.Add(time.Second), 10.5, 0.2, 6.1))
+
+	samples, err := pg.GetMissionTelemetry(ctx, tenantID, estateID, missionID)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.TelemetrySample{
+		{RecordedAt: recordedAt, X: 0, Y: 0, Altitude: 1},
+		{RecordedAt: recordedAt.Add(time.Second), X: 10.5, Y: 0.2, Altitude: 6.1},
+	}, samples)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+// commitError is a failed commit, the transaction may have been applied unless the server aborted it
+type commitError struct {
+	err error
+}
+
+func (e *commitError) Error() string {
+	return e.err.Error()
+}
+
+func (e *commitError) Unwrap() error {
+	return e.err
+}
+
+// retryableWrite report transient errors, except lost connections while committing
+// since retrying a commit that was applied would write twice
+func retryableWrite(err error) bool {
+	var commitErr *commitError
+	if errors.As(err, &commitErr) {
+		return isAborted(err)
+	}
+	return isTransient(err)
+}
+
+// inTx run fn in a transaction committed when fn succeeds and rolled back otherwise.
+// The whole transaction is run again when it fails with a transient error, so fn must not keep state between runs
+func (p *postgres) inTx(ctx context.Context, operation string, fn func(tx *sql.Tx) error) error {
+	err := p.writeRetry.retry(ctx, operation, retryableWrite, func() error {
+		tx, err := p.DB.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+
+		err = fn(tx)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		err = tx.Commit()
+		if err != nil {
+			return &commitError{err: err}
+		}
+		return nil
+	})
+
+	var commitErr *commitError
+	if errors.As(err, &commitErr) {
+		return commitErr.err
+	}
+	return err
+}
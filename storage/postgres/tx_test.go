@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_postgres_inTx(t *testing.T) {
+	serializationErr := &pq.Error{Code: "40001"}
+
+	tests := []struct {
+		name     string
+		mockFunc func(mock sqlmock.Sqlmock)
+		wantErr  error
+	}{
+		{
+			name: "Committed",
+			mockFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE estates").WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Serialization failure is run again",
+			mockFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE estates").WillReturnError(serializationErr)
+				mock.ExpectRollback()
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE estates").WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Aborted commit is run again",
+			mockFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE estates").WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit().WillReturnError(serializationErr)
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE estates").WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Connection lost while committing is not run again",
+			mockFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE estates").WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit().WillReturnError(syscall.ECONNRESET)
+			},
+			wantErr: syscall.ECONNRESET,
+		},
+		{
+			name: "Permanent error is rolled back",
+			mockFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE estates").WillReturnError(errors.New("exec error"))
+				mock.ExpectRollback()
+			},
+			wantErr: errors.New("exec error"),
+		},
+		{
+			name: "Attempts exhausted",
+			mockFunc: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin().WillReturnError(syscall.ECONNREFUSED)
+				mock.ExpectBegin().WillReturnError(syscall.ECONNREFUSED)
+			},
+			wantErr: syscall.ECONNREFUSED,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer mockDB.Close()
+
+			pg := &postgres{DB: mockDB, writeRetry: retryPolicy{maxAttempts: 2, initialBackoff: time.Millisecond, maxBackoff: time.Millisecond}}
+			tt.mockFunc(mock)
+
+			err = pg.inTx(context.Background(), "UpdateEstate", func(tx *sql.Tx) error {
+				_, err := tx.Exec("UPDATE estates SET width = 1")
+				return err
+			})
+			assert.Equal(t, tt.wantErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// insertOutboxEvents write events into transactional outbox using the caller transaction,
+// the webhook dispatcher will pick them up only after the transaction is committed
+func insertOutboxEvents(ctx context.Context, tx *sql.Tx, events []domain.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	query := `INSERT INTO event_outbox (id, tenant_id, estate_id, type, payload, occurred_at) VALUES `
+	args := []interface{}{}
+	argPos := 1
+	for _, event := range events {
+		payload, err := json.Marshal(event.DataMap())
+		if err != nil {
+			return err
+		}
+
+		// constructed with placeholder, still safe from sql injections
+		query += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d),", argPos, argPos+1, argPos+2, argPos+3, argPos+4, argPos+5)
+		args = append(args, event.ID, event.TenantID, event.EstateID, string(event.Type), payload, event.OccurredAt)
+		argPos += 6
+	}
+
+	// Trim the trailing comma
+	query = query[:len(query)-1]
+
+	_, err := tx.ExecContext(ctx, query, args...)
+	return err
+}
+
+// CreateWebhookSubscription create webhook subscription, empty event types is stored as empty array meaning all events.
+// An estate deleted in between return ErrorEstatesNotFound
+func (p *postgres) CreateWebhookSubscription(ctx context.Context, subscription *domain.WebhookSubscription) error {
+	query := `INSERT INTO webhook_subscriptions (id, tenant_id, estate_id, url, secret, event_types) VALUES ($1, $2, $3, $4, $5, $6)`
+	_, err := p.DB.ExecContext(ctx, query, subscription.ID, subscription.TenantID, subscription.EstateID, subscription.URL, subscription.Secret, pq.Array(eventTypesToStrings(subscription.EventTypes)))
+	return mapConstraintError(err, nil, domain.ErrorEstatesNotFound)
+}
+
+// DeleteWebhookSubscription delete webhook subscription along with its deliveries, return false when it does not exist in the tenant
+func (p *postgres) DeleteWebhookSubscription(ctx context.Context, tenantID uuid.UUID, webhookID uuid.UUID) (bool, error) {
+	query := `DELETE FROM webhook_subscriptions WHERE id = $1 AND tenant_id = $2`
+	result, err := p.DB.ExecContext(ctx, query, webhookID, tenantID)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// CreateWebhookDeliveries fan out outbox events into deliveries and mark the events processed in one transaction.
+// Deliveries are unique per subscription and event, so fanning out the same event twice is harmless
+func (p *postgres) CreateWebhookDeliveries(ctx context.Context, processedEventIDs []uuid.UUID, deliveries []domain.WebhookDelivery) error {
+	return p.inTx(ctx, "CreateWebhookDeliveries", func(tx *sql.Tx) error {
+		if len(deliveries) > 0 {
+			query := `INSERT INTO webhook_deliveries (id, subscription_id, event_id, status, next_attempt_at) VALUES `
+			args := []interface{}{}
+			argPos := 1
+			for _, delivery := range deliveries {
+				// constructed with placeholder, still safe from sql injections
+				query += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d),", argPos, argPos+1, argPos+2, argPos+3, argPos+4)
+				args = append(args, delivery.ID, delivery.Subscription.ID, delivery.Event.ID, string(delivery.Status), delivery.NextAttemptAt)
+				argPos += 5
+			}
+
+			// Trim the trailing comma
+			query = query[:len(query)-1] + ` ON CONFLICT (subscription_id, event_id) DO NOTHING`
+
+			_, err := tx.ExecContext(ctx, query, args...)
+			if err != nil {
+				return err
+			}
+		}
+
+		query := `UPDATE event_outbox SET processed_at = NOW() WHERE id = ANY($1)`
+		_, err := tx.ExecContext(ctx, query, pq.Array(uuidsToStrings(processedEventIDs)))
+		return err
+	})
+}
+
+// UpdateWebhookDelivery save delivery attempt result and release its claim
+func (p *postgres) UpdateWebhookDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	query := `
+        UPDATE webhook_deliveries
+        SET status = $2, attempts = $3, next_attempt_at = $4, last_error = $5, locked_until = NULL, updated_at = NOW()
+        WHERE id = $1
+    `
+	_, err := p.DB.ExecContext(ctx, query, delivery.ID, string(delivery.Status), delivery.Attempts, delivery.NextAttemptAt, delivery.LastError)
+	return err
+}
+
+// RetryDeadWebhookDelivery move dead delivery back to pending with fresh attempts,
+// return false when it is not a dead delivery of the tenant
+func (p *postgres) RetryDeadWebhookDelivery(ctx context.Context, tenantID uuid.UUID, deliveryID uuid.UUID, now time.Time) (bool, error) {
+	query := `
+        UPDATE webhook_deliveries
+        SET status = 'pending', attempts = 0, next_attempt_at = $2, updated_at = NOW()
+        WHERE id = $1 AND status = 'dead'
+        AND subscription_id IN (SELECT id FROM webhook_subscriptions WHERE tenant_id = $3)
+    `
+	result, err := p.DB.ExecContext(ctx, query, deliveryID, now, tenantID)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func eventTypesToStrings(eventTypes []domain.EventType) []string {
+	result := make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		result = append(result, string(eventType))
+	}
+	return result
+}
+
+func uuidsToStrings(ids []uuid.UUID) []string {
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, id.String())
+	}
+	return result
+}
+
+// DeleteProcessedOutboxEvents delete outbox events of every tenant processed before processedBefore, with their delivered deliveries.
+// Events that still have a pending or dead delivery are kept. The delivered deliveries are deleted by the same statement,
+// so the outer delete does not see them in its snapshot and only checks the other statuses
+func (p *postgres) DeleteProcessedOutboxEvents(ctx context.Context, processedBefore time.Time) error {
+	query := `WITH delivered AS (
+			DELETE FROM webhook_deliveries d USING event_outbox o
+			WHERE d.event_id = o.id AND o.processed_at < $1 AND d.status = 'delivered'
+		)
+		DELETE FROM event_outbox o
+		WHERE o.processed_at < $1
+			AND NOT EXISTS (SELECT 1 FROM webhook_deliveries d WHERE d.event_id = o.id AND d.status <> 'delivered')`
+	_, err := p.DB.ExecContext(ctx, query, processedBefore)
+	return err
+}
@@ -0,0 +1,227 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_postgres_CreateWebhookSubscription(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	estateID := uuid.New()
+	subscription := &domain.WebhookSubscription{
+		ID:         uuid.New(),
+		TenantID:   uuid.New(),
+		EstateID:   &estateID,
+		URL:        "https://erp.example.com/hooks",
+		Secret:     "secret",
+		EventTypes: []domain.EventType{domain.EventTreePlanted},
+	}
+
+	mock.ExpectExec("INSERT INTO webhook_subscriptions").
+		WithArgs(subscription.ID, subscription.TenantID, subscription.EstateID, subscription.URL, subscription.Secret, pq.Array([]string{"tree.planted"})).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	assert.NoError(t, pg.CreateWebhookSubscription(ctx, subscription))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func Test_postgres_DeleteWebhookSubscription(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	tenantID := uuid.New()
+	id := uuid.New()
+
+	tests := []struct {
+		name      string
+		mockFunc  func()
+		expected  bool
+		wantError bool
+	}{
+		{
+			name: "Deleted",
+			mockFunc: func() {
+				mock.ExpectExec("DELETE FROM webhook_subscriptions WHERE id = \\$1 AND tenant_id = \\$2").WithArgs(id, tenantID).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			expected: true,
+		},
+		{
+			name: "Not found",
+			mockFunc: func() {
+				mock.ExpectExec("DELETE FROM webhook_subscriptions WHERE id = \\$1 AND tenant_id = \\$2").WithArgs(id, tenantID).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expected: false,
+		},
+		{
+			name: "Exec error",
+			mockFunc: func() {
+				mock.ExpectExec("DELETE FROM webhook_subscriptions WHERE id = \\$1 AND tenant_id = \\$2").WithArgs(id, tenantID).WillReturnError(errors.New("exec error"))
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			deleted, err := pg.DeleteWebhookSubscription(ctx, tenantID, id)
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, deleted)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_postgres_CreateWebhookDeliveries(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	event := domain.NewEvent(domain.EventTreePlanted, uuid.New(), uuid.New(), nil)
+	delivery := domain.WebhookDelivery{
+		ID:            uuid.New(),
+		Subscription:  domain.WebhookSubscription{ID: uuid.New()},
+		Event:         event,
+		Status:        domain.WebhookDeliveryPending,
+		NextAttemptAt: time.Now(),
+	}
+
+	tests := []struct {
+		name       string
+		deliveries []domain.WebhookDelivery
+		mockFunc   func()
+		wantError  bool
+	}{
+		{
+			name:       "Success",
+			deliveries: []domain.WebhookDelivery{delivery},
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO webhook_deliveries .* ON CONFLICT \\(subscription_id, event_id\\) DO NOTHING").
+					WithArgs(delivery.ID, delivery.Subscription.ID, event.ID, "pending", delivery.NextAttemptAt).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("UPDATE event_outbox SET processed_at").WithArgs(pq.Array([]string{event.ID.String()})).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:       "No matching subscriptions only mark processed",
+			deliveries: nil,
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE event_outbox SET processed_at").WithArgs(pq.Array([]string{event.ID.String()})).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:       "Insert error rollback",
+			deliveries: []domain.WebhookDelivery{delivery},
+			mockFunc: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT INTO webhook_deliveries").WillReturnError(errors.New("insert error"))
+				mock.ExpectRollback()
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			err := pg.CreateWebhookDeliveries(ctx, []uuid.UUID{event.ID}, tt.deliveries)
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_postgres_UpdateWebhookDelivery(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	delivery := &domain.WebhookDelivery{
+		ID:            uuid.New(),
+		Status:        domain.WebhookDeliveryDead,
+		Attempts:      8,
+		NextAttemptAt: time.Now(),
+		LastError:     "timeout",
+	}
+
+	mock.ExpectExec("UPDATE webhook_deliveries SET .* locked_until = NULL").
+		WithArgs(delivery.ID, "dead", 8, delivery.NextAttemptAt, "timeout").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, pg.UpdateWebhookDelivery(ctx, delivery))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func Test_postgres_RetryDeadWebhookDelivery(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	tenantID := uuid.New()
+	id := uuid.New()
+	now := time.Now()
+
+	mock.ExpectExec("UPDATE webhook_deliveries .* WHERE id = \\$1 AND status = 'dead' AND subscription_id IN \\(SELECT id FROM webhook_subscriptions WHERE tenant_id = \\$3\\)").
+		WithArgs(id, now, tenantID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	retried, err := pg.RetryDeadWebhookDelivery(ctx, tenantID, id, now)
+	assert.NoError(t, err)
+	assert.True(t, retried)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func Test_postgres_DeleteProcessedOutboxEvents(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	before := time.Now().Add(-7 * 24 * time.Hour)
+
+	mock.ExpectExec("WITH delivered AS \\(\\s*DELETE FROM webhook_deliveries .* d.status = 'delivered'.* DELETE FROM event_outbox o\\s+WHERE o.processed_at < \\$1\\s+AND NOT EXISTS \\(.*d.status <> 'delivered'\\)").
+		WithArgs(before).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	assert.NoError(t, pg.DeleteProcessedOutboxEvents(ctx, before))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
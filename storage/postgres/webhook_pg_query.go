@@ -0,0 +1,272 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const webhookDeliveryColumns = `
+        d.id, d.status, d.attempts, d.next_attempt_at, COALESCE(d.last_error, ''),
+        s.id, s.tenant_id, s.estate_id, s.url, s.secret, s.event_types, s.created_at,
+        o.id, o.tenant_id, o.estate_id, o.type, o.payload, o.occurred_at
+    `
+
+// GetWebhookSubscriptions retrieves webhook subscriptions of the tenant, oldest first
+func (p *postgres) GetWebhookSubscriptions(ctx context.Context, tenantID uuid.UUID) ([]domain.WebhookSubscription, error) {
+	query := `
+        SELECT id, tenant_id, estate_id, url, secret, event_types, created_at
+        FROM webhook_subscriptions
+        WHERE tenant_id = $1
+        ORDER BY created_at
+    `
+
+	rows, err := p.DB.QueryContext(ctx, query, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return scanWebhookSubscriptions(rows)
+}
+
+// GetAllWebhookSubscriptions retrieves webhook subscriptions of every tenant for the dispatcher,
+// they are few so fan out can match them in memory
+func (p *postgres) GetAllWebhookSubscriptions(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	query := `
+        SELECT id, tenant_id, estate_id, url, secret, event_types, created_at
+        FROM webhook_subscriptions
+        ORDER BY created_at
+    `
+
+	rows, err := p.DB.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return scanWebhookSubscriptions(rows)
+}
+
+// EstateExists report whether the estate exists in the tenant, so subscriptions never point to an estate of another tenant
+func (p *postgres) EstateExists(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM estates WHERE id = $1 AND tenant_id = $2)`
+
+	var exists bool
+	err := p.DB.QueryRowContext(ctx, query, estateID, tenantID).Scan(&exists)
+	return exists, err
+}
+
+// GetWebhookSubscription retrieves webhook subscription of the tenant, nil when it does not exist
+func (p *postgres) GetWebhookSubscription(ctx context.Context, tenantID uuid.UUID, webhookID uuid.UUID) (*domain.WebhookSubscription, error) {
+	query := `
+        SELECT id, tenant_id, estate_id, url, secret, event_types, created_at
+        FROM webhook_subscriptions
+        WHERE id = $1 AND tenant_id = $2
+    `
+
+	rows, err := p.DB.QueryContext(ctx, query, webhookID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := scanWebhookSubscriptions(rows)
+	if err != nil || len(subscriptions) == 0 {
+		return nil, err
+	}
+	return &subscriptions[0], nil
+}
+
+func scanWebhookSubscriptions(rows *sql.Rows) ([]domain.WebhookSubscription, error) {
+	defer rows.Close()
+
+	subscriptions := []domain.WebhookSubscription{}
+	for rows.Next() {
+		var subscription domain.WebhookSubscription
+		var eventTypes []string
+		err := rows.Scan(&subscription.ID, &subscription.TenantID, &subscription.EstateID, &subscription.URL, &subscription.Secret, pq.Array(&eventTypes), &subscription.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		subscription.EventTypes = stringsToEventTypes(eventTypes)
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+// ClaimPendingOutboxEvents claims outbox events that are not fanned out into webhook deliveries yet, oldest first.
+// Claimed events are locked until lockedUntil so other dispatcher replicas skip them,
+// they are claimed again once the lock expires without being processed
+func (p *postgres) ClaimPendingOutboxEvents(ctx context.Context, now time.Time, lockedUntil time.Time, limit int) ([]domain.Event, error) {
+	query := `
+        WITH claimed AS (
+            UPDATE event_outbox SET locked_until = $2
+            WHERE id IN (
+                SELECT id FROM event_outbox
+                WHERE processed_at IS NULL AND (locked_until IS NULL OR locked_until <= $1)
+                ORDER BY occurred_at
+                LIMIT $3
+                FOR UPDATE SKIP LOCKED
+            )
+            RETURNING id, tenant_id, estate_id, type, payload, occurred_at
+        )
+        SELECT id, tenant_id, estate_id, type, payload, occurred_at FROM claimed ORDER BY occurred_at
+    `
+
+	rows, err := p.DB.QueryContext(ctx, query, now, lockedUntil, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []domain.Event
+	for rows.Next() {
+		var event domain.Event
+		var eventType string
+		var payload []byte
+		err := rows.Scan(&event.ID, &event.TenantID, &event.EstateID, &eventType, &payload, &event.OccurredAt)
+		if err != nil {
+			return nil, err
+		}
+		event.Type = domain.EventType(eventType)
+		event.Data, err = decodeEventPayload(payload)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// ClaimDueWebhookDeliveries claims pending deliveries whose next attempt is due, along with subscription and event.
+// Claimed deliveries are locked until lockedUntil so other dispatcher replicas do not send them too.
+// Delivery is at-least-once, receivers should deduplicate using the event id
+func (p *postgres) ClaimDueWebhookDeliveries(ctx context.Context, now time.Time, lockedUntil time.Time, limit int) ([]domain.WebhookDelivery, error) {
+	query := `
+        WITH claimed AS (
+            UPDATE webhook_deliveries SET locked_until = $2
+            WHERE id IN (
+                SELECT id FROM webhook_deliveries
+                WHERE status = 'pending' AND next_attempt_at <= $1 AND (locked_until IS NULL OR locked_until <= $1)
+                ORDER BY next_attempt_at
+                LIMIT $3
+                FOR UPDATE SKIP LOCKED
+            )
+            RETURNING *
+        )
+        SELECT ` + webhookDeliveryColumns + `
+        FROM claimed d
+        JOIN webhook_subscriptions s ON s.id = d.subscription_id
+        JOIN event_outbox o ON o.id = d.event_id
+        ORDER BY d.next_attempt_at
+    `
+
+	rows, err := p.DB.QueryContext(ctx, query, now, lockedUntil, limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanWebhookDeliveries(rows)
+}
+
+// GetDeadWebhookDeliveries retrieves deliveries of the tenant that exhausted their attempts, most recent first
+func (p *postgres) GetDeadWebhookDeliveries(ctx context.Context, tenantID uuid.UUID) ([]domain.WebhookDelivery, error) {
+	query := `
+        SELECT ` + webhookDeliveryColumns + `
+        FROM webhook_deliveries d
+        JOIN webhook_subscriptions s ON s.id = d.subscription_id
+        JOIN event_outbox o ON o.id = d.event_id
+        WHERE d.status = 'dead' AND s.tenant_id = $1
+        ORDER BY d.updated_at DESC
+    `
+
+	rows, err := p.DB.QueryContext(ctx, query, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return scanWebhookDeliveries(rows)
+}
+
+// GetWebhookDelivery retrieves delivery of a subscription of the tenant along with subscription and event,
+// nil when it does not exist
+func (p *postgres) GetWebhookDelivery(ctx context.Context, tenantID uuid.UUID, deliveryID uuid.UUID) (*domain.WebhookDelivery, error) {
+	query := `
+        SELECT ` + webhookDeliveryColumns + `
+        FROM webhook_deliveries d
+        JOIN webhook_subscriptions s ON s.id = d.subscription_id
+        JOIN event_outbox o ON o.id = d.event_id
+        WHERE d.id = $1 AND s.tenant_id = $2
+    `
+
+	rows, err := p.DB.QueryContext(ctx, query, deliveryID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, err := scanWebhookDeliveries(rows)
+	if err != nil || len(deliveries) == 0 {
+		return nil, err
+	}
+	return &deliveries[0], nil
+}
+
+func scanWebhookDeliveries(rows *sql.Rows) ([]domain.WebhookDelivery, error) {
+	defer rows.Close()
+
+	deliveries := []domain.WebhookDelivery{}
+	for rows.Next() {
+		var delivery domain.WebhookDelivery
+		var status, eventType string
+		var eventTypes []string
+		var payload []byte
+		err := rows.Scan(
+			&delivery.ID, &status, &delivery.Attempts, &delivery.NextAttemptAt, &delivery.LastError,
+			&delivery.Subscription.ID, &delivery.Subscription.TenantID, &delivery.Subscription.EstateID, &delivery.Subscription.URL, &delivery.Subscription.Secret, pq.Array(&eventTypes), &delivery.Subscription.CreatedAt,
+			&delivery.Event.ID, &delivery.Event.TenantID, &delivery.Event.EstateID, &eventType, &payload, &delivery.Event.OccurredAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		delivery.Status = domain.WebhookDeliveryStatus(status)
+		delivery.Subscription.EventTypes = stringsToEventTypes(eventTypes)
+		delivery.Event.Type = domain.EventType(eventType)
+		delivery.Event.Data, err = decodeEventPayload(payload)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func decodeEventPayload(payload []byte) (map[string]any, error) {
+	var data map[string]any
+	if len(payload) == 0 {
+		return data, nil
+	}
+	err := json.Unmarshal(payload, &data)
+	return data, err
+}
+
+func stringsToEventTypes(values []string) []domain.EventType {
+	result := make([]domain.EventType, 0, len(values))
+	for _, value := range values {
+		result = append(result, domain.EventType(value))
+	}
+	return result
+}
@@ -0,0 +1,290 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_postgres_GetWebhookSubscriptions(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	id := uuid.New()
+	tenantID := uuid.New()
+	estateID := uuid.New()
+	createdAt := time.Now()
+
+	mock.ExpectQuery("SELECT id, tenant_id, estate_id, url, secret, event_types, created_at FROM webhook_subscriptions WHERE tenant_id = \\$1").
+		WithArgs(tenantID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "estate_id", "url", "secret", "event_types", "created_at"}).
+			AddRow(id, tenantID, estateID, "https://erp.example.com/hooks", "secret", "{tree.planted,tree.removed}", createdAt).
+			AddRow(id, tenantID, nil, "https://erp.example.com/all", "secret", "{}", createdAt))
+
+	subscriptions, err := pg.GetWebhookSubscriptions(ctx, tenantID)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.WebhookSubscription{
+		{
+			ID:         id,
+			TenantID:   tenantID,
+			EstateID:   &estateID,
+			URL:        "https://erp.example.com/hooks",
+			Secret:     "secret",
+			EventTypes: []domain.EventType{domain.EventTreePlanted, domain.EventTreeRemoved},
+			CreatedAt:  createdAt,
+		},
+		{
+			ID:         id,
+			TenantID:   tenantID,
+			URL:        "https://erp.example.com/all",
+			Secret:     "secret",
+			EventTypes: []domain.EventType{},
+			CreatedAt:  createdAt,
+		},
+	}, subscriptions)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func Test_postgres_GetAllWebhookSubscriptions(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	tenantIDs := []uuid.UUID{uuid.New(), uuid.New()}
+	createdAt := time.Now()
+
+	mock.ExpectQuery("SELECT id, tenant_id, estate_id, url, secret, event_types, created_at FROM webhook_subscriptions ORDER BY created_at").
+		WithoutArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "estate_id", "url", "secret", "event_types", "created_at"}).
+			AddRow(uuid.New(), tenantIDs[0], nil, "https://erp.example.com/all", "secret", "{}", createdAt).
+			AddRow(uuid.New(), tenantIDs[1], nil, "https://other.example.com/all", "secret", "{}", createdAt))
+
+	subscriptions, err := pg.GetAllWebhookSubscriptions(ctx)
+	assert.NoError(t, err)
+	if assert.Len(t, subscriptions, 2) {
+		assert.Equal(t, tenantIDs[0], subscriptions[0].TenantID)
+		assert.Equal(t, tenantIDs[1], subscriptions[1].TenantID)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func Test_postgres_ClaimPendingOutboxEvents(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	id := uuid.New()
+	tenantID := uuid.New()
+	estateID := uuid.New()
+	occurredAt := time.Now()
+	now := time.Now()
+	lockedUntil := now.Add(time.Minute)
+
+	tests := []struct {
+		name      string
+		mockFunc  func()
+		expected  []domain.Event
+		wantError bool
+	}{
+		{
+			name: "Success",
+			mockFunc: func() {
+				mock.ExpectQuery("UPDATE event_outbox SET locked_until = \\$2 WHERE id IN \\( SELECT id FROM event_outbox WHERE processed_at IS NULL AND \\(locked_until IS NULL OR locked_until <= \\$1\\) ORDER BY occurred_at LIMIT \\$3 FOR UPDATE SKIP LOCKED \\)").
+					WithArgs(now, lockedUntil, 10).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "estate_id", "type", "payload", "occurred_at"}).
+						AddRow(id, tenantID, estateID, "tree.planted", []byte(`{"height":10}`), occurredAt))
+			},
+			expected: []domain.Event{
+				{ID: id, TenantID: tenantID, EstateID: estateID, Type: domain.EventTreePlanted, OccurredAt: occurredAt, Data: map[string]any{"height": float64(10)}},
+			},
+		},
+		{
+			name: "Query error",
+			mockFunc: func() {
+				mock.ExpectQuery("UPDATE event_outbox SET locked_until").
+					WithArgs(now, lockedUntil, 10).
+					WillReturnError(errors.New("query error"))
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			events, err := pg.ClaimPendingOutboxEvents(ctx, now, lockedUntil, 10)
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, events)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_postgres_ClaimDueWebhookDeliveries(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	now := time.Now()
+	deliveryID := uuid.New()
+	subscriptionID := uuid.New()
+	eventID := uuid.New()
+	tenantID := uuid.New()
+	estateID := uuid.New()
+
+	mock.ExpectQuery("UPDATE webhook_deliveries SET locked_until = \\$2 WHERE id IN \\( SELECT id FROM webhook_deliveries WHERE status = 'pending' AND next_attempt_at <= \\$1 AND \\(locked_until IS NULL OR locked_until <= \\$1\\) ORDER BY next_attempt_at LIMIT \\$3 FOR UPDATE SKIP LOCKED \\)").
+		WithArgs(now, now.Add(time.Minute), 10).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "status", "attempts", "next_attempt_at", "last_error",
+			"id", "tenant_id", "estate_id", "url", "secret", "event_types", "created_at",
+			"id", "tenant_id", "estate_id", "type", "payload", "occurred_at",
+		}).AddRow(
+			deliveryID, "pending", 2, now, "timeout",
+			subscriptionID, tenantID, nil, "https://erp.example.com/hooks", "secret", "{}", now,
+			eventID, tenantID, estateID, "tree.removed", []byte(`{}`), now,
+		))
+
+	deliveries, err := pg.ClaimDueWebhookDeliveries(ctx, now, now.Add(time.Minute), 10)
+	assert.NoError(t, err)
+	assert.Len(t, deliveries, 1)
+	assert.Equal(t, deliveryID, deliveries[0].ID)
+	assert.Equal(t, domain.WebhookDeliveryPending, deliveries[0].Status)
+	assert.Equal(t, 2, deliveries[0].Attempts)
+	assert.Equal(t, "https://erp.example.com/hooks", deliveries[0].Subscription.URL)
+	assert.Equal(t, domain.EventTreeRemoved, deliveries[0].Event.Type)
+	assert.Equal(t, estateID, deliveries[0].Event.EstateID)
+	assert.Equal(t, tenantID, deliveries[0].Event.TenantID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func Test_postgres_GetDeadWebhookDeliveries(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	tenantID := uuid.New()
+
+	mock.ExpectQuery("FROM webhook_deliveries d .* WHERE d.status = 'dead' AND s.tenant_id = \\$1").
+		WithArgs(tenantID).
+		WillReturnError(errors.New("query error"))
+
+	_, err = pg.GetDeadWebhookDeliveries(ctx, tenantID)
+	assert.Error(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func Test_postgres_EstateExists(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	tenantID := uuid.New()
+	estateID := uuid.New()
+	query := "SELECT EXISTS \\(SELECT 1 FROM estates WHERE id = \\$1 AND tenant_id = \\$2\\)"
+
+	mock.ExpectQuery(query).WithArgs(estateID, tenantID).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	exists, err := pg.EstateExists(ctx, tenantID, estateID)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	mock.ExpectQuery(query).WithArgs(estateID, tenantID).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	exists, err = pg.EstateExists(ctx, tenantID, estateID)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func Test_postgres_GetWebhookSubscription(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	id := uuid.New()
+	tenantID := uuid.New()
+	estateID := uuid.New()
+	columns := []string{"id", "tenant_id", "estate_id", "url", "secret", "event_types", "created_at"}
+	query := "SELECT id, tenant_id, estate_id, url, secret, event_types, created_at FROM webhook_subscriptions WHERE id = \\$1 AND tenant_id = \\$2"
+
+	mock.ExpectQuery(query).WithArgs(id, tenantID).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(id, tenantID, estateID, "https://erp.example.com/hooks", "secret", "{}", time.Now()))
+	subscription, err := pg.GetWebhookSubscription(ctx, tenantID, id)
+	assert.NoError(t, err)
+	if assert.NotNil(t, subscription) {
+		assert.Equal(t, id, subscription.ID)
+		assert.Equal(t, &estateID, subscription.EstateID)
+	}
+
+	mock.ExpectQuery(query).WithArgs(id, tenantID).WillReturnRows(sqlmock.NewRows(columns))
+	subscription, err = pg.GetWebhookSubscription(ctx, tenantID, id)
+	assert.NoError(t, err)
+	assert.Nil(t, subscription)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func Test_postgres_GetWebhookDelivery(t *testing.T) {
+	ctx := context.Background()
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	pg := &postgres{DB: mockDB}
+	now := time.Now()
+	deliveryID := uuid.New()
+	tenantID := uuid.New()
+	estateID := uuid.New()
+	columns := []string{
+		"id", "status", "attempts", "next_attempt_at", "last_error",
+		"id", "tenant_id", "estate_id", "url", "secret", "event_types", "created_at",
+		"id", "tenant_id", "estate_id", "type", "payload", "occurred_at",
+	}
+	query := "FROM webhook_deliveries d .* WHERE d.id = \\$1 AND s.tenant_id = \\$2"
+
+	mock.ExpectQuery(query).WithArgs(deliveryID, tenantID).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(
+			deliveryID, "dead", 8, now, "timeout",
+			uuid.New(), tenantID, estateID, "https://erp.example.com/hooks", "secret", "{}", now,
+			uuid.New(), tenantID, estateID, "tree.planted", []byte(`{}`), now,
+		))
+	delivery, err := pg.GetWebhookDelivery(ctx, tenantID, deliveryID)
+	assert.NoError(t, err)
+	if assert.NotNil(t, delivery) {
+		assert.Equal(t, domain.WebhookDeliveryDead, delivery.Status)
+		assert.Equal(t, &estateID, delivery.Subscription.EstateID)
+	}
+
+	mock.ExpectQuery(query).WithArgs(deliveryID, tenantID).WillReturnRows(sqlmock.NewRows(columns))
+	delivery, err = pg.GetWebhookDelivery(ctx, tenantID, deliveryID)
+	assert.NoError(t, err)
+	assert.Nil(t, delivery)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
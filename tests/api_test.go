@@ -2,20 +2,36 @@
 package tests
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
-	"fmt"
-	"io"
+	"errors"
 	"net/http"
+	"os"
+	"sync"
 	"testing"
 
+	"github.com/SawitProRecruitment/EstateService/client"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 )
 
 const ApiUrl = "http://localhost:8080"
 
+// ApiKey is the bootstrap admin key configured in docker-compose.yml
+var ApiKey = apiKeyFromEnv()
+
+func apiKeyFromEnv() string {
+	if key := os.Getenv("API_KEY"); key != "" {
+		return key
+	}
+	return "local-admin-key"
+}
+
+func newClient(t *testing.T) *client.Client {
+	c, err := client.New(ApiUrl, client.WithAPIKey(ApiKey))
+	require.NoError(t, err)
+	return c
+}
+
 func TestApi(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skip API tests")
@@ -23,117 +39,120 @@ func TestApi(t *testing.T) {
 
 	testcases := getTestCases()
 	ctx := context.Background()
-	client := &http.Client{}
+	c := newClient(t)
 
 	for _, tc := range testcases {
 		t.Run(tc.Name, func(t *testing.T) {
-			for idx := range tc.Steps {
-				step := &tc.Steps[idx]
-				request, err := step.Request(t, ctx, &tc)
-				request.Header.Set("Content-Type", "application/json")
-				request.Header.Set("Accept", "application/json")
-				require.NoError(t, err)
+			state := &TestState{}
+			for _, step := range tc.Steps {
+				step(t, ctx, c, state)
+			}
+		})
+	}
+}
 
-				// Send request
-				response, err := client.Do(request)
+// TestApiConcurrentTreePlanting plant the same plot concurrently, only one planting may succeed
+func TestApiConcurrentTreePlanting(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skip API tests")
+	}
 
-				require.NoError(t, err)
-				defer response.Body.Close()
+	const requests = 10
+	ctx := context.Background()
+	c := newClient(t)
 
-				// Check response
-				ReadJsonResult(t, response, step)
-				step.Expect(t, ctx, &tc, response, step.Result)
-			}
-		})
+	estate, err := c.CreateEstate(ctx, 10, 10)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, requests)
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.CreateTree(ctx, estate.ID, 5, 5, 10)
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	planted, rejected := 0, 0
+	for err := range errs {
+		switch {
+		case err == nil:
+			planted++
+		case errors.Is(err, client.ErrTreeAlreadyExists):
+			rejected++
+		default:
+			t.Error(err)
+		}
 	}
+	require.Equal(t, 1, planted)
+	require.Equal(t, requests-1, rejected)
 }
 
 func getTestCases() []TestCase {
 	return []TestCase{
-		//{
-		//	Name: "Test Hello",
-		//	Steps: []TestCaseStep{
-		//		{
-		//			Request: func(t *testing.T, ctx context.Context, tc *TestCase) (*http.Request, error) {
-		//				return http.NewRequest("GET", ApiUrl+"/hello", nil)
-		//			},
-		//			Expect: func(t *testing.T, ctx context.Context, tc *TestCase, resp *http.Response, data map[string]any) {
-		//				require.Equal(t, http.StatusBadRequest, resp.StatusCode)
-		//			},
-		//		},
-		//	},
-		//},
-		//{
-		//	Name: "Test Hello with name",
-		//	Steps: []TestCaseStep{
-		//		{
-		//			Request: func(t *testing.T, ctx context.Context, tc *TestCase) (*http.Request, error) {
-		//				return http.NewRequest("GET", ApiUrl+"/hello?id=123", nil)
-		//			},
-		//			Expect: func(t *testing.T, ctx context.Context, tc *TestCase, resp *http.Response, data map[string]any) {
-		//				require.Equal(t, http.StatusOK, resp.StatusCode)
-		//				require.Equal(t, "Hello User 123", data["message"])
-		//			},
-		//		},
-		//		{
-		//			Request: func(t *testing.T, ctx context.Context, tc *TestCase) (*http.Request, error) {
-		//				return http.NewRequest("GET", ApiUrl+"/hello?id=456", nil)
-		//			},
-		//			Expect: func(t *testing.T, ctx context.Context, tc *TestCase, resp *http.Response, data map[string]any) {
-		//				require.Equal(t, http.StatusOK, resp.StatusCode)
-		//				step1 := tc.Steps[0]
-		//				require.Equal(t, "Hello User 123", step1.Result["message"])
-		//			},
-		//		},
-		//	},
-		//},
-		//----- Test for API
 		{
 			Name: "Test Error 1",
 			Steps: []TestCaseStep{
-				{
-					Request: func(t *testing.T, ctx context.Context, tc *TestCase) (*http.Request, error) {
-						return http.NewRequest("POST", ApiUrl+"/estate", nil)
-					},
-					Expect: ExpectBadRequest(),
+				func(t *testing.T, ctx context.Context, c *client.Client, state *TestState) {
+					res, err := c.API().PostEstateWithBodyWithResponse(ctx, nil, "application/json", nil)
+					require.NoError(t, err)
+					require.Equal(t, http.StatusBadRequest, res.StatusCode())
 				},
 			},
 		},
 		{
 			Name: "Test Error 2: Invalid Format",
 			Steps: []TestCaseStep{
-				{
-					Request: SendRequestNewEstate(-1, -5),
-					Expect:  ExpectBadRequest(),
-				},
+				CreateEstateFails(-1, -5, http.StatusBadRequest),
 			},
 		},
 		{
 			Name: "Test Error: Create Tree Out of Bound",
 			Steps: []TestCaseStep{
-				{
-					Request: SendRequestNewEstate(10, 20),
-					Expect:  ExpectNewEstateOk(),
-				},
-				{
-					Request: SendRequestNewTree(5, 0, 0),
-					Expect:  ExpectBadRequest(),
+				CreateEstateOk(10, 20),
+				CreateTreeFails(5, 0, 0, http.StatusBadRequest),
+			},
+		},
+		{
+			Name: "Normal 1",
+			Steps: []TestCaseStep{
+				CreateEstateOk(10, 20),
+				CreateTreeOk(10, 5, 5),
+				CreateTreeOk(20, 6, 5),
+			},
+		},
+		{
+			Name: "Normal 2",
+			Steps: []TestCaseStep{
+				CreateEstateOk(5, 1),
+				CreateTreeOk(10, 2, 1),
+				CreateTreeOk(20, 3, 1),
+				CreateTreeOk(10, 4, 1),
+				GetStatsOk(3, 10, 20, 10),
+				GetDronePlanOk(0, 82),
+			},
+		},
+		{
+			Name: "Tree already exists",
+			Steps: []TestCaseStep{
+				CreateEstateOk(5, 5),
+				CreateTreeOk(10, 2, 2),
+				CreateTreeIs(10, 2, 2, client.ErrTreeAlreadyExists),
+			},
+		},
+		{
+			Name: "Estate not found",
+			Steps: []TestCaseStep{
+				func(t *testing.T, ctx context.Context, c *client.Client, state *TestState) {
+					_, err := c.GetEstateStats(ctx, uuid.New())
+					require.ErrorIs(t, err, client.ErrEstateNotFound)
 				},
 			},
 		},
-		CreateNormalTestCase("Normal 1", []any{
-			[]any{CreateEstate, 10, 20},
-			[]any{CreateTree, 10, 5, 5},
-			[]any{CreateTree, 20, 6, 5},
-		}),
-		CreateNormalTestCase("Normal 2", []any{
-			[]any{CreateEstate, 5, 1},
-			[]any{CreateTree, 10, 2, 1},
-			[]any{CreateTree, 20, 3, 1},
-			[]any{CreateTree, 10, 4, 1},
-			[]any{GetStats, 3, 10, 20, 10},
-			[]any{GetDronePlan, 0, 82},
-		}),
 	}
 }
 
@@ -142,164 +161,70 @@ type TestCase struct {
 	Steps []TestCaseStep
 }
 
-type RequestFunc func(*testing.T, context.Context, *TestCase) (*http.Request, error)
-type ExpectFunc func(*testing.T, context.Context, *TestCase, *http.Response, map[string]any)
-
-type TestCaseStep struct {
-	Request RequestFunc
-	Expect  ExpectFunc
-	Result  map[string]any
-}
-
-func ResponseContains(t *testing.T, resp *http.Response, text string) {
-	body, err := io.ReadAll(resp.Body)
-	bodyStr := string(body)
-	require.NoError(t, err)
-	require.Contains(t, bodyStr, text)
-}
-
-func ReadJsonResult(t *testing.T, resp *http.Response, step *TestCaseStep) {
-	var result map[string]any
-	err := json.NewDecoder(resp.Body).Decode(&result)
-	step.Result = result
-	require.NoError(t, err)
+// TestState is shared by the steps of a test case, EstateID is the estate created by its first step
+type TestState struct {
+	EstateID uuid.UUID
 }
 
-func RequireIsUUID(t *testing.T, value string) {
-	_, err := uuid.Parse(value)
-	require.NoError(t, err)
-}
-
-const (
-	CreateEstate = iota
-	CreateTree
-	GetStats
-	GetDronePlan
-)
-
-func CreateNormalTestCase(name string, a []any) TestCase {
-	tc := TestCase{}
-	tc.Name = name
-
-	for _, step := range a {
-		switch step.([]any)[0].(int) {
-		case CreateEstate:
-			tc.Steps = append(tc.Steps, TestCaseStep{
-				Request: SendRequestNewEstate(step.([]any)[1].(int), step.([]any)[2].(int)),
-				Expect:  ExpectNewEstateOk(),
-			})
-		case CreateTree:
-			tc.Steps = append(tc.Steps, TestCaseStep{
-				Request: SendRequestNewTree(step.([]any)[1].(int), step.([]any)[2].(int), step.([]any)[3].(int)),
-				Expect:  ExpectNewTreeOk(),
-			})
-		case GetStats:
-			tc.Steps = append(tc.Steps, TestCaseStep{
-				Request: SendRequestGetStats(),
-				Expect:  ExpectGetStatsOk(step.([]any)[1].(int), step.([]any)[2].(int), step.([]any)[3].(int), step.([]any)[4].(int)),
-			})
-		case GetDronePlan:
-			tc.Steps = append(tc.Steps, TestCaseStep{
-				Request: SendRequestGetDronePlan(step.([]any)[1].(int)),
-				Expect:  ExpectGetDronePlanOk(step.([]any)[2].(int)),
-			})
-		}
-
-	}
-	return tc
-}
+type TestCaseStep func(*testing.T, context.Context, *client.Client, *TestState)
 
-func SendRequestNewEstate(length, width int) RequestFunc {
-	return func(t *testing.T, ctx context.Context, tc *TestCase) (*http.Request, error) {
-		req := map[string]int{
-			"length": length,
-			"width":  width,
-		}
-		body, err := json.Marshal(req)
+func CreateEstateOk(length, width int) TestCaseStep {
+	return func(t *testing.T, ctx context.Context, c *client.Client, state *TestState) {
+		estate, err := c.CreateEstate(ctx, width, length)
 		require.NoError(t, err)
-		return http.NewRequest("POST", ApiUrl+"/estate", bytes.NewReader(body))
+		require.NotEqual(t, uuid.Nil, estate.ID)
+		state.EstateID = estate.ID
 	}
 }
 
-func ExpectNewEstateOk() ExpectFunc {
-	return func(t *testing.T, ctx context.Context, tc *TestCase, resp *http.Response, data map[string]any) {
-		RequireReturnIsUUID(t, resp, data)
+func CreateEstateFails(length, width, status int) TestCaseStep {
+	return func(t *testing.T, ctx context.Context, c *client.Client, state *TestState) {
+		_, err := c.CreateEstate(ctx, width, length)
+		RequireStatus(t, err, status)
 	}
 }
 
-func SendRequestNewTree(height, x, y int) RequestFunc {
-	return func(t *testing.T, ctx context.Context, tc *TestCase) (*http.Request, error) {
-		req := map[string]int{
-			"height": height,
-			"x":      x,
-			"y":      y,
-		}
-		id := tc.Steps[0].Result["id"].(string)
-		body, err := json.Marshal(req)
+func CreateTreeOk(height, x, y int) TestCaseStep {
+	return func(t *testing.T, ctx context.Context, c *client.Client, state *TestState) {
+		tree, err := c.CreateTree(ctx, state.EstateID, x, y, height)
 		require.NoError(t, err)
-		return http.NewRequest("POST", ApiUrl+"/estate/"+id+"/tree", bytes.NewReader(body))
+		require.NotEqual(t, uuid.Nil, tree.ID)
 	}
 }
 
-func ExpectNewTreeOk() ExpectFunc {
-	return func(t *testing.T, ctx context.Context, tc *TestCase, resp *http.Response, data map[string]any) {
-		RequireReturnIsUUID(t, resp, data)
+func CreateTreeFails(height, x, y, status int) TestCaseStep {
+	return func(t *testing.T, ctx context.Context, c *client.Client, state *TestState) {
+		_, err := c.CreateTree(ctx, state.EstateID, x, y, height)
+		RequireStatus(t, err, status)
 	}
 }
 
-func SendRequestGetStats() RequestFunc {
-	return func(t *testing.T, ctx context.Context, tc *TestCase) (*http.Request, error) {
-		id := tc.Steps[0].Result["id"].(string)
-		return http.NewRequest("GET", ApiUrl+"/estate/"+id+"/stats", nil)
+func CreateTreeIs(height, x, y int, want error) TestCaseStep {
+	return func(t *testing.T, ctx context.Context, c *client.Client, state *TestState) {
+		_, err := c.CreateTree(ctx, state.EstateID, x, y, height)
+		require.ErrorIs(t, err, want)
 	}
 }
 
-func ExpectGetStatsOk(count, min, max, median int) ExpectFunc {
-	return func(t *testing.T, ctx context.Context, tc *TestCase, resp *http.Response, data map[string]any) {
-		RequireStats(t, resp, data, count, min, max, median)
-	}
-}
-
-func SendRequestGetDronePlan(distance int) RequestFunc {
-	return func(t *testing.T, ctx context.Context, tc *TestCase) (*http.Request, error) {
-		id := tc.Steps[0].Result["id"].(string)
-		var url string
-
-		if distance == 0 {
-			url = fmt.Sprintf("%s/estate/%s/drone-plan", ApiUrl, id)
-		} else {
-			url = fmt.Sprintf("%s/estate/%s/drone-plan?max_distance=%d", ApiUrl, id, distance)
-		}
-		return http.NewRequest("GET", url, nil)
+func GetStatsOk(count, min, max, median int) TestCaseStep {
+	return func(t *testing.T, ctx context.Context, c *client.Client, state *TestState) {
+		stats, err := c.GetEstateStats(ctx, state.EstateID)
+		require.NoError(t, err)
+		require.Equal(t, client.EstateStats{Count: count, Min: min, Max: max, Median: median}, stats)
 	}
 }
 
-func ExpectGetDronePlanOk(distance int) ExpectFunc {
-	return func(t *testing.T, ctx context.Context, tc *TestCase, resp *http.Response, data map[string]any) {
-		RequireDistance(t, resp, data, distance)
+func GetDronePlanOk(maxDistance, distance int) TestCaseStep {
+	return func(t *testing.T, ctx context.Context, c *client.Client, state *TestState) {
+		plan, err := c.GetDronePlan(ctx, state.EstateID, maxDistance)
+		require.NoError(t, err)
+		require.Equal(t, distance, plan.Distance)
 	}
 }
 
-func RequireReturnIsUUID(t *testing.T, resp *http.Response, data map[string]any) {
-	require.Equal(t, http.StatusCreated, resp.StatusCode)
-	RequireIsUUID(t, data["id"].(string))
-}
-
-func RequireStats(t *testing.T, resp *http.Response, data map[string]any, count, min, max, median int) {
-	require.Equal(t, http.StatusOK, resp.StatusCode)
-	require.Equal(t, count, int(data["count"].(float64)))
-	require.Equal(t, min, int(data["min"].(float64)))
-	require.Equal(t, max, int(data["max"].(float64)))
-	require.Equal(t, median, int(data["median"].(float64)))
-}
-
-func RequireDistance(t *testing.T, resp *http.Response, data map[string]any, distance int) {
-	require.Equal(t, http.StatusOK, resp.StatusCode)
-	require.Equal(t, distance, int(data["distance"].(float64)))
-}
-
-func ExpectBadRequest() ExpectFunc {
-	return func(t *testing.T, ctx context.Context, tc *TestCase, resp *http.Response, data map[string]any) {
-		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
-	}
+// RequireStatus require err to be an API error with the given status
+func RequireStatus(t *testing.T, err error, status int) {
+	var apiErr *client.Error
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, status, apiErr.StatusCode)
 }
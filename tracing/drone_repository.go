@@ -0,0 +1,54 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// droneRepository is a tracing decorator of DroneRepository, it starts a span per call
+type droneRepository struct {
+	next   interfaces.DroneRepository
+	tracer trace.Tracer
+}
+
+func NewDroneRepository(next interfaces.DroneRepository, tp trace.TracerProvider) *droneRepository {
+	return &droneRepository{
+		next:   next,
+		tracer: tp.Tracer(instrumentationName),
+	}
+}
+
+func (r *droneRepository) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	attrs = append(attrs, semconv.DBSystemPostgreSQL, semconv.DBOperation(method))
+	return r.tracer.Start(ctx, "DroneRepository."+method, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+func (r *droneRepository) CreateDrone(ctx context.Context, drone *domain.Drone) (err error) {
+	ctx, span := r.start(ctx, "CreateDrone", attrTenantID.String(drone.TenantID.String()), attrDroneID.String(drone.ID.String()))
+	defer end(span, &err)
+	return r.next.CreateDrone(ctx, drone)
+}
+
+func (r *droneRepository) ListDrones(ctx context.Context, tenantID uuid.UUID, status *domain.DroneStatus, limit int, offset int) (drones []domain.Drone, err error) {
+	ctx, span := r.start(ctx, "ListDrones", attrTenantID.String(tenantID.String()))
+	defer end(span, &err)
+	return r.next.ListDrones(ctx, tenantID, status, limit, offset)
+}
+
+func (r *droneRepository) GetDrone(ctx context.Context, tenantID uuid.UUID, droneID uuid.UUID) (drone *domain.Drone, err error) {
+	ctx, span := r.start(ctx, "GetDrone", attrTenantID.String(tenantID.String()), attrDroneID.String(droneID.String()))
+	defer end(span, &err)
+	return r.next.GetDrone(ctx, tenantID, droneID)
+}
+
+func (r *droneRepository) UpdateDrone(ctx context.Context, drone *domain.Drone) (err error) {
+	ctx, span := r.start(ctx, "UpdateDrone", attrTenantID.String(drone.TenantID.String()), attrDroneID.String(drone.ID.String()))
+	defer end(span, &err)
+	return r.next.UpdateDrone(ctx, drone)
+}
@@ -0,0 +1,101 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_droneRepository(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockDroneRepository(ctrl)
+	tp, recorder := newRecorder()
+	repo := NewDroneRepository(mockRepo, tp)
+	ctx := context.Background()
+	tenantID := uuid.New()
+	drone := &domain.Drone{ID: uuid.New(), TenantID: tenantID, Name: "drone-7"}
+	queryErr := errors.New("query error")
+
+	tests := []struct {
+		name      string
+		call      func() error
+		wantName  string
+		wantDrone bool
+		wantErr   error
+	}{
+		{
+			name: "CreateDrone",
+			call: func() error {
+				mockRepo.EXPECT().CreateDrone(gomock.Any(), drone).Return(nil)
+				return repo.CreateDrone(ctx, drone)
+			},
+			wantName:  "DroneRepository.CreateDrone",
+			wantDrone: true,
+		},
+		{
+			name: "ListDrones",
+			call: func() error {
+				mockRepo.EXPECT().ListDrones(gomock.Any(), tenantID, nil, 10, 0).Return(nil, queryErr)
+				_, err := repo.ListDrones(ctx, tenantID, nil, 10, 0)
+				return err
+			},
+			wantName: "DroneRepository.ListDrones",
+			wantErr:  queryErr,
+		},
+		{
+			name: "GetDrone",
+			call: func() error {
+				mockRepo.EXPECT().GetDrone(gomock.Any(), tenantID, drone.ID).Return(nil, domain.ErrorDroneNotFound)
+				_, err := repo.GetDrone(ctx, tenantID, drone.ID)
+				return err
+			},
+			wantName:  "DroneRepository.GetDrone",
+			wantDrone: true,
+			wantErr:   domain.ErrorDroneNotFound,
+		},
+		{
+			name: "UpdateDrone",
+			call: func() error {
+				mockRepo.EXPECT().UpdateDrone(gomock.Any(), drone).Return(nil)
+				return repo.UpdateDrone(ctx, drone)
+			},
+			wantName:  "DroneRepository.UpdateDrone",
+			wantDrone: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ended := len(recorder.Ended())
+			assert.Equal(t, tt.wantErr, tt.call())
+
+			spans := recorder.Ended()[ended:]
+			if !assert.Len(t, spans, 1) {
+				return
+			}
+			span := spans[0]
+			assert.Equal(t, tt.wantName, span.Name())
+			assert.Equal(t, trace.SpanKindClient, span.SpanKind())
+			assert.Equal(t, "postgresql", attrValue(span, "db.system"))
+			assert.Equal(t, tt.name, attrValue(span, "db.operation"))
+			assert.Equal(t, tenantID.String(), attrValue(span, string(attrTenantID)))
+			if tt.wantDrone {
+				assert.Equal(t, drone.ID.String(), attrValue(span, string(attrDroneID)))
+			}
+			if tt.wantErr != nil {
+				assert.Equal(t, codes.Error, span.Status().Code)
+			} else {
+				assert.Equal(t, codes.Unset, span.Status().Code)
+			}
+		})
+	}
+}
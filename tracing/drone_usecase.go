@@ -0,0 +1,53 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// droneUsecase is a tracing decorator of DroneUsecase, it starts a span per call
+// as child of the request span carried by ctx
+type droneUsecase struct {
+	next   interfaces.DroneUsecase
+	tracer trace.Tracer
+}
+
+func NewDroneUsecase(next interfaces.DroneUsecase, tp trace.TracerProvider) *droneUsecase {
+	return &droneUsecase{
+		next:   next,
+		tracer: tp.Tracer(instrumentationName),
+	}
+}
+
+func (u *droneUsecase) CreateDrone(ctx context.Context, drone *domain.Drone) (created *domain.Drone, err error) {
+	ctx, span := u.tracer.Start(ctx, "DroneUsecase.CreateDrone")
+	defer end(span, &err)
+
+	created, err = u.next.CreateDrone(ctx, drone)
+	if err == nil {
+		span.SetAttributes(attrDroneID.String(created.ID.String()))
+	}
+	return created, err
+}
+
+func (u *droneUsecase) ListDrones(ctx context.Context, status *domain.DroneStatus, limit int, offset int) (drones []domain.Drone, err error) {
+	ctx, span := u.tracer.Start(ctx, "DroneUsecase.ListDrones")
+	defer end(span, &err)
+	return u.next.ListDrones(ctx, status, limit, offset)
+}
+
+func (u *droneUsecase) GetDrone(ctx context.Context, droneID uuid.UUID) (drone *domain.Drone, err error) {
+	ctx, span := u.tracer.Start(ctx, "DroneUsecase.GetDrone", trace.WithAttributes(attrDroneID.String(droneID.String())))
+	defer end(span, &err)
+	return u.next.GetDrone(ctx, droneID)
+}
+
+func (u *droneUsecase) UpdateDrone(ctx context.Context, droneID uuid.UUID, update domain.DroneUpdate) (drone *domain.Drone, err error) {
+	ctx, span := u.tracer.Start(ctx, "DroneUsecase.UpdateDrone", trace.WithAttributes(attrDroneID.String(droneID.String())))
+	defer end(span, &err)
+	return u.next.UpdateDrone(ctx, droneID, update)
+}
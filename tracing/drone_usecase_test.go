@@ -0,0 +1,54 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/codes"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_droneUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockDroneUsecase(ctrl)
+	tp, recorder := newRecorder()
+	u := NewDroneUsecase(mockUsecase, tp)
+	ctx := context.Background()
+	drone := &domain.Drone{ID: uuid.New(), Name: "drone-7"}
+	retired := domain.DroneRetired
+
+	mockUsecase.EXPECT().CreateDrone(gomock.Any(), drone).Return(drone, nil)
+	_, err := u.CreateDrone(ctx, drone)
+	assert.NoError(t, err)
+
+	mockUsecase.EXPECT().ListDrones(gomock.Any(), nil, 10, 0).Return([]domain.Drone{*drone}, nil)
+	_, err = u.ListDrones(ctx, nil, 10, 0)
+	assert.NoError(t, err)
+
+	mockUsecase.EXPECT().GetDrone(gomock.Any(), drone.ID).Return(drone, nil)
+	_, err = u.GetDrone(ctx, drone.ID)
+	assert.NoError(t, err)
+
+	update := domain.DroneUpdate{Status: &retired}
+	mockUsecase.EXPECT().UpdateDrone(gomock.Any(), drone.ID, update).Return(nil, domain.ErrorDroneRetired)
+	_, err = u.UpdateDrone(ctx, drone.ID, update)
+	assert.Equal(t, domain.ErrorDroneRetired, err)
+
+	spans := recorder.Ended()
+	if assert.Len(t, spans, 4) {
+		assert.Equal(t, "DroneUsecase.CreateDrone", spans[0].Name())
+		assert.Equal(t, drone.ID.String(), attrValue(spans[0], string(attrDroneID)))
+		assert.Equal(t, "DroneUsecase.ListDrones", spans[1].Name())
+		assert.Equal(t, "DroneUsecase.GetDrone", spans[2].Name())
+		assert.Equal(t, drone.ID.String(), attrValue(spans[2], string(attrDroneID)))
+		assert.Equal(t, "DroneUsecase.UpdateDrone", spans[3].Name())
+		assert.Equal(t, drone.ID.String(), attrValue(spans[3], string(attrDroneID)))
+		assert.Equal(t, codes.Error, spans[3].Status().Code)
+	}
+}
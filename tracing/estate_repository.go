@@ -0,0 +1,195 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// estateRepository is a tracing decorator of EstateRepository, it starts a span per call.
+// Statements of the call are recorded as its children by the driver registered with RegisterSQLDriver
+type estateRepository struct {
+	next   interfaces.EstateRepository
+	tracer trace.Tracer
+}
+
+func NewEstateRepository(next interfaces.EstateRepository, tp trace.TracerProvider) *estateRepository {
+	return &estateRepository{
+		next:   next,
+		tracer: tp.Tracer(instrumentationName),
+	}
+}
+
+func (r *estateRepository) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	attrs = append(attrs, semconv.DBSystemPostgreSQL, semconv.DBOperation(method))
+	return r.tracer.Start(ctx, "EstateRepository."+method, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+func (r *estateRepository) CreateEstateAndDroneRoute(ctx context.Context, estate *domain.Estate, droneRoutes []domain.DroneRoute, outbox []domain.Event) (err error) {
+	ctx, span := r.start(ctx, "CreateEstateAndDroneRoute", attrTenantID.String(estate.TenantID.String()), attrEstateID.String(estate.ID.String()))
+	defer end(span, &err)
+	return r.next.CreateEstateAndDroneRoute(ctx, estate, droneRoutes, outbox)
+}
+
+func (r *estateRepository) CreateTreeAndUpdateDroneRoute(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, droneRouteAltitude int, tree *domain.Tree, outbox []domain.Event) (change domain.DroneDistanceChange, err error) {
+	ctx, span := r.start(ctx, "CreateTreeAndUpdateDroneRoute", attrTenantID.String(tenantID.String()), attrEstateID.String(estateID.String()), attrTreeID.String(tree.ID.String()))
+	defer end(span, &err)
+	return r.next.CreateTreeAndUpdateDroneRoute(ctx, tenantID, estateID, droneRouteAltitude, tree, outbox)
+}
+
+func (r *estateRepository) GetEstateAndStats(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID) (estate *domain.Estate, stats *domain.EstateStats, err error) {
+	ctx, span := r.start(ctx, "GetEstateAndStats", attrTenantID.String(tenantID.String()), attrEstateID.String(estateID.String()))
+	defer end(span, &err)
+	return r.next.GetEstateAndStats(ctx, tenantID, estateID)
+}
+
+func (r *estateRepository) GetDroneRoutes(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID) (routes []domain.DroneRoute, err error) {
+	ctx, span := r.start(ctx, "GetDroneRoutes", attrTenantID.String(tenantID.String()), attrEstateID.String(estateID.String()))
+	defer end(span, &err)
+	return r.next.GetDroneRoutes(ctx, tenantID, estateID)
+}
+
+func (r *estateRepository) GetEstateAndTree(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, plot domain.Plot) (estate *domain.Estate, tree *domain.Tree, err error) {
+	ctx, span := r.start(ctx, "GetEstateAndTree", attrTenantID.String(tenantID.String()), attrEstateID.String(estateID.String()))
+	defer end(span, &err)
+	return r.next.GetEstateAndTree(ctx, tenantID, estateID, plot)
+}
+
+func (r *estateRepository) GetTree(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, treeID uuid.UUID) (tree *domain.Tree, err error) {
+	ctx, span := r.start(ctx, "GetTree", attrTenantID.String(tenantID.String()), attrEstateID.String(estateID.String()), attrTreeID.String(treeID.String()))
+	defer end(span, &err)
+	return r.next.GetTree(ctx, tenantID, estateID, treeID)
+}
+
+func (r *estateRepository) ListEstates(ctx context.Context, tenantID uuid.UUID, limit int, offset int) (estates []domain.Estate, err error) {
+	ctx, span := r.start(ctx, "ListEstates", attrTenantID.String(tenantID.String()))
+	defer end(span, &err)
+	return r.next.ListEstates(ctx, tenantID, limit, offset)
+}
+
+func (r *estateRepository) ListTrees(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID) (trees []domain.Tree, err error) {
+	ctx, span := r.start(ctx, "ListTrees", attrTenantID.String(tenantID.String()), attrEstateID.String(estateID.String()))
+	defer end(span, &err)
+	return r.next.ListTrees(ctx, tenantID, estateID)
+}
+
+func (r *estateRepository) DeleteEstate(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, outbox []domain.Event) (err error) {
+	ctx, span := r.start(ctx, "DeleteEstate", attrTenantID.String(tenantID.String()), attrEstateID.String(estateID.String()))
+	defer end(span, &err)
+	return r.next.DeleteEstate(ctx, tenantID, estateID, outbox)
+}
+
+func (r *estateRepository) ResizeEstateAndDroneRoute(ctx context.Context, tenantID uuid.UUID, estate *domain.Estate, expectedVersion int, droneRoutes []domain.DroneRoute, outbox []domain.Event) (change domain.DroneDistanceChange, err error) {
+	ctx, span := r.start(ctx, "ResizeEstateAndDroneRoute", attrTenantID.String(tenantID.String()), attrEstateID.String(estate.ID.String()))
+	defer end(span, &err)
+	return r.next.ResizeEstateAndDroneRoute(ctx, tenantID, estate, expectedVersion, droneRoutes, outbox)
+}
+
+func (r *estateRepository) UpdateTreeAndDroneRoute(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, tree *domain.Tree, expectedVersion int, outbox []domain.Event) (change domain.DroneDistanceChange, err error) {
+	ctx, span := r.start(ctx, "UpdateTreeAndDroneRoute", attrTenantID.String(tenantID.String()), attrEstateID.String(estateID.String()), attrTreeID.String(tree.ID.String()))
+	defer end(span, &err)
+	return r.next.UpdateTreeAndDroneRoute(ctx, tenantID, estateID, tree, expectedVersion, outbox)
+}
+
+func (r *estateRepository) ListTreesByEstates(ctx context.Context, tenantID uuid.UUID, estateIDs []uuid.UUID) (trees map[uuid.UUID][]domain.Tree, err error) {
+	ctx, span := r.start(ctx, "ListTreesByEstates", attrTenantID.String(tenantID.String()), attrEstateCount.Int(len(estateIDs)))
+	defer end(span, &err)
+	return r.next.ListTreesByEstates(ctx, tenantID, estateIDs)
+}
+
+func (r *estateRepository) GetEstatesStats(ctx context.Context, tenantID uuid.UUID, estateIDs []uuid.UUID) (stats map[uuid.UUID]domain.EstateStats, err error) {
+	ctx, span := r.start(ctx, "GetEstatesStats", attrTenantID.String(tenantID.String()), attrEstateCount.Int(len(estateIDs)))
+	defer end(span, &err)
+	return r.next.GetEstatesStats(ctx, tenantID, estateIDs)
+}
+
+func (r *estateRepository) GetDroneRoutesByEstates(ctx context.Context, tenantID uuid.UUID, estateIDs []uuid.UUID) (routes map[uuid.UUID][]domain.DroneRoute, err error) {
+	ctx, span := r.start(ctx, "GetDroneRoutesByEstates", attrTenantID.String(tenantID.String()), attrEstateCount.Int(len(estateIDs)))
+	defer end(span, &err)
+	return r.next.GetDroneRoutesByEstates(ctx, tenantID, estateIDs)
+}
+
+func (r *estateRepository) ListTreeMeasurements(ctx context.Context, tenantID uuid.UUID, estateIDs []uuid.UUID, treeIDs []uuid.UUID) (measurements map[uuid.UUID][]domain.TreeMeasurement, err error) {
+	ctx, span := r.start(ctx, "ListTreeMeasurements", attrTenantID.String(tenantID.String()), attrEstateCount.Int(len(estateIDs)), attrTreeCount.Int(len(treeIDs)))
+	defer end(span, &err)
+	return r.next.ListTreeMeasurements(ctx, tenantID, estateIDs, treeIDs)
+}
+
+func (r *estateRepository) GetEstateSnapshot(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID) (snapshot *domain.EstateSnapshot, err error) {
+	ctx, span := r.start(ctx, "GetEstateSnapshot", attrTenantID.String(tenantID.String()), attrEstateID.String(estateID.String()))
+	defer end(span, &err)
+	return r.next.GetEstateSnapshot(ctx, tenantID, estateID)
+}
+
+func (r *estateRepository) ImportEstate(ctx context.Context, snapshot *domain.EstateSnapshot, droneRoutes []domain.DroneRoute, outbox []domain.Event) (err error) {
+	ctx, span := r.start(ctx, "ImportEstate", attrTenantID.String(snapshot.Estate.TenantID.String()), attrEstateID.String(snapshot.Estate.ID.String()), attrTreeCount.Int(len(snapshot.Trees)))
+	defer end(span, &err)
+	return r.next.ImportEstate(ctx, snapshot, droneRoutes, outbox)
+}
+
+func (r *estateRepository) DeleteTreeAndDroneRoute(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, tree *domain.Tree, outbox []domain.Event) (change domain.DroneDistanceChange, err error) {
+	ctx, span := r.start(ctx, "DeleteTreeAndDroneRoute", attrTenantID.String(tenantID.String()), attrEstateID.String(estateID.String()), attrTreeID.String(tree.ID.String()))
+	defer end(span, &err)
+	return r.next.DeleteTreeAndDroneRoute(ctx, tenantID, estateID, tree, outbox)
+}
+
+func (r *estateRepository) PromoteSandbox(ctx context.Context, tenantID uuid.UUID, sandbox *domain.Estate, changes domain.TreeChanges, outbox []domain.Event) (change domain.DroneDistanceChange, err error) {
+	ctx, span := r.start(ctx, "PromoteSandbox", attrTenantID.String(tenantID.String()), attrEstateID.String(sandbox.ID.String()),
+		attrTreeCount.Int(len(changes.Planted)+len(changes.Updated)+len(changes.Removed)))
+	defer end(span, &err)
+	return r.next.PromoteSandbox(ctx, tenantID, sandbox, changes, outbox)
+}
+
+func (r *estateRepository) CreateMission(ctx context.Context, tenantID uuid.UUID, mission *domain.Mission) (err error) {
+	ctx, span := r.start(ctx, "CreateMission", attrTenantID.String(tenantID.String()), attrEstateID.String(mission.EstateID.String()), attrMissionID.String(mission.ID.String()))
+	defer end(span, &err)
+	return r.next.CreateMission(ctx, tenantID, mission)
+}
+
+func (r *estateRepository) ListMissions(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, status *domain.MissionStatus, limit int, offset int) (missions []domain.Mission, err error) {
+	ctx, span := r.start(ctx, "ListMissions", attrTenantID.String(tenantID.String()), attrEstateID.String(estateID.String()))
+	defer end(span, &err)
+	return r.next.ListMissions(ctx, tenantID, estateID, status, limit, offset)
+}
+
+func (r *estateRepository) GetMission(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, missionID uuid.UUID) (mission *domain.Mission, err error) {
+	ctx, span := r.start(ctx, "GetMission", attrTenantID.String(tenantID.String()), attrEstateID.String(estateID.String()), attrMissionID.String(missionID.String()))
+	defer end(span, &err)
+	return r.next.GetMission(ctx, tenantID, estateID, missionID)
+}
+
+func (r *estateRepository) UpdateMission(ctx context.Context, tenantID uuid.UUID, mission *domain.Mission, expectedStatus domain.MissionStatus) (err error) {
+	ctx, span := r.start(ctx, "UpdateMission", attrTenantID.String(tenantID.String()), attrEstateID.String(mission.EstateID.String()), attrMissionID.String(mission.ID.String()))
+	defer end(span, &err)
+	return r.next.UpdateMission(ctx, tenantID, mission, expectedStatus)
+}
+
+func (r *estateRepository) ReplaceMissionTelemetry(ctx context.Context, tenantID uuid.UUID, mission *domain.Mission, samples []domain.TelemetrySample) (err error) {
+	ctx, span := r.start(ctx, "ReplaceMissionTelemetry", attrTenantID.String(tenantID.String()), attrEstateID.String(mission.EstateID.String()),
+		attrMissionID.String(mission.ID.String()), attrSampleCount.Int(len(samples)))
+	defer end(span, &err)
+	return r.next.ReplaceMissionTelemetry(ctx, tenantID, mission, samples)
+}
+
+func (r *estateRepository) GetMissionTelemetry(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, missionID uuid.UUID) (samples []domain.TelemetrySample, err error) {
+	ctx, span := r.start(ctx, "GetMissionTelemetry", attrTenantID.String(tenantID.String()), attrEstateID.String(estateID.String()), attrMissionID.String(missionID.String()))
+	defer end(span, &err)
+	return r.next.GetMissionTelemetry(ctx, tenantID, estateID, missionID)
+}
+
+func (r *estateRepository) ReplaceChargingPads(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID, pads []domain.Plot) (err error) {
+	ctx, span := r.start(ctx, "ReplaceChargingPads", attrTenantID.String(tenantID.String()), attrEstateID.String(estateID.String()), attrPadCount.Int(len(pads)))
+	defer end(span, &err)
+	return r.next.ReplaceChargingPads(ctx, tenantID, estateID, pads)
+}
+
+func (r *estateRepository) ListChargingPads(ctx context.Context, tenantID uuid.UUID, estateID uuid.UUID) (pads []domain.Plot, err error) {
+	ctx, span := r.start(ctx, "ListChargingPads", attrTenantID.String(tenantID.String()), attrEstateID.String(estateID.String()))
+	defer end(span, &err)
+	return r.next.ListChargingPads(ctx, tenantID, estateID)
+}
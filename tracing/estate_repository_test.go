@@ -0,0 +1,288 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_estateRepository(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	tp, recorder := newRecorder()
+	repo := NewEstateRepository(mockRepo, tp)
+	ctx := context.Background()
+	tenantID := uuid.New()
+	estate := &domain.Estate{ID: uuid.New(), TenantID: tenantID, Width: 1, Length: 2}
+	tree := &domain.Tree{ID: uuid.New(), Plot: domain.Plot{Row: 1, Col: 1}, Height: 10}
+	queryErr := errors.New("query error")
+
+	tests := []struct {
+		name     string
+		call     func() error
+		wantName string
+		wantTree bool
+		wantErr  error
+	}{
+		{
+			name: "CreateEstateAndDroneRoute",
+			call: func() error {
+				mockRepo.EXPECT().CreateEstateAndDroneRoute(gomock.Any(), estate, nil, nil).Return(nil)
+				return repo.CreateEstateAndDroneRoute(ctx, estate, nil, nil)
+			},
+			wantName: "EstateRepository.CreateEstateAndDroneRoute",
+		},
+		{
+			name: "CreateTreeAndUpdateDroneRoute",
+			call: func() error {
+				mockRepo.EXPECT().CreateTreeAndUpdateDroneRoute(gomock.Any(), tenantID, estate.ID, 11, tree, nil).Return(domain.DroneDistanceChange{}, domain.ErrorTreeAlreadyExists)
+				_, err := repo.CreateTreeAndUpdateDroneRoute(ctx, tenantID, estate.ID, 11, tree, nil)
+				return err
+			},
+			wantName: "EstateRepository.CreateTreeAndUpdateDroneRoute",
+			wantTree: true,
+			wantErr:  domain.ErrorTreeAlreadyExists,
+		},
+		{
+			name: "GetEstateAndStats",
+			call: func() error {
+				mockRepo.EXPECT().GetEstateAndStats(gomock.Any(), tenantID, estate.ID).Return(nil, nil, queryErr)
+				_, _, err := repo.GetEstateAndStats(ctx, tenantID, estate.ID)
+				return err
+			},
+			wantName: "EstateRepository.GetEstateAndStats",
+			wantErr:  queryErr,
+		},
+		{
+			name: "GetDroneRoutes",
+			call: func() error {
+				mockRepo.EXPECT().GetDroneRoutes(gomock.Any(), tenantID, estate.ID).Return(nil, nil)
+				_, err := repo.GetDroneRoutes(ctx, tenantID, estate.ID)
+				return err
+			},
+			wantName: "EstateRepository.GetDroneRoutes",
+		},
+		{
+			name: "GetEstateAndTree",
+			call: func() error {
+				mockRepo.EXPECT().GetEstateAndTree(gomock.Any(), tenantID, estate.ID, tree.Plot).Return(estate, tree, nil)
+				_, _, err := repo.GetEstateAndTree(ctx, tenantID, estate.ID, tree.Plot)
+				return err
+			},
+			wantName: "EstateRepository.GetEstateAndTree",
+		},
+		{
+			name: "GetTree",
+			call: func() error {
+				mockRepo.EXPECT().GetTree(gomock.Any(), tenantID, estate.ID, tree.ID).Return(tree, nil)
+				_, err := repo.GetTree(ctx, tenantID, estate.ID, tree.ID)
+				return err
+			},
+			wantName: "EstateRepository.GetTree",
+			wantTree: true,
+		},
+		{
+			name: "ResizeEstateAndDroneRoute",
+			call: func() error {
+				mockRepo.EXPECT().ResizeEstateAndDroneRoute(gomock.Any(), tenantID, estate, 1, nil, nil).Return(domain.DroneDistanceChange{}, domain.ErrorVersionMismatch)
+				_, err := repo.ResizeEstateAndDroneRoute(ctx, tenantID, estate, 1, nil, nil)
+				return err
+			},
+			wantName: "EstateRepository.ResizeEstateAndDroneRoute",
+			wantErr:  domain.ErrorVersionMismatch,
+		},
+		{
+			name: "UpdateTreeAndDroneRoute",
+			call: func() error {
+				mockRepo.EXPECT().UpdateTreeAndDroneRoute(gomock.Any(), tenantID, estate.ID, tree, 1, nil).Return(domain.DroneDistanceChange{}, nil)
+				_, err := repo.UpdateTreeAndDroneRoute(ctx, tenantID, estate.ID, tree, 1, nil)
+				return err
+			},
+			wantName: "EstateRepository.UpdateTreeAndDroneRoute",
+			wantTree: true,
+		},
+		{
+			name: "ListTrees",
+			call: func() error {
+				mockRepo.EXPECT().ListTrees(gomock.Any(), tenantID, estate.ID).Return([]domain.Tree{*tree}, nil)
+				_, err := repo.ListTrees(ctx, tenantID, estate.ID)
+				return err
+			},
+			wantName: "EstateRepository.ListTrees",
+		},
+		{
+			name: "DeleteEstate",
+			call: func() error {
+				mockRepo.EXPECT().DeleteEstate(gomock.Any(), tenantID, estate.ID, nil).Return(domain.ErrorEstatesNotFound)
+				return repo.DeleteEstate(ctx, tenantID, estate.ID, nil)
+			},
+			wantName: "EstateRepository.DeleteEstate",
+			wantErr:  domain.ErrorEstatesNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ended := len(recorder.Ended())
+			assert.Equal(t, tt.wantErr, tt.call())
+
+			spans := recorder.Ended()[ended:]
+			if !assert.Len(t, spans, 1) {
+				return
+			}
+			span := spans[0]
+			assert.Equal(t, tt.wantName, span.Name())
+			assert.Equal(t, trace.SpanKindClient, span.SpanKind())
+			assert.Equal(t, "postgresql", attrValue(span, "db.system"))
+			assert.Equal(t, tt.name, attrValue(span, "db.operation"))
+			assert.Equal(t, tenantID.String(), attrValue(span, string(attrTenantID)))
+			assert.Equal(t, estate.ID.String(), attrValue(span, string(attrEstateID)))
+			if tt.wantTree {
+				assert.Equal(t, tree.ID.String(), attrValue(span, string(attrTreeID)))
+			}
+			if tt.wantErr != nil {
+				assert.Equal(t, codes.Error, span.Status().Code)
+			} else {
+				assert.Equal(t, codes.Unset, span.Status().Code)
+			}
+		})
+	}
+}
+
+func Test_estateRepository_Batch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	tp, recorder := newRecorder()
+	repo := NewEstateRepository(mockRepo, tp)
+	ctx := context.Background()
+	tenantID := uuid.New()
+	estateIDs := []uuid.UUID{uuid.New(), uuid.New()}
+	treeIDs := []uuid.UUID{uuid.New()}
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{
+			name: "ListTreesByEstates",
+			call: func() error {
+				mockRepo.EXPECT().ListTreesByEstates(gomock.Any(), tenantID, estateIDs).Return(nil, nil)
+				_, err := repo.ListTreesByEstates(ctx, tenantID, estateIDs)
+				return err
+			},
+		},
+		{
+			name: "GetEstatesStats",
+			call: func() error {
+				mockRepo.EXPECT().GetEstatesStats(gomock.Any(), tenantID, estateIDs).Return(nil, nil)
+				_, err := repo.GetEstatesStats(ctx, tenantID, estateIDs)
+				return err
+			},
+		},
+		{
+			name: "GetDroneRoutesByEstates",
+			call: func() error {
+				mockRepo.EXPECT().GetDroneRoutesByEstates(gomock.Any(), tenantID, estateIDs).Return(nil, nil)
+				_, err := repo.GetDroneRoutesByEstates(ctx, tenantID, estateIDs)
+				return err
+			},
+		},
+		{
+			name: "ListTreeMeasurements",
+			call: func() error {
+				mockRepo.EXPECT().ListTreeMeasurements(gomock.Any(), tenantID, estateIDs, treeIDs).Return(nil, nil)
+				_, err := repo.ListTreeMeasurements(ctx, tenantID, estateIDs, treeIDs)
+				return err
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ended := len(recorder.Ended())
+			assert.NoError(t, tt.call())
+
+			spans := recorder.Ended()[ended:]
+			if assert.Len(t, spans, 1) {
+				assert.Equal(t, "EstateRepository."+tt.name, spans[0].Name())
+				assert.Equal(t, tenantID.String(), attrValue(spans[0], string(attrTenantID)))
+				// batches record their size instead of every estate id
+				assert.Equal(t, "2", attrValue(spans[0], string(attrEstateCount)))
+				assert.Equal(t, "", attrValue(spans[0], string(attrEstateID)))
+			}
+		})
+	}
+}
+
+func Test_estateRepository_ImportEstate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	tp, recorder := newRecorder()
+	repo := NewEstateRepository(mockRepo, tp)
+	ctx := context.Background()
+	snapshot := &domain.EstateSnapshot{
+		Estate: domain.Estate{ID: uuid.New(), TenantID: uuid.New()},
+		Trees:  []domain.Tree{{ID: uuid.New()}},
+	}
+
+	mockRepo.EXPECT().GetEstateSnapshot(gomock.Any(), snapshot.Estate.TenantID, snapshot.Estate.ID).Return(snapshot, nil)
+	_, err := repo.GetEstateSnapshot(ctx, snapshot.Estate.TenantID, snapshot.Estate.ID)
+	assert.NoError(t, err)
+
+	mockRepo.EXPECT().ImportEstate(gomock.Any(), snapshot, nil, nil).Return(domain.ErrorSnapshotConflict)
+	assert.Equal(t, domain.ErrorSnapshotConflict, repo.ImportEstate(ctx, snapshot, nil, nil))
+
+	spans := recorder.Ended()
+	if assert.Len(t, spans, 2) {
+		assert.Equal(t, "EstateRepository.GetEstateSnapshot", spans[0].Name())
+		assert.Equal(t, snapshot.Estate.ID.String(), attrValue(spans[0], string(attrEstateID)))
+		assert.Equal(t, "EstateRepository.ImportEstate", spans[1].Name())
+		assert.Equal(t, snapshot.Estate.TenantID.String(), attrValue(spans[1], string(attrTenantID)))
+		assert.Equal(t, "1", attrValue(spans[1], string(attrTreeCount)))
+		assert.Equal(t, codes.Error, spans[1].Status().Code)
+	}
+}
+
+func Test_estateRepository_PromoteSandbox(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := interfaces.NewMockEstateRepository(ctrl)
+	tp, recorder := newRecorder()
+	repo := NewEstateRepository(mockRepo, tp)
+	ctx := context.Background()
+	tenantID := uuid.New()
+	sandbox := &domain.Estate{ID: uuid.New()}
+	tree := &domain.Tree{ID: uuid.New()}
+	changes := domain.TreeChanges{Planted: []domain.Tree{*tree}, Updated: []domain.Tree{{ID: uuid.New()}}}
+
+	mockRepo.EXPECT().DeleteTreeAndDroneRoute(gomock.Any(), tenantID, sandbox.ID, tree, nil).Return(domain.DroneDistanceChange{}, nil)
+	_, err := repo.DeleteTreeAndDroneRoute(ctx, tenantID, sandbox.ID, tree, nil)
+	assert.NoError(t, err)
+
+	mockRepo.EXPECT().PromoteSandbox(gomock.Any(), tenantID, sandbox, changes, nil).Return(domain.DroneDistanceChange{}, domain.ErrorSandboxStale)
+	_, err = repo.PromoteSandbox(ctx, tenantID, sandbox, changes, nil)
+	assert.Equal(t, domain.ErrorSandboxStale, err)
+
+	spans := recorder.Ended()
+	if assert.Len(t, spans, 2) {
+		assert.Equal(t, "EstateRepository.DeleteTreeAndDroneRoute", spans[0].Name())
+		assert.Equal(t, tree.ID.String(), attrValue(spans[0], string(attrTreeID)))
+		assert.Equal(t, "EstateRepository.PromoteSandbox", spans[1].Name())
+		assert.Equal(t, sandbox.ID.String(), attrValue(spans[1], string(attrEstateID)))
+		assert.Equal(t, "2", attrValue(spans[1], string(attrTreeCount)))
+		assert.Equal(t, codes.Error, spans[1].Status().Code)
+	}
+}
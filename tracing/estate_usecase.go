@@ -0,0 +1,301 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// estateUsecase is a tracing decorator of EstateUsecase, it starts a span per call
+// as child of the request span carried by ctx
+type estateUsecase struct {
+	next   interfaces.EstateUsecase
+	tracer trace.Tracer
+}
+
+func NewEstateUsecase(next interfaces.EstateUsecase, tp trace.TracerProvider) *estateUsecase {
+	return &estateUsecase{
+		next:   next,
+		tracer: tp.Tracer(instrumentationName),
+	}
+}
+
+func (u *estateUsecase) CreateEstate(ctx context.Context, width int, length int) (estate *domain.Estate, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.CreateEstate")
+	defer end(span, &err)
+
+	estate, err = u.next.CreateEstate(ctx, width, length)
+	if err == nil {
+		span.SetAttributes(attrEstateID.String(estate.ID.String()))
+	}
+	return estate, err
+}
+
+func (u *estateUsecase) CreateTree(ctx context.Context, estateID uuid.UUID, plot domain.Plot, height int) (tree *domain.Tree, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.CreateTree", trace.WithAttributes(attrEstateID.String(estateID.String())))
+	defer end(span, &err)
+
+	tree, err = u.next.CreateTree(ctx, estateID, plot, height)
+	if err == nil {
+		span.SetAttributes(attrTreeID.String(tree.ID.String()))
+	}
+	return tree, err
+}
+
+func (u *estateUsecase) GetEstate(ctx context.Context, estateID uuid.UUID) (estate *domain.Estate, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.GetEstate", trace.WithAttributes(attrEstateID.String(estateID.String())))
+	defer end(span, &err)
+	return u.next.GetEstate(ctx, estateID)
+}
+
+func (u *estateUsecase) ListEstates(ctx context.Context, limit int, offset int) (estates []domain.Estate, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.ListEstates")
+	defer end(span, &err)
+	return u.next.ListEstates(ctx, limit, offset)
+}
+
+func (u *estateUsecase) DeleteEstate(ctx context.Context, estateID uuid.UUID) (err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.DeleteEstate", trace.WithAttributes(attrEstateID.String(estateID.String())))
+	defer end(span, &err)
+	return u.next.DeleteEstate(ctx, estateID)
+}
+
+func (u *estateUsecase) ResizeEstate(ctx context.Context, estateID uuid.UUID, width int, length int, expectedVersion int) (estate *domain.Estate, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.ResizeEstate", trace.WithAttributes(attrEstateID.String(estateID.String())))
+	defer end(span, &err)
+	return u.next.ResizeEstate(ctx, estateID, width, length, expectedVersion)
+}
+
+func (u *estateUsecase) GetTree(ctx context.Context, estateID uuid.UUID, treeID uuid.UUID) (tree *domain.Tree, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.GetTree", trace.WithAttributes(
+		attrEstateID.String(estateID.String()),
+		attrTreeID.String(treeID.String()),
+	))
+	defer end(span, &err)
+	return u.next.GetTree(ctx, estateID, treeID)
+}
+
+func (u *estateUsecase) ListTrees(ctx context.Context, estateID uuid.UUID) (trees []domain.Tree, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.ListTrees", trace.WithAttributes(attrEstateID.String(estateID.String())))
+	defer end(span, &err)
+	return u.next.ListTrees(ctx, estateID)
+}
+
+func (u *estateUsecase) UpdateTreeHeight(ctx context.Context, estateID uuid.UUID, treeID uuid.UUID, height int, expectedVersion int) (tree *domain.Tree, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.UpdateTreeHeight", trace.WithAttributes(
+		attrEstateID.String(estateID.String()),
+		attrTreeID.String(treeID.String()),
+	))
+	defer end(span, &err)
+	return u.next.UpdateTreeHeight(ctx, estateID, treeID, height, expectedVersion)
+}
+
+func (u *estateUsecase) GetEstateStats(ctx context.Context, estateID uuid.UUID) (stats *domain.EstateStats, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.GetEstateStats", trace.WithAttributes(attrEstateID.String(estateID.String())))
+	defer end(span, &err)
+	return u.next.GetEstateStats(ctx, estateID)
+}
+
+func (u *estateUsecase) GetDroneDistance(ctx context.Context, estateID uuid.UUID, maxDistance *int, droneID *uuid.UUID) (distance *domain.DroneDistance, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.GetDroneDistance", trace.WithAttributes(attrEstateID.String(estateID.String())))
+	defer end(span, &err)
+	setDroneID(span, droneID)
+	return u.next.GetDroneDistance(ctx, estateID, maxDistance, droneID)
+}
+
+func (u *estateUsecase) GetDroneWaypoints(ctx context.Context, estateID uuid.UUID) (waypoints []domain.DroneWaypoint, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.GetDroneWaypoints", trace.WithAttributes(attrEstateID.String(estateID.String())))
+	defer end(span, &err)
+	return u.next.GetDroneWaypoints(ctx, estateID)
+}
+
+func (u *estateUsecase) GetDroneAltitudeProfile(ctx context.Context, estateID uuid.UUID) (profile *domain.AltitudeProfile, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.GetDroneAltitudeProfile", trace.WithAttributes(attrEstateID.String(estateID.String())))
+	defer end(span, &err)
+	return u.next.GetDroneAltitudeProfile(ctx, estateID)
+}
+
+func (u *estateUsecase) GetDronePlan(ctx context.Context, estateID uuid.UUID, maxDistance *int, droneID *uuid.UUID) (plan *domain.DronePlan, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.GetDronePlan", trace.WithAttributes(attrEstateID.String(estateID.String())))
+	defer end(span, &err)
+	setDroneID(span, droneID)
+	return u.next.GetDronePlan(ctx, estateID, maxDistance, droneID)
+}
+
+// SubscribeEstateEvents span only covers the subscription, not the lifetime of the stream
+func (u *estateUsecase) SubscribeEstateEvents(ctx context.Context, estateID uuid.UUID, lastEventID string) (events <-chan domain.Event, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.SubscribeEstateEvents", trace.WithAttributes(attrEstateID.String(estateID.String())))
+	defer end(span, &err)
+	return u.next.SubscribeEstateEvents(ctx, estateID, lastEventID)
+}
+
+func (u *estateUsecase) ListTreesByEstates(ctx context.Context, estateIDs []uuid.UUID) (trees map[uuid.UUID][]domain.Tree, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.ListTreesByEstates", trace.WithAttributes(attrEstateCount.Int(len(estateIDs))))
+	defer end(span, &err)
+	return u.next.ListTreesByEstates(ctx, estateIDs)
+}
+
+func (u *estateUsecase) GetEstatesStats(ctx context.Context, estateIDs []uuid.UUID) (stats map[uuid.UUID]domain.EstateStats, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.GetEstatesStats", trace.WithAttributes(attrEstateCount.Int(len(estateIDs))))
+	defer end(span, &err)
+	return u.next.GetEstatesStats(ctx, estateIDs)
+}
+
+func (u *estateUsecase) GetDroneDistances(ctx context.Context, estateIDs []uuid.UUID, maxDistance *int) (distances map[uuid.UUID]domain.DroneDistance, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.GetDroneDistances", trace.WithAttributes(attrEstateCount.Int(len(estateIDs))))
+	defer end(span, &err)
+	return u.next.GetDroneDistances(ctx, estateIDs, maxDistance)
+}
+
+func (u *estateUsecase) ListTreeMeasurements(ctx context.Context, estateIDs []uuid.UUID, treeIDs []uuid.UUID) (measurements map[uuid.UUID][]domain.TreeMeasurement, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.ListTreeMeasurements", trace.WithAttributes(
+		attrEstateCount.Int(len(estateIDs)),
+		attrTreeCount.Int(len(treeIDs)),
+	))
+	defer end(span, &err)
+	return u.next.ListTreeMeasurements(ctx, estateIDs, treeIDs)
+}
+
+func (u *estateUsecase) ExportEstate(ctx context.Context, estateID uuid.UUID) (snapshot *domain.EstateSnapshot, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.ExportEstate", trace.WithAttributes(attrEstateID.String(estateID.String())))
+	defer end(span, &err)
+	return u.next.ExportEstate(ctx, estateID)
+}
+
+func (u *estateUsecase) ImportEstate(ctx context.Context, snapshot *domain.EstateSnapshot, keepIDs bool) (estate *domain.Estate, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.ImportEstate", trace.WithAttributes(attrTreeCount.Int(len(snapshot.Trees))))
+	defer end(span, &err)
+
+	estate, err = u.next.ImportEstate(ctx, snapshot, keepIDs)
+	if err == nil {
+		span.SetAttributes(attrEstateID.String(estate.ID.String()))
+	}
+	return estate, err
+}
+
+func (u *estateUsecase) DeleteTree(ctx context.Context, estateID uuid.UUID, treeID uuid.UUID) (err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.DeleteTree", trace.WithAttributes(
+		attrEstateID.String(estateID.String()),
+		attrTreeID.String(treeID.String()),
+	))
+	defer end(span, &err)
+	return u.next.DeleteTree(ctx, estateID, treeID)
+}
+
+// CloneEstate span is on the source estate, the sandbox id is only known once cloned
+func (u *estateUsecase) CloneEstate(ctx context.Context, estateID uuid.UUID) (sandbox *domain.Estate, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.CloneEstate", trace.WithAttributes(attrEstateID.String(estateID.String())))
+	defer end(span, &err)
+
+	sandbox, err = u.next.CloneEstate(ctx, estateID)
+	if err == nil {
+		span.SetAttributes(attrSandboxID.String(sandbox.ID.String()))
+	}
+	return sandbox, err
+}
+
+func (u *estateUsecase) DiffSandbox(ctx context.Context, sandboxID uuid.UUID) (diff *domain.SandboxDiff, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.DiffSandbox", trace.WithAttributes(attrSandboxID.String(sandboxID.String())))
+	defer end(span, &err)
+	return u.next.DiffSandbox(ctx, sandboxID)
+}
+
+func (u *estateUsecase) PromoteSandbox(ctx context.Context, sandboxID uuid.UUID) (estate *domain.Estate, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.PromoteSandbox", trace.WithAttributes(attrSandboxID.String(sandboxID.String())))
+	defer end(span, &err)
+
+	estate, err = u.next.PromoteSandbox(ctx, sandboxID)
+	if err == nil {
+		span.SetAttributes(attrEstateID.String(estate.ID.String()))
+	}
+	return estate, err
+}
+
+func (u *estateUsecase) CreateMission(ctx context.Context, estateID uuid.UUID, maxDistance *int, droneID *uuid.UUID, drone string, pilot string) (mission *domain.Mission, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.CreateMission", trace.WithAttributes(attrEstateID.String(estateID.String())))
+	defer end(span, &err)
+	setDroneID(span, droneID)
+
+	mission, err = u.next.CreateMission(ctx, estateID, maxDistance, droneID, drone, pilot)
+	if err == nil {
+		span.SetAttributes(attrMissionID.String(mission.ID.String()))
+	}
+	return mission, err
+}
+
+func (u *estateUsecase) ListMissions(ctx context.Context, estateID uuid.UUID, status *domain.MissionStatus, limit int, offset int) (missions []domain.Mission, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.ListMissions", trace.WithAttributes(attrEstateID.String(estateID.String())))
+	defer end(span, &err)
+	return u.next.ListMissions(ctx, estateID, status, limit, offset)
+}
+
+func (u *estateUsecase) GetMission(ctx context.Context, estateID uuid.UUID, missionID uuid.UUID) (mission *domain.Mission, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.GetMission", trace.WithAttributes(
+		attrEstateID.String(estateID.String()),
+		attrMissionID.String(missionID.String()),
+	))
+	defer end(span, &err)
+	return u.next.GetMission(ctx, estateID, missionID)
+}
+
+func (u *estateUsecase) UpdateMission(ctx context.Context, estateID uuid.UUID, missionID uuid.UUID, update domain.MissionUpdate) (mission *domain.Mission, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.UpdateMission", trace.WithAttributes(
+		attrEstateID.String(estateID.String()),
+		attrMissionID.String(missionID.String()),
+	))
+	defer end(span, &err)
+	return u.next.UpdateMission(ctx, estateID, missionID, update)
+}
+
+func (u *estateUsecase) RecordTelemetry(ctx context.Context, estateID uuid.UUID, missionID uuid.UUID, samples []domain.TelemetrySample) (report *domain.TelemetryReport, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.RecordTelemetry", trace.WithAttributes(
+		attrEstateID.String(estateID.String()),
+		attrMissionID.String(missionID.String()),
+		attrSampleCount.Int(len(samples)),
+	))
+	defer end(span, &err)
+	return u.next.RecordTelemetry(ctx, estateID, missionID, samples)
+}
+
+func (u *estateUsecase) GetTelemetryReport(ctx context.Context, estateID uuid.UUID, missionID uuid.UUID) (report *domain.TelemetryReport, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.GetTelemetryReport", trace.WithAttributes(
+		attrEstateID.String(estateID.String()),
+		attrMissionID.String(missionID.String()),
+	))
+	defer end(span, &err)
+	return u.next.GetTelemetryReport(ctx, estateID, missionID)
+}
+
+func (u *estateUsecase) ReplaceChargingPads(ctx context.Context, estateID uuid.UUID, pads []domain.Plot) (replaced []domain.Plot, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.ReplaceChargingPads", trace.WithAttributes(
+		attrEstateID.String(estateID.String()),
+		attrPadCount.Int(len(pads)),
+	))
+	defer end(span, &err)
+	return u.next.ReplaceChargingPads(ctx, estateID, pads)
+}
+
+func (u *estateUsecase) ListChargingPads(ctx context.Context, estateID uuid.UUID) (pads []domain.Plot, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.ListChargingPads", trace.WithAttributes(attrEstateID.String(estateID.String())))
+	defer end(span, &err)
+	return u.next.ListChargingPads(ctx, estateID)
+}
+
+func (u *estateUsecase) GetSortiePlan(ctx context.Context, estateID uuid.UUID, droneID uuid.UUID) (plan *domain.SortiePlan, err error) {
+	ctx, span := u.tracer.Start(ctx, "EstateUsecase.GetSortiePlan", trace.WithAttributes(
+		attrEstateID.String(estateID.String()),
+		attrDroneID.String(droneID.String()),
+	))
+	defer end(span, &err)
+	return u.next.GetSortiePlan(ctx, estateID, droneID)
+}
+
+// setDroneID record the drone a plan or mission is computed for, plans without drone have none
+func setDroneID(span trace.Span, droneID *uuid.UUID) {
+	if droneID != nil {
+		span.SetAttributes(attrDroneID.String(droneID.String()))
+	}
+}
@@ -0,0 +1,404 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/SawitProRecruitment/EstateService/core/interfaces"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_estateUsecase_CreateEstate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	tp, recorder := newRecorder()
+	u := NewEstateUsecase(mockUsecase, tp)
+	estate := &domain.Estate{ID: uuid.New(), Width: 1, Length: 2}
+
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "request")
+	mockUsecase.EXPECT().CreateEstate(gomock.Any(), 1, 2).DoAndReturn(func(ctx context.Context, width int, length int) (*domain.Estate, error) {
+		// the decorated usecase runs inside the usecase span
+		assert.True(t, trace.SpanContextFromContext(ctx).IsValid())
+		assert.NotEqual(t, parent.SpanContext().SpanID(), trace.SpanContextFromContext(ctx).SpanID())
+		return estate, nil
+	})
+
+	got, err := u.CreateEstate(ctx, 1, 2)
+	parent.End()
+	assert.NoError(t, err)
+	assert.Equal(t, estate, got)
+
+	spans := recorder.Ended()
+	if assert.Len(t, spans, 2) {
+		assert.Equal(t, "EstateUsecase.CreateEstate", spans[0].Name())
+		assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+		assert.Equal(t, estate.ID.String(), attrValue(spans[0], string(attrEstateID)))
+		assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	}
+}
+
+func Test_estateUsecase_Errors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	tp, recorder := newRecorder()
+	u := NewEstateUsecase(mockUsecase, tp)
+	ctx := context.Background()
+	estateID := uuid.New()
+	treeID := uuid.New()
+
+	tests := []struct {
+		name     string
+		call     func() error
+		wantName string
+		wantErr  error
+	}{
+		{
+			name: "CreateTree",
+			call: func() error {
+				mockUsecase.EXPECT().CreateTree(gomock.Any(), estateID, domain.Plot{Row: 1, Col: 1}, 10).Return(nil, domain.ErrorTreeAlreadyExists)
+				_, err := u.CreateTree(ctx, estateID, domain.Plot{Row: 1, Col: 1}, 10)
+				return err
+			},
+			wantName: "EstateUsecase.CreateTree",
+			wantErr:  domain.ErrorTreeAlreadyExists,
+		},
+		{
+			name: "GetEstate",
+			call: func() error {
+				mockUsecase.EXPECT().GetEstate(gomock.Any(), estateID).Return(nil, domain.ErrorEstatesNotFound)
+				_, err := u.GetEstate(ctx, estateID)
+				return err
+			},
+			wantName: "EstateUsecase.GetEstate",
+			wantErr:  domain.ErrorEstatesNotFound,
+		},
+		{
+			name: "ResizeEstate",
+			call: func() error {
+				mockUsecase.EXPECT().ResizeEstate(gomock.Any(), estateID, 3, 4, 1).Return(nil, domain.ErrorVersionMismatch)
+				_, err := u.ResizeEstate(ctx, estateID, 3, 4, 1)
+				return err
+			},
+			wantName: "EstateUsecase.ResizeEstate",
+			wantErr:  domain.ErrorVersionMismatch,
+		},
+		{
+			name: "GetTree",
+			call: func() error {
+				mockUsecase.EXPECT().GetTree(gomock.Any(), estateID, treeID).Return(nil, domain.ErrorTreeNotFound)
+				_, err := u.GetTree(ctx, estateID, treeID)
+				return err
+			},
+			wantName: "EstateUsecase.GetTree",
+			wantErr:  domain.ErrorTreeNotFound,
+		},
+		{
+			name: "UpdateTreeHeight",
+			call: func() error {
+				mockUsecase.EXPECT().UpdateTreeHeight(gomock.Any(), estateID, treeID, 5, 1).Return(nil, domain.ErrorVersionMismatch)
+				_, err := u.UpdateTreeHeight(ctx, estateID, treeID, 5, 1)
+				return err
+			},
+			wantName: "EstateUsecase.UpdateTreeHeight",
+			wantErr:  domain.ErrorVersionMismatch,
+		},
+		{
+			name: "GetEstateStats",
+			call: func() error {
+				mockUsecase.EXPECT().GetEstateStats(gomock.Any(), estateID).Return(nil, domain.ErrorEstatesNotFound)
+				_, err := u.GetEstateStats(ctx, estateID)
+				return err
+			},
+			wantName: "EstateUsecase.GetEstateStats",
+			wantErr:  domain.ErrorEstatesNotFound,
+		},
+		{
+			name: "GetDroneDistance",
+			call: func() error {
+				mockUsecase.EXPECT().GetDroneDistance(gomock.Any(), estateID, nil, nil).Return(nil, domain.ErrorEstatesNotFound)
+				_, err := u.GetDroneDistance(ctx, estateID, nil, nil)
+				return err
+			},
+			wantName: "EstateUsecase.GetDroneDistance",
+			wantErr:  domain.ErrorEstatesNotFound,
+		},
+		{
+			name: "GetDroneWaypoints",
+			call: func() error {
+				mockUsecase.EXPECT().GetDroneWaypoints(gomock.Any(), estateID).Return(nil, domain.ErrorEstatesNotFound)
+				_, err := u.GetDroneWaypoints(ctx, estateID)
+				return err
+			},
+			wantName: "EstateUsecase.GetDroneWaypoints",
+			wantErr:  domain.ErrorEstatesNotFound,
+		},
+		{
+			name: "SubscribeEstateEvents",
+			call: func() error {
+				mockUsecase.EXPECT().SubscribeEstateEvents(gomock.Any(), estateID, "").Return(nil, domain.ErrorEventStreamUnavailable)
+				_, err := u.SubscribeEstateEvents(ctx, estateID, "")
+				return err
+			},
+			wantName: "EstateUsecase.SubscribeEstateEvents",
+			wantErr:  domain.ErrorEventStreamUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ended := len(recorder.Ended())
+			assert.Equal(t, tt.wantErr, tt.call())
+
+			spans := recorder.Ended()[ended:]
+			if assert.Len(t, spans, 1) {
+				assert.Equal(t, tt.wantName, spans[0].Name())
+				assert.Equal(t, estateID.String(), attrValue(spans[0], string(attrEstateID)))
+				assert.Equal(t, codes.Error, spans[0].Status().Code)
+				assert.Equal(t, tt.wantErr.Error(), spans[0].Status().Description)
+			}
+		})
+	}
+}
+
+func Test_estateUsecase_Batch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	tp, recorder := newRecorder()
+	u := NewEstateUsecase(mockUsecase, tp)
+	ctx := context.Background()
+	estateIDs := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	treeIDs := []uuid.UUID{uuid.New()}
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{
+			name: "ListTreesByEstates",
+			call: func() error {
+				mockUsecase.EXPECT().ListTreesByEstates(gomock.Any(), estateIDs).Return(nil, domain.ErrorForbidden)
+				_, err := u.ListTreesByEstates(ctx, estateIDs)
+				return err
+			},
+		},
+		{
+			name: "GetEstatesStats",
+			call: func() error {
+				mockUsecase.EXPECT().GetEstatesStats(gomock.Any(), estateIDs).Return(nil, domain.ErrorForbidden)
+				_, err := u.GetEstatesStats(ctx, estateIDs)
+				return err
+			},
+		},
+		{
+			name: "GetDroneDistances",
+			call: func() error {
+				mockUsecase.EXPECT().GetDroneDistances(gomock.Any(), estateIDs, nil).Return(nil, domain.ErrorForbidden)
+				_, err := u.GetDroneDistances(ctx, estateIDs, nil)
+				return err
+			},
+		},
+		{
+			name: "ListTreeMeasurements",
+			call: func() error {
+				mockUsecase.EXPECT().ListTreeMeasurements(gomock.Any(), estateIDs, treeIDs).Return(nil, domain.ErrorForbidden)
+				_, err := u.ListTreeMeasurements(ctx, estateIDs, treeIDs)
+				return err
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ended := len(recorder.Ended())
+			assert.Equal(t, domain.ErrorForbidden, tt.call())
+
+			spans := recorder.Ended()[ended:]
+			if assert.Len(t, spans, 1) {
+				assert.Equal(t, "EstateUsecase."+tt.name, spans[0].Name())
+				assert.Equal(t, "3", attrValue(spans[0], string(attrEstateCount)))
+				assert.Equal(t, codes.Error, spans[0].Status().Code)
+			}
+		})
+	}
+}
+
+func Test_estateUsecase_ImportEstate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	tp, recorder := newRecorder()
+	u := NewEstateUsecase(mockUsecase, tp)
+	ctx := context.Background()
+	snapshot := &domain.EstateSnapshot{Trees: []domain.Tree{{ID: uuid.New()}, {ID: uuid.New()}}}
+	estate := &domain.Estate{ID: uuid.New(), Width: 1, Length: 2}
+
+	mockUsecase.EXPECT().ImportEstate(gomock.Any(), snapshot, false).Return(estate, nil)
+	_, err := u.ImportEstate(ctx, snapshot, false)
+	assert.NoError(t, err)
+
+	mockUsecase.EXPECT().ImportEstate(gomock.Any(), snapshot, true).Return(nil, domain.ErrorSnapshotConflict)
+	_, err = u.ImportEstate(ctx, snapshot, true)
+	assert.Equal(t, domain.ErrorSnapshotConflict, err)
+
+	spans := recorder.Ended()
+	if assert.Len(t, spans, 2) {
+		assert.Equal(t, "EstateUsecase.ImportEstate", spans[0].Name())
+		assert.Equal(t, "2", attrValue(spans[0], string(attrTreeCount)))
+		// the id of the imported estate is only known once it is created
+		assert.Equal(t, estate.ID.String(), attrValue(spans[0], string(attrEstateID)))
+		assert.Equal(t, "", attrValue(spans[1], string(attrEstateID)))
+		assert.Equal(t, codes.Error, spans[1].Status().Code)
+	}
+}
+
+func Test_estateUsecase_Sandbox(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	tp, recorder := newRecorder()
+	u := NewEstateUsecase(mockUsecase, tp)
+	ctx := context.Background()
+	sourceID := uuid.New()
+	sandbox := &domain.Estate{ID: uuid.New(), Sandbox: &domain.Sandbox{SourceEstateID: &sourceID}}
+
+	mockUsecase.EXPECT().CloneEstate(gomock.Any(), sourceID).Return(sandbox, nil)
+	_, err := u.CloneEstate(ctx, sourceID)
+	assert.NoError(t, err)
+
+	mockUsecase.EXPECT().DiffSandbox(gomock.Any(), sandbox.ID).Return(&domain.SandboxDiff{SourceEstateID: sourceID}, nil)
+	_, err = u.DiffSandbox(ctx, sandbox.ID)
+	assert.NoError(t, err)
+
+	mockUsecase.EXPECT().PromoteSandbox(gomock.Any(), sandbox.ID).Return(nil, domain.ErrorSandboxStale)
+	_, err = u.PromoteSandbox(ctx, sandbox.ID)
+	assert.Equal(t, domain.ErrorSandboxStale, err)
+
+	spans := recorder.Ended()
+	if assert.Len(t, spans, 3) {
+		assert.Equal(t, "EstateUsecase.CloneEstate", spans[0].Name())
+		assert.Equal(t, sourceID.String(), attrValue(spans[0], string(attrEstateID)))
+		assert.Equal(t, sandbox.ID.String(), attrValue(spans[0], string(attrSandboxID)))
+		assert.Equal(t, "EstateUsecase.DiffSandbox", spans[1].Name())
+		assert.Equal(t, sandbox.ID.String(), attrValue(spans[1], string(attrSandboxID)))
+		assert.Equal(t, "EstateUsecase.PromoteSandbox", spans[2].Name())
+		assert.Equal(t, "", attrValue(spans[2], string(attrEstateID)))
+		assert.Equal(t, codes.Error, spans[2].Status().Code)
+	}
+}
+
+func Test_estateUsecase_Mission(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	tp, recorder := newRecorder()
+	u := NewEstateUsecase(mockUsecase, tp)
+	ctx := context.Background()
+	estateID := uuid.New()
+	mission := &domain.Mission{ID: uuid.New(), EstateID: estateID}
+	completed := domain.MissionCompleted
+	update := domain.MissionUpdate{Status: &completed}
+
+	mockUsecase.EXPECT().CreateMission(gomock.Any(), estateID, nil, nil, "drone-7", "").Return(mission, nil)
+	_, err := u.CreateMission(ctx, estateID, nil, nil, "drone-7", "")
+	assert.NoError(t, err)
+
+	mockUsecase.EXPECT().UpdateMission(gomock.Any(), estateID, mission.ID, update).Return(nil, domain.ErrorMissionInvalidTransition)
+	_, err = u.UpdateMission(ctx, estateID, mission.ID, update)
+	assert.Equal(t, domain.ErrorMissionInvalidTransition, err)
+
+	spans := recorder.Ended()
+	if assert.Len(t, spans, 2) {
+		assert.Equal(t, "EstateUsecase.CreateMission", spans[0].Name())
+		assert.Equal(t, mission.ID.String(), attrValue(spans[0], string(attrMissionID)))
+		assert.Equal(t, "EstateUsecase.UpdateMission", spans[1].Name())
+		assert.Equal(t, estateID.String(), attrValue(spans[1], string(attrEstateID)))
+		assert.Equal(t, codes.Error, spans[1].Status().Code)
+	}
+}
+
+func Test_estateUsecase_RecordTelemetry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	tp, recorder := newRecorder()
+	u := NewEstateUsecase(mockUsecase, tp)
+	estateID := uuid.New()
+	missionID := uuid.New()
+	samples := []domain.TelemetrySample{{X: 0, Y: 0, Altitude: 1}, {X: 10, Y: 0, Altitude: 1}}
+
+	mockUsecase.EXPECT().RecordTelemetry(gomock.Any(), estateID, missionID, samples).Return(&domain.TelemetryReport{Samples: 2}, nil)
+	_, err := u.RecordTelemetry(context.Background(), estateID, missionID, samples)
+	assert.NoError(t, err)
+
+	spans := recorder.Ended()
+	if assert.Len(t, spans, 1) {
+		assert.Equal(t, "EstateUsecase.RecordTelemetry", spans[0].Name())
+		assert.Equal(t, missionID.String(), attrValue(spans[0], string(attrMissionID)))
+		assert.Equal(t, "2", attrValue(spans[0], string(attrSampleCount)))
+	}
+}
+
+func Test_estateUsecase_DronePlan(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	tp, recorder := newRecorder()
+	u := NewEstateUsecase(mockUsecase, tp)
+	estateID := uuid.New()
+	droneID := uuid.New()
+
+	mockUsecase.EXPECT().GetDronePlan(gomock.Any(), estateID, nil, &droneID).Return(nil, domain.ErrorDroneUnavailable)
+	_, err := u.GetDronePlan(context.Background(), estateID, nil, &droneID)
+	assert.Equal(t, domain.ErrorDroneUnavailable, err)
+
+	spans := recorder.Ended()
+	if assert.Len(t, spans, 1) {
+		assert.Equal(t, "EstateUsecase.GetDronePlan", spans[0].Name())
+		assert.Equal(t, droneID.String(), attrValue(spans[0], string(attrDroneID)))
+		assert.Equal(t, codes.Error, spans[0].Status().Code)
+	}
+}
+
+func Test_estateUsecase_ChargingPads(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := interfaces.NewMockEstateUsecase(ctrl)
+	tp, recorder := newRecorder()
+	u := NewEstateUsecase(mockUsecase, tp)
+	ctx := context.Background()
+	estateID := uuid.New()
+	droneID := uuid.New()
+	pads := []domain.Plot{{Row: 1, Col: 1}, {Row: 1, Col: 5}}
+
+	mockUsecase.EXPECT().ReplaceChargingPads(gomock.Any(), estateID, pads).Return(pads, nil)
+	_, err := u.ReplaceChargingPads(ctx, estateID, pads)
+	assert.NoError(t, err)
+
+	mockUsecase.EXPECT().GetSortiePlan(gomock.Any(), estateID, droneID).Return(nil, domain.ErrorPlotOutOfPadRange)
+	_, err = u.GetSortiePlan(ctx, estateID, droneID)
+	assert.Equal(t, domain.ErrorPlotOutOfPadRange, err)
+
+	spans := recorder.Ended()
+	if assert.Len(t, spans, 2) {
+		assert.Equal(t, "EstateUsecase.ReplaceChargingPads", spans[0].Name())
+		assert.Equal(t, "2", attrValue(spans[0], string(attrPadCount)))
+		assert.Equal(t, "EstateUsecase.GetSortiePlan", spans[1].Name())
+		assert.Equal(t, droneID.String(), attrValue(spans[1], string(attrDroneID)))
+		assert.Equal(t, codes.Error, spans[1].Status().Code)
+	}
+}
@@ -0,0 +1,13 @@
+package tracing
+
+import (
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/stats"
+)
+
+// GRPCServerHandler start a server span per rpc, continuing the trace of the caller traceparent metadata.
+// It is registered with grpc.StatsHandler so spans also cover authentication interceptors
+func GRPCServerHandler(tp trace.TracerProvider) stats.Handler {
+	return otelgrpc.NewServerHandler(otelgrpc.WithTracerProvider(tp), otelgrpc.WithPropagators(Propagator))
+}
@@ -0,0 +1,58 @@
+package tracing
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// unmatchedRoute name spans of requests without route, so unknown paths do not create new span names
+const unmatchedRoute = "unmatched"
+
+// HTTPMiddleware start a server span per request, continuing the trace of the caller traceparent header.
+// Like the metrics middleware, errors are rendered here through the echo error handler so their status code is known
+func HTTPMiddleware(tp trace.TracerProvider) echo.MiddlewareFunc {
+	tracer := tp.Tracer(instrumentationName)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			req := ctx.Request()
+
+			route := ctx.Path()
+			if route == "" || route == "/*" {
+				route = unmatchedRoute
+			}
+
+			parent := Propagator.Extract(req.Context(), propagation.HeaderCarrier(req.Header))
+			spanCtx, span := tracer.Start(parent, req.Method+" "+route,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					semconv.HTTPRequestMethodKey.String(req.Method),
+					semconv.HTTPRoute(route),
+					semconv.URLPath(req.URL.Path),
+				),
+			)
+			defer span.End()
+			ctx.SetRequest(req.WithContext(spanCtx))
+
+			err := next(ctx)
+			if err != nil {
+				ctx.Error(err)
+			}
+
+			status := ctx.Response().Status
+			span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+			if id := ctx.Response().Header().Get(echo.HeaderXRequestID); id != "" {
+				span.SetAttributes(attrRequestID.String(id))
+			}
+			if status >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, http.StatusText(status))
+			}
+			return nil
+		}
+	}
+}
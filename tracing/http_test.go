@@ -0,0 +1,103 @@
+package tracing
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestHTTPMiddleware(t *testing.T) {
+	tp, recorder := newRecorder()
+	e := echo.New()
+	e.Use(HTTPMiddleware(tp))
+	e.Use(middleware.RequestID())
+
+	var handlerSpan trace.SpanContext
+	e.GET("/estate/:id", func(ctx echo.Context) error {
+		handlerSpan = trace.SpanContextFromContext(ctx.Request().Context())
+		if ctx.Param("id") == "broken" {
+			return errors.New("boom")
+		}
+		return ctx.NoContent(http.StatusOK)
+	})
+
+	tests := []struct {
+		name        string
+		path        string
+		traceparent string
+		wantName    string
+		wantStatus  string
+		wantCode    codes.Code
+	}{
+		{
+			name:       "new trace",
+			path:       "/estate/1",
+			wantName:   "GET /estate/:id",
+			wantStatus: "200",
+			wantCode:   codes.Unset,
+		},
+		{
+			name:        "continue caller trace",
+			path:        "/estate/1",
+			traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			wantName:    "GET /estate/:id",
+			wantStatus:  "200",
+			wantCode:    codes.Unset,
+		},
+		{
+			name:       "server error",
+			path:       "/estate/broken",
+			wantName:   "GET /estate/:id",
+			wantStatus: "500",
+			wantCode:   codes.Error,
+		},
+		{
+			name:       "unmatched route",
+			path:       "/unknown",
+			wantName:   "GET " + unmatchedRoute,
+			wantStatus: "404",
+			wantCode:   codes.Unset,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ended := len(recorder.Ended())
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.traceparent != "" {
+				req.Header.Set("traceparent", tt.traceparent)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			spans := recorder.Ended()[ended:]
+			if !assert.Len(t, spans, 1) {
+				return
+			}
+			span := spans[0]
+			assert.Equal(t, tt.wantName, span.Name())
+			assert.Equal(t, trace.SpanKindServer, span.SpanKind())
+			assert.Equal(t, tt.wantStatus, attrValue(span, "http.response.status_code"))
+			assert.Equal(t, tt.path, attrValue(span, "url.path"))
+			assert.Equal(t, rec.Header().Get(echo.HeaderXRequestID), attrValue(span, string(attrRequestID)))
+			assert.Equal(t, tt.wantCode, span.Status().Code)
+
+			if tt.traceparent != "" {
+				assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext().TraceID().String())
+				assert.Equal(t, "00f067aa0ba902b7", span.Parent().SpanID().String())
+				assert.True(t, span.Parent().IsRemote())
+			} else {
+				assert.False(t, span.Parent().IsValid())
+			}
+			if tt.wantName != "GET "+unmatchedRoute {
+				assert.Equal(t, span.SpanContext().SpanID(), handlerSpan.SpanID())
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/XSAM/otelsql"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	serviceName = "estate-service"
+
+	// instrumentationName name the tracer of every span created by this package
+	instrumentationName = "github.com/SawitProRecruitment/EstateService/tracing"
+)
+
+// span attributes of the estate service
+const (
+	attrEstateID  = attribute.Key("estate.id")
+	attrTreeID    = attribute.Key("tree.id")
+	attrTenantID  = attribute.Key("tenant.id")
+	attrRequestID = attribute.Key("request.id")
+	attrSandboxID = attribute.Key("sandbox.id")
+	attrMissionID = attribute.Key("mission.id")
+	attrDroneID   = attribute.Key("drone.id")
+
+	// batch calls record how many estates and trees they cover instead of their ids
+	attrEstateCount = attribute.Key("estate.count")
+	attrTreeCount   = attribute.Key("tree.count")
+	attrSampleCount = attribute.Key("telemetry.sample.count")
+	attrPadCount    = attribute.Key("charging_pad.count")
+)
+
+const (
+	ExporterNone   = "none"
+	ExporterStdout = "stdout"
+	ExporterOTLP   = "otlp"
+)
+
+// Propagator read and write W3C traceparent and baggage headers
+var Propagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+
+// NewTracerProvider create a provider exporting spans to exporter, spans are only propagated with ExporterNone.
+// The otlp exporter is configured by the standard OTEL_EXPORTER_OTLP_* variables
+func NewTracerProvider(ctx context.Context, exporter string) (*sdktrace.TracerProvider, error) {
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName))),
+	}
+
+	switch exporter {
+	case ExporterNone, "":
+	case ExporterStdout:
+		spanExporter, err := stdouttrace.New()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, sdktrace.WithBatcher(spanExporter))
+	case ExporterOTLP:
+		spanExporter, err := otlptracehttp.New(ctx)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, sdktrace.WithBatcher(spanExporter))
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q", exporter)
+	}
+
+	return sdktrace.NewTracerProvider(opts...), nil
+}
+
+// RegisterSQLDriver register a wrapper of the postgres driver recording a span with the statement of every query,
+// the returned name is used in place of the postgres driver name
+func RegisterSQLDriver(tp trace.TracerProvider) (string, error) {
+	return registerSQLDriver("postgres", tp)
+}
+
+func registerSQLDriver(driverName string, tp trace.TracerProvider) (string, error) {
+	return otelsql.Register(driverName,
+		otelsql.WithTracerProvider(tp),
+		otelsql.WithAttributes(semconv.DBSystemPostgreSQL),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{
+			OmitConnResetSession: true,
+			OmitRows:             true,
+		}),
+	)
+}
+
+// end record err on span and end it.
+// err is a pointer to the named result since it is only known once the deferred call runs
+func end(span trace.Span, err *error) {
+	if *err != nil {
+		span.RecordError(*err)
+		span.SetStatus(codes.Error, (*err).Error())
+	}
+	span.End()
+}
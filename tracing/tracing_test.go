@@ -0,0 +1,89 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+)
+
+// newRecorder return a provider whose ended spans are kept by the recorder
+func newRecorder() (*sdktrace.TracerProvider, *tracetest.SpanRecorder) {
+	recorder := tracetest.NewSpanRecorder()
+	return sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)), recorder
+}
+
+// attrValue return the value of key in the attributes of span, empty when it is not set
+func attrValue(span sdktrace.ReadOnlySpan, key string) string {
+	for _, attr := range span.Attributes() {
+		if string(attr.Key) == key {
+			return attr.Value.Emit()
+		}
+	}
+	return ""
+}
+
+func TestNewTracerProvider(t *testing.T) {
+	tests := []struct {
+		name     string
+		exporter string
+		wantErr  bool
+	}{
+		{name: "default", exporter: ""},
+		{name: "none", exporter: ExporterNone},
+		{name: "stdout", exporter: ExporterStdout},
+		{name: "otlp", exporter: ExporterOTLP},
+		{name: "unknown", exporter: "jaeger", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tp, err := NewTracerProvider(context.Background(), tt.exporter)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, tp)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NoError(t, tp.Shutdown(context.Background()))
+		})
+	}
+}
+
+func TestRegisterSQLDriver(t *testing.T) {
+	_, mock, err := sqlmock.NewWithDSN("tracing-dsn")
+	assert.NoError(t, err)
+
+	tp, recorder := newRecorder()
+	driverName, err := registerSQLDriver("sqlmock", tp)
+	assert.NoError(t, err)
+
+	db, err := sql.Open(driverName, "tracing-dsn")
+	assert.NoError(t, err)
+	defer db.Close()
+
+	query := "SELECT id FROM estates WHERE id = $1"
+	mock.ExpectQuery("SELECT id FROM estates").WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+	var id int
+	assert.NoError(t, db.QueryRowContext(ctx, query, 1).Scan(&id))
+	parent.End()
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	var statement sdktrace.ReadOnlySpan
+	for _, span := range recorder.Ended() {
+		if attrValue(span, string(semconv.DBStatementKey)) != "" {
+			statement = span
+		}
+	}
+	if assert.NotNil(t, statement) {
+		assert.Equal(t, query, attrValue(statement, string(semconv.DBStatementKey)))
+		assert.Equal(t, "postgresql", attrValue(statement, string(semconv.DBSystemKey)))
+		assert.Equal(t, parent.SpanContext().TraceID(), statement.SpanContext().TraceID())
+	}
+}
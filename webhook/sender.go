@@ -0,0 +1,110 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+)
+
+const (
+	HeaderEvent     = "X-Estate-Event"
+	HeaderEventID   = "X-Estate-Event-Id"
+	HeaderDelivery  = "X-Estate-Delivery"
+	HeaderSignature = "X-Estate-Signature"
+)
+
+type payload struct {
+	ID         uuid.UUID      `json:"id"`
+	Type       string         `json:"type"`
+	EstateID   uuid.UUID      `json:"estate_id"`
+	OccurredAt time.Time      `json:"occurred_at"`
+	Data       map[string]any `json:"data,omitempty"`
+}
+
+// NewClient return the http client to send webhooks with. Subscription urls are validated when created,
+// but their host may resolve to a private address at any time later, so every dialed address is checked again
+func NewClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   dialPublicOnly,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+	// a proxy would be the dialed address instead of the subscription host
+	transport.Proxy = nil
+	return &http.Client{Timeout: timeout, Transport: transport}
+}
+
+// dialPublicOnly refuse connections to loopback, private and link-local addresses, it runs after name resolution
+func dialPublicOnly(_ string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !domain.IsPublicIP(ip) {
+		return domain.ErrorWebhookPrivateHost
+	}
+	return nil
+}
+
+// sender is an outbound adapter delivering webhook as signed json POST request
+type sender struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+func NewSender(client *http.Client) *sender {
+	return &sender{
+		client: client,
+		now:    time.Now,
+	}
+}
+
+// Send post event to subscription url, any non 2xx response is considered failed delivery
+func (s *sender) Send(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	body, err := json.Marshal(payload{
+		ID:         delivery.Event.ID,
+		Type:       string(delivery.Event.Type),
+		EstateID:   delivery.Event.EstateID,
+		OccurredAt: delivery.Event.OccurredAt,
+		Data:       delivery.Event.DataMap(),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Subscription.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "EstateService-Webhook/1.0")
+	req.Header.Set(HeaderEvent, string(delivery.Event.Type))
+	req.Header.Set(HeaderEventID, delivery.Event.ID.String())
+	req.Header.Set(HeaderDelivery, delivery.ID.String())
+	req.Header.Set(HeaderSignature, domain.SignWebhookPayload(delivery.Subscription.Secret, s.now().Unix(), body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected webhook response status %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/SawitProRecruitment/EstateService/core/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSender_Send(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	estateID := uuid.New()
+	event := domain.NewEvent(domain.EventTreePlanted, uuid.New(), estateID, domain.TreeEventData{
+		TreeID: uuid.New(),
+		Plot:   domain.Plot{Row: 2, Col: 3},
+		Height: 10,
+	})
+
+	tests := []struct {
+		name       string
+		statusCode int
+		wantError  bool
+	}{
+		{
+			name:       "Success",
+			statusCode: http.StatusNoContent,
+			wantError:  false,
+		},
+		{
+			name:       "Non 2xx response",
+			statusCode: http.StatusInternalServerError,
+			wantError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+
+				assert.Equal(t, "tree.planted", r.Header.Get(HeaderEvent))
+				assert.Equal(t, event.ID.String(), r.Header.Get(HeaderEventID))
+				assert.Equal(t, domain.SignWebhookPayload("secret", now.Unix(), body), r.Header.Get(HeaderSignature))
+
+				var got map[string]any
+				assert.NoError(t, json.Unmarshal(body, &got))
+				assert.Equal(t, estateID.String(), got["estate_id"])
+				assert.Equal(t, float64(3), got["data"].(map[string]any)["x"])
+
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer srv.Close()
+
+			s := NewSender(srv.Client())
+			s.now = func() time.Time { return now }
+
+			err := s.Send(context.Background(), &domain.WebhookDelivery{
+				ID:           uuid.New(),
+				Subscription: domain.WebhookSubscription{URL: srv.URL, Secret: "secret"},
+				Event:        event,
+			})
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	err := NewSender(NewClient(time.Second)).Send(context.Background(), &domain.WebhookDelivery{
+		ID:           uuid.New(),
+		Subscription: domain.WebhookSubscription{URL: srv.URL, Secret: "secret"},
+		Event:        domain.NewEvent(domain.EventEstateCreated, uuid.New(), uuid.New(), nil),
+	})
+	assert.True(t, errors.Is(err, domain.ErrorWebhookPrivateHost), err)
+}